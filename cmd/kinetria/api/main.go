@@ -1,6 +1,8 @@
 package main
 
 import (
+	_ "time/tzdata" // user timezones must load on images without zoneinfo
+
 	"github.com/go-chi/chi/v5"
 	"github.com/go-playground/validator/v10"
 	"go.opentelemetry.io/otel"
@@ -8,19 +10,27 @@ import (
 	"go.uber.org/fx"
 
 	_ "github.com/kinetria/kinetria-back/docs"
+	domainachievements "github.com/kinetria/kinetria-back/internal/kinetria/domain/achievements"
 	domainauth "github.com/kinetria/kinetria-back/internal/kinetria/domain/auth"
 	domaindashboard "github.com/kinetria/kinetria-back/internal/kinetria/domain/dashboard"
 	domainexercises "github.com/kinetria/kinetria-back/internal/kinetria/domain/exercises"
+	domaingoals "github.com/kinetria/kinetria-back/internal/kinetria/domain/goals"
+	domainmeasurements "github.com/kinetria/kinetria-back/internal/kinetria/domain/measurements"
+	domainmedia "github.com/kinetria/kinetria-back/internal/kinetria/domain/media"
 	"github.com/kinetria/kinetria-back/internal/kinetria/domain/ports"
 	domainprofile "github.com/kinetria/kinetria-back/internal/kinetria/domain/profile"
+	domainreadiness "github.com/kinetria/kinetria-back/internal/kinetria/domain/readiness"
 	domainsessions "github.com/kinetria/kinetria-back/internal/kinetria/domain/sessions"
 	domainstatistics "github.com/kinetria/kinetria-back/internal/kinetria/domain/statistics"
+	domainstreaks "github.com/kinetria/kinetria-back/internal/kinetria/domain/streaks"
 	domainworkouts "github.com/kinetria/kinetria-back/internal/kinetria/domain/workouts"
 	gatewayauth "github.com/kinetria/kinetria-back/internal/kinetria/gateways/auth"
 	"github.com/kinetria/kinetria-back/internal/kinetria/gateways/config"
 	httpgateway "github.com/kinetria/kinetria-back/internal/kinetria/gateways/http"
 	healthhandler "github.com/kinetria/kinetria-back/internal/kinetria/gateways/http/health"
+	"github.com/kinetria/kinetria-back/internal/kinetria/gateways/jobs"
 	"github.com/kinetria/kinetria-back/internal/kinetria/gateways/repositories"
+	"github.com/kinetria/kinetria-back/internal/kinetria/gateways/storage"
 )
 
 // @title Kinetria API
@@ -64,6 +74,10 @@ func main() {
 			repositories.NewDatabasePool,
 			repositories.NewSQLDB,
 			repositories.NewMigrator,
+			fx.Annotate(
+				repositories.NewTransactor,
+				fx.As(new(ports.Transactor)),
+			),
 
 			// JWT - Provide JWTManager as both concrete type and interface
 			func(cfg config.Config) *gatewayauth.JWTManager {
@@ -89,6 +103,9 @@ func main() {
 			fx.Annotate(
 				repositories.NewSessionRepository,
 				fx.As(new(ports.SessionRepository)),
+				fx.As(new(ports.SessionEffortRepository)),
+				fx.As(new(ports.SessionSummaryRepository)),
+				fx.As(new(ports.SessionLogRepository)),
 			),
 			fx.Annotate(
 				repositories.NewSetRecordRepository,
@@ -97,6 +114,8 @@ func main() {
 			fx.Annotate(
 				repositories.NewExerciseRepository,
 				fx.As(new(ports.ExerciseRepository)),
+				fx.As(new(ports.ExerciseLibraryRepository)),
+				fx.As(new(ports.ExerciseUsageRepository)),
 			),
 			fx.Annotate(
 				repositories.NewWorkoutRepository,
@@ -106,6 +125,50 @@ func main() {
 				repositories.NewAuditLogRepository,
 				fx.As(new(ports.AuditLogRepository)),
 			),
+			fx.Annotate(
+				repositories.NewMediaRepository,
+				fx.As(new(ports.MediaRepository)),
+			),
+			fx.Annotate(
+				repositories.NewFavoriteRepository,
+				fx.As(new(ports.FavoriteRepository)),
+			),
+			fx.Annotate(
+				repositories.NewBodyMeasurementRepository,
+				fx.As(new(ports.BodyMeasurementRepository)),
+				fx.As(new(ports.BodyWeightRepository)),
+			),
+			fx.Annotate(
+				repositories.NewStatsRollupRepository,
+				fx.As(new(ports.StatsRollupRepository)),
+			),
+			fx.Annotate(
+				repositories.NewGoalRepository,
+				fx.As(new(ports.GoalRepository)),
+			),
+			fx.Annotate(
+				repositories.NewAchievementRepository,
+				fx.As(new(ports.AchievementRepository)),
+			),
+			fx.Annotate(
+				repositories.NewStreakRepository,
+				fx.As(new(ports.StreakRepository)),
+			),
+			fx.Annotate(
+				repositories.NewReadinessRepository,
+				fx.As(new(ports.ReadinessRepository)),
+			),
+			fx.Annotate(
+				repositories.NewSyncRepository,
+				fx.As(new(ports.SyncRepository)),
+			),
+			fx.Annotate(
+				repositories.NewIdempotencyRepository,
+				fx.As(new(ports.IdempotencyRepository)),
+			),
+
+			// Media storage (local filesystem or S3-compatible, per MEDIA_STORAGE_DRIVER)
+			storage.NewMediaStorage,
 
 			// Use cases
 			func(userRepo ports.UserRepository, refreshTokenRepo ports.RefreshTokenRepository, tokenMgr ports.TokenManager, cfg config.Config) *domainauth.RegisterUC {
@@ -122,11 +185,18 @@ func main() {
 			domainsessions.NewRecordSetUseCase,
 			domainsessions.NewFinishSessionUseCase,
 			domainsessions.NewAbandonSessionUseCase,
+			domainsessions.NewGetSessionSummaryUC,
+			domainsessions.NewGetSessionFeedbackUC,
+			domainsessions.NewUpdateSessionFeedbackUC,
+			domainsessions.NewLogSessionUC,
+			domainsessions.NewUpdateSessionUC,
+			domainsessions.NewSyncSessionsUC,
 			domainworkouts.NewListWorkoutsUC,
 			domainworkouts.NewGetWorkoutUC,
 			domainworkouts.NewCreateWorkoutUC,
 			domainworkouts.NewUpdateWorkoutUC,
 			domainworkouts.NewDeleteWorkoutUC,
+			domainworkouts.NewSetWorkoutFavoriteUC,
 			domaindashboard.NewGetUserProfileUC,
 			domaindashboard.NewGetTodayWorkoutUC,
 			domaindashboard.NewGetWeekProgressUC,
@@ -138,12 +208,86 @@ func main() {
 			domainexercises.NewListExercisesUC,
 			domainexercises.NewGetExerciseUC,
 			domainexercises.NewGetExerciseHistoryUC,
+			domainexercises.NewGetRecentExercisesUC,
+			domainexercises.NewSetExerciseFavoriteUC,
+			domainexercises.NewRefreshExercisePopularityUC,
+			domainexercises.NewImportExercisesUC,
+			domainexercises.NewExportExercisesUC,
 
 			// Statistics use cases
 			domainstatistics.NewGetOverviewUC,
 			domainstatistics.NewGetProgressionUC,
 			domainstatistics.NewGetPersonalRecordsUC,
 			domainstatistics.NewGetFrequencyUC,
+			func(setRecordRepo ports.SetRecordRepository, userRepo ports.UserRepository, cfg config.Config) *domainstatistics.GetMuscleVolumeUC {
+				return domainstatistics.NewGetMuscleVolumeUC(setRecordRepo, userRepo, domainstatistics.VolumeLandmarks{
+					MinSets: cfg.MuscleVolumeMinSets,
+					MaxSets: cfg.MuscleVolumeMaxSets,
+				})
+			},
+			domainstatistics.NewGetRelativeStrengthUC,
+			domainstatistics.NewGetTrainingLoadUC,
+			domainstatistics.NewGetProgressInsightsUC,
+			domainstatistics.NewGetHeatmapUC,
+			domainstatistics.NewGetRecapUC,
+			domainstatistics.NewGetWellnessUC,
+
+			// Body measurement use cases
+			domainmeasurements.NewCreateMeasurementUC,
+			domainmeasurements.NewGetMeasurementUC,
+			domainmeasurements.NewListMeasurementsUC,
+			domainmeasurements.NewUpdateMeasurementUC,
+			domainmeasurements.NewDeleteMeasurementUC,
+			domainmeasurements.NewGetMeasurementTrendUC,
+			domainmeasurements.NewGetGoalWeightUC,
+			domainmeasurements.NewSetGoalWeightUC,
+			domainmeasurements.NewDeleteGoalWeightUC,
+
+			// Goal use cases
+			domaingoals.NewCreateGoalUC,
+			domaingoals.NewGetGoalUC,
+			domaingoals.NewListGoalsUC,
+			domaingoals.NewUpdateGoalUC,
+			domaingoals.NewDeleteGoalUC,
+			// Evaluated when sessions finish, are logged or corrected and when weigh-ins are written
+			fx.Annotate(
+				domaingoals.NewEvaluateGoalsUC,
+				fx.As(new(ports.GoalEvaluator)),
+			),
+
+			// Achievement use cases; the evaluator runs when sessions finish and sets are recorded
+			func(cfg config.Config) ([]domainachievements.Rule, error) {
+				return domainachievements.LoadRules(cfg.AchievementRulesPath)
+			},
+			fx.Annotate(
+				domainachievements.NewEvaluateAchievementsUC,
+				fx.As(new(ports.AchievementEvaluator)),
+			),
+			domainachievements.NewListAchievementsUC,
+
+			// Streak use cases; the overview reads the streak through ports.StreakCalculator
+			domainstreaks.NewGetStreakUC,
+			func(getStreakUC *domainstreaks.GetStreakUC) ports.StreakCalculator {
+				return getStreakUC
+			},
+			domainstreaks.NewListRestDaysUC,
+			domainstreaks.NewPlanRestDayUC,
+			domainstreaks.NewDeleteRestDayUC,
+
+			// Readiness use cases; sessions record check-ins through ports.ReadinessRecorder
+			domainreadiness.NewCheckInUC,
+			func(checkInUC *domainreadiness.CheckInUC) ports.ReadinessRecorder {
+				return checkInUC
+			},
+			domainreadiness.NewListCheckInsUC,
+
+			// Media use cases
+			func(mediaStorage ports.MediaStorage, mediaRepo ports.MediaRepository, exerciseRepo ports.ExerciseRepository, workoutRepo ports.WorkoutRepository, cfg config.Config) *domainmedia.UploadMediaUC {
+				return domainmedia.NewUploadMediaUC(mediaStorage, mediaRepo, exerciseRepo, workoutRepo, domainmedia.UploadLimits{
+					MaxImageBytes: cfg.MediaMaxImageBytes,
+					MaxVideoBytes: cfg.MediaMaxVideoBytes,
+				})
+			},
 
 			// Validator and HTTP
 			validator.New,
@@ -155,6 +299,19 @@ func main() {
 			httpgateway.NewProfileHandler,
 			httpgateway.NewExercisesHandler,
 			httpgateway.NewStatisticsHandler,
+			httpgateway.NewMediaHandler,
+			httpgateway.NewMeasurementsHandler,
+			httpgateway.NewGoalsHandler,
+			httpgateway.NewAchievementsHandler,
+			httpgateway.NewStreaksHandler,
+			httpgateway.NewReadinessHandler,
+			httpgateway.NewSyncHandler,
+			func(importExercisesUC *domainexercises.ImportExercisesUC, exportExercisesUC *domainexercises.ExportExercisesUC, cfg config.Config) *httpgateway.ExerciseLibraryHandler {
+				return httpgateway.NewExerciseLibraryHandler(importExercisesUC, exportExercisesUC, cfg.AdminAPIKey)
+			},
+			func(idempotencyRepo ports.IdempotencyRepository, cfg config.Config) *httpgateway.IdempotencyKeys {
+				return httpgateway.NewIdempotencyKeys(idempotencyRepo, cfg.IdempotencyKeyTTL)
+			},
 			httpgateway.NewServiceRouter,
 			chi.NewRouter,
 		),
@@ -163,5 +320,7 @@ func main() {
 		}),
 		fx.Invoke(repositories.RunMigrations),
 		fx.Invoke(httpgateway.StartHTTPServer),
+		fx.Invoke(jobs.StartExercisePopularityRefresher),
+		fx.Invoke(jobs.StartIdempotencyKeyPurger),
 	).Run()
 }
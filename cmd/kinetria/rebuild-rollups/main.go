@@ -0,0 +1,62 @@
+// Command rebuild-rollups recomputes the statistics rollups (user_daily_stats and
+// user_weekly_stats) from the raw sessions and sets. Run it once after deploying the
+// rollups to backfill history, or with -user to repair a single user.
+//
+// It reads the same environment as the API.
+package main
+
+import (
+	"context"
+	"flag"
+	"fmt"
+	"log"
+	_ "time/tzdata" // user timezones must load on images without zoneinfo
+
+	"github.com/google/uuid"
+
+	domainstatistics "github.com/kinetria/kinetria-back/internal/kinetria/domain/statistics"
+	"github.com/kinetria/kinetria-back/internal/kinetria/gateways/config"
+	"github.com/kinetria/kinetria-back/internal/kinetria/gateways/repositories"
+)
+
+func main() {
+	userFlag := flag.String("user", "", "rebuild only the user with this ID")
+	flag.Parse()
+
+	if err := run(context.Background(), *userFlag); err != nil {
+		log.Fatal(err)
+	}
+}
+
+func run(ctx context.Context, user string) error {
+	var userID *uuid.UUID
+	if user != "" {
+		id, err := uuid.Parse(user)
+		if err != nil {
+			return fmt.Errorf("invalid -user: %w", err)
+		}
+		userID = &id
+	}
+
+	cfg, err := config.ParseConfigFromEnv()
+	if err != nil {
+		return err
+	}
+	db, err := repositories.NewSQLDB(cfg)
+	if err != nil {
+		return err
+	}
+	defer db.Close()
+
+	if err := repositories.NewMigrator(db).Run(ctx); err != nil {
+		return fmt.Errorf("migrations failed: %w", err)
+	}
+
+	uc := domainstatistics.NewRebuildStatsRollupsUC(repositories.NewStatsRollupRepository(db))
+	rebuilt, err := uc.Execute(ctx, userID)
+	if err != nil {
+		return fmt.Errorf("stats rollups rebuild failed after %d users: %w", rebuilt, err)
+	}
+	log.Printf("stats rollups rebuilt for %d users", rebuilt)
+	return nil
+}
@@ -0,0 +1,124 @@
+package achievements
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/kinetria/kinetria-back/internal/kinetria/domain/entities"
+	"github.com/kinetria/kinetria-back/internal/kinetria/domain/ports"
+	"github.com/kinetria/kinetria-back/internal/kinetria/domain/vos"
+)
+
+// engine evaluates the rules of the catalog against the user's counters and awards
+// the achievements whose threshold is reached.
+type engine struct {
+	achievementRepo ports.AchievementRepository
+	streaks         ports.StreakCalculator
+	rules           []Rule
+}
+
+// evaluation is the outcome of one pass over the catalog.
+type evaluation struct {
+	progress []AchievementProgress
+	awarded  []entities.UserAchievement
+}
+
+// evaluate returns the progress toward each rule in catalog order. With award, it first
+// awards every rule the user now meets and did not have yet; without it, nothing is written.
+func (e *engine) evaluate(ctx context.Context, userID uuid.UUID, now time.Time, award bool) (*evaluation, error) {
+	owned, err := e.achievementRepo.ListByUser(ctx, userID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list achievements: %w", err)
+	}
+	awardedAt := make(map[string]time.Time, len(owned))
+	for _, a := range owned {
+		awardedAt[a.Code] = a.AwardedAt
+	}
+
+	values, err := e.metrics(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	out := &evaluation{progress: make([]AchievementProgress, 0, len(e.rules))}
+	for _, rule := range e.rules {
+		value := values[rule.Metric]
+		at, unlocked := awardedAt[rule.Code]
+		if award && !unlocked && value >= rule.Threshold {
+			ok, err := e.achievementRepo.Award(ctx, userID, rule.Code, now)
+			if err != nil {
+				return nil, fmt.Errorf("failed to award achievement: %w", err)
+			}
+			// Outra avaliação concorrente pode ter concedido primeiro; só ela o reporta
+			if ok {
+				out.awarded = append(out.awarded, entities.UserAchievement{UserID: userID, Code: rule.Code, AwardedAt: now})
+			}
+			at, unlocked = now, true
+		}
+
+		p := AchievementProgress{
+			Rule:            rule,
+			Value:           value,
+			ProgressPercent: progressPercent(value, rule.Threshold, unlocked),
+			Unlocked:        unlocked,
+		}
+		if unlocked {
+			p.AwardedAt = &at
+		}
+		out.progress = append(out.progress, p)
+	}
+	return out, nil
+}
+
+// metrics returns the user's value for each metric. The streak is read only when a rule
+// needs it.
+func (e *engine) metrics(ctx context.Context, userID uuid.UUID) (map[vos.AchievementMetric]int64, error) {
+	stats, err := e.achievementRepo.GetStats(ctx, userID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get achievement stats: %w", err)
+	}
+	values := map[vos.AchievementMetric]int64{
+		vos.AchievementMetricSessions:    int64(stats.Sessions),
+		vos.AchievementMetricSets:        int64(stats.Sets),
+		vos.AchievementMetricReps:        stats.Reps,
+		vos.AchievementMetricVolume:      stats.Volume,
+		vos.AchievementMetricHeaviestSet: int64(stats.HeaviestSet),
+		vos.AchievementMetricExercises:   int64(stats.Exercises),
+	}
+
+	for _, rule := range e.rules {
+		if rule.Metric != vos.AchievementMetricStreakDays {
+			continue
+		}
+		streak, err := e.streaks.Execute(ctx, userID)
+		if err != nil {
+			return nil, fmt.Errorf("failed to get streak: %w", err)
+		}
+		values[vos.AchievementMetricStreakDays] = streakDays(streak)
+		break
+	}
+	return values, nil
+}
+
+// streakDays returns the user's longest streak, as shown with their streak, in days: in
+// weekly mode each week of the streak counts as 7 days.
+func streakDays(streak *ports.StreakStatus) int64 {
+	if streak.Mode == vos.StreakModeWeekly {
+		return int64(streak.Longest) * 7
+	}
+	return int64(streak.Longest)
+}
+
+// progressPercent is the share of the threshold covered by value, clamped to 0–100 and
+// rounded to one decimal. Unlocked achievements are always complete.
+func progressPercent(value, threshold int64, unlocked bool) float64 {
+	if unlocked {
+		return 100
+	}
+	pct := float64(value) / float64(threshold) * 100
+	pct = math.Max(0, math.Min(100, pct))
+	return math.Round(pct*10) / 10
+}
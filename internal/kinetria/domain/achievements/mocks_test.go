@@ -0,0 +1,61 @@
+package achievements_test
+
+import (
+	"context"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/kinetria/kinetria-back/internal/kinetria/domain/entities"
+	"github.com/kinetria/kinetria-back/internal/kinetria/domain/ports"
+)
+
+// mockAchievementRepo is an in-memory ports.AchievementRepository.
+type mockAchievementRepo struct {
+	stats   ports.AchievementStats
+	awarded []entities.UserAchievement
+	err     error
+}
+
+func (m *mockAchievementRepo) GetStats(_ context.Context, _ uuid.UUID) (*ports.AchievementStats, error) {
+	if m.err != nil {
+		return nil, m.err
+	}
+	s := m.stats
+	return &s, nil
+}
+
+func (m *mockAchievementRepo) ListByUser(_ context.Context, userID uuid.UUID) ([]entities.UserAchievement, error) {
+	if m.err != nil {
+		return nil, m.err
+	}
+	var out []entities.UserAchievement
+	for _, a := range m.awarded {
+		if a.UserID == userID {
+			out = append(out, a)
+		}
+	}
+	return out, nil
+}
+
+func (m *mockAchievementRepo) Award(_ context.Context, userID uuid.UUID, code string, awardedAt time.Time) (bool, error) {
+	if m.err != nil {
+		return false, m.err
+	}
+	for _, a := range m.awarded {
+		if a.UserID == userID && a.Code == code {
+			return false, nil
+		}
+	}
+	m.awarded = append(m.awarded, entities.UserAchievement{UserID: userID, Code: code, AwardedAt: awardedAt})
+	return true, nil
+}
+
+// mockStreakCalculator is a ports.StreakCalculator returning a fixed streak.
+type mockStreakCalculator struct {
+	status ports.StreakStatus
+}
+
+func (m *mockStreakCalculator) Execute(_ context.Context, _ uuid.UUID) (*ports.StreakStatus, error) {
+	s := m.status
+	return &s, nil
+}
@@ -0,0 +1,83 @@
+package achievements
+
+import (
+	_ "embed"
+	"encoding/json"
+	"fmt"
+	"os"
+	"regexp"
+
+	domainerrors "github.com/kinetria/kinetria-back/internal/kinetria/domain/errors"
+	"github.com/kinetria/kinetria-back/internal/kinetria/domain/vos"
+)
+
+// rulesJSON is the built-in achievements catalog, used unless another is configured (see
+// LoadRules). New badges over an existing metric only need a new entry in the catalog;
+// codes are stored with awarded badges and must not be renamed.
+//
+//go:embed rules.json
+var rulesJSON []byte
+
+var ruleCodePattern = regexp.MustCompile(`^[a-z0-9_]{1,64}$`)
+
+// Rule awards an achievement once the user's Metric reaches Threshold, in the metric's
+// canonical unit (grams for heaviest_set, grams × reps for volume, counts otherwise).
+type Rule struct {
+	Code        string                `json:"code"`
+	Name        string                `json:"name"`
+	Description string                `json:"description"`
+	Metric      vos.AchievementMetric `json:"metric"`
+	Threshold   int64                 `json:"threshold"`
+}
+
+// ParseRules decodes and validates a catalog: codes must be unique lowercase
+// identifiers, every rule needs a name, a known metric and a positive threshold.
+func ParseRules(data []byte) ([]Rule, error) {
+	var rules []Rule
+	if err := json.Unmarshal(data, &rules); err != nil {
+		return nil, fmt.Errorf("%w: invalid achievement rules: %v", domainerrors.ErrMalformedParameters, err)
+	}
+	seen := make(map[string]bool, len(rules))
+	for _, r := range rules {
+		if !ruleCodePattern.MatchString(r.Code) {
+			return nil, fmt.Errorf("%w: invalid achievement code %q", domainerrors.ErrMalformedParameters, r.Code)
+		}
+		if seen[r.Code] {
+			return nil, fmt.Errorf("%w: duplicate achievement code %q", domainerrors.ErrMalformedParameters, r.Code)
+		}
+		seen[r.Code] = true
+		if r.Name == "" {
+			return nil, fmt.Errorf("%w: achievement %q has no name", domainerrors.ErrMalformedParameters, r.Code)
+		}
+		if err := r.Metric.Validate(); err != nil {
+			return nil, err
+		}
+		if r.Threshold <= 0 {
+			return nil, fmt.Errorf("%w: achievement %q needs a positive threshold", domainerrors.ErrMalformedParameters, r.Code)
+		}
+	}
+	return rules, nil
+}
+
+// DefaultRules returns the built-in catalog. It panics if rules.json is invalid, which
+// the package tests rule out.
+func DefaultRules() []Rule {
+	rules, err := ParseRules(rulesJSON)
+	if err != nil {
+		panic(err)
+	}
+	return rules
+}
+
+// LoadRules returns the catalog in the JSON file at path, or the built-in one when path is
+// empty, so that badges can be added or changed without a new build.
+func LoadRules(path string) ([]Rule, error) {
+	if path == "" {
+		return DefaultRules(), nil
+	}
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read achievement rules: %w", err)
+	}
+	return ParseRules(data)
+}
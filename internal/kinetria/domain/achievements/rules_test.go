@@ -0,0 +1,81 @@
+package achievements_test
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/kinetria/kinetria-back/internal/kinetria/domain/achievements"
+	domainerrors "github.com/kinetria/kinetria-back/internal/kinetria/domain/errors"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestDefaultRules(t *testing.T) {
+	rules := achievements.DefaultRules()
+	require.NotEmpty(t, rules)
+
+	codes := make(map[string]bool, len(rules))
+	for _, r := range rules {
+		codes[r.Code] = true
+	}
+	for _, code := range []string{"first_workout", "sessions_100", "streak_30"} {
+		assert.True(t, codes[code], code)
+	}
+}
+
+func TestParseRules(t *testing.T) {
+	valid := `[{"code":"a","name":"A","description":"","metric":"sessions","threshold":1}]`
+	rules, err := achievements.ParseRules([]byte(valid))
+	require.NoError(t, err)
+	require.Len(t, rules, 1)
+	assert.Equal(t, int64(1), rules[0].Threshold)
+
+	tests := []struct {
+		name string
+		data string
+	}{
+		{"malformed_json", `{`},
+		{"empty_code", `[{"code":"","name":"A","metric":"sessions","threshold":1}]`},
+		{"invalid_code", `[{"code":"First Workout","name":"A","metric":"sessions","threshold":1}]`},
+		{"duplicate_code", `[{"code":"a","name":"A","metric":"sessions","threshold":1},{"code":"a","name":"B","metric":"sets","threshold":1}]`},
+		{"missing_name", `[{"code":"a","metric":"sessions","threshold":1}]`},
+		{"unknown_metric", `[{"code":"a","name":"A","metric":"calories","threshold":1}]`},
+		{"zero_threshold", `[{"code":"a","name":"A","metric":"sessions","threshold":0}]`},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := achievements.ParseRules([]byte(tt.data))
+			assert.ErrorIs(t, err, domainerrors.ErrMalformedParameters)
+		})
+	}
+}
+
+func TestLoadRules(t *testing.T) {
+	t.Run("built_in_without_path", func(t *testing.T) {
+		rules, err := achievements.LoadRules("")
+		require.NoError(t, err)
+		assert.Equal(t, achievements.DefaultRules(), rules)
+	})
+
+	t.Run("catalog_file", func(t *testing.T) {
+		path := filepath.Join(t.TempDir(), "rules.json")
+		require.NoError(t, os.WriteFile(path, []byte(`[{"code":"sessions_1000","name":"Millennium","metric":"sessions","threshold":1000}]`), 0o600))
+		rules, err := achievements.LoadRules(path)
+		require.NoError(t, err)
+		require.Len(t, rules, 1)
+		assert.Equal(t, "sessions_1000", rules[0].Code)
+	})
+
+	t.Run("invalid_catalog_file", func(t *testing.T) {
+		path := filepath.Join(t.TempDir(), "rules.json")
+		require.NoError(t, os.WriteFile(path, []byte(`[{"code":"a"}]`), 0o600))
+		_, err := achievements.LoadRules(path)
+		assert.ErrorIs(t, err, domainerrors.ErrMalformedParameters)
+	})
+
+	t.Run("missing_file", func(t *testing.T) {
+		_, err := achievements.LoadRules(filepath.Join(t.TempDir(), "missing.json"))
+		assert.Error(t, err)
+	})
+}
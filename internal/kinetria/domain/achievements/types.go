@@ -0,0 +1,13 @@
+package achievements
+
+import "time"
+
+// AchievementProgress is a catalog rule with the user's progress toward it. Value is in
+// the canonical unit of the rule's metric.
+type AchievementProgress struct {
+	Rule            Rule
+	Value           int64
+	ProgressPercent float64 // 0–100, arredondado a uma casa
+	Unlocked        bool
+	AwardedAt       *time.Time
+}
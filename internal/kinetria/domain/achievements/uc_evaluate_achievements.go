@@ -0,0 +1,40 @@
+package achievements
+
+import (
+	"context"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/kinetria/kinetria-back/internal/kinetria/domain/entities"
+	"github.com/kinetria/kinetria-back/internal/kinetria/domain/ports"
+)
+
+// EvaluateAchievementsUC awards the achievements the user has just earned. It runs after
+// a session is finished or a set is recorded.
+type EvaluateAchievementsUC struct {
+	engine *engine
+}
+
+// NewEvaluateAchievementsUC creates a new EvaluateAchievementsUC with the rules of the catalog.
+func NewEvaluateAchievementsUC(
+	achievementRepo ports.AchievementRepository,
+	streaks ports.StreakCalculator,
+	rules []Rule,
+) *EvaluateAchievementsUC {
+	return &EvaluateAchievementsUC{
+		engine: &engine{
+			achievementRepo: achievementRepo,
+			streaks:         streaks,
+			rules:           rules,
+		},
+	}
+}
+
+// Execute returns the achievements awarded by this evaluation, empty if none.
+func (uc *EvaluateAchievementsUC) Execute(ctx context.Context, userID uuid.UUID) ([]entities.UserAchievement, error) {
+	result, err := uc.engine.evaluate(ctx, userID, time.Now().UTC(), true)
+	if err != nil {
+		return nil, err
+	}
+	return result.awarded, nil
+}
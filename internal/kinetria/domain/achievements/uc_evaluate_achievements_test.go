@@ -0,0 +1,126 @@
+package achievements_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/kinetria/kinetria-back/internal/kinetria/domain/achievements"
+	"github.com/kinetria/kinetria-back/internal/kinetria/domain/entities"
+	"github.com/kinetria/kinetria-back/internal/kinetria/domain/ports"
+	"github.com/kinetria/kinetria-back/internal/kinetria/domain/vos"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func codes(list []entities.UserAchievement) []string {
+	out := make([]string, 0, len(list))
+	for _, a := range list {
+		out = append(out, a.Code)
+	}
+	return out
+}
+
+func TestEvaluateAchievementsUC_Execute(t *testing.T) {
+	userID := uuid.New()
+
+	t.Run("awards_first_workout_once", func(t *testing.T) {
+		repo := &mockAchievementRepo{}
+		repo.stats.Sessions = 1
+		uc := achievements.NewEvaluateAchievementsUC(repo, &mockStreakCalculator{}, achievements.DefaultRules())
+
+		awarded, err := uc.Execute(context.Background(), userID)
+		require.NoError(t, err)
+		assert.Equal(t, []string{"first_workout"}, codes(awarded))
+
+		awarded, err = uc.Execute(context.Background(), userID)
+		require.NoError(t, err)
+		assert.Empty(t, awarded)
+		assert.Len(t, repo.awarded, 1)
+	})
+
+	t.Run("awards_streak_from_the_user_streak", func(t *testing.T) {
+		repo := &mockAchievementRepo{}
+		repo.stats.Sessions = 30
+		streaks := &mockStreakCalculator{status: ports.StreakStatus{Mode: vos.StreakModeDaily, Current: 2, Longest: 30}}
+		uc := achievements.NewEvaluateAchievementsUC(repo, streaks, achievements.DefaultRules())
+
+		awarded, err := uc.Execute(context.Background(), userID)
+		require.NoError(t, err)
+		assert.Subset(t, codes(awarded), []string{"first_workout", "sessions_10", "streak_7", "streak_30"})
+		assert.NotContains(t, codes(awarded), "sessions_50")
+	})
+
+	t.Run("short_streak", func(t *testing.T) {
+		repo := &mockAchievementRepo{}
+		streaks := &mockStreakCalculator{status: ports.StreakStatus{Mode: vos.StreakModeDaily, Longest: 5}}
+		uc := achievements.NewEvaluateAchievementsUC(repo, streaks, achievements.DefaultRules())
+
+		awarded, err := uc.Execute(context.Background(), userID)
+		require.NoError(t, err)
+		assert.NotContains(t, codes(awarded), "streak_7")
+	})
+
+	t.Run("weekly_streak_counts_seven_days_a_week", func(t *testing.T) {
+		repo := &mockAchievementRepo{}
+		streaks := &mockStreakCalculator{status: ports.StreakStatus{Mode: vos.StreakModeWeekly, WeeklyTarget: 3, Longest: 2}}
+		uc := achievements.NewEvaluateAchievementsUC(repo, streaks, achievements.DefaultRules())
+
+		awarded, err := uc.Execute(context.Background(), userID)
+		require.NoError(t, err)
+		assert.Contains(t, codes(awarded), "streak_7")
+		assert.NotContains(t, codes(awarded), "streak_30")
+	})
+
+	t.Run("repository_error", func(t *testing.T) {
+		repo := &mockAchievementRepo{err: errors.New("db down")}
+		uc := achievements.NewEvaluateAchievementsUC(repo, &mockStreakCalculator{}, achievements.DefaultRules())
+
+		_, err := uc.Execute(context.Background(), userID)
+		assert.Error(t, err)
+	})
+}
+
+func TestListAchievementsUC_Execute(t *testing.T) {
+	userID := uuid.New()
+	awardedAt := time.Date(2026, 1, 10, 18, 0, 0, 0, time.UTC)
+	repo := &mockAchievementRepo{
+		awarded: []entities.UserAchievement{{UserID: userID, Code: "first_workout", AwardedAt: awardedAt}},
+	}
+	repo.stats.Sessions = 25
+	repo.stats.HeaviestSet = 80000
+	uc := achievements.NewListAchievementsUC(repo, &mockStreakCalculator{}, achievements.DefaultRules())
+
+	out, err := uc.Execute(context.Background(), userID)
+	require.NoError(t, err)
+	require.Len(t, out, len(achievements.DefaultRules()))
+
+	byCode := make(map[string]achievements.AchievementProgress, len(out))
+	for _, p := range out {
+		byCode[p.Rule.Code] = p
+	}
+
+	first := byCode["first_workout"]
+	assert.True(t, first.Unlocked)
+	require.NotNil(t, first.AwardedAt)
+	assert.Equal(t, awardedAt, *first.AwardedAt)
+
+	// Atingida mas ainda não concedida: a listagem não concede nada
+	ten := byCode["sessions_10"]
+	assert.False(t, ten.Unlocked)
+	assert.Nil(t, ten.AwardedAt)
+	assert.Equal(t, 100.0, ten.ProgressPercent)
+	assert.Len(t, repo.awarded, 1)
+
+	fifty := byCode["sessions_50"]
+	assert.False(t, fifty.Unlocked)
+	assert.Nil(t, fifty.AwardedAt)
+	assert.Equal(t, int64(25), fifty.Value)
+	assert.Equal(t, 50.0, fifty.ProgressPercent)
+
+	heavy := byCode["heavy_100kg"]
+	assert.False(t, heavy.Unlocked)
+	assert.Equal(t, 80.0, heavy.ProgressPercent)
+}
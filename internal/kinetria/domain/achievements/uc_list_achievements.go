@@ -0,0 +1,40 @@
+package achievements
+
+import (
+	"context"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/kinetria/kinetria-back/internal/kinetria/domain/ports"
+)
+
+// ListAchievementsUC returns the achievements catalog with the user's progress.
+type ListAchievementsUC struct {
+	engine *engine
+}
+
+// NewListAchievementsUC creates a new ListAchievementsUC with the rules of the catalog.
+func NewListAchievementsUC(
+	achievementRepo ports.AchievementRepository,
+	streaks ports.StreakCalculator,
+	rules []Rule,
+) *ListAchievementsUC {
+	return &ListAchievementsUC{
+		engine: &engine{
+			achievementRepo: achievementRepo,
+			streaks:         streaks,
+			rules:           rules,
+		},
+	}
+}
+
+// Execute returns every rule of the catalog, unlocked or not, in catalog order. It awards
+// nothing: a rule already met but not yet awarded (e.g. added to the catalog later) is
+// listed as locked until the next evaluation, when a session or set is written.
+func (uc *ListAchievementsUC) Execute(ctx context.Context, userID uuid.UUID) ([]AchievementProgress, error) {
+	result, err := uc.engine.evaluate(ctx, userID, time.Now().UTC(), false)
+	if err != nil {
+		return nil, err
+	}
+	return result.progress, nil
+}
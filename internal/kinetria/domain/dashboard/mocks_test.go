@@ -7,6 +7,7 @@ import (
 	"github.com/google/uuid"
 	"github.com/kinetria/kinetria-back/internal/kinetria/domain/entities"
 	"github.com/kinetria/kinetria-back/internal/kinetria/domain/ports"
+	"github.com/kinetria/kinetria-back/internal/kinetria/domain/vos"
 )
 
 // mockUserRepository is a mock implementation of ports.UserRepository for testing.
@@ -15,6 +16,13 @@ type mockUserRepository struct {
 	getByIDErr error
 }
 
+// utcUserRepository returns a user repository whose user buckets days in UTC.
+func utcUserRepository() *mockUserRepository {
+	prefs := vos.DefaultUserPreferences()
+	prefs.Timezone = "UTC"
+	return &mockUserRepository{user: &entities.User{ID: uuid.New(), Preferences: prefs}}
+}
+
 func (m *mockUserRepository) Create(_ context.Context, _ *entities.User) error {
 	return nil
 }
@@ -44,7 +52,7 @@ func (m *mockWorkoutRepository) ExistsByIDAndUserID(_ context.Context, _, _ uuid
 	return false, nil
 }
 
-func (m *mockWorkoutRepository) ListByUserID(_ context.Context, _ uuid.UUID, _, _ int) ([]entities.Workout, int, error) {
+func (m *mockWorkoutRepository) ListByUserID(_ context.Context, _ uuid.UUID, _ bool, _, _ int) ([]entities.Workout, int, error) {
 	return nil, 0, nil
 }
 
@@ -80,9 +88,13 @@ func (m *mockWorkoutRepository) HasActiveSessions(_ context.Context, _ uuid.UUID
 }
 
 // mockSessionRepository is a mock implementation of ports.SessionRepository for testing.
+// Dashboard queries read the daily rollups through GetStatsByUserAndPeriod and
+// GetFrequencyByUserAndPeriod; gotStart records the start of the last period asked.
 type mockSessionRepository struct {
-	completedSessions []entities.Session
-	completedErr      error
+	frequency []ports.FrequencyData
+	stats     *ports.SessionStats
+	err       error
+	gotStart  time.Time
 }
 
 func (m *mockSessionRepository) Create(_ context.Context, _ *entities.Session) error {
@@ -101,21 +113,26 @@ func (m *mockSessionRepository) UpdateStatus(_ context.Context, _ uuid.UUID, _ s
 	return true, nil
 }
 
-func (m *mockSessionRepository) GetCompletedSessionsByUserAndDateRange(_ context.Context, _ uuid.UUID, _, _ time.Time) ([]entities.Session, error) {
-	if m.completedErr != nil {
-		return nil, m.completedErr
-	}
-	return m.completedSessions, nil
+func (m *mockSessionRepository) GetCompletedSessionsByUserAndDateRange(_ context.Context, _ uuid.UUID, _, _ time.Time, _ *time.Location) ([]entities.Session, error) {
+	return nil, m.err
 }
 
-func (m *mockSessionRepository) GetStatsByUserAndPeriod(_ context.Context, _ uuid.UUID, _, _ time.Time) (*ports.SessionStats, error) {
-	return &ports.SessionStats{}, nil
+func (m *mockSessionRepository) GetStatsByUserAndPeriod(_ context.Context, _ uuid.UUID, start, _ time.Time) (*ports.SessionStats, error) {
+	m.gotStart = start
+	if m.err != nil {
+		return nil, m.err
+	}
+	if m.stats == nil {
+		return &ports.SessionStats{}, nil
+	}
+	return m.stats, nil
 }
 
-func (m *mockSessionRepository) GetFrequencyByUserAndPeriod(_ context.Context, _ uuid.UUID, _, _ time.Time) ([]ports.FrequencyData, error) {
-	return nil, nil
+func (m *mockSessionRepository) GetFrequencyByUserAndPeriod(_ context.Context, _ uuid.UUID, start, _ time.Time, _ *time.Location) ([]ports.FrequencyData, error) {
+	m.gotStart = start
+	return m.frequency, m.err
 }
 
-func (m *mockSessionRepository) GetSessionsForStreak(_ context.Context, _ uuid.UUID) ([]time.Time, error) {
+func (m *mockSessionRepository) GetSessionsForStreak(_ context.Context, _ uuid.UUID, _ *time.Location) ([]time.Time, error) {
 	return nil, nil
 }
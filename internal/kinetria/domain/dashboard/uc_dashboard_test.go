@@ -9,6 +9,8 @@ import (
 	"github.com/google/uuid"
 	"github.com/kinetria/kinetria-back/internal/kinetria/domain/dashboard"
 	"github.com/kinetria/kinetria-back/internal/kinetria/domain/entities"
+	"github.com/kinetria/kinetria-back/internal/kinetria/domain/ports"
+	"github.com/kinetria/kinetria-back/internal/kinetria/domain/vos"
 	"go.opentelemetry.io/otel/trace/noop"
 )
 
@@ -149,17 +151,6 @@ func TestGetWeekProgressUC_Execute(t *testing.T) {
 	yesterday := today.AddDate(0, 0, -1)
 	twoDaysAgo := today.AddDate(0, 0, -2)
 
-	sessionOnYesterday := entities.Session{
-		ID:        uuid.New(),
-		UserID:    userID,
-		StartedAt: yesterday.Add(10 * time.Hour),
-	}
-	sessionOnTwoDaysAgo := entities.Session{
-		ID:        uuid.New(),
-		UserID:    userID,
-		StartedAt: twoDaysAgo.Add(10 * time.Hour),
-	}
-
 	tests := []struct {
 		name        string
 		sessionRepo *mockSessionRepository
@@ -167,11 +158,9 @@ func TestGetWeekProgressUC_Execute(t *testing.T) {
 		checkOutput func(t *testing.T, out *dashboard.GetWeekProgressOutput)
 	}{
 		{
-			name: "success - empty week returns 7 days all missed or future",
-			sessionRepo: &mockSessionRepository{
-				completedSessions: nil,
-			},
-			wantErr: false,
+			name:        "success - empty week returns 7 days all missed or future",
+			sessionRepo: &mockSessionRepository{},
+			wantErr:     false,
 			checkOutput: func(t *testing.T, out *dashboard.GetWeekProgressOutput) {
 				if len(out.Days) != 7 {
 					t.Errorf("Days count = %d, want 7", len(out.Days))
@@ -187,7 +176,7 @@ func TestGetWeekProgressUC_Execute(t *testing.T) {
 		{
 			name: "success - sessions on some days mark them as completed",
 			sessionRepo: &mockSessionRepository{
-				completedSessions: []entities.Session{sessionOnYesterday, sessionOnTwoDaysAgo},
+				frequency: []ports.FrequencyData{{Date: twoDaysAgo, Count: 1}, {Date: yesterday, Count: 2}},
 			},
 			wantErr: false,
 			checkOutput: func(t *testing.T, out *dashboard.GetWeekProgressOutput) {
@@ -206,11 +195,9 @@ func TestGetWeekProgressUC_Execute(t *testing.T) {
 			},
 		},
 		{
-			name: "success - today is included as missed if no session today",
-			sessionRepo: &mockSessionRepository{
-				completedSessions: nil,
-			},
-			wantErr: false,
+			name:        "success - today is included as missed if no session today",
+			sessionRepo: &mockSessionRepository{},
+			wantErr:     false,
 			checkOutput: func(t *testing.T, out *dashboard.GetWeekProgressOutput) {
 				todayStr := today.Format("2006-01-02")
 				found := false
@@ -230,7 +217,7 @@ func TestGetWeekProgressUC_Execute(t *testing.T) {
 		{
 			name: "error - session repo fails",
 			sessionRepo: &mockSessionRepository{
-				completedErr: errors.New("db error"),
+				err: errors.New("db error"),
 			},
 			wantErr: true,
 		},
@@ -238,7 +225,7 @@ func TestGetWeekProgressUC_Execute(t *testing.T) {
 
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
-			uc := dashboard.NewGetWeekProgressUC(tracer, tt.sessionRepo)
+			uc := dashboard.NewGetWeekProgressUC(tracer, tt.sessionRepo, utcUserRepository())
 			out, err := uc.Execute(context.Background(), dashboard.GetWeekProgressInput{UserID: userID})
 
 			if (err != nil) != tt.wantErr {
@@ -252,34 +239,45 @@ func TestGetWeekProgressUC_Execute(t *testing.T) {
 	}
 }
 
-func TestGetWeekStatsUC_Execute(t *testing.T) {
+func TestGetWeekProgressUC_Execute_UsesUserTimezone(t *testing.T) {
 	tracer := noop.NewTracerProvider().Tracer("test")
-	userID := uuid.New()
+	tokyo, err := time.LoadLocation("Asia/Tokyo")
+	if err != nil {
+		t.Skipf("timezone data unavailable: %v", err)
+	}
 
-	now := time.Now().UTC()
-	yesterday := now.AddDate(0, 0, -1)
+	prefs := vos.DefaultUserPreferences()
+	prefs.Timezone = "Asia/Tokyo"
+	userRepo := &mockUserRepository{user: &entities.User{ID: uuid.New(), Preferences: prefs}}
 
-	finishedAt60 := yesterday.Add(60 * time.Minute)
-	finishedAt30 := yesterday.Add(30 * time.Minute)
+	// Rollup days are calendar days in the user's timezone
+	now := time.Now().In(tokyo)
+	localToday := time.Date(now.Year(), now.Month(), now.Day(), 0, 0, 0, 0, tokyo)
+	sessionRepo := &mockSessionRepository{
+		frequency: []ports.FrequencyData{{Date: time.Date(now.Year(), now.Month(), now.Day(), 0, 0, 0, 0, time.UTC), Count: 1}},
+	}
+
+	uc := dashboard.NewGetWeekProgressUC(tracer, sessionRepo, userRepo)
+	out, err := uc.Execute(context.Background(), dashboard.GetWeekProgressInput{UserID: userRepo.user.ID})
+	if err != nil {
+		t.Fatalf("Execute() error = %v", err)
+	}
 
-	session60min := entities.Session{
-		ID:         uuid.New(),
-		UserID:     userID,
-		StartedAt:  yesterday,
-		FinishedAt: &finishedAt60,
+	last := out.Days[len(out.Days)-1]
+	if last.Date != localToday.Format("2006-01-02") {
+		t.Errorf("last day = %s, want local today %s", last.Date, localToday.Format("2006-01-02"))
 	}
-	session30min := entities.Session{
-		ID:         uuid.New(),
-		UserID:     userID,
-		StartedAt:  yesterday,
-		FinishedAt: &finishedAt30,
+	if last.Status != "completed" {
+		t.Errorf("local today status = %q, want completed", last.Status)
 	}
-	sessionNoFinish := entities.Session{
-		ID:         uuid.New(),
-		UserID:     userID,
-		StartedAt:  yesterday,
-		FinishedAt: nil,
+	if want := localToday.AddDate(0, 0, -6); !sessionRepo.gotStart.Equal(want) {
+		t.Errorf("period start = %v, want local midnight %v", sessionRepo.gotStart, want)
 	}
+}
+
+func TestGetWeekStatsUC_Execute(t *testing.T) {
+	tracer := noop.NewTracerProvider().Tracer("test")
+	userID := uuid.New()
 
 	tests := []struct {
 		name        string
@@ -288,11 +286,9 @@ func TestGetWeekStatsUC_Execute(t *testing.T) {
 		checkOutput func(t *testing.T, out *dashboard.GetWeekStatsOutput)
 	}{
 		{
-			name: "success - no sessions returns zero stats",
-			sessionRepo: &mockSessionRepository{
-				completedSessions: nil,
-			},
-			wantErr: false,
+			name:        "success - no sessions returns zero stats",
+			sessionRepo: &mockSessionRepository{},
+			wantErr:     false,
 			checkOutput: func(t *testing.T, out *dashboard.GetWeekStatsOutput) {
 				if out.TotalTimeMinutes != 0 {
 					t.Errorf("TotalTimeMinutes = %d, want 0", out.TotalTimeMinutes)
@@ -303,36 +299,24 @@ func TestGetWeekStatsUC_Execute(t *testing.T) {
 			},
 		},
 		{
-			name: "success - calculates total time and calories correctly",
+			name: "success - returns the rolled up time and calories",
 			sessionRepo: &mockSessionRepository{
-				completedSessions: []entities.Session{session60min, session30min},
+				stats: &ports.SessionStats{TotalWorkouts: 2, TotalTime: 90, TotalCalories: 480 + 175},
 			},
 			wantErr: false,
 			checkOutput: func(t *testing.T, out *dashboard.GetWeekStatsOutput) {
 				if out.TotalTimeMinutes != 90 {
 					t.Errorf("TotalTimeMinutes = %d, want 90", out.TotalTimeMinutes)
 				}
-				if out.Calories != 90*7 {
-					t.Errorf("Calories = %d, want %d", out.Calories, 90*7)
-				}
-			},
-		},
-		{
-			name: "success - sessions without finished_at are excluded from time calculation",
-			sessionRepo: &mockSessionRepository{
-				completedSessions: []entities.Session{session60min, sessionNoFinish},
-			},
-			wantErr: false,
-			checkOutput: func(t *testing.T, out *dashboard.GetWeekStatsOutput) {
-				if out.TotalTimeMinutes != 60 {
-					t.Errorf("TotalTimeMinutes = %d, want 60 (sessionNoFinish excluded)", out.TotalTimeMinutes)
+				if out.Calories != 480+175 {
+					t.Errorf("Calories = %d, want %d (sum of stored estimates)", out.Calories, 480+175)
 				}
 			},
 		},
 		{
 			name: "error - session repo fails",
 			sessionRepo: &mockSessionRepository{
-				completedErr: errors.New("db error"),
+				err: errors.New("db error"),
 			},
 			wantErr: true,
 		},
@@ -340,7 +324,7 @@ func TestGetWeekStatsUC_Execute(t *testing.T) {
 
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
-			uc := dashboard.NewGetWeekStatsUC(tracer, tt.sessionRepo)
+			uc := dashboard.NewGetWeekStatsUC(tracer, tt.sessionRepo, utcUserRepository())
 			out, err := uc.Execute(context.Background(), dashboard.GetWeekStatsInput{UserID: userID})
 
 			if (err != nil) != tt.wantErr {
@@ -350,6 +334,13 @@ func TestGetWeekStatsUC_Execute(t *testing.T) {
 			if tt.checkOutput != nil && err == nil {
 				tt.checkOutput(t, out)
 			}
+
+			// The week is the last 7 calendar days, starting at local midnight
+			now := time.Now().UTC()
+			want := time.Date(now.Year(), now.Month(), now.Day(), 0, 0, 0, 0, time.UTC).AddDate(0, 0, -6)
+			if !tt.sessionRepo.gotStart.Equal(want) {
+				t.Errorf("period start = %v, want %v", tt.sessionRepo.gotStart, want)
+			}
 		})
 	}
 }
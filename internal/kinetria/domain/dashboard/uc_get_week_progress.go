@@ -6,6 +6,7 @@ import (
 
 	"github.com/google/uuid"
 	"github.com/kinetria/kinetria-back/internal/kinetria/domain/ports"
+	"github.com/kinetria/kinetria-back/internal/kinetria/domain/profile"
 	"go.opentelemetry.io/otel/trace"
 )
 
@@ -26,36 +27,43 @@ type GetWeekProgressOutput struct {
 type GetWeekProgressUC struct {
 	tracer      trace.Tracer
 	sessionRepo ports.SessionRepository
+	userRepo    ports.UserRepository
 }
 
-func NewGetWeekProgressUC(tracer trace.Tracer, sessionRepo ports.SessionRepository) *GetWeekProgressUC {
-	return &GetWeekProgressUC{tracer: tracer, sessionRepo: sessionRepo}
+func NewGetWeekProgressUC(tracer trace.Tracer, sessionRepo ports.SessionRepository, userRepo ports.UserRepository) *GetWeekProgressUC {
+	return &GetWeekProgressUC{tracer: tracer, sessionRepo: sessionRepo, userRepo: userRepo}
 }
 
 func (uc *GetWeekProgressUC) Execute(ctx context.Context, input GetWeekProgressInput) (*GetWeekProgressOutput, error) {
 	ctx, span := uc.tracer.Start(ctx, "GetWeekProgressUC")
 	defer span.End()
 
-	now := time.Now().UTC()
+	prefs, err := profile.LoadPreferences(ctx, uc.userRepo, input.UserID)
+	if err != nil {
+		return nil, err
+	}
+	loc := prefs.Location()
+
+	// "Hoje" é o dia de calendário no fuso do usuário
+	now := time.Now().In(loc)
 	today := time.Date(now.Year(), now.Month(), now.Day(), 0, 0, 0, 0, time.UTC)
 	startDate := today.AddDate(0, 0, -6) // 6 dias atrás
-	endDate := today
 
-	// Buscar sessões completed na semana
-	sessions, err := uc.sessionRepo.GetCompletedSessionsByUserAndDateRange(ctx, input.UserID, startDate, endDate)
+	// Dias com sessões completed na semana (rollup diário)
+	start := time.Date(startDate.Year(), startDate.Month(), startDate.Day(), 0, 0, 0, 0, loc)
+	days, err := uc.sessionRepo.GetFrequencyByUserAndPeriod(ctx, input.UserID, start, now, loc)
 	if err != nil {
 		return nil, err
 	}
 
 	// Mapear datas de sessões completed
 	completedDates := make(map[string]bool)
-	for _, s := range sessions {
-		dateStr := s.StartedAt.Format("2006-01-02")
-		completedDates[dateStr] = true
+	for _, d := range days {
+		completedDates[d.Date.Format("2006-01-02")] = true
 	}
 
 	// Gerar array de 7 dias
-	days := make([]DayProgress, 7)
+	progress := make([]DayProgress, 7)
 	dayLabels := []string{"D", "S", "T", "Q", "Q", "S", "S"} // domingo=0, segunda=1, ...
 
 	for i := 0; i < 7; i++ {
@@ -70,12 +78,12 @@ func (uc *GetWeekProgressUC) Execute(ctx context.Context, input GetWeekProgressI
 			status = "completed"
 		}
 
-		days[i] = DayProgress{
+		progress[i] = DayProgress{
 			Day:    dayLabels[weekday],
 			Date:   dateStr,
 			Status: status,
 		}
 	}
 
-	return &GetWeekProgressOutput{Days: days}, nil
+	return &GetWeekProgressOutput{Days: progress}, nil
 }
@@ -6,6 +6,7 @@ import (
 
 	"github.com/google/uuid"
 	"github.com/kinetria/kinetria-back/internal/kinetria/domain/ports"
+	"github.com/kinetria/kinetria-back/internal/kinetria/domain/profile"
 	"go.opentelemetry.io/otel/trace"
 )
 
@@ -21,39 +22,35 @@ type GetWeekStatsOutput struct {
 type GetWeekStatsUC struct {
 	tracer      trace.Tracer
 	sessionRepo ports.SessionRepository
+	userRepo    ports.UserRepository
 }
 
-func NewGetWeekStatsUC(tracer trace.Tracer, sessionRepo ports.SessionRepository) *GetWeekStatsUC {
-	return &GetWeekStatsUC{tracer: tracer, sessionRepo: sessionRepo}
+func NewGetWeekStatsUC(tracer trace.Tracer, sessionRepo ports.SessionRepository, userRepo ports.UserRepository) *GetWeekStatsUC {
+	return &GetWeekStatsUC{tracer: tracer, sessionRepo: sessionRepo, userRepo: userRepo}
 }
 
 func (uc *GetWeekStatsUC) Execute(ctx context.Context, input GetWeekStatsInput) (*GetWeekStatsOutput, error) {
 	ctx, span := uc.tracer.Start(ctx, "GetWeekStatsUC")
 	defer span.End()
 
-	now := time.Now().UTC()
-	today := time.Date(now.Year(), now.Month(), now.Day(), 0, 0, 0, 0, time.UTC)
-	startDate := today.AddDate(0, 0, -6)
-	endDate := today
-
-	sessions, err := uc.sessionRepo.GetCompletedSessionsByUserAndDateRange(ctx, input.UserID, startDate, endDate)
+	prefs, err := profile.LoadPreferences(ctx, uc.userRepo, input.UserID)
 	if err != nil {
 		return nil, err
 	}
+	loc := prefs.Location()
 
-	totalMinutes := 0
-	for _, s := range sessions {
-		if s.FinishedAt != nil {
-			duration := s.FinishedAt.Sub(s.StartedAt)
-			totalMinutes += int(duration.Minutes())
-		}
-	}
+	// Últimos 7 dias de calendário no fuso do usuário, somados a partir dos rollups diários
+	now := time.Now().In(loc)
+	start := time.Date(now.Year(), now.Month(), now.Day(), 0, 0, 0, 0, loc).AddDate(0, 0, -6)
 
-	// Calorias estimadas: 7 kcal/min (ACSM guideline para exercício moderado)
-	calories := totalMinutes * 7
+	stats, err := uc.sessionRepo.GetStatsByUserAndPeriod(ctx, input.UserID, start, now)
+	if err != nil {
+		return nil, err
+	}
 
+	// Calorias: estimativas gravadas ao finalizar cada sessão (MET × peso × tempo ativo)
 	return &GetWeekStatsOutput{
-		Calories:         calories,
-		TotalTimeMinutes: totalMinutes,
+		Calories:         stats.TotalCalories,
+		TotalTimeMinutes: stats.TotalTime,
 	}, nil
 }
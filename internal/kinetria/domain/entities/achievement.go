@@ -0,0 +1,11 @@
+package entities
+
+import "time"
+
+// UserAchievement is a badge awarded to a user. Code identifies the achievement rule
+// (see the achievements catalog); a badge is awarded once and never revoked.
+type UserAchievement struct {
+	UserID    UserID
+	Code      string
+	AwardedAt time.Time
+}
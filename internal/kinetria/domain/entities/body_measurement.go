@@ -0,0 +1,35 @@
+package entities
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/kinetria/kinetria-back/internal/kinetria/domain/vos"
+)
+
+type BodyMeasurementID = uuid.UUID
+
+// BodyMeasurement is a body composition log entry. Every value is optional, but an
+// entry records at least one. Weight is in grams and circumferences in millimeters.
+type BodyMeasurement struct {
+	ID             BodyMeasurementID
+	UserID         UserID
+	MeasuredAt     time.Time
+	WeightGrams    *int
+	BodyFatPercent *float64
+	Circumferences map[vos.BodySite]int
+	Notes          string
+	CreatedAt      time.Time
+	UpdatedAt      time.Time
+}
+
+// BodyWeightGoal is the user's target body weight. StartGrams is the latest
+// weight when the goal was set (nil if none was logged yet).
+type BodyWeightGoal struct {
+	UserID      UserID
+	TargetGrams int
+	StartGrams  *int
+	TargetDate  *time.Time
+	CreatedAt   time.Time
+	UpdatedAt   time.Time
+}
@@ -2,6 +2,7 @@ package entities
 
 import (
 	"github.com/google/uuid"
+	"github.com/kinetria/kinetria-back/internal/kinetria/domain/vos"
 )
 
 type ExerciseID = uuid.UUID
@@ -11,10 +12,22 @@ type ExerciseID = uuid.UUID
 // Workout-specific fields (Sets, Reps, etc.) are populated when fetching exercises for a workout.
 type Exercise struct {
 	ID           ExerciseID
+	Slug         string // stable identifier used by library import/export
 	Name         string
 	ThumbnailURL string
 	Muscles      []string
 
+	// MuscleGroups is the canonical taxonomy breakdown of Muscles, with the
+	// fraction of each set credited to every muscle group.
+	MuscleGroups []ExerciseMuscle
+
+	// Tags are free-form library labels; the vos.BigLift tags map exercises
+	// to the squat, bench press and deadlift.
+	Tags []string
+
+	// IsFavorite is whether the requesting user has favorited the exercise.
+	IsFavorite bool
+
 	// Library metadata fields (nullable — populated from exercise library endpoints)
 	Description  *string
 	Instructions *string
@@ -30,3 +43,11 @@ type Exercise struct {
 	Weight     int
 	OrderIndex int
 }
+
+// ExerciseMuscle is the involvement of a canonical muscle group in an exercise.
+// Involvement is the fraction of a set credited to the muscle (0 < Involvement <= 1).
+type ExerciseMuscle struct {
+	MuscleGroup vos.MuscleGroup
+	Role        vos.MuscleRole
+	Involvement float64
+}
@@ -0,0 +1,27 @@
+package entities
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/kinetria/kinetria-back/internal/kinetria/domain/vos"
+)
+
+type GoalID = uuid.UUID
+
+// Goal is a user's training target. TargetValue and StartValue are in the canonical unit
+// of the goal type (see vos.GoalType). StartValue is the value when the goal was set
+// (nil for periodic goals or when there was no data yet).
+type Goal struct {
+	ID          GoalID
+	UserID      UserID
+	Type        vos.GoalType
+	ExerciseID  *uuid.UUID // só metas de exercício
+	TargetValue int64
+	StartValue  *int64
+	TargetDate  *time.Time // dia de calendário
+	Status      vos.GoalStatus
+	AchievedAt  *time.Time
+	CreatedAt   time.Time
+	UpdatedAt   time.Time
+}
@@ -0,0 +1,27 @@
+package entities
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/kinetria/kinetria-back/internal/kinetria/domain/vos"
+)
+
+type MediaAssetID = uuid.UUID
+
+// MediaAsset is a file uploaded to media storage and attached to an exercise, workout or user.
+// ThumbnailKey/ThumbnailURL are empty for videos.
+type MediaAsset struct {
+	ID           MediaAssetID
+	UploadedBy   UserID
+	OwnerType    vos.MediaOwnerType
+	OwnerID      uuid.UUID
+	Kind         vos.MediaKind
+	ContentType  string
+	SizeBytes    int64
+	StorageKey   string
+	URL          string
+	ThumbnailKey string
+	ThumbnailURL string
+	CreatedAt    time.Time
+}
@@ -0,0 +1,23 @@
+package entities
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/kinetria/kinetria-back/internal/kinetria/domain/vos"
+)
+
+type ReadinessCheckInID = uuid.UUID
+
+// ReadinessCheckIn is a pre-workout readiness check-in and its score. SessionID and
+// Adjustment are set when a session was started with the check-in.
+type ReadinessCheckIn struct {
+	ID        ReadinessCheckInID
+	UserID    UserID
+	SessionID *uuid.UUID
+	vos.ReadinessMarkers
+	Score      int // 0–100
+	Level      vos.ReadinessLevel
+	Adjustment *vos.ReadinessAdjustment // ajuste aplicado à prescrição da sessão
+	CheckedAt  time.Time
+}
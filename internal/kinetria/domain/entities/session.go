@@ -19,4 +19,8 @@ type Session struct {
 	FinishedAt *time.Time
 	CreatedAt  time.Time
 	UpdatedAt  time.Time
+	// Calories is the kcal estimate stored when the session finishes (nil while active).
+	Calories *int
+	// RPE is the session rating of perceived exertion (1-10) given on finish; nil if not given.
+	RPE *int
 }
@@ -21,6 +21,9 @@ type Workout struct {
 	UpdatedAt   time.Time
 	CreatedBy   *uuid.UUID
 	DeletedAt   *time.Time
+
+	// IsFavorite is whether the requesting user has favorited the workout.
+	IsFavorite bool
 }
 
 // WorkoutExercise represents the association between a workout and an exercise.
@@ -22,6 +22,7 @@ var (
 	ErrSessionAlreadyClosed = errors.New("session is already closed")
 	ErrSetAlreadyRecorded   = errors.New("set already recorded")
 	ErrExerciseNotFound     = errors.New("exercise not found")
+	ErrSessionOverlap       = errors.New("session overlaps another session")
 
 	// Workout management errors
 	ErrForbidden                = errors.New("forbidden")
@@ -32,4 +33,8 @@ var (
 	ErrInvalidPeriod = errors.New("startDate must be before or equal to endDate")
 	ErrPeriodTooLong = errors.New("period must not exceed 730 days")
 	ErrInvalidUUID   = errors.New("invalid UUID format")
+
+	// Media errors
+	ErrUnsupportedMediaType = errors.New("unsupported media type")
+	ErrMediaTooLarge        = errors.New("media file too large")
 )
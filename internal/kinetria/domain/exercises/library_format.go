@@ -0,0 +1,413 @@
+package exercises
+
+import (
+	"bytes"
+	"encoding/csv"
+	"encoding/json"
+	"fmt"
+	"io"
+	"strconv"
+	"strings"
+	"unicode/utf8"
+
+	"github.com/kinetria/kinetria-back/internal/kinetria/domain/entities"
+	domerrors "github.com/kinetria/kinetria-back/internal/kinetria/domain/errors"
+	"github.com/kinetria/kinetria-back/internal/kinetria/domain/vos"
+)
+
+// LibraryFormat is the serialization format of bulk exercise import/export.
+type LibraryFormat string
+
+const (
+	LibraryFormatJSON LibraryFormat = "json"
+	LibraryFormatCSV  LibraryFormat = "csv"
+)
+
+func (f LibraryFormat) Validate() error {
+	switch f {
+	case LibraryFormatJSON, LibraryFormatCSV:
+		return nil
+	}
+	return fmt.Errorf("invalid library format %q: %w", string(f), domerrors.ErrMalformedParameters)
+}
+
+// libraryCSVHeader is the column layout of the CSV format.
+// muscles and tags are "|"-separated; muscle_groups is "|"-separated "group:role:involvement" triples.
+var libraryCSVHeader = []string{
+	"slug", "name", "description", "thumbnail_url", "video_url",
+	"instructions", "tips", "difficulty", "equipment", "muscles", "muscle_groups", "tags",
+}
+
+// LibraryRecord is one exercise in the import/export format.
+type LibraryRecord struct {
+	Slug         string               `json:"slug"`
+	Name         string               `json:"name"`
+	Description  string               `json:"description,omitempty"`
+	ThumbnailURL string               `json:"thumbnailUrl,omitempty"`
+	VideoURL     string               `json:"videoUrl,omitempty"`
+	Instructions string               `json:"instructions,omitempty"`
+	Tips         string               `json:"tips,omitempty"`
+	Difficulty   string               `json:"difficulty,omitempty"`
+	Equipment    string               `json:"equipment,omitempty"`
+	Muscles      []string             `json:"muscles"`
+	MuscleGroups []LibraryMuscleGroup `json:"muscleGroups,omitempty"`
+	Tags         []string             `json:"tags,omitempty"`
+}
+
+// LibraryMuscleGroup is a muscle-group involvement in the import/export format.
+type LibraryMuscleGroup struct {
+	MuscleGroup string  `json:"muscleGroup"`
+	Role        string  `json:"role"`
+	Involvement float64 `json:"involvement"`
+}
+
+// numberedRecord is a decoded record with its 1-based position in the input
+// (array index for JSON, line number for CSV).
+type numberedRecord struct {
+	Row    int
+	Record LibraryRecord
+}
+
+// decodeLibrary parses data in the given format. Rows that cannot be parsed are
+// reported as row errors; a file that cannot be parsed at all returns an error.
+func decodeLibrary(format LibraryFormat, data []byte) ([]numberedRecord, []ImportRowError, error) {
+	switch format {
+	case LibraryFormatJSON:
+		return decodeLibraryJSON(data)
+	case LibraryFormatCSV:
+		return decodeLibraryCSV(data)
+	}
+	return nil, nil, format.Validate()
+}
+
+func decodeLibraryJSON(data []byte) ([]numberedRecord, []ImportRowError, error) {
+	var raw []json.RawMessage
+	if err := json.Unmarshal(data, &raw); err != nil {
+		return nil, nil, fmt.Errorf("expected a JSON array of exercises: %w", domerrors.ErrMalformedParameters)
+	}
+
+	records := make([]numberedRecord, 0, len(raw))
+	var rowErrs []ImportRowError
+	for i, item := range raw {
+		var rec LibraryRecord
+		dec := json.NewDecoder(bytes.NewReader(item))
+		dec.DisallowUnknownFields()
+		if err := dec.Decode(&rec); err != nil {
+			rowErrs = append(rowErrs, ImportRowError{Row: i + 1, Message: fmt.Sprintf("invalid JSON object: %v", err)})
+			continue
+		}
+		records = append(records, numberedRecord{Row: i + 1, Record: rec})
+	}
+	return records, rowErrs, nil
+}
+
+func decodeLibraryCSV(data []byte) ([]numberedRecord, []ImportRowError, error) {
+	r := csv.NewReader(bytes.NewReader(data))
+	r.FieldsPerRecord = -1
+
+	header, err := r.Read()
+	if err != nil {
+		return nil, nil, fmt.Errorf("missing CSV header: %w", domerrors.ErrMalformedParameters)
+	}
+	cols := make(map[string]int, len(header))
+	for i, h := range header {
+		cols[strings.TrimSpace(strings.TrimPrefix(h, "\ufeff"))] = i
+	}
+	for _, required := range []string{"name"} {
+		if _, ok := cols[required]; !ok {
+			return nil, nil, fmt.Errorf("CSV header must include %q: %w", required, domerrors.ErrMalformedParameters)
+		}
+	}
+	for name := range cols {
+		if !containsString(libraryCSVHeader, name) {
+			return nil, nil, fmt.Errorf("unknown CSV column %q: %w", name, domerrors.ErrMalformedParameters)
+		}
+	}
+
+	var records []numberedRecord
+	var rowErrs []ImportRowError
+	for {
+		fields, err := r.Read()
+		if err == io.EOF {
+			break
+		}
+		line, _ := r.FieldPos(0)
+		if err != nil {
+			rowErrs = append(rowErrs, ImportRowError{Row: line, Message: fmt.Sprintf("invalid CSV row: %v", err)})
+			continue
+		}
+		get := func(col string) string {
+			if i, ok := cols[col]; ok && i < len(fields) {
+				return strings.TrimSpace(fields[i])
+			}
+			return ""
+		}
+
+		rec := LibraryRecord{
+			Slug:         get("slug"),
+			Name:         get("name"),
+			Description:  get("description"),
+			ThumbnailURL: get("thumbnail_url"),
+			VideoURL:     get("video_url"),
+			Instructions: get("instructions"),
+			Tips:         get("tips"),
+			Difficulty:   get("difficulty"),
+			Equipment:    get("equipment"),
+			Muscles:      splitList(get("muscles")),
+			Tags:         splitList(get("tags")),
+		}
+		groups, err := parseCSVMuscleGroups(get("muscle_groups"))
+		if err != nil {
+			rowErrs = append(rowErrs, ImportRowError{Row: line, Slug: rec.Slug, Field: "muscle_groups", Message: err.Error()})
+			continue
+		}
+		rec.MuscleGroups = groups
+		records = append(records, numberedRecord{Row: line, Record: rec})
+	}
+	return records, rowErrs, nil
+}
+
+// parseCSVMuscleGroups parses "chest:primary:1|triceps:secondary:0.5".
+// The involvement may be omitted to use the role's default.
+func parseCSVMuscleGroups(s string) ([]LibraryMuscleGroup, error) {
+	var groups []LibraryMuscleGroup
+	for _, item := range splitList(s) {
+		parts := strings.Split(item, ":")
+		if len(parts) < 2 || len(parts) > 3 {
+			return nil, fmt.Errorf("expected group:role[:involvement], got %q", item)
+		}
+		g := LibraryMuscleGroup{MuscleGroup: strings.TrimSpace(parts[0]), Role: strings.TrimSpace(parts[1])}
+		if len(parts) == 3 {
+			v, err := strconv.ParseFloat(strings.TrimSpace(parts[2]), 64)
+			if err != nil {
+				return nil, fmt.Errorf("invalid involvement %q", parts[2])
+			}
+			g.Involvement = v
+		}
+		groups = append(groups, g)
+	}
+	return groups, nil
+}
+
+// encodeLibrary serializes records in the given format.
+func encodeLibrary(format LibraryFormat, records []LibraryRecord) ([]byte, error) {
+	switch format {
+	case LibraryFormatJSON:
+		return json.MarshalIndent(records, "", "  ")
+	case LibraryFormatCSV:
+		var buf bytes.Buffer
+		w := csv.NewWriter(&buf)
+		if err := w.Write(libraryCSVHeader); err != nil {
+			return nil, err
+		}
+		for _, rec := range records {
+			groups := make([]string, 0, len(rec.MuscleGroups))
+			for _, g := range rec.MuscleGroups {
+				groups = append(groups, fmt.Sprintf("%s:%s:%s", g.MuscleGroup, g.Role, strconv.FormatFloat(g.Involvement, 'f', -1, 64)))
+			}
+			if err := w.Write([]string{
+				rec.Slug, rec.Name, rec.Description, rec.ThumbnailURL, rec.VideoURL,
+				rec.Instructions, rec.Tips, rec.Difficulty, rec.Equipment,
+				strings.Join(rec.Muscles, "|"), strings.Join(groups, "|"), strings.Join(rec.Tags, "|"),
+			}); err != nil {
+				return nil, err
+			}
+		}
+		w.Flush()
+		return buf.Bytes(), w.Error()
+	}
+	return nil, format.Validate()
+}
+
+// splitList splits a "|"-separated list, dropping empty items.
+func splitList(s string) []string {
+	var out []string
+	for _, item := range strings.Split(s, "|") {
+		if item = strings.TrimSpace(item); item != "" {
+			out = append(out, item)
+		}
+	}
+	return out
+}
+
+func containsString(list []string, s string) bool {
+	for _, v := range list {
+		if v == s {
+			return true
+		}
+	}
+	return false
+}
+
+// recordFromExercise converts a library exercise to its import/export representation.
+func recordFromExercise(e *entities.Exercise) LibraryRecord {
+	rec := LibraryRecord{
+		Slug:         e.Slug,
+		Name:         e.Name,
+		ThumbnailURL: e.ThumbnailURL,
+		Description:  derefString(e.Description),
+		VideoURL:     derefString(e.VideoURL),
+		Instructions: derefString(e.Instructions),
+		Tips:         derefString(e.Tips),
+		Difficulty:   derefString(e.Difficulty),
+		Equipment:    derefString(e.Equipment),
+		Muscles:      e.Muscles,
+		Tags:         e.Tags,
+	}
+	if rec.Muscles == nil {
+		rec.Muscles = []string{}
+	}
+	for _, m := range e.MuscleGroups {
+		rec.MuscleGroups = append(rec.MuscleGroups, LibraryMuscleGroup{
+			MuscleGroup: m.MuscleGroup.String(),
+			Role:        m.Role.String(),
+			Involvement: m.Involvement,
+		})
+	}
+	return rec
+}
+
+// toExercise validates the record and converts it to an exercise (without ID).
+// All validation failures are returned, one per offending field.
+func (rec LibraryRecord) toExercise(row int) (*entities.Exercise, []ImportRowError) {
+	var errs []ImportRowError
+	fail := func(field, format string, args ...any) {
+		errs = append(errs, ImportRowError{Row: row, Slug: rec.Slug, Field: field, Message: fmt.Sprintf(format, args...)})
+	}
+
+	if rec.Slug == "" && rec.Name != "" {
+		rec.Slug = Slugify(rec.Name)
+	}
+	if rec.Slug != "" && !IsValidSlug(rec.Slug) {
+		fail("slug", "must be lowercase letters and digits separated by hyphens")
+	}
+	if rec.Name == "" {
+		fail("name", "is required")
+	}
+	for _, f := range []struct {
+		field string
+		value string
+		max   int
+	}{
+		{"name", rec.Name, 255},
+		{"description", rec.Description, 500},
+		{"thumbnailUrl", rec.ThumbnailURL, 500},
+		{"difficulty", rec.Difficulty, 50},
+		{"equipment", rec.Equipment, 100},
+	} {
+		if utf8.RuneCountInString(f.value) > f.max {
+			fail(f.field, "must be at most %d characters", f.max)
+		}
+	}
+
+	groups := make([]entities.ExerciseMuscle, 0, len(rec.MuscleGroups))
+	seen := make(map[vos.MuscleGroup]bool, len(rec.MuscleGroups))
+	for _, g := range rec.MuscleGroups {
+		mg := vos.MuscleGroup(g.MuscleGroup)
+		role := vos.MuscleRole(g.Role)
+		if err := mg.Validate(); err != nil {
+			fail("muscleGroups", "unknown muscle group %q", g.MuscleGroup)
+			continue
+		}
+		if err := role.Validate(); err != nil {
+			fail("muscleGroups", "unknown role %q for %s", g.Role, g.MuscleGroup)
+			continue
+		}
+		if seen[mg] {
+			fail("muscleGroups", "muscle group %s listed more than once", mg)
+			continue
+		}
+		seen[mg] = true
+		involvement := g.Involvement
+		if involvement == 0 {
+			involvement = role.DefaultInvolvement()
+		}
+		if involvement < 0 || involvement > 1 {
+			fail("muscleGroups", "involvement for %s must be between 0 and 1", mg)
+			continue
+		}
+		groups = append(groups, entities.ExerciseMuscle{MuscleGroup: mg, Role: role, Involvement: involvement})
+	}
+	if len(rec.MuscleGroups) == 0 {
+		groups = muscleGroupsFromLabels(rec.Muscles)
+	}
+
+	tags := make([]string, 0, len(rec.Tags))
+	for _, tag := range rec.Tags {
+		tag = strings.ToLower(strings.TrimSpace(tag))
+		if !IsValidSlug(tag) || len(tag) > maxTagLength {
+			fail("tags", "tag %q must be lowercase letters and digits separated by hyphens, at most %d characters", tag, maxTagLength)
+			continue
+		}
+		if !containsString(tags, tag) {
+			tags = append(tags, tag)
+		}
+	}
+
+	if len(errs) > 0 {
+		return nil, errs
+	}
+
+	muscles := rec.Muscles
+	if muscles == nil {
+		muscles = []string{}
+	}
+	thumbnail := rec.ThumbnailURL
+	if thumbnail == "" {
+		thumbnail = defaultExerciseThumbnail
+	}
+	return &entities.Exercise{
+		Slug:         rec.Slug,
+		Name:         rec.Name,
+		ThumbnailURL: thumbnail,
+		Muscles:      muscles,
+		MuscleGroups: groups,
+		Tags:         tags,
+		Description:  optionalString(rec.Description),
+		Instructions: optionalString(rec.Instructions),
+		Tips:         optionalString(rec.Tips),
+		Difficulty:   optionalString(rec.Difficulty),
+		Equipment:    optionalString(rec.Equipment),
+		VideoURL:     optionalString(rec.VideoURL),
+	}, nil
+}
+
+// maxTagLength bounds a single library tag.
+const maxTagLength = 50
+
+// defaultExerciseThumbnail matches the column default of exercises.thumbnail_url.
+const defaultExerciseThumbnail = "/assets/exercises/generic.png"
+
+// muscleGroupsFromLabels derives the taxonomy breakdown from free-text muscle labels,
+// the same way migration 015 backfilled it: first mapped label primary, the rest secondary.
+func muscleGroupsFromLabels(labels []string) []entities.ExerciseMuscle {
+	groups := []entities.ExerciseMuscle{}
+	seen := make(map[vos.MuscleGroup]bool, len(labels))
+	for i, label := range labels {
+		mg, ok := vos.MuscleGroupFromLabel(label)
+		if !ok || seen[mg] {
+			continue
+		}
+		seen[mg] = true
+		role := vos.MuscleRoleSecondary
+		if i == 0 {
+			role = vos.MuscleRolePrimary
+		}
+		groups = append(groups, entities.ExerciseMuscle{MuscleGroup: mg, Role: role, Involvement: role.DefaultInvolvement()})
+	}
+	return groups
+}
+
+func optionalString(s string) *string {
+	if s == "" {
+		return nil
+	}
+	return &s
+}
+
+func derefString(s *string) string {
+	if s == nil {
+		return ""
+	}
+	return *s
+}
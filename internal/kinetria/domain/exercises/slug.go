@@ -0,0 +1,37 @@
+package exercises
+
+import (
+	"regexp"
+	"strings"
+)
+
+// slugPattern is the accepted format for exercise slugs: lowercase words joined by hyphens.
+var slugPattern = regexp.MustCompile(`^[a-z0-9]+(-[a-z0-9]+)*$`)
+
+// slugAccents mirrors the translate() table of exercise_slugify() in migration 017,
+// so slugs derived here match the ones backfilled by the database.
+var slugAccents = strings.NewReplacer(
+	"á", "a", "à", "a", "â", "a", "ã", "a", "ä", "a",
+	"é", "e", "è", "e", "ê", "e", "ë", "e",
+	"í", "i", "ì", "i", "î", "i", "ï", "i",
+	"ó", "o", "ò", "o", "ô", "o", "õ", "o", "ö", "o",
+	"ú", "u", "ù", "u", "û", "u", "ü", "u",
+	"ç", "c", "ñ", "n",
+)
+
+var slugSeparators = regexp.MustCompile(`[^a-z0-9]+`)
+
+// Slugify derives a slug from an exercise name ("Supino Reto com Barra" → "supino-reto-com-barra").
+func Slugify(name string) string {
+	s := slugAccents.Replace(strings.ToLower(name))
+	s = strings.Trim(slugSeparators.ReplaceAllString(s, "-"), "-")
+	if s == "" {
+		return "exercise"
+	}
+	return s
+}
+
+// IsValidSlug reports whether s is a well-formed exercise slug.
+func IsValidSlug(s string) bool {
+	return len(s) <= 255 && slugPattern.MatchString(s)
+}
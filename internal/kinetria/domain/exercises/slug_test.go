@@ -0,0 +1,24 @@
+package exercises_test
+
+import (
+	"testing"
+
+	"github.com/kinetria/kinetria-back/internal/kinetria/domain/exercises"
+)
+
+func TestSlugify(t *testing.T) {
+	tests := []struct {
+		name string
+		want string
+	}{
+		{"Supino Reto com Barra", "supino-reto-com-barra"},
+		{"Elevação Lateral", "elevacao-lateral"},
+		{"  Rosca 21's  ", "rosca-21-s"},
+		{"!!!", "exercise"},
+	}
+	for _, tt := range tests {
+		if got := exercises.Slugify(tt.name); got != tt.want {
+			t.Errorf("Slugify(%q) = %q, want %q", tt.name, got, tt.want)
+		}
+	}
+}
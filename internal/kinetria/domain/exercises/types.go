@@ -11,3 +11,42 @@ type ExerciseWithStats struct {
 	Exercise  *entities.Exercise
 	UserStats *ports.ExerciseUserStats
 }
+
+// ImportAction is what an import does with a single exercise.
+type ImportAction string
+
+const (
+	ImportActionCreate    ImportAction = "create"
+	ImportActionUpdate    ImportAction = "update"
+	ImportActionUnchanged ImportAction = "unchanged"
+)
+
+// ImportChange describes the effect of one imported row on the library.
+// Fields lists the changed fields for updates.
+type ImportChange struct {
+	Row    int
+	Slug   string
+	Action ImportAction
+	Fields []string
+}
+
+// ImportRowError is a validation error on one imported row.
+// Field is empty when the error applies to the row as a whole.
+type ImportRowError struct {
+	Row     int
+	Slug    string
+	Field   string
+	Message string
+}
+
+// ImportResult is the outcome of a bulk exercise import.
+// Applied is false for dry runs and whenever any row failed validation.
+type ImportResult struct {
+	DryRun    bool
+	Applied   bool
+	Created   int
+	Updated   int
+	Unchanged int
+	Changes   []ImportChange
+	Errors    []ImportRowError
+}
@@ -0,0 +1,42 @@
+package exercises
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/kinetria/kinetria-back/internal/kinetria/domain/ports"
+)
+
+// ExportExercisesUC serializes the whole exercise library in the import format,
+// so an export can be edited and re-imported.
+type ExportExercisesUC struct {
+	libraryRepo ports.ExerciseLibraryRepository
+}
+
+// NewExportExercisesUC creates a new ExportExercisesUC.
+func NewExportExercisesUC(libraryRepo ports.ExerciseLibraryRepository) *ExportExercisesUC {
+	return &ExportExercisesUC{libraryRepo: libraryRepo}
+}
+
+// Execute returns the encoded library. Returns ErrMalformedParameters for unknown formats.
+func (uc *ExportExercisesUC) Execute(ctx context.Context, format LibraryFormat) ([]byte, error) {
+	if err := format.Validate(); err != nil {
+		return nil, err
+	}
+
+	exercises, err := uc.libraryRepo.ListAll(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list exercises: %w", err)
+	}
+
+	records := make([]LibraryRecord, 0, len(exercises))
+	for _, e := range exercises {
+		records = append(records, recordFromExercise(e))
+	}
+
+	data, err := encodeLibrary(format, records)
+	if err != nil {
+		return nil, fmt.Errorf("failed to encode exercises: %w", err)
+	}
+	return data, nil
+}
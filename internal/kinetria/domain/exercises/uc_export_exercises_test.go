@@ -0,0 +1,87 @@
+package exercises_test
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/kinetria/kinetria-back/internal/kinetria/domain/entities"
+	domainerrors "github.com/kinetria/kinetria-back/internal/kinetria/domain/errors"
+	"github.com/kinetria/kinetria-back/internal/kinetria/domain/exercises"
+)
+
+func TestExportExercisesUC_Execute(t *testing.T) {
+	repo := &mockLibraryRepo{
+		listAllFunc: func(_ context.Context) ([]*entities.Exercise, error) {
+			return []*entities.Exercise{existingBenchPress(uuid.New())}, nil
+		},
+	}
+	uc := exercises.NewExportExercisesUC(repo)
+
+	t.Run("csv", func(t *testing.T) {
+		data, err := uc.Execute(context.Background(), exercises.LibraryFormatCSV)
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		lines := strings.Split(strings.TrimSpace(string(data)), "\n")
+		if len(lines) != 2 {
+			t.Fatalf("expected header + 1 row, got %q", data)
+		}
+		if !strings.HasPrefix(lines[0], "slug,name,") {
+			t.Errorf("unexpected header %q", lines[0])
+		}
+		if !strings.Contains(lines[1], "Peito|Tríceps,chest:primary:1|triceps:secondary:0.5") {
+			t.Errorf("unexpected row %q", lines[1])
+		}
+	})
+
+	t.Run("json", func(t *testing.T) {
+		data, err := uc.Execute(context.Background(), exercises.LibraryFormatJSON)
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		var records []exercises.LibraryRecord
+		if err := json.Unmarshal(data, &records); err != nil {
+			t.Fatalf("invalid JSON: %v", err)
+		}
+		if len(records) != 1 || records[0].Slug != "supino-reto" || len(records[0].MuscleGroups) != 2 {
+			t.Errorf("unexpected records %+v", records)
+		}
+	})
+
+	t.Run("invalid_format", func(t *testing.T) {
+		_, err := uc.Execute(context.Background(), "xml")
+		if !errors.Is(err, domainerrors.ErrMalformedParameters) {
+			t.Errorf("expected ErrMalformedParameters, got %v", err)
+		}
+	})
+}
+
+// An export must re-import as a no-op.
+func TestExportExercisesUC_RoundTrip(t *testing.T) {
+	existing := existingBenchPress(uuid.New())
+	repo := &mockLibraryRepo{
+		listAllFunc: func(_ context.Context) ([]*entities.Exercise, error) {
+			return []*entities.Exercise{existing}, nil
+		},
+	}
+
+	for _, format := range []exercises.LibraryFormat{exercises.LibraryFormatCSV, exercises.LibraryFormatJSON} {
+		t.Run(string(format), func(t *testing.T) {
+			data, err := exercises.NewExportExercisesUC(repo).Execute(context.Background(), format)
+			if err != nil {
+				t.Fatalf("export failed: %v", err)
+			}
+			result, err := exercises.NewImportExercisesUC(repo).Execute(context.Background(), exercises.ImportExercisesInput{Format: format, Data: data})
+			if err != nil {
+				t.Fatalf("import failed: %v", err)
+			}
+			if result.Unchanged != 1 || result.Created != 0 || result.Updated != 0 || len(result.Errors) != 0 {
+				t.Errorf("expected a no-op re-import, got %+v", result)
+			}
+		})
+	}
+}
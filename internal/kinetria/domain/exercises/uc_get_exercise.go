@@ -5,6 +5,7 @@ import (
 	"fmt"
 
 	"github.com/google/uuid"
+	"github.com/kinetria/kinetria-back/internal/kinetria/domain/entities"
 	"github.com/kinetria/kinetria-back/internal/kinetria/domain/errors"
 	"github.com/kinetria/kinetria-back/internal/kinetria/domain/ports"
 )
@@ -13,14 +14,15 @@ import (
 // If a userID is provided, it also fetches the user's performance stats for the exercise.
 type GetExerciseUC struct {
 	exerciseRepo ports.ExerciseRepository
+	favoriteRepo ports.FavoriteRepository
 }
 
 // NewGetExerciseUC creates a new GetExerciseUC.
-func NewGetExerciseUC(exerciseRepo ports.ExerciseRepository) *GetExerciseUC {
-	return &GetExerciseUC{exerciseRepo: exerciseRepo}
+func NewGetExerciseUC(exerciseRepo ports.ExerciseRepository, favoriteRepo ports.FavoriteRepository) *GetExerciseUC {
+	return &GetExerciseUC{exerciseRepo: exerciseRepo, favoriteRepo: favoriteRepo}
 }
 
-// Execute retrieves the exercise and optionally its user stats.
+// Execute retrieves the exercise and optionally its user stats and favorite flag.
 // userID may be nil for unauthenticated requests — stats will be omitted in that case.
 // Returns errors.ErrExerciseNotFound if the exercise does not exist.
 func (uc *GetExerciseUC) Execute(ctx context.Context, exerciseID uuid.UUID, userID *uuid.UUID) (*ExerciseWithStats, error) {
@@ -40,6 +42,10 @@ func (uc *GetExerciseUC) Execute(ctx context.Context, exerciseID uuid.UUID, user
 			return nil, fmt.Errorf("failed to get user stats: %w", err)
 		}
 		result.UserStats = stats
+
+		if err := markFavoriteExercises(ctx, uc.favoriteRepo, *userID, []*entities.Exercise{exercise}); err != nil {
+			return nil, err
+		}
 	}
 
 	return result, nil
@@ -137,7 +137,7 @@ func TestGetExerciseUC_Execute(t *testing.T) {
 				},
 			}
 
-			uc := exercises.NewGetExerciseUC(mockRepo)
+			uc := exercises.NewGetExerciseUC(mockRepo, &mockFavoriteRepo{})
 			result, err := uc.Execute(context.Background(), tt.exerciseID, tt.userID)
 
 			if tt.wantErrIs != nil {
@@ -182,3 +182,23 @@ func TestGetExerciseUC_Execute(t *testing.T) {
 		})
 	}
 }
+
+func TestGetExerciseUC_Execute_IsFavorite(t *testing.T) {
+	exerciseID := uuid.New()
+	userID := uuid.New()
+	mockRepo := &mockExerciseRepoForGet{
+		getByIDFunc: func(_ context.Context, _ uuid.UUID) (*entities.Exercise, error) {
+			return &entities.Exercise{ID: exerciseID, Name: "Supino Reto"}, nil
+		},
+	}
+	favoriteRepo := &mockFavoriteRepo{exercises: map[uuid.UUID]bool{exerciseID: true}}
+	uc := exercises.NewGetExerciseUC(mockRepo, favoriteRepo)
+
+	result, err := uc.Execute(context.Background(), exerciseID, &userID)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if !result.Exercise.IsFavorite {
+		t.Error("expected exercise to be marked as favorite")
+	}
+}
@@ -0,0 +1,72 @@
+package exercises
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/google/uuid"
+	"github.com/kinetria/kinetria-back/internal/kinetria/domain/entities"
+	"github.com/kinetria/kinetria-back/internal/kinetria/domain/errors"
+	"github.com/kinetria/kinetria-back/internal/kinetria/domain/ports"
+	"github.com/kinetria/kinetria-back/internal/kinetria/domain/vos"
+)
+
+// MaxRecentExercises caps the number of exercises returned by GetRecentExercisesUC.
+const MaxRecentExercises = 50
+
+// GetRecentExercisesInput holds parameters for listing a user's recently performed exercises.
+type GetRecentExercisesInput struct {
+	UserID uuid.UUID
+	Sort   vos.RecentExerciseSort
+	Limit  int
+}
+
+// GetRecentExercisesOutput holds the user's recently performed exercises.
+type GetRecentExercisesOutput struct {
+	Exercises []ports.RecentExercise
+}
+
+// GetRecentExercisesUC is the use case for listing the exercises a user performed most
+// recently or most frequently.
+type GetRecentExercisesUC struct {
+	usageRepo    ports.ExerciseUsageRepository
+	favoriteRepo ports.FavoriteRepository
+}
+
+// NewGetRecentExercisesUC creates a new GetRecentExercisesUC.
+func NewGetRecentExercisesUC(usageRepo ports.ExerciseUsageRepository, favoriteRepo ports.FavoriteRepository) *GetRecentExercisesUC {
+	return &GetRecentExercisesUC{usageRepo: usageRepo, favoriteRepo: favoriteRepo}
+}
+
+// Execute returns up to input.Limit exercises performed by the user in completed sessions.
+// An empty Sort defaults to vos.RecentExerciseSortRecent.
+func (uc *GetRecentExercisesUC) Execute(ctx context.Context, input GetRecentExercisesInput) (GetRecentExercisesOutput, error) {
+	if input.UserID == uuid.Nil {
+		return GetRecentExercisesOutput{}, fmt.Errorf("userId cannot be empty: %w", errors.ErrMalformedParameters)
+	}
+	if input.Limit < 1 || input.Limit > MaxRecentExercises {
+		return GetRecentExercisesOutput{}, fmt.Errorf("limit must be between 1 and %d: %w", MaxRecentExercises, errors.ErrMalformedParameters)
+	}
+	sort := input.Sort
+	if sort == "" {
+		sort = vos.RecentExerciseSortRecent
+	}
+	if err := sort.Validate(); err != nil {
+		return GetRecentExercisesOutput{}, err
+	}
+
+	recent, err := uc.usageRepo.ListRecentByUser(ctx, input.UserID, sort, input.Limit)
+	if err != nil {
+		return GetRecentExercisesOutput{}, fmt.Errorf("failed to list recent exercises: %w", err)
+	}
+
+	exercises := make([]*entities.Exercise, len(recent))
+	for i := range recent {
+		exercises[i] = recent[i].Exercise
+	}
+	if err := markFavoriteExercises(ctx, uc.favoriteRepo, input.UserID, exercises); err != nil {
+		return GetRecentExercisesOutput{}, err
+	}
+
+	return GetRecentExercisesOutput{Exercises: recent}, nil
+}
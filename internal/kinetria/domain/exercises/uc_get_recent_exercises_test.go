@@ -0,0 +1,157 @@
+package exercises_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/kinetria/kinetria-back/internal/kinetria/domain/entities"
+	domainerrors "github.com/kinetria/kinetria-back/internal/kinetria/domain/errors"
+	"github.com/kinetria/kinetria-back/internal/kinetria/domain/exercises"
+	"github.com/kinetria/kinetria-back/internal/kinetria/domain/ports"
+	"github.com/kinetria/kinetria-back/internal/kinetria/domain/vos"
+)
+
+// mockUsageRepo implements ports.ExerciseUsageRepository.
+type mockUsageRepo struct {
+	recent    []ports.RecentExercise
+	gotSort   vos.RecentExerciseSort
+	gotLimit  int
+	refreshed int64
+	gotNow    time.Time
+	gotSince  time.Time
+	gotHalf   time.Duration
+	err       error
+}
+
+func (m *mockUsageRepo) ListRecentByUser(_ context.Context, _ uuid.UUID, sort vos.RecentExerciseSort, limit int) ([]ports.RecentExercise, error) {
+	m.gotSort = sort
+	m.gotLimit = limit
+	if m.err != nil {
+		return nil, m.err
+	}
+	return m.recent, nil
+}
+
+func (m *mockUsageRepo) RefreshPopularity(_ context.Context, now, since time.Time, halfLife time.Duration) (int64, error) {
+	m.gotNow, m.gotSince, m.gotHalf = now, since, halfLife
+	if m.err != nil {
+		return 0, m.err
+	}
+	return m.refreshed, nil
+}
+
+func TestGetRecentExercisesUC_Execute(t *testing.T) {
+	userID := uuid.New()
+	squat := &entities.Exercise{ID: uuid.New(), Name: "Agachamento"}
+	bench := &entities.Exercise{ID: uuid.New(), Name: "Supino Reto"}
+	recent := []ports.RecentExercise{
+		{Exercise: squat, LastPerformedAt: time.Now(), TimesPerformed: 2},
+		{Exercise: bench, LastPerformedAt: time.Now().Add(-48 * time.Hour), TimesPerformed: 7},
+	}
+
+	tests := []struct {
+		name     string
+		input    exercises.GetRecentExercisesInput
+		repoErr  error
+		wantSort vos.RecentExerciseSort
+		wantErr  error
+		wantLen  int
+	}{
+		{
+			name:     "defaults to recent",
+			input:    exercises.GetRecentExercisesInput{UserID: userID, Limit: 10},
+			wantSort: vos.RecentExerciseSortRecent,
+			wantLen:  2,
+		},
+		{
+			name:     "frequent",
+			input:    exercises.GetRecentExercisesInput{UserID: userID, Sort: vos.RecentExerciseSortFrequent, Limit: 10},
+			wantSort: vos.RecentExerciseSortFrequent,
+			wantLen:  2,
+		},
+		{
+			name:    "invalid sort",
+			input:   exercises.GetRecentExercisesInput{UserID: userID, Sort: "popularity", Limit: 10},
+			wantErr: domainerrors.ErrMalformedParameters,
+		},
+		{
+			name:    "limit too large",
+			input:   exercises.GetRecentExercisesInput{UserID: userID, Limit: exercises.MaxRecentExercises + 1},
+			wantErr: domainerrors.ErrMalformedParameters,
+		},
+		{
+			name:    "limit zero",
+			input:   exercises.GetRecentExercisesInput{UserID: userID},
+			wantErr: domainerrors.ErrMalformedParameters,
+		},
+		{
+			name:    "missing user",
+			input:   exercises.GetRecentExercisesInput{Limit: 10},
+			wantErr: domainerrors.ErrMalformedParameters,
+		},
+		{
+			name:    "repository error",
+			input:   exercises.GetRecentExercisesInput{UserID: userID, Limit: 10},
+			repoErr: errors.New("db down"),
+			wantLen: -1,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			repo := &mockUsageRepo{recent: recent, err: tt.repoErr}
+			uc := exercises.NewGetRecentExercisesUC(repo, &mockFavoriteRepo{})
+
+			out, err := uc.Execute(context.Background(), tt.input)
+			if tt.wantErr != nil {
+				if !errors.Is(err, tt.wantErr) {
+					t.Fatalf("expected %v, got %v", tt.wantErr, err)
+				}
+				return
+			}
+			if tt.repoErr != nil {
+				if err == nil {
+					t.Fatal("expected error, got nil")
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if repo.gotSort != tt.wantSort {
+				t.Errorf("expected sort %q, got %q", tt.wantSort, repo.gotSort)
+			}
+			if repo.gotLimit != tt.input.Limit {
+				t.Errorf("expected limit %d, got %d", tt.input.Limit, repo.gotLimit)
+			}
+			if len(out.Exercises) != tt.wantLen {
+				t.Errorf("expected %d exercises, got %d", tt.wantLen, len(out.Exercises))
+			}
+		})
+	}
+}
+
+func TestGetRecentExercisesUC_Execute_MarksFavorites(t *testing.T) {
+	userID := uuid.New()
+	squat := &entities.Exercise{ID: uuid.New(), Name: "Agachamento"}
+	bench := &entities.Exercise{ID: uuid.New(), Name: "Supino Reto"}
+	repo := &mockUsageRepo{recent: []ports.RecentExercise{
+		{Exercise: squat, LastPerformedAt: time.Now(), TimesPerformed: 1},
+		{Exercise: bench, LastPerformedAt: time.Now(), TimesPerformed: 1},
+	}}
+	favoriteRepo := &mockFavoriteRepo{exercises: map[uuid.UUID]bool{bench.ID: true}}
+
+	out, err := exercises.NewGetRecentExercisesUC(repo, favoriteRepo).Execute(context.Background(), exercises.GetRecentExercisesInput{UserID: userID, Limit: 5})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if out.Exercises[0].Exercise.IsFavorite {
+		t.Error("expected squat not to be favorite")
+	}
+	if !out.Exercises[1].Exercise.IsFavorite {
+		t.Error("expected bench to be favorite")
+	}
+}
@@ -0,0 +1,178 @@
+package exercises
+
+import (
+	"context"
+	"fmt"
+	"reflect"
+
+	"github.com/google/uuid"
+	"github.com/kinetria/kinetria-back/internal/kinetria/domain/entities"
+	"github.com/kinetria/kinetria-back/internal/kinetria/domain/ports"
+)
+
+// ImportExercisesInput is the payload of a bulk library import.
+type ImportExercisesInput struct {
+	Format LibraryFormat
+	Data   []byte
+	DryRun bool
+}
+
+// ImportExercisesUC upserts library exercises in bulk, matching existing ones by slug.
+// The import is all-or-nothing: if any row fails validation nothing is written,
+// and every row error is reported so the file can be fixed in one pass.
+type ImportExercisesUC struct {
+	libraryRepo ports.ExerciseLibraryRepository
+}
+
+// NewImportExercisesUC creates a new ImportExercisesUC.
+func NewImportExercisesUC(libraryRepo ports.ExerciseLibraryRepository) *ImportExercisesUC {
+	return &ImportExercisesUC{libraryRepo: libraryRepo}
+}
+
+// Execute validates the file, diffs it against the current library and, unless
+// DryRun is set, applies the creates and updates.
+// Returns ErrMalformedParameters if the format is unknown or the file cannot be parsed at all.
+func (uc *ImportExercisesUC) Execute(ctx context.Context, input ImportExercisesInput) (*ImportResult, error) {
+	if err := input.Format.Validate(); err != nil {
+		return nil, err
+	}
+
+	records, rowErrs, err := decodeLibrary(input.Format, input.Data)
+	if err != nil {
+		return nil, err
+	}
+
+	result := &ImportResult{DryRun: input.DryRun, Changes: []ImportChange{}, Errors: rowErrs}
+
+	existing, err := uc.libraryRepo.ListAll(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list exercises: %w", err)
+	}
+	bySlug := make(map[string]*entities.Exercise, len(existing))
+	for _, e := range existing {
+		bySlug[e.Slug] = e
+	}
+
+	var toWrite []*entities.Exercise
+	seenRows := make(map[string]int, len(records))
+	for _, nr := range records {
+		exercise, errs := nr.Record.toExercise(nr.Row)
+		if len(errs) > 0 {
+			result.Errors = append(result.Errors, errs...)
+			continue
+		}
+		if firstRow, dup := seenRows[exercise.Slug]; dup {
+			result.Errors = append(result.Errors, ImportRowError{
+				Row:     nr.Row,
+				Slug:    exercise.Slug,
+				Field:   "slug",
+				Message: fmt.Sprintf("duplicate slug, already used on row %d", firstRow),
+			})
+			continue
+		}
+		seenRows[exercise.Slug] = nr.Row
+
+		change := ImportChange{Row: nr.Row, Slug: exercise.Slug}
+		current, ok := bySlug[exercise.Slug]
+		switch {
+		case !ok:
+			change.Action = ImportActionCreate
+			exercise.ID = uuid.New()
+			result.Created++
+			toWrite = append(toWrite, exercise)
+		default:
+			change.Fields = changedFields(current, exercise)
+			if len(change.Fields) == 0 {
+				change.Action = ImportActionUnchanged
+				result.Unchanged++
+				break
+			}
+			change.Action = ImportActionUpdate
+			exercise.ID = current.ID
+			result.Updated++
+			toWrite = append(toWrite, exercise)
+		}
+		result.Changes = append(result.Changes, change)
+	}
+
+	if input.DryRun || len(result.Errors) > 0 || len(toWrite) == 0 {
+		return result, nil
+	}
+
+	if err := uc.libraryRepo.UpsertBySlug(ctx, toWrite); err != nil {
+		return nil, fmt.Errorf("failed to upsert exercises: %w", err)
+	}
+	result.Applied = true
+
+	return result, nil
+}
+
+// changedFields lists the import-format fields that differ between two exercises.
+func changedFields(current, incoming *entities.Exercise) []string {
+	a, b := recordFromExercise(current), recordFromExercise(incoming)
+	var fields []string
+	if a.Name != b.Name {
+		fields = append(fields, "name")
+	}
+	if a.Description != b.Description {
+		fields = append(fields, "description")
+	}
+	if a.ThumbnailURL != b.ThumbnailURL {
+		fields = append(fields, "thumbnailUrl")
+	}
+	if a.VideoURL != b.VideoURL {
+		fields = append(fields, "videoUrl")
+	}
+	if a.Instructions != b.Instructions {
+		fields = append(fields, "instructions")
+	}
+	if a.Tips != b.Tips {
+		fields = append(fields, "tips")
+	}
+	if a.Difficulty != b.Difficulty {
+		fields = append(fields, "difficulty")
+	}
+	if a.Equipment != b.Equipment {
+		fields = append(fields, "equipment")
+	}
+	if !reflect.DeepEqual(a.Muscles, b.Muscles) {
+		fields = append(fields, "muscles")
+	}
+	if !sameMuscleGroups(a.MuscleGroups, b.MuscleGroups) {
+		fields = append(fields, "muscleGroups")
+	}
+	if !sameTags(a.Tags, b.Tags) {
+		fields = append(fields, "tags")
+	}
+	return fields
+}
+
+// sameTags compares tags regardless of order.
+func sameTags(a, b []string) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	for _, tag := range b {
+		if !containsString(a, tag) {
+			return false
+		}
+	}
+	return true
+}
+
+// sameMuscleGroups compares muscle groups regardless of order.
+func sameMuscleGroups(a, b []LibraryMuscleGroup) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	index := make(map[string]LibraryMuscleGroup, len(a))
+	for _, g := range a {
+		index[g.MuscleGroup] = g
+	}
+	for _, g := range b {
+		if index[g.MuscleGroup] != g {
+			return false
+		}
+	}
+	return true
+}
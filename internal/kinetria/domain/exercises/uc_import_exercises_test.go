@@ -0,0 +1,257 @@
+package exercises_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/kinetria/kinetria-back/internal/kinetria/domain/entities"
+	domainerrors "github.com/kinetria/kinetria-back/internal/kinetria/domain/errors"
+	"github.com/kinetria/kinetria-back/internal/kinetria/domain/exercises"
+	"github.com/kinetria/kinetria-back/internal/kinetria/domain/vos"
+)
+
+// mockLibraryRepo is an inline mock for the import/export use cases.
+type mockLibraryRepo struct {
+	listAllFunc func(ctx context.Context) ([]*entities.Exercise, error)
+	upserted    []*entities.Exercise
+	upsertErr   error
+}
+
+func (m *mockLibraryRepo) ListAll(ctx context.Context) ([]*entities.Exercise, error) {
+	if m.listAllFunc != nil {
+		return m.listAllFunc(ctx)
+	}
+	return nil, nil
+}
+
+func (m *mockLibraryRepo) UpsertBySlug(_ context.Context, list []*entities.Exercise) error {
+	m.upserted = list
+	return m.upsertErr
+}
+
+func existingBenchPress(id uuid.UUID) *entities.Exercise {
+	desc := "Exercício composto para peitoral"
+	return &entities.Exercise{
+		ID:           id,
+		Slug:         "supino-reto",
+		Name:         "Supino Reto",
+		ThumbnailURL: "/assets/exercises/generic.png",
+		Muscles:      []string{"Peito", "Tríceps"},
+		MuscleGroups: []entities.ExerciseMuscle{
+			{MuscleGroup: vos.MuscleGroupChest, Role: vos.MuscleRolePrimary, Involvement: 1},
+			{MuscleGroup: vos.MuscleGroupTriceps, Role: vos.MuscleRoleSecondary, Involvement: 0.5},
+		},
+		Tags:        []string{"bench"},
+		Description: &desc,
+	}
+}
+
+func TestImportExercisesUC_Execute(t *testing.T) {
+	existingID := uuid.New()
+
+	tests := []struct {
+		name          string
+		input         exercises.ImportExercisesInput
+		listErr       error
+		wantErrIs     error
+		wantErr       bool
+		wantApplied   bool
+		wantCreated   int
+		wantUpdated   int
+		wantUnchanged int
+		wantErrors    int
+		wantUpserted  int
+	}{
+		{
+			name: "json_create_update_unchanged",
+			input: exercises.ImportExercisesInput{
+				Format: exercises.LibraryFormatJSON,
+				Data: []byte(`[
+					{"slug":"supino-reto","name":"Supino Reto","description":"Exercício composto para peitoral","muscles":["Peito","Tríceps"],"tags":["bench"]},
+					{"name":"Remada Curvada","muscles":["Costas","Bíceps"]}
+				]`),
+			},
+			wantApplied:   true,
+			wantCreated:   1,
+			wantUnchanged: 1,
+			wantUpserted:  1,
+		},
+		{
+			name: "csv_update",
+			input: exercises.ImportExercisesInput{
+				Format: exercises.LibraryFormatCSV,
+				Data: []byte("slug,name,description,muscles,muscle_groups\n" +
+					"supino-reto,Supino Reto,Nova descrição,Peito|Tríceps,chest:primary:1|triceps:secondary:0.5\n"),
+			},
+			wantApplied:  true,
+			wantUpdated:  1,
+			wantUpserted: 1,
+		},
+		{
+			name: "dry_run_does_not_write",
+			input: exercises.ImportExercisesInput{
+				Format: exercises.LibraryFormatJSON,
+				Data:   []byte(`[{"name":"Agachamento Livre","muscles":["Pernas"]}]`),
+				DryRun: true,
+			},
+			wantCreated: 1,
+		},
+		{
+			name: "row_errors_block_whole_import",
+			input: exercises.ImportExercisesInput{
+				Format: exercises.LibraryFormatJSON,
+				Data: []byte(`[
+					{"name":"Agachamento Livre","muscles":["Pernas"]},
+					{"slug":"Bad Slug","name":"","muscles":[]},
+					{"name":"Agachamento Livre","muscleGroups":[{"muscleGroup":"neck","role":"primary"}]}
+				]`),
+			},
+			wantCreated: 1,
+			wantErrors:  3,
+		},
+		{
+			name: "invalid_tag",
+			input: exercises.ImportExercisesInput{
+				Format: exercises.LibraryFormatCSV,
+				Data:   []byte("name,muscles,tags\nAgachamento Frontal,Quadríceps,squat|front squat\n"),
+			},
+			wantErrors: 1,
+		},
+		{
+			name: "duplicate_slug_in_file",
+			input: exercises.ImportExercisesInput{
+				Format: exercises.LibraryFormatCSV,
+				Data:   []byte("name,muscles\nRosca Direta,Bíceps\nRosca Direta,Bíceps\n"),
+			},
+			wantCreated: 1,
+			wantErrors:  1,
+		},
+		{
+			name:      "invalid_format",
+			input:     exercises.ImportExercisesInput{Format: "xml", Data: []byte("<x/>")},
+			wantErrIs: domainerrors.ErrMalformedParameters,
+		},
+		{
+			name:      "unparseable_json",
+			input:     exercises.ImportExercisesInput{Format: exercises.LibraryFormatJSON, Data: []byte(`{"name":"x"}`)},
+			wantErrIs: domainerrors.ErrMalformedParameters,
+		},
+		{
+			name:      "csv_without_name_column",
+			input:     exercises.ImportExercisesInput{Format: exercises.LibraryFormatCSV, Data: []byte("slug\nfoo\n")},
+			wantErrIs: domainerrors.ErrMalformedParameters,
+		},
+		{
+			name:    "repository_error",
+			input:   exercises.ImportExercisesInput{Format: exercises.LibraryFormatJSON, Data: []byte(`[]`)},
+			listErr: errors.New("db down"),
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			repo := &mockLibraryRepo{
+				listAllFunc: func(_ context.Context) ([]*entities.Exercise, error) {
+					if tt.listErr != nil {
+						return nil, tt.listErr
+					}
+					return []*entities.Exercise{existingBenchPress(existingID)}, nil
+				},
+			}
+			uc := exercises.NewImportExercisesUC(repo)
+
+			result, err := uc.Execute(context.Background(), tt.input)
+
+			if tt.wantErrIs != nil || tt.wantErr {
+				if err == nil {
+					t.Fatal("expected error, got nil")
+				}
+				if tt.wantErrIs != nil && !errors.Is(err, tt.wantErrIs) {
+					t.Errorf("expected %v, got %v", tt.wantErrIs, err)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+
+			if result.Applied != tt.wantApplied {
+				t.Errorf("Applied = %v, want %v", result.Applied, tt.wantApplied)
+			}
+			if result.Created != tt.wantCreated || result.Updated != tt.wantUpdated || result.Unchanged != tt.wantUnchanged {
+				t.Errorf("counts = %d/%d/%d, want %d/%d/%d",
+					result.Created, result.Updated, result.Unchanged,
+					tt.wantCreated, tt.wantUpdated, tt.wantUnchanged)
+			}
+			if len(result.Errors) != tt.wantErrors {
+				t.Errorf("got %d row errors, want %d: %+v", len(result.Errors), tt.wantErrors, result.Errors)
+			}
+			if len(repo.upserted) != tt.wantUpserted {
+				t.Errorf("upserted %d exercises, want %d", len(repo.upserted), tt.wantUpserted)
+			}
+		})
+	}
+}
+
+func TestImportExercisesUC_Execute_UpdateKeepsIDAndReportsFields(t *testing.T) {
+	existingID := uuid.New()
+	repo := &mockLibraryRepo{
+		listAllFunc: func(_ context.Context) ([]*entities.Exercise, error) {
+			return []*entities.Exercise{existingBenchPress(existingID)}, nil
+		},
+	}
+	uc := exercises.NewImportExercisesUC(repo)
+
+	result, err := uc.Execute(context.Background(), exercises.ImportExercisesInput{
+		Format: exercises.LibraryFormatJSON,
+		Data:   []byte(`[{"slug":"supino-reto","name":"Supino Reto","difficulty":"Intermediário","muscles":["Peito","Tríceps","Ombros"],"tags":["Bench","powerlifting"]}]`),
+	})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if len(repo.upserted) != 1 || repo.upserted[0].ID != existingID {
+		t.Fatalf("expected update of existing exercise %s, got %+v", existingID, repo.upserted)
+	}
+	want := []string{"description", "difficulty", "muscles", "muscleGroups", "tags"}
+	got := result.Changes[0].Fields
+	if len(got) != len(want) {
+		t.Fatalf("changed fields = %v, want %v", got, want)
+	}
+	for i := range want {
+		if got[i] != want[i] {
+			t.Errorf("changed fields = %v, want %v", got, want)
+		}
+	}
+	if n := len(repo.upserted[0].MuscleGroups); n != 3 {
+		t.Errorf("expected 3 muscle groups derived from muscles, got %d", n)
+	}
+	if tags := repo.upserted[0].Tags; len(tags) != 2 || tags[0] != "bench" {
+		t.Errorf("expected normalized tags [bench powerlifting], got %v", tags)
+	}
+}
+
+func TestImportExercisesUC_Execute_CSVRowNumbers(t *testing.T) {
+	uc := exercises.NewImportExercisesUC(&mockLibraryRepo{})
+
+	result, err := uc.Execute(context.Background(), exercises.ImportExercisesInput{
+		Format: exercises.LibraryFormatCSV,
+		Data:   []byte("name,muscles,muscle_groups\nRosca Direta,Bíceps,\n,Bíceps,\nTríceps Testa,Tríceps,triceps\n"),
+	})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if len(result.Errors) != 2 {
+		t.Fatalf("expected 2 row errors, got %+v", result.Errors)
+	}
+	if result.Errors[0].Row != 4 || result.Errors[0].Field != "muscle_groups" {
+		t.Errorf("expected muscle_groups error on line 4, got %+v", result.Errors[0])
+	}
+	if result.Errors[1].Row != 3 || result.Errors[1].Field != "name" {
+		t.Errorf("expected name error on line 3, got %+v", result.Errors[1])
+	}
+}
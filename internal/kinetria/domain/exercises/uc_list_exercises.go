@@ -5,7 +5,9 @@ import (
 	"fmt"
 	"math"
 
+	"github.com/google/uuid"
 	"github.com/kinetria/kinetria-back/internal/kinetria/domain/entities"
+	"github.com/kinetria/kinetria-back/internal/kinetria/domain/errors"
 	"github.com/kinetria/kinetria-back/internal/kinetria/domain/ports"
 )
 
@@ -14,6 +16,11 @@ type ListExercisesInput struct {
 	Filters  ports.ExerciseFilters
 	Page     int
 	PageSize int
+
+	// UserID is the requesting user (nil for anonymous requests); used for IsFavorite.
+	UserID *uuid.UUID
+	// FavoritesOnly restricts the list to the user's favorites. Requires UserID.
+	FavoritesOnly bool
 }
 
 // ListExercisesOutput holds the result of listing exercises.
@@ -28,15 +35,16 @@ type ListExercisesOutput struct {
 // ListExercisesUC is the use case for listing exercises from the library with optional filters.
 type ListExercisesUC struct {
 	exerciseRepo ports.ExerciseRepository
+	favoriteRepo ports.FavoriteRepository
 }
 
 // NewListExercisesUC creates a new ListExercisesUC.
-func NewListExercisesUC(exerciseRepo ports.ExerciseRepository) *ListExercisesUC {
-	return &ListExercisesUC{exerciseRepo: exerciseRepo}
+func NewListExercisesUC(exerciseRepo ports.ExerciseRepository, favoriteRepo ports.FavoriteRepository) *ListExercisesUC {
+	return &ListExercisesUC{exerciseRepo: exerciseRepo, favoriteRepo: favoriteRepo}
 }
 
 // Execute retrieves a paginated list of exercises matching the provided filters.
-// Returns an error if pagination parameters are invalid.
+// Returns an error if pagination parameters or the sort order are invalid.
 func (uc *ListExercisesUC) Execute(ctx context.Context, input ListExercisesInput) (ListExercisesOutput, error) {
 	// Validate
 	if input.Page < 1 {
@@ -48,12 +56,31 @@ func (uc *ListExercisesUC) Execute(ctx context.Context, input ListExercisesInput
 	if input.PageSize > 100 {
 		return ListExercisesOutput{}, fmt.Errorf("pageSize must be <= 100")
 	}
+	if input.Filters.Sort != "" {
+		if err := input.Filters.Sort.Validate(); err != nil {
+			return ListExercisesOutput{}, err
+		}
+	}
+	if input.FavoritesOnly && input.UserID == nil {
+		return ListExercisesOutput{}, fmt.Errorf("favorites filter requires an authenticated user: %w", errors.ErrMalformedParameters)
+	}
 
-	exercises, total, err := uc.exerciseRepo.List(ctx, input.Filters, input.Page, input.PageSize)
+	filters := input.Filters
+	if input.FavoritesOnly {
+		filters.FavoritesOf = input.UserID
+	}
+
+	exercises, total, err := uc.exerciseRepo.List(ctx, filters, input.Page, input.PageSize)
 	if err != nil {
 		return ListExercisesOutput{}, fmt.Errorf("failed to list exercises: %w", err)
 	}
 
+	if input.UserID != nil {
+		if err := markFavoriteExercises(ctx, uc.favoriteRepo, *input.UserID, exercises); err != nil {
+			return ListExercisesOutput{}, err
+		}
+	}
+
 	totalPages := 0
 	if total > 0 {
 		totalPages = int(math.Ceil(float64(total) / float64(input.PageSize)))
@@ -67,3 +94,22 @@ func (uc *ListExercisesUC) Execute(ctx context.Context, input ListExercisesInput
 		TotalPages: totalPages,
 	}, nil
 }
+
+// markFavoriteExercises sets IsFavorite on each exercise for the given user.
+func markFavoriteExercises(ctx context.Context, favoriteRepo ports.FavoriteRepository, userID uuid.UUID, exercises []*entities.Exercise) error {
+	if len(exercises) == 0 {
+		return nil
+	}
+	ids := make([]uuid.UUID, len(exercises))
+	for i, e := range exercises {
+		ids[i] = e.ID
+	}
+	favorites, err := favoriteRepo.FavoriteExerciseIDs(ctx, userID, ids)
+	if err != nil {
+		return fmt.Errorf("failed to load favorite exercises: %w", err)
+	}
+	for _, e := range exercises {
+		e.IsFavorite = favorites[e.ID]
+	}
+	return nil
+}
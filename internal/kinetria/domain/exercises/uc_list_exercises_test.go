@@ -8,8 +8,10 @@ import (
 
 	"github.com/google/uuid"
 	"github.com/kinetria/kinetria-back/internal/kinetria/domain/entities"
+	domainerrors "github.com/kinetria/kinetria-back/internal/kinetria/domain/errors"
 	"github.com/kinetria/kinetria-back/internal/kinetria/domain/exercises"
 	"github.com/kinetria/kinetria-back/internal/kinetria/domain/ports"
+	"github.com/kinetria/kinetria-back/internal/kinetria/domain/vos"
 )
 
 // mockExerciseRepo is an inline mock that only needs methods for ListExercisesUC tests.
@@ -202,6 +204,27 @@ func TestListExercisesUC_Execute(t *testing.T) {
 			mockError:       errors.New("db connection failed"),
 			wantErrContains: "failed to list exercises",
 		},
+		{
+			name: "sort_by_popularity",
+			input: exercises.ListExercisesInput{
+				Filters:  ports.ExerciseFilters{Sort: vos.ExerciseSortPopularity},
+				Page:     1,
+				PageSize: 20,
+			},
+			mockReturn:     makeExercises(3),
+			mockTotal:      3,
+			wantTotal:      3,
+			wantTotalPages: 1,
+		},
+		{
+			name: "invalid_sort",
+			input: exercises.ListExercisesInput{
+				Filters:  ports.ExerciseFilters{Sort: "newest"},
+				Page:     1,
+				PageSize: 20,
+			},
+			wantErrContains: "invalid exercise sort",
+		},
 		{
 			name: "pagination_page_2",
 			input: exercises.ListExercisesInput{
@@ -229,7 +252,7 @@ func TestListExercisesUC_Execute(t *testing.T) {
 				},
 			}
 
-			uc := exercises.NewListExercisesUC(mockRepo)
+			uc := exercises.NewListExercisesUC(mockRepo, &mockFavoriteRepo{})
 			output, err := uc.Execute(context.Background(), tt.input)
 
 			if tt.wantErrContains != "" {
@@ -269,3 +292,58 @@ func TestListExercisesUC_Execute(t *testing.T) {
 func contains(s, substr string) bool {
 	return strings.Contains(s, substr)
 }
+
+func TestListExercisesUC_Execute_Favorites(t *testing.T) {
+	userID := uuid.New()
+	list := makeExercises(2)
+	favoriteRepo := &mockFavoriteRepo{exercises: map[uuid.UUID]bool{list[1].ID: true}}
+
+	var gotFilters ports.ExerciseFilters
+	mockRepo := &mockExerciseRepoForList{
+		listFunc: func(_ context.Context, filters ports.ExerciseFilters, _, _ int) ([]*entities.Exercise, int, error) {
+			gotFilters = filters
+			return list, 2, nil
+		},
+	}
+	uc := exercises.NewListExercisesUC(mockRepo, favoriteRepo)
+
+	t.Run("favorites_only_filters_by_user", func(t *testing.T) {
+		output, err := uc.Execute(context.Background(), exercises.ListExercisesInput{
+			Page: 1, PageSize: 20, UserID: &userID, FavoritesOnly: true,
+		})
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if gotFilters.FavoritesOf == nil || *gotFilters.FavoritesOf != userID {
+			t.Errorf("expected FavoritesOf=%s, got %v", userID, gotFilters.FavoritesOf)
+		}
+		if output.Exercises[0].IsFavorite || !output.Exercises[1].IsFavorite {
+			t.Errorf("unexpected IsFavorite flags")
+		}
+	})
+
+	t.Run("favorites_only_requires_user", func(t *testing.T) {
+		_, err := uc.Execute(context.Background(), exercises.ListExercisesInput{Page: 1, PageSize: 20, FavoritesOnly: true})
+		if !errors.Is(err, domainerrors.ErrMalformedParameters) {
+			t.Errorf("expected ErrMalformedParameters, got %v", err)
+		}
+	})
+
+	t.Run("anonymous_list_has_no_favorites", func(t *testing.T) {
+		for _, e := range list {
+			e.IsFavorite = false
+		}
+		output, err := uc.Execute(context.Background(), exercises.ListExercisesInput{Page: 1, PageSize: 20})
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if gotFilters.FavoritesOf != nil {
+			t.Errorf("expected no favorites filter, got %v", gotFilters.FavoritesOf)
+		}
+		for _, e := range output.Exercises {
+			if e.IsFavorite {
+				t.Errorf("anonymous request must not mark favorites")
+			}
+		}
+	})
+}
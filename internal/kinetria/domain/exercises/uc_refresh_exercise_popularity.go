@@ -0,0 +1,37 @@
+package exercises
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/kinetria/kinetria-back/internal/kinetria/domain/ports"
+)
+
+const (
+	// PopularityWindow is how far back sessions count towards the popularity score.
+	PopularityWindow = 90 * 24 * time.Hour
+	// PopularityHalfLife is the age at which a session counts half as much as one done today.
+	PopularityHalfLife = 30 * 24 * time.Hour
+)
+
+// RefreshExercisePopularityUC recomputes the global popularity score of every exercise.
+// It is meant to run in batch (see gateways/jobs), never on the request path.
+type RefreshExercisePopularityUC struct {
+	usageRepo ports.ExerciseUsageRepository
+}
+
+// NewRefreshExercisePopularityUC creates a new RefreshExercisePopularityUC.
+func NewRefreshExercisePopularityUC(usageRepo ports.ExerciseUsageRepository) *RefreshExercisePopularityUC {
+	return &RefreshExercisePopularityUC{usageRepo: usageRepo}
+}
+
+// Execute recomputes popularity scores and returns the number of exercises updated.
+func (uc *RefreshExercisePopularityUC) Execute(ctx context.Context) (int64, error) {
+	now := time.Now().UTC()
+	updated, err := uc.usageRepo.RefreshPopularity(ctx, now, now.Add(-PopularityWindow), PopularityHalfLife)
+	if err != nil {
+		return 0, fmt.Errorf("failed to refresh exercise popularity: %w", err)
+	}
+	return updated, nil
+}
@@ -0,0 +1,37 @@
+package exercises_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/kinetria/kinetria-back/internal/kinetria/domain/exercises"
+)
+
+func TestRefreshExercisePopularityUC_Execute(t *testing.T) {
+	repo := &mockUsageRepo{refreshed: 42}
+	uc := exercises.NewRefreshExercisePopularityUC(repo)
+
+	updated, err := uc.Execute(context.Background())
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if updated != 42 {
+		t.Errorf("expected 42 updated, got %d", updated)
+	}
+	if got := repo.gotNow.Sub(repo.gotSince); got != exercises.PopularityWindow {
+		t.Errorf("expected window %v, got %v", exercises.PopularityWindow, got)
+	}
+	if repo.gotHalf != exercises.PopularityHalfLife {
+		t.Errorf("expected half-life %v, got %v", exercises.PopularityHalfLife, repo.gotHalf)
+	}
+}
+
+func TestRefreshExercisePopularityUC_Execute_RepoError(t *testing.T) {
+	repoErr := errors.New("db down")
+	uc := exercises.NewRefreshExercisePopularityUC(&mockUsageRepo{err: repoErr})
+
+	if _, err := uc.Execute(context.Background()); !errors.Is(err, repoErr) {
+		t.Errorf("expected wrapped repo error, got %v", err)
+	}
+}
@@ -0,0 +1,45 @@
+package exercises
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/google/uuid"
+	"github.com/kinetria/kinetria-back/internal/kinetria/domain/errors"
+	"github.com/kinetria/kinetria-back/internal/kinetria/domain/ports"
+)
+
+// SetExerciseFavoriteUC marks or unmarks a library exercise as one of the user's favorites.
+type SetExerciseFavoriteUC struct {
+	exerciseRepo ports.ExerciseRepository
+	favoriteRepo ports.FavoriteRepository
+}
+
+// NewSetExerciseFavoriteUC creates a new SetExerciseFavoriteUC.
+func NewSetExerciseFavoriteUC(exerciseRepo ports.ExerciseRepository, favoriteRepo ports.FavoriteRepository) *SetExerciseFavoriteUC {
+	return &SetExerciseFavoriteUC{exerciseRepo: exerciseRepo, favoriteRepo: favoriteRepo}
+}
+
+// Execute sets the favorite state; it is idempotent.
+// Returns errors.ErrExerciseNotFound when favoriting an exercise that does not exist.
+func (uc *SetExerciseFavoriteUC) Execute(ctx context.Context, userID, exerciseID uuid.UUID, favorite bool) error {
+	if !favorite {
+		if err := uc.favoriteRepo.RemoveExercise(ctx, userID, exerciseID); err != nil {
+			return fmt.Errorf("failed to remove favorite exercise: %w", err)
+		}
+		return nil
+	}
+
+	exercise, err := uc.exerciseRepo.GetByID(ctx, exerciseID)
+	if err != nil {
+		return fmt.Errorf("failed to get exercise: %w", err)
+	}
+	if exercise == nil {
+		return errors.ErrExerciseNotFound
+	}
+
+	if err := uc.favoriteRepo.AddExercise(ctx, userID, exerciseID); err != nil {
+		return fmt.Errorf("failed to add favorite exercise: %w", err)
+	}
+	return nil
+}
@@ -0,0 +1,129 @@
+package exercises_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/kinetria/kinetria-back/internal/kinetria/domain/entities"
+	domainerrors "github.com/kinetria/kinetria-back/internal/kinetria/domain/errors"
+	"github.com/kinetria/kinetria-back/internal/kinetria/domain/exercises"
+)
+
+// mockFavoriteRepo implements ports.FavoriteRepository for exercises tests.
+type mockFavoriteRepo struct {
+	exercises map[uuid.UUID]bool
+	err       error
+}
+
+func (m *mockFavoriteRepo) AddExercise(_ context.Context, _, exerciseID uuid.UUID) error {
+	if m.err != nil {
+		return m.err
+	}
+	if m.exercises == nil {
+		m.exercises = map[uuid.UUID]bool{}
+	}
+	m.exercises[exerciseID] = true
+	return nil
+}
+
+func (m *mockFavoriteRepo) RemoveExercise(_ context.Context, _, exerciseID uuid.UUID) error {
+	if m.err != nil {
+		return m.err
+	}
+	delete(m.exercises, exerciseID)
+	return nil
+}
+
+func (m *mockFavoriteRepo) AddWorkout(_ context.Context, _, _ uuid.UUID) error    { return nil }
+func (m *mockFavoriteRepo) RemoveWorkout(_ context.Context, _, _ uuid.UUID) error { return nil }
+
+func (m *mockFavoriteRepo) FavoriteExerciseIDs(_ context.Context, _ uuid.UUID, _ []uuid.UUID) (map[uuid.UUID]bool, error) {
+	if m.err != nil {
+		return nil, m.err
+	}
+	return m.exercises, nil
+}
+
+func (m *mockFavoriteRepo) FavoriteWorkoutIDs(_ context.Context, _ uuid.UUID, _ []uuid.UUID) (map[uuid.UUID]bool, error) {
+	return map[uuid.UUID]bool{}, nil
+}
+
+func TestSetExerciseFavoriteUC_Execute(t *testing.T) {
+	userID := uuid.New()
+	exerciseID := uuid.New()
+
+	tests := []struct {
+		name         string
+		exercise     *entities.Exercise
+		favorite     bool
+		initial      map[uuid.UUID]bool
+		repoErr      error
+		wantErrIs    error
+		wantErr      bool
+		wantFavorite bool
+	}{
+		{
+			name:         "favorite_existing_exercise",
+			exercise:     &entities.Exercise{ID: exerciseID},
+			favorite:     true,
+			wantFavorite: true,
+		},
+		{
+			name:         "favorite_twice_is_idempotent",
+			exercise:     &entities.Exercise{ID: exerciseID},
+			favorite:     true,
+			initial:      map[uuid.UUID]bool{exerciseID: true},
+			wantFavorite: true,
+		},
+		{
+			name:      "missing_exercise",
+			favorite:  true,
+			wantErrIs: domainerrors.ErrExerciseNotFound,
+		},
+		{
+			name:         "unfavorite",
+			favorite:     false,
+			initial:      map[uuid.UUID]bool{exerciseID: true},
+			wantFavorite: false,
+		},
+		{
+			name:     "repository_error",
+			exercise: &entities.Exercise{ID: exerciseID},
+			favorite: true,
+			repoErr:  errors.New("db down"),
+			wantErr:  true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			exerciseRepo := &mockExerciseRepoForGet{
+				getByIDFunc: func(_ context.Context, _ uuid.UUID) (*entities.Exercise, error) {
+					return tt.exercise, nil
+				},
+			}
+			favoriteRepo := &mockFavoriteRepo{exercises: tt.initial, err: tt.repoErr}
+			uc := exercises.NewSetExerciseFavoriteUC(exerciseRepo, favoriteRepo)
+
+			err := uc.Execute(context.Background(), userID, exerciseID, tt.favorite)
+
+			if tt.wantErrIs != nil || tt.wantErr {
+				if err == nil {
+					t.Fatal("expected error, got nil")
+				}
+				if tt.wantErrIs != nil && !errors.Is(err, tt.wantErrIs) {
+					t.Errorf("expected %v, got %v", tt.wantErrIs, err)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if favoriteRepo.exercises[exerciseID] != tt.wantFavorite {
+				t.Errorf("favorite = %v, want %v", favoriteRepo.exercises[exerciseID], tt.wantFavorite)
+			}
+		})
+	}
+}
@@ -0,0 +1,287 @@
+package goals_test
+
+import (
+	"context"
+	"sort"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/kinetria/kinetria-back/internal/kinetria/domain/entities"
+	domainerrors "github.com/kinetria/kinetria-back/internal/kinetria/domain/errors"
+	"github.com/kinetria/kinetria-back/internal/kinetria/domain/ports"
+	"github.com/kinetria/kinetria-back/internal/kinetria/domain/vos"
+)
+
+// mockGoalRepo is an in-memory ports.GoalRepository.
+type mockGoalRepo struct {
+	items map[uuid.UUID]entities.Goal
+	err   error
+}
+
+func newMockGoalRepo(items ...entities.Goal) *mockGoalRepo {
+	m := &mockGoalRepo{items: map[uuid.UUID]entities.Goal{}}
+	for _, it := range items {
+		m.items[it.ID] = it
+	}
+	return m
+}
+
+func (m *mockGoalRepo) Create(_ context.Context, goal *entities.Goal) error {
+	if m.err != nil {
+		return m.err
+	}
+	m.items[goal.ID] = *goal
+	return nil
+}
+
+func (m *mockGoalRepo) GetByID(_ context.Context, userID, id uuid.UUID) (*entities.Goal, error) {
+	if m.err != nil {
+		return nil, m.err
+	}
+	it, ok := m.items[id]
+	if !ok || it.UserID != userID {
+		return nil, nil
+	}
+	return &it, nil
+}
+
+func (m *mockGoalRepo) ListByUser(_ context.Context, userID uuid.UUID) ([]entities.Goal, error) {
+	if m.err != nil {
+		return nil, m.err
+	}
+	var out []entities.Goal
+	for _, it := range m.items {
+		if it.UserID == userID {
+			out = append(out, it)
+		}
+	}
+	sort.Slice(out, func(i, j int) bool {
+		ai, aj := out[i].Status == vos.GoalStatusActive, out[j].Status == vos.GoalStatusActive
+		if ai != aj {
+			return ai
+		}
+		return out[i].CreatedAt.After(out[j].CreatedAt)
+	})
+	return out, nil
+}
+
+func (m *mockGoalRepo) Update(_ context.Context, goal *entities.Goal) error {
+	if m.err != nil {
+		return m.err
+	}
+	it := m.items[goal.ID]
+	it.TargetValue = goal.TargetValue
+	it.TargetDate = goal.TargetDate
+	it.UpdatedAt = goal.UpdatedAt
+	m.items[goal.ID] = it
+	return nil
+}
+
+func (m *mockGoalRepo) Delete(_ context.Context, userID, id uuid.UUID) (bool, error) {
+	if m.err != nil {
+		return false, m.err
+	}
+	it, ok := m.items[id]
+	if !ok || it.UserID != userID {
+		return false, nil
+	}
+	delete(m.items, id)
+	return true, nil
+}
+
+func (m *mockGoalRepo) MarkAchieved(_ context.Context, userID, id uuid.UUID, achievedAt time.Time) (bool, error) {
+	if m.err != nil {
+		return false, m.err
+	}
+	it, ok := m.items[id]
+	if !ok || it.UserID != userID || it.Status != vos.GoalStatusActive {
+		return false, nil
+	}
+	it.Status = vos.GoalStatusAchieved
+	it.AchievedAt = &achievedAt
+	m.items[id] = it
+	return true, nil
+}
+
+// mockUserRepo is a ports.UserRepository returning a single user with default preferences.
+type mockUserRepo struct {
+	user *entities.User
+}
+
+func newMockUserRepo() *mockUserRepo {
+	prefs := vos.DefaultUserPreferences()
+	prefs.Timezone = "UTC"
+	return &mockUserRepo{user: &entities.User{ID: uuid.New(), Preferences: prefs}}
+}
+
+func (m *mockUserRepo) Create(_ context.Context, _ *entities.User) error { return nil }
+func (m *mockUserRepo) GetByEmail(_ context.Context, _ string) (*entities.User, error) {
+	return nil, domainerrors.ErrNotFound
+}
+func (m *mockUserRepo) GetByID(_ context.Context, _ uuid.UUID) (*entities.User, error) {
+	return m.user, nil
+}
+func (m *mockUserRepo) Update(_ context.Context, _ *entities.User) error { return nil }
+
+// mockExerciseRepo implements ports.ExerciseRepository.
+type mockExerciseRepo struct {
+	exercise *entities.Exercise
+}
+
+func (m *mockExerciseRepo) ExistsByIDAndWorkoutID(_ context.Context, _, _ uuid.UUID) (bool, error) {
+	return false, nil
+}
+
+func (m *mockExerciseRepo) FindWorkoutExerciseID(_ context.Context, _, _ uuid.UUID) (uuid.UUID, error) {
+	return uuid.Nil, nil
+}
+
+func (m *mockExerciseRepo) List(_ context.Context, _ ports.ExerciseFilters, _, _ int) ([]*entities.Exercise, int, error) {
+	return nil, 0, nil
+}
+
+func (m *mockExerciseRepo) GetByID(_ context.Context, _ uuid.UUID) (*entities.Exercise, error) {
+	return m.exercise, nil
+}
+
+func (m *mockExerciseRepo) GetUserStats(_ context.Context, _, _ uuid.UUID) (*ports.ExerciseUserStats, error) {
+	return nil, nil
+}
+
+func (m *mockExerciseRepo) GetHistory(_ context.Context, _, _ uuid.UUID, _, _ int) ([]*ports.ExerciseHistoryEntry, int, error) {
+	return nil, 0, nil
+}
+
+// mockSessionRepo implements ports.SessionRepository; only the period stats are used.
+type mockSessionRepo struct {
+	stats    ports.SessionStats
+	gotStart time.Time
+}
+
+func (m *mockSessionRepo) Create(_ context.Context, _ *entities.Session) error { return nil }
+func (m *mockSessionRepo) FindActiveByUserID(_ context.Context, _ uuid.UUID) (*entities.Session, error) {
+	return nil, nil
+}
+func (m *mockSessionRepo) FindByID(_ context.Context, _ uuid.UUID) (*entities.Session, error) {
+	return nil, nil
+}
+func (m *mockSessionRepo) UpdateStatus(_ context.Context, _ uuid.UUID, _ string, _ *time.Time, _ string) (bool, error) {
+	return false, nil
+}
+func (m *mockSessionRepo) GetCompletedSessionsByUserAndDateRange(_ context.Context, _ uuid.UUID, _, _ time.Time, _ *time.Location) ([]entities.Session, error) {
+	return nil, nil
+}
+func (m *mockSessionRepo) GetStatsByUserAndPeriod(_ context.Context, _ uuid.UUID, start, _ time.Time) (*ports.SessionStats, error) {
+	m.gotStart = start
+	s := m.stats
+	return &s, nil
+}
+func (m *mockSessionRepo) GetFrequencyByUserAndPeriod(_ context.Context, _ uuid.UUID, _, _ time.Time, _ *time.Location) ([]ports.FrequencyData, error) {
+	return nil, nil
+}
+func (m *mockSessionRepo) GetSessionsForStreak(_ context.Context, _ uuid.UUID, _ *time.Location) ([]time.Time, error) {
+	return nil, nil
+}
+
+// mockSetRecordRepo implements ports.SetRecordRepository; only the set summaries and
+// period totals are used.
+type mockSetRecordRepo struct {
+	rows  []ports.ExerciseSetSummaryRow
+	stats ports.SetRecordStats
+}
+
+func (m *mockSetRecordRepo) Create(_ context.Context, _ *entities.SetRecord) error { return nil }
+func (m *mockSetRecordRepo) FindBySessionExerciseSet(_ context.Context, _, _ uuid.UUID, _ int) (*entities.SetRecord, error) {
+	return nil, nil
+}
+func (m *mockSetRecordRepo) GetTotalSetsRepsVolume(_ context.Context, _ uuid.UUID, _, _ time.Time) (*ports.SetRecordStats, error) {
+	s := m.stats
+	return &s, nil
+}
+func (m *mockSetRecordRepo) GetPersonalRecordsByUser(_ context.Context, _ uuid.UUID) ([]ports.PersonalRecord, error) {
+	return nil, nil
+}
+func (m *mockSetRecordRepo) GetProgressionByUserAndExercise(_ context.Context, _ uuid.UUID, _ *uuid.UUID, _, _ time.Time, _ *time.Location) ([]ports.ProgressionPoint, error) {
+	return nil, nil
+}
+func (m *mockSetRecordRepo) GetMuscleVolumeByUserAndPeriod(_ context.Context, _ uuid.UUID, _, _ time.Time, _ *time.Location, _ vos.WeekStart) ([]ports.MuscleVolumeRow, error) {
+	return nil, nil
+}
+func (m *mockSetRecordRepo) GetBigLiftSetsByUser(_ context.Context, _ uuid.UUID, _, _ time.Time, _ *time.Location) ([]ports.BigLiftSetRow, error) {
+	return nil, nil
+}
+func (m *mockSetRecordRepo) GetExerciseSetSummariesByUser(_ context.Context, _ uuid.UUID, _, _ time.Time, _ *time.Location) ([]ports.ExerciseSetSummaryRow, error) {
+	return m.rows, nil
+}
+
+// mockBodyWeightRepo implements ports.BodyWeightRepository from a list of weigh-ins, oldest first.
+type mockBodyWeightRepo struct {
+	entries []ports.BodyWeightEntry
+}
+
+func (m *mockBodyWeightRepo) GetLatestBodyWeight(_ context.Context, _ uuid.UUID) (*int, error) {
+	if len(m.entries) == 0 {
+		return nil, nil
+	}
+	g := m.entries[len(m.entries)-1].WeightGrams
+	return &g, nil
+}
+
+func (m *mockBodyWeightRepo) ListBodyWeights(_ context.Context, _ uuid.UUID, _, _ time.Time) ([]ports.BodyWeightEntry, error) {
+	return m.entries, nil
+}
+
+// mockAuditLogRepo records the appended entries.
+type mockAuditLogRepo struct {
+	entries []entities.AuditLog
+}
+
+func (m *mockAuditLogRepo) Append(_ context.Context, entry *entities.AuditLog) error {
+	m.entries = append(m.entries, *entry)
+	return nil
+}
+
+// env bundles the mocks a goal use case depends on.
+type env struct {
+	goals      *mockGoalRepo
+	users      *mockUserRepo
+	exercises  *mockExerciseRepo
+	sessions   *mockSessionRepo
+	sets       *mockSetRecordRepo
+	bodyWeight *mockBodyWeightRepo
+	audit      *mockAuditLogRepo
+}
+
+func newEnv(goals ...entities.Goal) *env {
+	return &env{
+		goals:      newMockGoalRepo(goals...),
+		users:      newMockUserRepo(),
+		exercises:  &mockExerciseRepo{exercise: &entities.Exercise{ID: uuid.New(), Name: "Agachamento"}},
+		sessions:   &mockSessionRepo{},
+		sets:       &mockSetRecordRepo{},
+		bodyWeight: &mockBodyWeightRepo{},
+		audit:      &mockAuditLogRepo{},
+	}
+}
+
+// sessionSets builds one set summary of exercise per session, one session every 7 days
+// ending today.
+func sessionSets(exerciseID uuid.UUID, reps int, weights ...int) []ports.ExerciseSetSummaryRow {
+	today := time.Now().UTC().Truncate(24 * time.Hour)
+	rows := make([]ports.ExerciseSetSummaryRow, 0, len(weights))
+	for i, w := range weights {
+		day := today.AddDate(0, 0, -7*(len(weights)-1-i))
+		rows = append(rows, ports.ExerciseSetSummaryRow{
+			SessionID:  uuid.New(),
+			Date:       day,
+			StartedAt:  day.Add(time.Hour),
+			ExerciseID: exerciseID,
+			Reps:       reps,
+			MaxWeight:  w,
+			Volume:     int64(w * reps),
+		})
+	}
+	return rows
+}
+
+func int64Ptr(v int64) *int64 { return &v }
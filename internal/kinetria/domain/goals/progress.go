@@ -0,0 +1,402 @@
+package goals
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"math"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/kinetria/kinetria-back/internal/kinetria/domain/entities"
+	"github.com/kinetria/kinetria-back/internal/kinetria/domain/ports"
+	"github.com/kinetria/kinetria-back/internal/kinetria/domain/vos"
+)
+
+const (
+	// strengthWindowDays is the look-back for the current e1RM or weight of exercise goals.
+	strengthWindowDays = 90
+	// bodyWeightRateDays is the look-back used to estimate the body weight trend.
+	bodyWeightRateDays = 28
+	// minRateSpanDays is the minimum span of data points needed to estimate a rate.
+	minRateSpanDays = 7
+)
+
+// tracker computes goal progress from the session, set and body weight history and
+// records goals as achieved when their target is reached.
+type tracker struct {
+	goalRepo       ports.GoalRepository
+	sessionRepo    ports.SessionRepository
+	setRecordRepo  ports.SetRecordRepository
+	bodyWeightRepo ports.BodyWeightRepository
+	auditLogRepo   ports.AuditLogRepository
+}
+
+// dataPoint is one value of the series a goal's trend is fit on.
+type dataPoint struct {
+	at    time.Time
+	value float64
+}
+
+// reading is the current value of a goal, the series behind it and, for periodic goals,
+// the period it covers.
+type reading struct {
+	value       *int64
+	points      []dataPoint
+	periodStart *time.Time
+	periodEnd   *time.Time
+}
+
+// read returns the current value of the goal at now. Days, weeks and months follow the
+// user's timezone and week start.
+func (t *tracker) read(ctx context.Context, goal *entities.Goal, prefs vos.UserPreferences, now time.Time) (reading, error) {
+	loc := prefs.Location()
+	local := now.In(loc)
+
+	switch goal.Type {
+	case vos.GoalTypeExerciseE1RM, vos.GoalTypeExerciseWeight:
+		rows, err := t.setRecordRepo.GetExerciseSetSummariesByUser(ctx, goal.UserID, now.AddDate(0, 0, -strengthWindowDays), now, loc)
+		if err != nil {
+			return reading{}, fmt.Errorf("failed to get exercise sets: %w", err)
+		}
+		return exerciseReading(goal, rows), nil
+
+	case vos.GoalTypeWeeklySessions:
+		start := prefs.WeekStart.StartOf(local)
+		end := start.AddDate(0, 0, 7)
+		stats, err := t.sessionRepo.GetStatsByUserAndPeriod(ctx, goal.UserID, start, now)
+		if err != nil {
+			return reading{}, fmt.Errorf("failed to get session stats: %w", err)
+		}
+		v := int64(stats.TotalWorkouts)
+		return reading{value: &v, periodStart: &start, periodEnd: &end}, nil
+
+	case vos.GoalTypeMonthlyVolume:
+		start := time.Date(local.Year(), local.Month(), 1, 0, 0, 0, 0, loc)
+		end := start.AddDate(0, 1, 0)
+		stats, err := t.setRecordRepo.GetTotalSetsRepsVolume(ctx, goal.UserID, start, now)
+		if err != nil {
+			return reading{}, fmt.Errorf("failed to get volume: %w", err)
+		}
+		v := stats.TotalVolume
+		return reading{value: &v, periodStart: &start, periodEnd: &end}, nil
+
+	case vos.GoalTypeBodyWeight:
+		latest, err := t.bodyWeightRepo.GetLatestBodyWeight(ctx, goal.UserID)
+		if err != nil {
+			return reading{}, fmt.Errorf("failed to get latest body weight: %w", err)
+		}
+		if latest == nil {
+			return reading{}, nil
+		}
+		entries, err := t.bodyWeightRepo.ListBodyWeights(ctx, goal.UserID, now.AddDate(0, 0, -bodyWeightRateDays), now)
+		if err != nil {
+			return reading{}, fmt.Errorf("failed to list body weights: %w", err)
+		}
+		v := int64(*latest)
+		r := reading{value: &v}
+		for _, e := range entries {
+			r.points = append(r.points, dataPoint{at: e.MeasuredAt, value: float64(e.WeightGrams)})
+		}
+		return r, nil
+	}
+	return reading{}, nil
+}
+
+// exerciseReading takes the best value of each session with the goal's exercise (heaviest
+// set, or best Epley estimate for e1RM goals); the current value is the best of the window.
+func exerciseReading(goal *entities.Goal, rows []ports.ExerciseSetSummaryRow) reading {
+	var r reading
+	if goal.ExerciseID == nil {
+		return r
+	}
+	bySession := make(map[uuid.UUID]int)
+	var best int64
+	for _, row := range rows {
+		if row.ExerciseID != *goal.ExerciseID {
+			continue
+		}
+		v := int64(row.MaxWeight)
+		if goal.Type == vos.GoalTypeExerciseE1RM {
+			v = int64(vos.EstimateOneRepMax(row.MaxWeight, row.Reps))
+		}
+		if v <= 0 {
+			continue
+		}
+		if i, ok := bySession[row.SessionID]; ok {
+			r.points[i].value = math.Max(r.points[i].value, float64(v))
+		} else {
+			bySession[row.SessionID] = len(r.points)
+			r.points = append(r.points, dataPoint{at: row.StartedAt, value: float64(v)})
+		}
+		if v > best {
+			best = v
+		}
+	}
+	if best > 0 {
+		r.value = &best
+	}
+	return r
+}
+
+// progress returns the goal's progress at now, without recording it as achieved: that
+// happens when the data behind it is written (see EvaluateGoalsUC).
+func (t *tracker) progress(ctx context.Context, goal *entities.Goal, prefs vos.UserPreferences, now time.Time) (*GoalProgress, error) {
+	r, err := t.read(ctx, goal, prefs, now)
+	if err != nil {
+		return nil, err
+	}
+	return progressOf(goal, r, prefs, now), nil
+}
+
+// evaluate returns the goal's progress at now. An active goal whose target is reached is
+// marked as achieved first.
+func (t *tracker) evaluate(ctx context.Context, goal *entities.Goal, prefs vos.UserPreferences, now time.Time) (*GoalProgress, error) {
+	r, err := t.read(ctx, goal, prefs, now)
+	if err != nil {
+		return nil, err
+	}
+	if _, err := t.achieveIfReached(ctx, goal, r, prefs, now); err != nil {
+		return nil, err
+	}
+	return progressOf(goal, r, prefs, now), nil
+}
+
+// progressOf builds the goal's progress at now from its reading.
+func progressOf(goal *entities.Goal, r reading, prefs vos.UserPreferences, now time.Time) *GoalProgress {
+	if r.value == nil {
+		return &GoalProgress{Goal: *goal, PeriodStart: r.periodStart, PeriodEnd: r.periodEnd}
+	}
+
+	current := *r.value
+	reached := isReached(goal, current)
+	remaining := goal.TargetValue - current
+	out := &GoalProgress{
+		Goal:         *goal,
+		CurrentValue: &current,
+		Remaining:    &remaining,
+		PeriodStart:  r.periodStart,
+		PeriodEnd:    r.periodEnd,
+	}
+	if base := baseline(goal); base != nil {
+		pct := progressPercent(*base, current, goal.TargetValue, reached)
+		out.ProgressPercent = &pct
+	}
+
+	loc := prefs.Location()
+	if !reached {
+		out.ProjectedDate = projectDate(goal, r, remaining, now, loc)
+	}
+
+	// Metas periódicas têm como prazo o fim do período; as demais, a data alvo (se houver)
+	switch {
+	case r.periodEnd != nil:
+		onTrack := reached || out.ProjectedDate != nil
+		out.OnTrack = &onTrack
+	case goal.TargetDate != nil:
+		onTrack := reached || (out.ProjectedDate != nil && !out.ProjectedDate.After(calendarDay(*goal.TargetDate)))
+		out.OnTrack = &onTrack
+	}
+	return out
+}
+
+// achieveIfReached records an active goal whose target is reached as achieved, at the time
+// it was reached. It reports whether this call recorded it.
+func (t *tracker) achieveIfReached(ctx context.Context, goal *entities.Goal, r reading, prefs vos.UserPreferences, now time.Time) (bool, error) {
+	if goal.Status != vos.GoalStatusActive || r.value == nil || !isReached(goal, *r.value) {
+		return false, nil
+	}
+	at, err := t.reachedAt(ctx, goal, r, prefs, now)
+	if err != nil {
+		return false, err
+	}
+	return t.markAchieved(ctx, goal, *r.value, at, now)
+}
+
+// reachedAt returns when the goal's current value reached the target: the session that took
+// the exercise best, the week's session count or the month's volume to it, or the first
+// weigh-in of the latest run meeting a body weight target. It is never before the goal was
+// created, and is now when the series does not tell.
+func (t *tracker) reachedAt(ctx context.Context, goal *entities.Goal, r reading, prefs vos.UserPreferences, now time.Time) (time.Time, error) {
+	var at *time.Time
+	switch goal.Type {
+	case vos.GoalTypeExerciseE1RM, vos.GoalTypeExerciseWeight:
+		for _, p := range r.points {
+			if isReached(goal, int64(p.value)) {
+				at = &p.at
+				break
+			}
+		}
+
+	case vos.GoalTypeBodyWeight:
+		for i := len(r.points) - 1; i >= 0 && isReached(goal, int64(r.points[i].value)); i-- {
+			at = &r.points[i].at
+		}
+
+	case vos.GoalTypeWeeklySessions:
+		loc := prefs.Location()
+		sessions, err := t.sessionRepo.GetCompletedSessionsByUserAndDateRange(ctx, goal.UserID, calendarDay(r.periodStart.In(loc)), calendarDay(now.In(loc)), loc)
+		if err != nil {
+			return time.Time{}, fmt.Errorf("failed to list sessions: %w", err)
+		}
+		// As sessões vêm da mais recente para a mais antiga
+		if n := len(sessions); goal.TargetValue > 0 && int64(n) >= goal.TargetValue {
+			at = &sessions[n-int(goal.TargetValue)].StartedAt
+		}
+
+	case vos.GoalTypeMonthlyVolume:
+		rows, err := t.setRecordRepo.GetExerciseSetSummariesByUser(ctx, goal.UserID, *r.periodStart, now, prefs.Location())
+		if err != nil {
+			return time.Time{}, fmt.Errorf("failed to get exercise sets: %w", err)
+		}
+		var volume int64
+		for _, row := range rows {
+			volume += row.Volume
+			if volume >= goal.TargetValue {
+				at = &row.StartedAt
+				break
+			}
+		}
+	}
+
+	switch {
+	case at == nil || at.After(now):
+		return now, nil
+	case at.Before(goal.CreatedAt):
+		return goal.CreatedAt, nil
+	}
+	return at.UTC(), nil
+}
+
+// markAchieved records the goal as achieved at achievedAt and audits it. Only the
+// evaluation that flips the status writes the audit entry.
+func (t *tracker) markAchieved(ctx context.Context, goal *entities.Goal, value int64, achievedAt, now time.Time) (bool, error) {
+	ok, err := t.goalRepo.MarkAchieved(ctx, goal.UserID, goal.ID, achievedAt)
+	if err != nil {
+		return false, fmt.Errorf("failed to mark goal achieved: %w", err)
+	}
+	if !ok {
+		return false, nil
+	}
+	goal.Status = vos.GoalStatusAchieved
+	goal.AchievedAt = &achievedAt
+	goal.UpdatedAt = now
+
+	actionData, _ := json.Marshal(map[string]interface{}{
+		"type":        goal.Type,
+		"exerciseId":  goal.ExerciseID,
+		"targetValue": goal.TargetValue,
+		"value":       value,
+		"achievedAt":  achievedAt,
+	})
+	auditEntry := entities.AuditLog{
+		ID:         uuid.New(),
+		UserID:     goal.UserID,
+		EntityType: "goal",
+		EntityID:   goal.ID,
+		Action:     "achieved",
+		ActionData: actionData,
+		OccurredAt: now,
+	}
+	_ = t.auditLogRepo.Append(ctx, &auditEntry)
+	return true, nil
+}
+
+// isReached reports whether current meets the target. Body weight goals may go down: their
+// direction is given by the weight when the goal was set.
+func isReached(goal *entities.Goal, current int64) bool {
+	if goal.Type == vos.GoalTypeBodyWeight {
+		if goal.StartValue == nil {
+			return current == goal.TargetValue
+		}
+		if *goal.StartValue > goal.TargetValue {
+			return current <= goal.TargetValue
+		}
+	}
+	return current >= goal.TargetValue
+}
+
+// baseline is the value progress is measured from: zero for periodic goals, the starting
+// value otherwise (zero for exercise goals set before any set was logged).
+func baseline(goal *entities.Goal) *int64 {
+	if goal.StartValue != nil && !goal.Type.IsPeriodic() {
+		return goal.StartValue
+	}
+	if goal.Type == vos.GoalTypeBodyWeight {
+		return nil
+	}
+	var zero int64
+	return &zero
+}
+
+// progressPercent is the share of the way from base to target covered by current, clamped
+// to 0–100 and rounded to one decimal.
+func progressPercent(base, current, target int64, reached bool) float64 {
+	if reached {
+		return 100
+	}
+	if target == base {
+		return 0
+	}
+	pct := float64(current-base) / float64(target-base) * 100
+	pct = math.Max(0, math.Min(100, pct))
+	return math.Round(pct*10) / 10
+}
+
+// projectDate estimates the calendar day the target is reached. Periodic goals extrapolate
+// the pace since the start of the period and are projected only within it; the others
+// extrapolate the trend of their series, if it moves toward the target.
+func projectDate(goal *entities.Goal, r reading, remaining int64, now time.Time, loc *time.Location) *time.Time {
+	if r.periodStart != nil {
+		elapsed := now.Sub(*r.periodStart)
+		if r.value == nil || *r.value <= 0 || elapsed <= 0 {
+			return nil
+		}
+		total := float64(elapsed) * float64(goal.TargetValue) / float64(*r.value)
+		if total >= float64(r.periodEnd.Sub(*r.periodStart)) {
+			return nil
+		}
+		day := calendarDay(r.periodStart.Add(time.Duration(total)).In(loc))
+		return &day
+	}
+
+	rate, ok := dailyRate(r.points)
+	if !ok || rate == 0 || (rate > 0) != (remaining > 0) {
+		return nil
+	}
+	days := float64(remaining) / rate
+	if days > maxTargetHorizonDays {
+		return nil
+	}
+	day := calendarDay(now.In(loc).Add(time.Duration(days * 24 * float64(time.Hour))))
+	return &day
+}
+
+// dailyRate fits a least-squares line to the points and returns its slope per day.
+// It needs points spanning at least minRateSpanDays.
+func dailyRate(points []dataPoint) (float64, bool) {
+	if len(points) < 2 {
+		return 0, false
+	}
+	xs := make([]float64, len(points))
+	for i, p := range points {
+		xs[i] = p.at.Sub(points[0].at).Hours() / 24
+	}
+	if xs[len(xs)-1]-xs[0] < minRateSpanDays {
+		return 0, false
+	}
+
+	n := float64(len(points))
+	var sumX, sumY, sumXY, sumXX float64
+	for i, p := range points {
+		sumX += xs[i]
+		sumY += p.value
+		sumXY += xs[i] * p.value
+		sumXX += xs[i] * xs[i]
+	}
+	denom := n*sumXX - sumX*sumX
+	if denom == 0 {
+		return 0, false
+	}
+	return (n*sumXY - sumX*sumY) / denom, true
+}
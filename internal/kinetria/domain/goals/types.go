@@ -0,0 +1,45 @@
+package goals
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/kinetria/kinetria-back/internal/kinetria/domain/entities"
+	"github.com/kinetria/kinetria-back/internal/kinetria/domain/vos"
+)
+
+// CreateGoalInput contains the data needed to set a goal. TargetValue is in the canonical
+// unit of the type (see vos.GoalType); ExerciseID is required only for exercise goals.
+type CreateGoalInput struct {
+	UserID      uuid.UUID
+	Type        vos.GoalType
+	ExerciseID  *uuid.UUID
+	TargetValue int64
+	TargetDate  *time.Time
+}
+
+// UpdateGoalInput contains the fields to change on a goal. Nil fields are left unchanged.
+type UpdateGoalInput struct {
+	UserID      uuid.UUID
+	ID          uuid.UUID
+	TargetValue *int64
+	TargetDate  *time.Time
+}
+
+// GoalProgress is a goal with the user's progress toward it, in the canonical unit of
+// its type. Fields derived from the current value are nil until there is data.
+type GoalProgress struct {
+	Goal         entities.Goal
+	CurrentValue *int64
+	// Remaining is target minus current: negative means body weight still to lose.
+	Remaining       *int64
+	ProgressPercent *float64 // 0–100, desde StartValue (ou zero)
+	// PeriodStart and PeriodEnd bound the week or month of periodic goals (end exclusive).
+	PeriodStart *time.Time
+	PeriodEnd   *time.Time
+	// ProjectedDate is when the target is reached at the current rate, if moving toward it.
+	ProjectedDate *time.Time
+	// OnTrack reports whether ProjectedDate falls by the target date (or the end of the
+	// period); nil when the goal has no deadline.
+	OnTrack *bool
+}
@@ -0,0 +1,111 @@
+package goals
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/kinetria/kinetria-back/internal/kinetria/domain/entities"
+	domainerrors "github.com/kinetria/kinetria-back/internal/kinetria/domain/errors"
+	"github.com/kinetria/kinetria-back/internal/kinetria/domain/ports"
+	"github.com/kinetria/kinetria-back/internal/kinetria/domain/profile"
+	"github.com/kinetria/kinetria-back/internal/kinetria/domain/vos"
+)
+
+// CreateGoalUC sets a new training goal.
+type CreateGoalUC struct {
+	goalRepo     ports.GoalRepository
+	userRepo     ports.UserRepository
+	exerciseRepo ports.ExerciseRepository
+	tracker      *tracker
+}
+
+// NewCreateGoalUC creates a new CreateGoalUC.
+func NewCreateGoalUC(
+	goalRepo ports.GoalRepository,
+	userRepo ports.UserRepository,
+	exerciseRepo ports.ExerciseRepository,
+	sessionRepo ports.SessionRepository,
+	setRecordRepo ports.SetRecordRepository,
+	bodyWeightRepo ports.BodyWeightRepository,
+	auditLogRepo ports.AuditLogRepository,
+) *CreateGoalUC {
+	return &CreateGoalUC{
+		goalRepo:     goalRepo,
+		userRepo:     userRepo,
+		exerciseRepo: exerciseRepo,
+		tracker: &tracker{
+			goalRepo:       goalRepo,
+			sessionRepo:    sessionRepo,
+			setRecordRepo:  setRecordRepo,
+			bodyWeightRepo: bodyWeightRepo,
+			auditLogRepo:   auditLogRepo,
+		},
+	}
+}
+
+// Execute stores the goal and returns its progress. Exercise and body weight goals start
+// from the user's current value, which progress is measured from.
+func (uc *CreateGoalUC) Execute(ctx context.Context, input CreateGoalInput) (*GoalProgress, error) {
+	if err := input.Type.Validate(); err != nil {
+		return nil, err
+	}
+	if input.Type.IsExercise() != (input.ExerciseID != nil) {
+		if input.ExerciseID == nil {
+			return nil, fmt.Errorf("%w: exerciseId is required for %s goals", domainerrors.ErrMalformedParameters, input.Type)
+		}
+		return nil, fmt.Errorf("%w: exerciseId is only supported for exercise goals", domainerrors.ErrMalformedParameters)
+	}
+	if err := validateTarget(input.Type, input.TargetValue); err != nil {
+		return nil, err
+	}
+	now := time.Now().UTC()
+	if input.TargetDate != nil {
+		if err := validateTargetDate(input.Type, *input.TargetDate, now); err != nil {
+			return nil, err
+		}
+	}
+
+	if input.ExerciseID != nil {
+		exercise, err := uc.exerciseRepo.GetByID(ctx, *input.ExerciseID)
+		if err != nil {
+			return nil, fmt.Errorf("failed to get exercise: %w", err)
+		}
+		if exercise == nil {
+			return nil, domainerrors.ErrExerciseNotFound
+		}
+	}
+
+	prefs, err := profile.LoadPreferences(ctx, uc.userRepo, input.UserID)
+	if err != nil {
+		return nil, err
+	}
+
+	goal := &entities.Goal{
+		ID:          uuid.New(),
+		UserID:      input.UserID,
+		Type:        input.Type,
+		ExerciseID:  input.ExerciseID,
+		TargetValue: input.TargetValue,
+		Status:      vos.GoalStatusActive,
+		CreatedAt:   now,
+		UpdatedAt:   now,
+	}
+	if input.TargetDate != nil {
+		d := calendarDay(*input.TargetDate)
+		goal.TargetDate = &d
+	}
+	if !input.Type.IsPeriodic() {
+		r, err := uc.tracker.read(ctx, goal, prefs, now)
+		if err != nil {
+			return nil, err
+		}
+		goal.StartValue = r.value
+	}
+
+	if err := uc.goalRepo.Create(ctx, goal); err != nil {
+		return nil, fmt.Errorf("failed to create goal: %w", err)
+	}
+	return uc.tracker.evaluate(ctx, goal, prefs, now)
+}
@@ -0,0 +1,127 @@
+package goals_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	domainerrors "github.com/kinetria/kinetria-back/internal/kinetria/domain/errors"
+	"github.com/kinetria/kinetria-back/internal/kinetria/domain/goals"
+	"github.com/kinetria/kinetria-back/internal/kinetria/domain/ports"
+	"github.com/kinetria/kinetria-back/internal/kinetria/domain/vos"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func newCreateGoalUC(e *env) *goals.CreateGoalUC {
+	return goals.NewCreateGoalUC(e.goals, e.users, e.exercises, e.sessions, e.sets, e.bodyWeight, e.audit)
+}
+
+func TestCreateGoalUC_Execute(t *testing.T) {
+	userID := uuid.New()
+
+	t.Run("exercise_goal_starts_from_best_e1rm", func(t *testing.T) {
+		e := newEnv()
+		exerciseID := e.exercises.exercise.ID
+		e.sets.rows = sessionSets(exerciseID, 5, 90000, 100000)
+
+		out, err := newCreateGoalUC(e).Execute(context.Background(), goals.CreateGoalInput{
+			UserID:      userID,
+			Type:        vos.GoalTypeExerciseE1RM,
+			ExerciseID:  &exerciseID,
+			TargetValue: 140000,
+		})
+		require.NoError(t, err)
+		require.NotNil(t, out.Goal.StartValue)
+		assert.Equal(t, int64(vos.EstimateOneRepMax(100000, 5)), *out.Goal.StartValue)
+		assert.Equal(t, vos.GoalStatusActive, out.Goal.Status)
+		require.NotNil(t, out.ProgressPercent)
+		assert.Equal(t, 0.0, *out.ProgressPercent)
+		assert.Contains(t, e.goals.items, out.Goal.ID)
+	})
+
+	t.Run("body_weight_goal_starts_from_latest_weight", func(t *testing.T) {
+		e := newEnv()
+		e.bodyWeight.entries = []ports.BodyWeightEntry{{MeasuredAt: time.Now().Add(-time.Hour), WeightGrams: 86000}}
+
+		out, err := newCreateGoalUC(e).Execute(context.Background(), goals.CreateGoalInput{
+			UserID:      userID,
+			Type:        vos.GoalTypeBodyWeight,
+			TargetValue: 80000,
+		})
+		require.NoError(t, err)
+		require.NotNil(t, out.Goal.StartValue)
+		assert.Equal(t, int64(86000), *out.Goal.StartValue)
+		require.NotNil(t, out.Remaining)
+		assert.Equal(t, int64(-6000), *out.Remaining)
+	})
+
+	t.Run("periodic_goal_has_no_start_value", func(t *testing.T) {
+		e := newEnv()
+		e.sessions.stats = ports.SessionStats{TotalWorkouts: 1}
+
+		out, err := newCreateGoalUC(e).Execute(context.Background(), goals.CreateGoalInput{
+			UserID:      userID,
+			Type:        vos.GoalTypeWeeklySessions,
+			TargetValue: 4,
+		})
+		require.NoError(t, err)
+		assert.Nil(t, out.Goal.StartValue)
+		require.NotNil(t, out.ProgressPercent)
+		assert.Equal(t, 25.0, *out.ProgressPercent)
+		require.NotNil(t, out.PeriodStart)
+		assert.Equal(t, e.sessions.gotStart, *out.PeriodStart)
+	})
+
+	t.Run("already_reached_is_achieved", func(t *testing.T) {
+		e := newEnv()
+		e.sessions.stats = ports.SessionStats{TotalWorkouts: 3}
+
+		out, err := newCreateGoalUC(e).Execute(context.Background(), goals.CreateGoalInput{
+			UserID:      userID,
+			Type:        vos.GoalTypeWeeklySessions,
+			TargetValue: 3,
+		})
+		require.NoError(t, err)
+		assert.Equal(t, vos.GoalStatusAchieved, out.Goal.Status)
+		assert.Len(t, e.audit.entries, 1)
+	})
+
+	t.Run("rejects_invalid_input", func(t *testing.T) {
+		exerciseID := uuid.New()
+		past := time.Now().AddDate(0, 0, -2)
+		cases := map[string]goals.CreateGoalInput{
+			"unknown_type":          {UserID: userID, Type: "streak", TargetValue: 1},
+			"exercise_without_id":   {UserID: userID, Type: vos.GoalTypeExerciseWeight, TargetValue: 100000},
+			"exercise_id_on_volume": {UserID: userID, Type: vos.GoalTypeMonthlyVolume, ExerciseID: &exerciseID, TargetValue: 100000},
+			"zero_target":           {UserID: userID, Type: vos.GoalTypeWeeklySessions, TargetValue: 0},
+			"too_many_sessions":     {UserID: userID, Type: vos.GoalTypeWeeklySessions, TargetValue: 30},
+			"body_weight_too_light": {UserID: userID, Type: vos.GoalTypeBodyWeight, TargetValue: 1000},
+			"periodic_target_date":  {UserID: userID, Type: vos.GoalTypeWeeklySessions, TargetValue: 3, TargetDate: &past},
+			"past_target_date":      {UserID: userID, Type: vos.GoalTypeBodyWeight, TargetValue: 80000, TargetDate: &past},
+		}
+		for name, input := range cases {
+			t.Run(name, func(t *testing.T) {
+				e := newEnv()
+				_, err := newCreateGoalUC(e).Execute(context.Background(), input)
+				assert.ErrorIs(t, err, domainerrors.ErrMalformedParameters)
+				assert.Empty(t, e.goals.items)
+			})
+		}
+	})
+
+	t.Run("unknown_exercise", func(t *testing.T) {
+		e := newEnv()
+		e.exercises.exercise = nil
+		exerciseID := uuid.New()
+
+		_, err := newCreateGoalUC(e).Execute(context.Background(), goals.CreateGoalInput{
+			UserID:      userID,
+			Type:        vos.GoalTypeExerciseWeight,
+			ExerciseID:  &exerciseID,
+			TargetValue: 100000,
+		})
+		assert.ErrorIs(t, err, domainerrors.ErrExerciseNotFound)
+	})
+}
@@ -0,0 +1,32 @@
+package goals
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/google/uuid"
+	domainerrors "github.com/kinetria/kinetria-back/internal/kinetria/domain/errors"
+	"github.com/kinetria/kinetria-back/internal/kinetria/domain/ports"
+)
+
+// DeleteGoalUC removes one of the user's goals.
+type DeleteGoalUC struct {
+	goalRepo ports.GoalRepository
+}
+
+// NewDeleteGoalUC creates a new DeleteGoalUC.
+func NewDeleteGoalUC(goalRepo ports.GoalRepository) *DeleteGoalUC {
+	return &DeleteGoalUC{goalRepo: goalRepo}
+}
+
+// Execute deletes the goal, or returns ErrNotFound if the user has no such goal.
+func (uc *DeleteGoalUC) Execute(ctx context.Context, userID, id uuid.UUID) error {
+	deleted, err := uc.goalRepo.Delete(ctx, userID, id)
+	if err != nil {
+		return fmt.Errorf("failed to delete goal: %w", err)
+	}
+	if !deleted {
+		return domainerrors.ErrNotFound
+	}
+	return nil
+}
@@ -0,0 +1,79 @@
+package goals
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/kinetria/kinetria-back/internal/kinetria/domain/entities"
+	"github.com/kinetria/kinetria-back/internal/kinetria/domain/ports"
+	"github.com/kinetria/kinetria-back/internal/kinetria/domain/profile"
+	"github.com/kinetria/kinetria-back/internal/kinetria/domain/vos"
+)
+
+// EvaluateGoalsUC records the user's active goals whose target has been reached as
+// achieved. It runs after sessions are finished, logged or updated and after weigh-ins are
+// stored, so that goals are achieved when the data reaching them is written.
+type EvaluateGoalsUC struct {
+	goalRepo ports.GoalRepository
+	userRepo ports.UserRepository
+	tracker  *tracker
+}
+
+// NewEvaluateGoalsUC creates a new EvaluateGoalsUC.
+func NewEvaluateGoalsUC(
+	goalRepo ports.GoalRepository,
+	userRepo ports.UserRepository,
+	sessionRepo ports.SessionRepository,
+	setRecordRepo ports.SetRecordRepository,
+	bodyWeightRepo ports.BodyWeightRepository,
+	auditLogRepo ports.AuditLogRepository,
+) *EvaluateGoalsUC {
+	return &EvaluateGoalsUC{
+		goalRepo: goalRepo,
+		userRepo: userRepo,
+		tracker: &tracker{
+			goalRepo:       goalRepo,
+			sessionRepo:    sessionRepo,
+			setRecordRepo:  setRecordRepo,
+			bodyWeightRepo: bodyWeightRepo,
+			auditLogRepo:   auditLogRepo,
+		},
+	}
+}
+
+// Execute returns the goals achieved by this evaluation, empty if none. Each is recorded
+// as achieved at the time its target was reached.
+func (uc *EvaluateGoalsUC) Execute(ctx context.Context, userID uuid.UUID) ([]entities.Goal, error) {
+	goals, err := uc.goalRepo.ListByUser(ctx, userID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list goals: %w", err)
+	}
+
+	prefs, err := profile.LoadPreferences(ctx, uc.userRepo, userID)
+	if err != nil {
+		return nil, err
+	}
+	now := time.Now().UTC()
+
+	achieved := []entities.Goal{}
+	for i := range goals {
+		goal := &goals[i]
+		if goal.Status != vos.GoalStatusActive {
+			continue
+		}
+		r, err := uc.tracker.read(ctx, goal, prefs, now)
+		if err != nil {
+			return nil, err
+		}
+		ok, err := uc.tracker.achieveIfReached(ctx, goal, r, prefs, now)
+		if err != nil {
+			return nil, err
+		}
+		if ok {
+			achieved = append(achieved, *goal)
+		}
+	}
+	return achieved, nil
+}
@@ -0,0 +1,64 @@
+package goals_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/kinetria/kinetria-back/internal/kinetria/domain/goals"
+	"github.com/kinetria/kinetria-back/internal/kinetria/domain/ports"
+	"github.com/kinetria/kinetria-back/internal/kinetria/domain/vos"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func newEvaluateGoalsUC(e *env) *goals.EvaluateGoalsUC {
+	return goals.NewEvaluateGoalsUC(e.goals, e.users, e.sessions, e.sets, e.bodyWeight, e.audit)
+}
+
+func TestEvaluateGoalsUC_Execute(t *testing.T) {
+	userID := uuid.New()
+
+	t.Run("records_achievement_once_at_the_time_it_was_reached", func(t *testing.T) {
+		goal := newGoal(userID, vos.GoalTypeMonthlyVolume, 1000000)
+		e := newEnv(goal)
+		e.sets.stats = ports.SetRecordStats{TotalVolume: 1200000}
+		now := time.Now().UTC()
+		reached := now.Add(-2 * time.Hour)
+		e.sets.rows = []ports.ExerciseSetSummaryRow{
+			{SessionID: uuid.New(), StartedAt: now.Add(-3 * time.Hour), Volume: 600000},
+			{SessionID: uuid.New(), StartedAt: reached, Volume: 400000},
+			{SessionID: uuid.New(), StartedAt: now.Add(-time.Hour), Volume: 200000},
+		}
+		uc := newEvaluateGoalsUC(e)
+
+		achieved, err := uc.Execute(context.Background(), userID)
+		require.NoError(t, err)
+		require.Len(t, achieved, 1)
+		assert.Equal(t, vos.GoalStatusAchieved, achieved[0].Status)
+		require.NotNil(t, achieved[0].AchievedAt)
+		assert.True(t, reached.Equal(*achieved[0].AchievedAt), "achieved at %v, want %v", *achieved[0].AchievedAt, reached)
+		require.Len(t, e.audit.entries, 1)
+		assert.Equal(t, "goal", e.audit.entries[0].EntityType)
+		assert.Equal(t, "achieved", e.audit.entries[0].Action)
+		assert.Equal(t, goal.ID, e.audit.entries[0].EntityID)
+
+		achieved, err = uc.Execute(context.Background(), userID)
+		require.NoError(t, err)
+		assert.Empty(t, achieved)
+		assert.Len(t, e.audit.entries, 1)
+	})
+
+	t.Run("unreached_goal_stays_active", func(t *testing.T) {
+		goal := newGoal(userID, vos.GoalTypeMonthlyVolume, 1000000)
+		e := newEnv(goal)
+		e.sets.stats = ports.SetRecordStats{TotalVolume: 500000}
+
+		achieved, err := newEvaluateGoalsUC(e).Execute(context.Background(), userID)
+		require.NoError(t, err)
+		assert.Empty(t, achieved)
+		assert.Equal(t, vos.GoalStatusActive, e.goals.items[goal.ID].Status)
+		assert.Empty(t, e.audit.entries)
+	})
+}
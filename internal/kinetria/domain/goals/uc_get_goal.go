@@ -0,0 +1,59 @@
+package goals
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+	domainerrors "github.com/kinetria/kinetria-back/internal/kinetria/domain/errors"
+	"github.com/kinetria/kinetria-back/internal/kinetria/domain/ports"
+	"github.com/kinetria/kinetria-back/internal/kinetria/domain/profile"
+)
+
+// GetGoalUC returns one of the user's goals with its progress.
+type GetGoalUC struct {
+	goalRepo ports.GoalRepository
+	userRepo ports.UserRepository
+	tracker  *tracker
+}
+
+// NewGetGoalUC creates a new GetGoalUC.
+func NewGetGoalUC(
+	goalRepo ports.GoalRepository,
+	userRepo ports.UserRepository,
+	sessionRepo ports.SessionRepository,
+	setRecordRepo ports.SetRecordRepository,
+	bodyWeightRepo ports.BodyWeightRepository,
+	auditLogRepo ports.AuditLogRepository,
+) *GetGoalUC {
+	return &GetGoalUC{
+		goalRepo: goalRepo,
+		userRepo: userRepo,
+		tracker: &tracker{
+			goalRepo:       goalRepo,
+			sessionRepo:    sessionRepo,
+			setRecordRepo:  setRecordRepo,
+			bodyWeightRepo: bodyWeightRepo,
+			auditLogRepo:   auditLogRepo,
+		},
+	}
+}
+
+// Execute returns the goal's progress, or ErrNotFound if it does not exist or belongs to
+// another user.
+func (uc *GetGoalUC) Execute(ctx context.Context, userID, id uuid.UUID) (*GoalProgress, error) {
+	goal, err := uc.goalRepo.GetByID(ctx, userID, id)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get goal: %w", err)
+	}
+	if goal == nil {
+		return nil, domainerrors.ErrNotFound
+	}
+
+	prefs, err := profile.LoadPreferences(ctx, uc.userRepo, userID)
+	if err != nil {
+		return nil, err
+	}
+	return uc.tracker.progress(ctx, goal, prefs, time.Now().UTC())
+}
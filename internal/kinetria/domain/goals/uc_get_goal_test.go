@@ -0,0 +1,177 @@
+package goals_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/kinetria/kinetria-back/internal/kinetria/domain/entities"
+	domainerrors "github.com/kinetria/kinetria-back/internal/kinetria/domain/errors"
+	"github.com/kinetria/kinetria-back/internal/kinetria/domain/goals"
+	"github.com/kinetria/kinetria-back/internal/kinetria/domain/ports"
+	"github.com/kinetria/kinetria-back/internal/kinetria/domain/vos"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func newGetGoalUC(e *env) *goals.GetGoalUC {
+	return goals.NewGetGoalUC(e.goals, e.users, e.sessions, e.sets, e.bodyWeight, e.audit)
+}
+
+func newGoal(userID uuid.UUID, typ vos.GoalType, target int64) entities.Goal {
+	now := time.Now().UTC()
+	return entities.Goal{
+		ID:          uuid.New(),
+		UserID:      userID,
+		Type:        typ,
+		TargetValue: target,
+		Status:      vos.GoalStatusActive,
+		CreatedAt:   now.AddDate(0, 0, -30),
+		UpdatedAt:   now.AddDate(0, 0, -30),
+	}
+}
+
+func TestGetGoalUC_Execute(t *testing.T) {
+	userID := uuid.New()
+
+	t.Run("exercise_progress_and_projection", func(t *testing.T) {
+		exerciseID := uuid.New()
+		goal := newGoal(userID, vos.GoalTypeExerciseWeight, 100000)
+		goal.ExerciseID = &exerciseID
+		goal.StartValue = int64Ptr(80000)
+		targetDate := time.Now().UTC().AddDate(0, 0, 30)
+		goal.TargetDate = &targetDate
+		e := newEnv(goal)
+		// +5 kg por semana, 90 kg hoje: faltam 10 kg, duas semanas
+		e.sets.rows = sessionSets(exerciseID, 5, 80000, 85000, 90000)
+
+		out, err := newGetGoalUC(e).Execute(context.Background(), userID, goal.ID)
+		require.NoError(t, err)
+		require.NotNil(t, out.CurrentValue)
+		assert.Equal(t, int64(90000), *out.CurrentValue)
+		require.NotNil(t, out.ProgressPercent)
+		assert.Equal(t, 50.0, *out.ProgressPercent)
+		require.NotNil(t, out.ProjectedDate)
+		expected := time.Now().UTC().AddDate(0, 0, 14).Truncate(24 * time.Hour)
+		assert.WithinDuration(t, expected, *out.ProjectedDate, 24*time.Hour)
+		require.NotNil(t, out.OnTrack)
+		assert.True(t, *out.OnTrack)
+		assert.Equal(t, vos.GoalStatusActive, out.Goal.Status)
+	})
+
+	t.Run("stalled_exercise_has_no_projection", func(t *testing.T) {
+		exerciseID := uuid.New()
+		goal := newGoal(userID, vos.GoalTypeExerciseWeight, 100000)
+		goal.ExerciseID = &exerciseID
+		targetDate := time.Now().UTC().AddDate(0, 0, 30)
+		goal.TargetDate = &targetDate
+		e := newEnv(goal)
+		e.sets.rows = sessionSets(exerciseID, 5, 90000, 90000, 90000)
+
+		out, err := newGetGoalUC(e).Execute(context.Background(), userID, goal.ID)
+		require.NoError(t, err)
+		assert.Nil(t, out.ProjectedDate)
+		require.NotNil(t, out.OnTrack)
+		assert.False(t, *out.OnTrack)
+	})
+
+	t.Run("ignores_other_exercises", func(t *testing.T) {
+		exerciseID := uuid.New()
+		goal := newGoal(userID, vos.GoalTypeExerciseE1RM, 100000)
+		goal.ExerciseID = &exerciseID
+		e := newEnv(goal)
+		e.sets.rows = sessionSets(uuid.New(), 1, 150000)
+
+		out, err := newGetGoalUC(e).Execute(context.Background(), userID, goal.ID)
+		require.NoError(t, err)
+		assert.Nil(t, out.CurrentValue)
+		assert.Nil(t, out.ProgressPercent)
+		assert.Equal(t, vos.GoalStatusActive, out.Goal.Status)
+	})
+
+	t.Run("body_weight_going_down", func(t *testing.T) {
+		goal := newGoal(userID, vos.GoalTypeBodyWeight, 80000)
+		goal.StartValue = int64Ptr(90000)
+		e := newEnv(goal)
+		now := time.Now().UTC()
+		e.bodyWeight.entries = []ports.BodyWeightEntry{
+			{MeasuredAt: now.AddDate(0, 0, -14), WeightGrams: 87000},
+			{MeasuredAt: now.AddDate(0, 0, -7), WeightGrams: 86000},
+			{MeasuredAt: now, WeightGrams: 85000},
+		}
+
+		out, err := newGetGoalUC(e).Execute(context.Background(), userID, goal.ID)
+		require.NoError(t, err)
+		require.NotNil(t, out.ProgressPercent)
+		assert.Equal(t, 50.0, *out.ProgressPercent)
+		assert.Equal(t, int64(-5000), *out.Remaining)
+		// -1 kg por semana: cinco semanas
+		require.NotNil(t, out.ProjectedDate)
+		assert.WithinDuration(t, now.AddDate(0, 0, 35), *out.ProjectedDate, 24*time.Hour)
+		assert.Nil(t, out.OnTrack)
+	})
+
+	t.Run("reaching_target_is_not_recorded_on_read", func(t *testing.T) {
+		goal := newGoal(userID, vos.GoalTypeMonthlyVolume, 1000000)
+		e := newEnv(goal)
+		e.sets.stats = ports.SetRecordStats{TotalVolume: 1200000}
+
+		out, err := newGetGoalUC(e).Execute(context.Background(), userID, goal.ID)
+		require.NoError(t, err)
+		assert.Equal(t, 100.0, *out.ProgressPercent)
+		assert.Equal(t, vos.GoalStatusActive, out.Goal.Status)
+		assert.Nil(t, out.Goal.AchievedAt)
+		assert.Empty(t, e.audit.entries)
+	})
+
+	t.Run("monthly_period_follows_calendar", func(t *testing.T) {
+		goal := newGoal(userID, vos.GoalTypeMonthlyVolume, 1000000)
+		e := newEnv(goal)
+
+		out, err := newGetGoalUC(e).Execute(context.Background(), userID, goal.ID)
+		require.NoError(t, err)
+		now := time.Now().UTC()
+		require.NotNil(t, out.PeriodStart)
+		assert.Equal(t, time.Date(now.Year(), now.Month(), 1, 0, 0, 0, 0, time.UTC), out.PeriodStart.UTC())
+		assert.Equal(t, out.PeriodStart.AddDate(0, 1, 0), *out.PeriodEnd)
+		assert.Nil(t, out.ProjectedDate)
+		require.NotNil(t, out.OnTrack)
+		assert.False(t, *out.OnTrack)
+	})
+
+	t.Run("other_users_goal_is_not_found", func(t *testing.T) {
+		goal := newGoal(uuid.New(), vos.GoalTypeWeeklySessions, 3)
+		e := newEnv(goal)
+
+		_, err := newGetGoalUC(e).Execute(context.Background(), userID, goal.ID)
+		assert.ErrorIs(t, err, domainerrors.ErrNotFound)
+	})
+}
+
+func TestUpdateGoalUC_Execute(t *testing.T) {
+	userID := uuid.New()
+
+	t.Run("changes_target", func(t *testing.T) {
+		goal := newGoal(userID, vos.GoalTypeWeeklySessions, 3)
+		e := newEnv(goal)
+		e.sessions.stats = ports.SessionStats{TotalWorkouts: 2}
+		uc := goals.NewUpdateGoalUC(e.goals, e.users, e.sessions, e.sets, e.bodyWeight, e.audit)
+
+		out, err := uc.Execute(context.Background(), goals.UpdateGoalInput{UserID: userID, ID: goal.ID, TargetValue: int64Ptr(4)})
+		require.NoError(t, err)
+		assert.Equal(t, int64(4), out.Goal.TargetValue)
+		assert.Equal(t, int64(4), e.goals.items[goal.ID].TargetValue)
+		assert.Equal(t, 50.0, *out.ProgressPercent)
+	})
+
+	t.Run("achieved_goal_is_final", func(t *testing.T) {
+		goal := newGoal(userID, vos.GoalTypeWeeklySessions, 3)
+		goal.Status = vos.GoalStatusAchieved
+		e := newEnv(goal)
+		uc := goals.NewUpdateGoalUC(e.goals, e.users, e.sessions, e.sets, e.bodyWeight, e.audit)
+
+		_, err := uc.Execute(context.Background(), goals.UpdateGoalInput{UserID: userID, ID: goal.ID, TargetValue: int64Ptr(4)})
+		assert.ErrorIs(t, err, domainerrors.ErrConflict)
+	})
+}
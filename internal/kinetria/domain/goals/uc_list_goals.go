@@ -0,0 +1,64 @@
+package goals
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/kinetria/kinetria-back/internal/kinetria/domain/ports"
+	"github.com/kinetria/kinetria-back/internal/kinetria/domain/profile"
+)
+
+// ListGoalsUC returns the user's goals with their progress.
+type ListGoalsUC struct {
+	goalRepo ports.GoalRepository
+	userRepo ports.UserRepository
+	tracker  *tracker
+}
+
+// NewListGoalsUC creates a new ListGoalsUC.
+func NewListGoalsUC(
+	goalRepo ports.GoalRepository,
+	userRepo ports.UserRepository,
+	sessionRepo ports.SessionRepository,
+	setRecordRepo ports.SetRecordRepository,
+	bodyWeightRepo ports.BodyWeightRepository,
+	auditLogRepo ports.AuditLogRepository,
+) *ListGoalsUC {
+	return &ListGoalsUC{
+		goalRepo: goalRepo,
+		userRepo: userRepo,
+		tracker: &tracker{
+			goalRepo:       goalRepo,
+			sessionRepo:    sessionRepo,
+			setRecordRepo:  setRecordRepo,
+			bodyWeightRepo: bodyWeightRepo,
+			auditLogRepo:   auditLogRepo,
+		},
+	}
+}
+
+// Execute returns every goal of the user, active first and then most recent first.
+func (uc *ListGoalsUC) Execute(ctx context.Context, userID uuid.UUID) ([]GoalProgress, error) {
+	goals, err := uc.goalRepo.ListByUser(ctx, userID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list goals: %w", err)
+	}
+
+	prefs, err := profile.LoadPreferences(ctx, uc.userRepo, userID)
+	if err != nil {
+		return nil, err
+	}
+	now := time.Now().UTC()
+
+	out := make([]GoalProgress, 0, len(goals))
+	for i := range goals {
+		p, err := uc.tracker.progress(ctx, &goals[i], prefs, now)
+		if err != nil {
+			return nil, err
+		}
+		out = append(out, *p)
+	}
+	return out, nil
+}
@@ -0,0 +1,82 @@
+package goals
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	domainerrors "github.com/kinetria/kinetria-back/internal/kinetria/domain/errors"
+	"github.com/kinetria/kinetria-back/internal/kinetria/domain/ports"
+	"github.com/kinetria/kinetria-back/internal/kinetria/domain/profile"
+	"github.com/kinetria/kinetria-back/internal/kinetria/domain/vos"
+)
+
+// UpdateGoalUC changes the target of one of the user's active goals.
+type UpdateGoalUC struct {
+	goalRepo ports.GoalRepository
+	userRepo ports.UserRepository
+	tracker  *tracker
+}
+
+// NewUpdateGoalUC creates a new UpdateGoalUC.
+func NewUpdateGoalUC(
+	goalRepo ports.GoalRepository,
+	userRepo ports.UserRepository,
+	sessionRepo ports.SessionRepository,
+	setRecordRepo ports.SetRecordRepository,
+	bodyWeightRepo ports.BodyWeightRepository,
+	auditLogRepo ports.AuditLogRepository,
+) *UpdateGoalUC {
+	return &UpdateGoalUC{
+		goalRepo: goalRepo,
+		userRepo: userRepo,
+		tracker: &tracker{
+			goalRepo:       goalRepo,
+			sessionRepo:    sessionRepo,
+			setRecordRepo:  setRecordRepo,
+			bodyWeightRepo: bodyWeightRepo,
+			auditLogRepo:   auditLogRepo,
+		},
+	}
+}
+
+// Execute applies the non-nil fields of input and returns the goal's progress.
+// Achieved goals are final: updating one returns ErrConflict.
+func (uc *UpdateGoalUC) Execute(ctx context.Context, input UpdateGoalInput) (*GoalProgress, error) {
+	goal, err := uc.goalRepo.GetByID(ctx, input.UserID, input.ID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get goal: %w", err)
+	}
+	if goal == nil {
+		return nil, domainerrors.ErrNotFound
+	}
+	if goal.Status != vos.GoalStatusActive {
+		return nil, fmt.Errorf("%w: goal already achieved", domainerrors.ErrConflict)
+	}
+
+	now := time.Now().UTC()
+	if input.TargetValue != nil {
+		if err := validateTarget(goal.Type, *input.TargetValue); err != nil {
+			return nil, err
+		}
+		goal.TargetValue = *input.TargetValue
+	}
+	if input.TargetDate != nil {
+		if err := validateTargetDate(goal.Type, *input.TargetDate, now); err != nil {
+			return nil, err
+		}
+		d := calendarDay(*input.TargetDate)
+		goal.TargetDate = &d
+	}
+	goal.UpdatedAt = now
+
+	if err := uc.goalRepo.Update(ctx, goal); err != nil {
+		return nil, fmt.Errorf("failed to update goal: %w", err)
+	}
+
+	prefs, err := profile.LoadPreferences(ctx, uc.userRepo, input.UserID)
+	if err != nil {
+		return nil, err
+	}
+	return uc.tracker.evaluate(ctx, goal, prefs, now)
+}
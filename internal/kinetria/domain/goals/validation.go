@@ -0,0 +1,65 @@
+package goals
+
+import (
+	"fmt"
+	"time"
+
+	domainerrors "github.com/kinetria/kinetria-back/internal/kinetria/domain/errors"
+	"github.com/kinetria/kinetria-back/internal/kinetria/domain/vos"
+)
+
+const (
+	maxLiftGrams         = 1000000        // 1000 kg
+	minBodyWeightGrams   = 20000          // 20 kg
+	maxBodyWeightGrams   = 400000         // 400 kg
+	maxWeeklySessions    = 14             // duas por dia
+	maxMonthlyVolume     = 10000000000000 // 10.000 t
+	maxTargetHorizonDays = 5 * 365
+)
+
+// validateTarget checks that value is a sensible target for a goal of type t.
+func validateTarget(t vos.GoalType, value int64) error {
+	if value <= 0 {
+		return fmt.Errorf("%w: targetValue must be positive", domainerrors.ErrMalformedParameters)
+	}
+	switch t {
+	case vos.GoalTypeExerciseE1RM, vos.GoalTypeExerciseWeight:
+		if value > maxLiftGrams {
+			return fmt.Errorf("%w: targetValue must be at most 1000 kg", domainerrors.ErrMalformedParameters)
+		}
+	case vos.GoalTypeBodyWeight:
+		if value < minBodyWeightGrams || value > maxBodyWeightGrams {
+			return fmt.Errorf("%w: targetValue must be between 20 and 400 kg", domainerrors.ErrMalformedParameters)
+		}
+	case vos.GoalTypeWeeklySessions:
+		if value > maxWeeklySessions {
+			return fmt.Errorf("%w: targetValue must be at most 14 sessions", domainerrors.ErrMalformedParameters)
+		}
+	case vos.GoalTypeMonthlyVolume:
+		if value > maxMonthlyVolume {
+			return fmt.Errorf("%w: targetValue is too large", domainerrors.ErrMalformedParameters)
+		}
+	}
+	return nil
+}
+
+// validateTargetDate rejects target dates on periodic goals (their deadline is the end of
+// each period), in the past or too far ahead.
+func validateTargetDate(t vos.GoalType, date time.Time, now time.Time) error {
+	if t.IsPeriodic() {
+		return fmt.Errorf("%w: targetDate is not supported for %s goals", domainerrors.ErrMalformedParameters, t)
+	}
+	today := calendarDay(now)
+	if calendarDay(date).Before(today) {
+		return fmt.Errorf("%w: targetDate must not be in the past", domainerrors.ErrMalformedParameters)
+	}
+	if calendarDay(date).After(today.AddDate(0, 0, maxTargetHorizonDays)) {
+		return fmt.Errorf("%w: targetDate must be within 5 years", domainerrors.ErrMalformedParameters)
+	}
+	return nil
+}
+
+// calendarDay truncates t to midnight UTC of its date, the form target dates are stored in.
+func calendarDay(t time.Time) time.Time {
+	return time.Date(t.Year(), t.Month(), t.Day(), 0, 0, 0, 0, time.UTC)
+}
@@ -0,0 +1,194 @@
+package measurements_test
+
+import (
+	"context"
+	"sort"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/kinetria/kinetria-back/internal/kinetria/domain/entities"
+	domainerrors "github.com/kinetria/kinetria-back/internal/kinetria/domain/errors"
+	"github.com/kinetria/kinetria-back/internal/kinetria/domain/ports"
+	"github.com/kinetria/kinetria-back/internal/kinetria/domain/vos"
+)
+
+// mockMeasurementRepo is an in-memory ports.BodyMeasurementRepository and ports.BodyWeightRepository.
+type mockMeasurementRepo struct {
+	items map[uuid.UUID]entities.BodyMeasurement
+	goal  *entities.BodyWeightGoal
+	err   error
+}
+
+func newMockMeasurementRepo(items ...entities.BodyMeasurement) *mockMeasurementRepo {
+	m := &mockMeasurementRepo{items: map[uuid.UUID]entities.BodyMeasurement{}}
+	for _, it := range items {
+		m.items[it.ID] = it
+	}
+	return m
+}
+
+func (m *mockMeasurementRepo) Create(_ context.Context, bm *entities.BodyMeasurement) error {
+	if m.err != nil {
+		return m.err
+	}
+	m.items[bm.ID] = *bm
+	return nil
+}
+
+func (m *mockMeasurementRepo) GetByID(_ context.Context, userID, id uuid.UUID) (*entities.BodyMeasurement, error) {
+	if m.err != nil {
+		return nil, m.err
+	}
+	it, ok := m.items[id]
+	if !ok || it.UserID != userID {
+		return nil, nil
+	}
+	return &it, nil
+}
+
+func (m *mockMeasurementRepo) Update(_ context.Context, bm *entities.BodyMeasurement) error {
+	if m.err != nil {
+		return m.err
+	}
+	m.items[bm.ID] = *bm
+	return nil
+}
+
+func (m *mockMeasurementRepo) Delete(_ context.Context, userID, id uuid.UUID) (bool, error) {
+	if m.err != nil {
+		return false, m.err
+	}
+	it, ok := m.items[id]
+	if !ok || it.UserID != userID {
+		return false, nil
+	}
+	delete(m.items, id)
+	return true, nil
+}
+
+func (m *mockMeasurementRepo) ListByUser(ctx context.Context, userID uuid.UUID, start, end time.Time, limit, offset int) ([]entities.BodyMeasurement, int, error) {
+	all, err := m.ListByUserInRange(ctx, userID, start, end)
+	if err != nil {
+		return nil, 0, err
+	}
+	sort.Slice(all, func(i, j int) bool { return all[i].MeasuredAt.After(all[j].MeasuredAt) })
+	if offset >= len(all) {
+		return []entities.BodyMeasurement{}, len(all), nil
+	}
+	return all[offset:min(offset+limit, len(all))], len(all), nil
+}
+
+func (m *mockMeasurementRepo) ListByUserInRange(_ context.Context, userID uuid.UUID, start, end time.Time) ([]entities.BodyMeasurement, error) {
+	if m.err != nil {
+		return nil, m.err
+	}
+	var out []entities.BodyMeasurement
+	for _, it := range m.items {
+		if it.UserID == userID && !it.MeasuredAt.Before(start) && !it.MeasuredAt.After(end) {
+			out = append(out, it)
+		}
+	}
+	sort.Slice(out, func(i, j int) bool { return out[i].MeasuredAt.Before(out[j].MeasuredAt) })
+	return out, nil
+}
+
+func (m *mockMeasurementRepo) GetLatestBodyWeight(_ context.Context, userID uuid.UUID) (*int, error) {
+	if m.err != nil {
+		return nil, m.err
+	}
+	var latest *entities.BodyMeasurement
+	for _, it := range m.items {
+		if it.UserID != userID || it.WeightGrams == nil {
+			continue
+		}
+		if latest == nil || it.MeasuredAt.After(latest.MeasuredAt) {
+			it := it
+			latest = &it
+		}
+	}
+	if latest == nil {
+		return nil, nil
+	}
+	return latest.WeightGrams, nil
+}
+
+func (m *mockMeasurementRepo) ListBodyWeights(ctx context.Context, userID uuid.UUID, start, end time.Time) ([]ports.BodyWeightEntry, error) {
+	all, err := m.ListByUserInRange(ctx, userID, time.Time{}, end)
+	if err != nil {
+		return nil, err
+	}
+	var out []ports.BodyWeightEntry
+	for _, it := range all {
+		if it.WeightGrams == nil {
+			continue
+		}
+		e := ports.BodyWeightEntry{MeasuredAt: it.MeasuredAt, WeightGrams: *it.WeightGrams}
+		if it.MeasuredAt.Before(start) {
+			out = []ports.BodyWeightEntry{e}
+			continue
+		}
+		out = append(out, e)
+	}
+	return out, nil
+}
+
+func (m *mockMeasurementRepo) GetGoalWeight(_ context.Context, userID uuid.UUID) (*entities.BodyWeightGoal, error) {
+	if m.err != nil {
+		return nil, m.err
+	}
+	if m.goal == nil || m.goal.UserID != userID {
+		return nil, nil
+	}
+	return m.goal, nil
+}
+
+func (m *mockMeasurementRepo) UpsertGoalWeight(_ context.Context, goal *entities.BodyWeightGoal) error {
+	if m.err != nil {
+		return m.err
+	}
+	m.goal = goal
+	return nil
+}
+
+func (m *mockMeasurementRepo) DeleteGoalWeight(_ context.Context, _ uuid.UUID) error {
+	if m.err != nil {
+		return m.err
+	}
+	m.goal = nil
+	return nil
+}
+
+// mockUserRepo is a ports.UserRepository returning a single user with the given timezone.
+type mockUserRepo struct {
+	user *entities.User
+}
+
+func newMockUserRepo(timezone string) *mockUserRepo {
+	prefs := vos.DefaultUserPreferences()
+	prefs.Timezone = timezone
+	return &mockUserRepo{user: &entities.User{ID: uuid.New(), Preferences: prefs}}
+}
+
+func (m *mockUserRepo) Create(_ context.Context, _ *entities.User) error { return nil }
+func (m *mockUserRepo) GetByEmail(_ context.Context, _ string) (*entities.User, error) {
+	return nil, domainerrors.ErrNotFound
+}
+func (m *mockUserRepo) GetByID(_ context.Context, _ uuid.UUID) (*entities.User, error) {
+	return m.user, nil
+}
+func (m *mockUserRepo) Update(_ context.Context, _ *entities.User) error { return nil }
+
+// weighIn builds a weight-only measurement.
+func weighIn(userID uuid.UUID, at time.Time, grams int) entities.BodyMeasurement {
+	return entities.BodyMeasurement{
+		ID:             uuid.New(),
+		UserID:         userID,
+		MeasuredAt:     at,
+		WeightGrams:    &grams,
+		Circumferences: map[vos.BodySite]int{},
+	}
+}
+
+func intPtr(v int) *int { return &v }
+
+func floatPtr(v float64) *float64 { return &v }
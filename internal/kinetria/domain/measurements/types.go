@@ -0,0 +1,107 @@
+package measurements
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/kinetria/kinetria-back/internal/kinetria/domain/entities"
+	"github.com/kinetria/kinetria-back/internal/kinetria/domain/vos"
+)
+
+// MeasurementValues holds the values of a measurement entry in canonical units.
+// Nil values (and sites missing from Circumferences) are not recorded.
+type MeasurementValues struct {
+	WeightGrams    *int
+	BodyFatPercent *float64
+	Circumferences map[vos.BodySite]int // milímetros
+}
+
+// CreateMeasurementInput contains the data needed to log a measurement.
+// A nil MeasuredAt means "now".
+type CreateMeasurementInput struct {
+	UserID     uuid.UUID
+	MeasuredAt *time.Time
+	Values     MeasurementValues
+	Notes      string
+}
+
+// UpdateMeasurementInput contains the fields to change on a measurement.
+// Nil fields are left unchanged; a circumference of 0 removes that site.
+type UpdateMeasurementInput struct {
+	UserID     uuid.UUID
+	ID         uuid.UUID
+	MeasuredAt *time.Time
+	Values     MeasurementValues
+	Notes      *string
+}
+
+// ListMeasurementsInput filters and paginates the measurement log.
+// Nil dates leave that side of the period open.
+type ListMeasurementsInput struct {
+	UserID    uuid.UUID
+	StartDate *time.Time
+	EndDate   *time.Time
+	Page      int
+	PageSize  int
+}
+
+// ListMeasurementsOutput is a page of measurements, most recent first.
+type ListMeasurementsOutput struct {
+	Measurements []entities.BodyMeasurement
+	Total        int
+	Page         int
+	PageSize     int
+	TotalPages   int
+}
+
+// GetMeasurementTrendInput selects a metric and period for the trend query.
+// Dates are calendar days in the user's timezone; nil dates default to the last 90 days.
+type GetMeasurementTrendInput struct {
+	UserID     uuid.UUID
+	Metric     vos.BodyMetric
+	StartDate  *time.Time
+	EndDate    *time.Time
+	WindowDays int // janela da média móvel; 0 = padrão (7)
+}
+
+// TrendPoint is the metric on one calendar day with a measurement.
+// Value is the day's mean; MovingAverage the mean of the daily values in the
+// trailing window ending on Date. Both are in canonical units (grams, percent, mm).
+type TrendPoint struct {
+	Date          time.Time
+	Value         float64
+	MovingAverage float64
+}
+
+// MeasurementTrend is the daily series of a metric with its moving average.
+type MeasurementTrend struct {
+	Metric     vos.BodyMetric
+	StartDate  time.Time
+	EndDate    time.Time
+	WindowDays int
+	Points     []TrendPoint
+	// Change is the last moving average minus the first (nil with fewer than two points).
+	Change *float64
+}
+
+// SetGoalWeightInput contains the target weight in grams and an optional target date.
+type SetGoalWeightInput struct {
+	UserID      uuid.UUID
+	TargetGrams int
+	TargetDate  *time.Time
+}
+
+// GoalWeightProgress is the goal weight with the user's progress toward it.
+// Fields derived from the current weight are nil until a weight is logged.
+type GoalWeightProgress struct {
+	Goal         entities.BodyWeightGoal
+	CurrentGrams *int
+	// RemainingGrams is target minus current: negative means weight still to lose.
+	RemainingGrams  *int
+	ProgressPercent *float64 // 0–100, desde StartGrams
+	Reached         bool
+	// WeeklyRateGrams is the weight change per week over the last 4 weeks (linear fit).
+	WeeklyRateGrams *int
+	// ProjectedDate is when the target is reached at the current rate, if moving toward it.
+	ProjectedDate *time.Time
+}
@@ -0,0 +1,74 @@
+package measurements
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/kinetria/kinetria-back/internal/kinetria/domain/entities"
+	domainerrors "github.com/kinetria/kinetria-back/internal/kinetria/domain/errors"
+	"github.com/kinetria/kinetria-back/internal/kinetria/domain/ports"
+	"github.com/kinetria/kinetria-back/internal/kinetria/domain/vos"
+)
+
+// CreateMeasurementUC logs a body measurement for the user.
+type CreateMeasurementUC struct {
+	repo  ports.BodyMeasurementRepository
+	goals ports.GoalEvaluator
+}
+
+// NewCreateMeasurementUC creates a new CreateMeasurementUC.
+// goals may be nil, in which case body weight goals are not evaluated.
+func NewCreateMeasurementUC(repo ports.BodyMeasurementRepository, goals ports.GoalEvaluator) *CreateMeasurementUC {
+	return &CreateMeasurementUC{repo: repo, goals: goals}
+}
+
+// Execute validates and stores a new measurement. At least one value must be provided.
+func (uc *CreateMeasurementUC) Execute(ctx context.Context, input CreateMeasurementInput) (*entities.BodyMeasurement, error) {
+	if err := validateValues(&input.Values, false); err != nil {
+		return nil, err
+	}
+	if err := validateNotes(input.Notes); err != nil {
+		return nil, err
+	}
+
+	now := time.Now().UTC()
+	measuredAt := now
+	if input.MeasuredAt != nil {
+		if err := validateMeasuredAt(*input.MeasuredAt); err != nil {
+			return nil, err
+		}
+		measuredAt = input.MeasuredAt.UTC()
+	}
+
+	circumferences := make(map[vos.BodySite]int, len(input.Values.Circumferences))
+	for site, mm := range input.Values.Circumferences {
+		circumferences[site] = mm
+	}
+
+	m := &entities.BodyMeasurement{
+		ID:             uuid.New(),
+		UserID:         input.UserID,
+		MeasuredAt:     measuredAt,
+		WeightGrams:    input.Values.WeightGrams,
+		BodyFatPercent: input.Values.BodyFatPercent,
+		Circumferences: circumferences,
+		Notes:          input.Notes,
+		CreatedAt:      now,
+		UpdatedAt:      now,
+	}
+	if !hasAnyValue(m) {
+		return nil, fmt.Errorf("%w: at least one measurement value must be provided", domainerrors.ErrMalformedParameters)
+	}
+
+	if err := uc.repo.Create(ctx, m); err != nil {
+		return nil, fmt.Errorf("failed to create measurement: %w", err)
+	}
+
+	// Uma pesagem pode alcançar uma meta de peso corporal (best-effort)
+	if m.WeightGrams != nil && uc.goals != nil {
+		_, _ = uc.goals.Execute(ctx, input.UserID)
+	}
+	return m, nil
+}
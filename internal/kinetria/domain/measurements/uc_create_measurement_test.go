@@ -0,0 +1,186 @@
+package measurements_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	domainerrors "github.com/kinetria/kinetria-back/internal/kinetria/domain/errors"
+	"github.com/kinetria/kinetria-back/internal/kinetria/domain/measurements"
+	"github.com/kinetria/kinetria-back/internal/kinetria/domain/vos"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCreateMeasurementUC_Execute(t *testing.T) {
+	userID := uuid.New()
+	past := time.Now().Add(-48 * time.Hour)
+	future := time.Now().Add(time.Hour)
+
+	tests := []struct {
+		name      string
+		input     measurements.CreateMeasurementInput
+		repoErr   error
+		wantErrIs error
+		wantErr   bool
+	}{
+		{
+			name: "weight_only",
+			input: measurements.CreateMeasurementInput{
+				UserID: userID,
+				Values: measurements.MeasurementValues{WeightGrams: intPtr(80500)},
+			},
+		},
+		{
+			name: "backdated_full_entry",
+			input: measurements.CreateMeasurementInput{
+				UserID:     userID,
+				MeasuredAt: &past,
+				Values: measurements.MeasurementValues{
+					WeightGrams:    intPtr(80500),
+					BodyFatPercent: floatPtr(18.44),
+					Circumferences: map[vos.BodySite]int{vos.BodySiteWaist: 850},
+				},
+				Notes: "morning, fasted",
+			},
+		},
+		{
+			name:      "no_values",
+			input:     measurements.CreateMeasurementInput{UserID: userID},
+			wantErrIs: domainerrors.ErrMalformedParameters,
+		},
+		{
+			name: "weight_out_of_range",
+			input: measurements.CreateMeasurementInput{
+				UserID: userID,
+				Values: measurements.MeasurementValues{WeightGrams: intPtr(5000)},
+			},
+			wantErrIs: domainerrors.ErrMalformedParameters,
+		},
+		{
+			name: "unknown_site",
+			input: measurements.CreateMeasurementInput{
+				UserID: userID,
+				Values: measurements.MeasurementValues{Circumferences: map[vos.BodySite]int{"ear": 200}},
+			},
+			wantErrIs: domainerrors.ErrMalformedParameters,
+		},
+		{
+			name: "measured_in_the_future",
+			input: measurements.CreateMeasurementInput{
+				UserID:     userID,
+				MeasuredAt: &future,
+				Values:     measurements.MeasurementValues{WeightGrams: intPtr(80500)},
+			},
+			wantErrIs: domainerrors.ErrMalformedParameters,
+		},
+		{
+			name: "repository_error",
+			input: measurements.CreateMeasurementInput{
+				UserID: userID,
+				Values: measurements.MeasurementValues{WeightGrams: intPtr(80500)},
+			},
+			repoErr: errors.New("db down"),
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			repo := newMockMeasurementRepo()
+			repo.err = tt.repoErr
+			uc := measurements.NewCreateMeasurementUC(repo, nil)
+
+			m, err := uc.Execute(context.Background(), tt.input)
+			if tt.wantErrIs != nil {
+				assert.ErrorIs(t, err, tt.wantErrIs)
+				return
+			}
+			if tt.wantErr {
+				assert.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, userID, m.UserID)
+			assert.Contains(t, repo.items, m.ID)
+			if tt.input.MeasuredAt != nil {
+				assert.True(t, m.MeasuredAt.Equal(*tt.input.MeasuredAt))
+			}
+			if tt.input.Values.BodyFatPercent != nil {
+				assert.Equal(t, 18.4, *m.BodyFatPercent)
+			}
+		})
+	}
+}
+
+func TestUpdateMeasurementUC_Execute(t *testing.T) {
+	userID := uuid.New()
+	existing := weighIn(userID, time.Now().Add(-time.Hour), 80000)
+	existing.Circumferences[vos.BodySiteWaist] = 850
+
+	t.Run("changes_given_fields_only", func(t *testing.T) {
+		repo := newMockMeasurementRepo(existing)
+		uc := measurements.NewUpdateMeasurementUC(repo, nil)
+
+		m, err := uc.Execute(context.Background(), measurements.UpdateMeasurementInput{
+			UserID: userID,
+			ID:     existing.ID,
+			Values: measurements.MeasurementValues{WeightGrams: intPtr(79500)},
+		})
+		require.NoError(t, err)
+		assert.Equal(t, 79500, *m.WeightGrams)
+		assert.Equal(t, 850, m.Circumferences[vos.BodySiteWaist])
+	})
+
+	t.Run("zero_removes_site", func(t *testing.T) {
+		repo := newMockMeasurementRepo(existing)
+		uc := measurements.NewUpdateMeasurementUC(repo, nil)
+
+		m, err := uc.Execute(context.Background(), measurements.UpdateMeasurementInput{
+			UserID: userID,
+			ID:     existing.ID,
+			Values: measurements.MeasurementValues{Circumferences: map[vos.BodySite]int{vos.BodySiteWaist: 0}},
+		})
+		require.NoError(t, err)
+		assert.NotContains(t, m.Circumferences, vos.BodySiteWaist)
+	})
+
+	t.Run("entry_must_keep_a_value", func(t *testing.T) {
+		onlyWaist := existing
+		onlyWaist.WeightGrams = nil
+		repo := newMockMeasurementRepo(onlyWaist)
+		uc := measurements.NewUpdateMeasurementUC(repo, nil)
+
+		_, err := uc.Execute(context.Background(), measurements.UpdateMeasurementInput{
+			UserID: userID,
+			ID:     existing.ID,
+			Values: measurements.MeasurementValues{Circumferences: map[vos.BodySite]int{vos.BodySiteWaist: 0}},
+		})
+		assert.ErrorIs(t, err, domainerrors.ErrMalformedParameters)
+	})
+
+	t.Run("other_users_measurement_is_not_found", func(t *testing.T) {
+		repo := newMockMeasurementRepo(existing)
+		uc := measurements.NewUpdateMeasurementUC(repo, nil)
+
+		_, err := uc.Execute(context.Background(), measurements.UpdateMeasurementInput{
+			UserID: uuid.New(),
+			ID:     existing.ID,
+			Values: measurements.MeasurementValues{WeightGrams: intPtr(79500)},
+		})
+		assert.ErrorIs(t, err, domainerrors.ErrNotFound)
+	})
+}
+
+func TestDeleteMeasurementUC_Execute(t *testing.T) {
+	userID := uuid.New()
+	existing := weighIn(userID, time.Now().Add(-time.Hour), 80000)
+	repo := newMockMeasurementRepo(existing)
+	uc := measurements.NewDeleteMeasurementUC(repo)
+
+	require.NoError(t, uc.Execute(context.Background(), userID, existing.ID))
+	assert.Empty(t, repo.items)
+	assert.ErrorIs(t, uc.Execute(context.Background(), userID, existing.ID), domainerrors.ErrNotFound)
+}
@@ -0,0 +1,27 @@
+package measurements
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/google/uuid"
+	"github.com/kinetria/kinetria-back/internal/kinetria/domain/ports"
+)
+
+// DeleteGoalWeightUC removes the user's goal weight.
+type DeleteGoalWeightUC struct {
+	repo ports.BodyMeasurementRepository
+}
+
+// NewDeleteGoalWeightUC creates a new DeleteGoalWeightUC.
+func NewDeleteGoalWeightUC(repo ports.BodyMeasurementRepository) *DeleteGoalWeightUC {
+	return &DeleteGoalWeightUC{repo: repo}
+}
+
+// Execute deletes the goal; deleting a missing goal is a no-op.
+func (uc *DeleteGoalWeightUC) Execute(ctx context.Context, userID uuid.UUID) error {
+	if err := uc.repo.DeleteGoalWeight(ctx, userID); err != nil {
+		return fmt.Errorf("failed to delete goal weight: %w", err)
+	}
+	return nil
+}
@@ -0,0 +1,32 @@
+package measurements
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/google/uuid"
+	domainerrors "github.com/kinetria/kinetria-back/internal/kinetria/domain/errors"
+	"github.com/kinetria/kinetria-back/internal/kinetria/domain/ports"
+)
+
+// DeleteMeasurementUC removes one of the user's measurements.
+type DeleteMeasurementUC struct {
+	repo ports.BodyMeasurementRepository
+}
+
+// NewDeleteMeasurementUC creates a new DeleteMeasurementUC.
+func NewDeleteMeasurementUC(repo ports.BodyMeasurementRepository) *DeleteMeasurementUC {
+	return &DeleteMeasurementUC{repo: repo}
+}
+
+// Execute deletes the measurement, or returns ErrNotFound if the user has no such measurement.
+func (uc *DeleteMeasurementUC) Execute(ctx context.Context, userID, id uuid.UUID) error {
+	deleted, err := uc.repo.Delete(ctx, userID, id)
+	if err != nil {
+		return fmt.Errorf("failed to delete measurement: %w", err)
+	}
+	if !deleted {
+		return domainerrors.ErrNotFound
+	}
+	return nil
+}
@@ -0,0 +1,114 @@
+package measurements
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/kinetria/kinetria-back/internal/kinetria/domain/entities"
+	domainerrors "github.com/kinetria/kinetria-back/internal/kinetria/domain/errors"
+	"github.com/kinetria/kinetria-back/internal/kinetria/domain/ports"
+)
+
+const (
+	// goalRateDays is the look-back used to estimate the weekly rate of change.
+	goalRateDays = 28
+	// minRateSpanDays is the minimum span of weigh-ins needed to estimate a rate.
+	minRateSpanDays = 7
+)
+
+// GetGoalWeightUC returns the user's goal weight and progress toward it.
+type GetGoalWeightUC struct {
+	repo           ports.BodyMeasurementRepository
+	bodyWeightRepo ports.BodyWeightRepository
+}
+
+// NewGetGoalWeightUC creates a new GetGoalWeightUC.
+func NewGetGoalWeightUC(repo ports.BodyMeasurementRepository, bodyWeightRepo ports.BodyWeightRepository) *GetGoalWeightUC {
+	return &GetGoalWeightUC{repo: repo, bodyWeightRepo: bodyWeightRepo}
+}
+
+// Execute returns ErrNotFound when the user has no goal.
+func (uc *GetGoalWeightUC) Execute(ctx context.Context, userID uuid.UUID) (*GoalWeightProgress, error) {
+	goal, err := uc.repo.GetGoalWeight(ctx, userID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get goal weight: %w", err)
+	}
+	if goal == nil {
+		return nil, domainerrors.ErrNotFound
+	}
+
+	out := &GoalWeightProgress{Goal: *goal}
+
+	current, err := uc.bodyWeightRepo.GetLatestBodyWeight(ctx, userID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get latest body weight: %w", err)
+	}
+	if current == nil {
+		return out, nil
+	}
+	out.CurrentGrams = current
+	remaining := goal.TargetGrams - *current
+	out.RemainingGrams = &remaining
+
+	if goal.StartGrams != nil && *goal.StartGrams != goal.TargetGrams {
+		toGo := float64(goal.TargetGrams - *goal.StartGrams)
+		done := float64(*current - *goal.StartGrams)
+		pct := math.Max(0, math.Min(100, done/toGo*100))
+		pct = math.Round(pct*10) / 10
+		out.ProgressPercent = &pct
+		out.Reached = pct >= 100
+	} else {
+		out.Reached = remaining == 0
+	}
+
+	now := time.Now().UTC()
+	recent, err := uc.repo.ListByUserInRange(ctx, userID, now.AddDate(0, 0, -goalRateDays), now)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list measurements: %w", err)
+	}
+	if rate, ok := weeklyRate(recent); ok {
+		r := int(math.Round(rate))
+		out.WeeklyRateGrams = &r
+		// Projeta apenas se o peso está indo na direção da meta
+		if !out.Reached && rate != 0 && (rate > 0) == (remaining > 0) {
+			weeks := float64(remaining) / rate
+			projected := calendarDay(now.Add(time.Duration(weeks * 7 * 24 * float64(time.Hour))))
+			out.ProjectedDate = &projected
+		}
+	}
+	return out, nil
+}
+
+// weeklyRate fits a least-squares line to the weigh-ins and returns its slope in
+// grams per week. It needs weigh-ins spanning at least minRateSpanDays.
+func weeklyRate(items []entities.BodyMeasurement) (float64, bool) {
+	var xs, ys []float64
+	for _, m := range items {
+		if m.WeightGrams == nil {
+			continue
+		}
+		xs = append(xs, m.MeasuredAt.Sub(items[0].MeasuredAt).Hours()/24)
+		ys = append(ys, float64(*m.WeightGrams))
+	}
+	if len(xs) < 2 || xs[len(xs)-1]-xs[0] < minRateSpanDays {
+		return 0, false
+	}
+
+	n := float64(len(xs))
+	var sumX, sumY, sumXY, sumXX float64
+	for i := range xs {
+		sumX += xs[i]
+		sumY += ys[i]
+		sumXY += xs[i] * ys[i]
+		sumXX += xs[i] * xs[i]
+	}
+	denom := n*sumXX - sumX*sumX
+	if denom == 0 {
+		return 0, false
+	}
+	slopePerDay := (n*sumXY - sumX*sumY) / denom
+	return slopePerDay * 7, true
+}
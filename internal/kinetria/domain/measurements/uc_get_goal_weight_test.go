@@ -0,0 +1,129 @@
+package measurements_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/kinetria/kinetria-back/internal/kinetria/domain/entities"
+	domainerrors "github.com/kinetria/kinetria-back/internal/kinetria/domain/errors"
+	"github.com/kinetria/kinetria-back/internal/kinetria/domain/measurements"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSetGoalWeightUC_Execute(t *testing.T) {
+	userID := uuid.New()
+
+	t.Run("start_is_latest_weight", func(t *testing.T) {
+		now := time.Now()
+		repo := newMockMeasurementRepo(
+			weighIn(userID, now.Add(-72*time.Hour), 86000),
+			weighIn(userID, now.Add(-time.Hour), 85000),
+		)
+		uc := measurements.NewSetGoalWeightUC(repo, repo, newMockUserRepo("UTC"))
+
+		goal, err := uc.Execute(context.Background(), measurements.SetGoalWeightInput{UserID: userID, TargetGrams: 80000})
+		require.NoError(t, err)
+		require.NotNil(t, goal.StartGrams)
+		assert.Equal(t, 85000, *goal.StartGrams)
+		assert.Equal(t, goal, repo.goal)
+	})
+
+	t.Run("rejects_past_target_date", func(t *testing.T) {
+		repo := newMockMeasurementRepo()
+		uc := measurements.NewSetGoalWeightUC(repo, repo, newMockUserRepo("UTC"))
+		yesterday := time.Now().AddDate(0, 0, -2)
+
+		_, err := uc.Execute(context.Background(), measurements.SetGoalWeightInput{UserID: userID, TargetGrams: 80000, TargetDate: &yesterday})
+		assert.ErrorIs(t, err, domainerrors.ErrMalformedParameters)
+	})
+
+	t.Run("target_date_is_checked_in_the_user_timezone", func(t *testing.T) {
+		// UTC-12 e UTC+14 estão sempre em dias diferentes: hoje em UTC-12 é passado em UTC+14
+		behind, err := time.LoadLocation("Etc/GMT+12")
+		require.NoError(t, err)
+		local := time.Now().In(behind)
+		target := time.Date(local.Year(), local.Month(), local.Day(), 0, 0, 0, 0, time.UTC)
+
+		repo := newMockMeasurementRepo()
+		uc := measurements.NewSetGoalWeightUC(repo, repo, newMockUserRepo("Etc/GMT+12"))
+		_, err = uc.Execute(context.Background(), measurements.SetGoalWeightInput{UserID: userID, TargetGrams: 80000, TargetDate: &target})
+		require.NoError(t, err)
+
+		uc = measurements.NewSetGoalWeightUC(repo, repo, newMockUserRepo("Pacific/Kiritimati"))
+		_, err = uc.Execute(context.Background(), measurements.SetGoalWeightInput{UserID: userID, TargetGrams: 80000, TargetDate: &target})
+		assert.ErrorIs(t, err, domainerrors.ErrMalformedParameters)
+	})
+
+	t.Run("rejects_implausible_target", func(t *testing.T) {
+		repo := newMockMeasurementRepo()
+		uc := measurements.NewSetGoalWeightUC(repo, repo, newMockUserRepo("UTC"))
+
+		_, err := uc.Execute(context.Background(), measurements.SetGoalWeightInput{UserID: userID, TargetGrams: 500})
+		assert.ErrorIs(t, err, domainerrors.ErrMalformedParameters)
+	})
+}
+
+func TestGetGoalWeightUC_Execute(t *testing.T) {
+	userID := uuid.New()
+	now := time.Now()
+
+	t.Run("no_goal", func(t *testing.T) {
+		repo := newMockMeasurementRepo()
+		uc := measurements.NewGetGoalWeightUC(repo, repo)
+
+		_, err := uc.Execute(context.Background(), userID)
+		assert.ErrorIs(t, err, domainerrors.ErrNotFound)
+	})
+
+	t.Run("progress_rate_and_projection", func(t *testing.T) {
+		// Perde 500 g por semana nas últimas 3 semanas
+		repo := newMockMeasurementRepo(
+			weighIn(userID, now.AddDate(0, 0, -21), 86000),
+			weighIn(userID, now.AddDate(0, 0, -14), 85500),
+			weighIn(userID, now.AddDate(0, 0, -7), 85000),
+			weighIn(userID, now, 84500),
+		)
+		repo.goal = &entities.BodyWeightGoal{UserID: userID, TargetGrams: 82000, StartGrams: intPtr(87000)}
+		uc := measurements.NewGetGoalWeightUC(repo, repo)
+
+		out, err := uc.Execute(context.Background(), userID)
+		require.NoError(t, err)
+		assert.Equal(t, 84500, *out.CurrentGrams)
+		assert.Equal(t, -2500, *out.RemainingGrams)
+		assert.Equal(t, 50.0, *out.ProgressPercent)
+		assert.False(t, out.Reached)
+		assert.Equal(t, -500, *out.WeeklyRateGrams)
+		require.NotNil(t, out.ProjectedDate)
+		// 2500 g a 500 g/semana = 5 semanas
+		assert.WithinDuration(t, now.AddDate(0, 0, 35), *out.ProjectedDate, 48*time.Hour)
+	})
+
+	t.Run("no_projection_when_moving_away", func(t *testing.T) {
+		repo := newMockMeasurementRepo(
+			weighIn(userID, now.AddDate(0, 0, -14), 84000),
+			weighIn(userID, now, 85000),
+		)
+		repo.goal = &entities.BodyWeightGoal{UserID: userID, TargetGrams: 82000, StartGrams: intPtr(84000)}
+		uc := measurements.NewGetGoalWeightUC(repo, repo)
+
+		out, err := uc.Execute(context.Background(), userID)
+		require.NoError(t, err)
+		assert.Equal(t, 0.0, *out.ProgressPercent)
+		assert.Greater(t, *out.WeeklyRateGrams, 0)
+		assert.Nil(t, out.ProjectedDate)
+	})
+
+	t.Run("reached", func(t *testing.T) {
+		repo := newMockMeasurementRepo(weighIn(userID, now, 81500))
+		repo.goal = &entities.BodyWeightGoal{UserID: userID, TargetGrams: 82000, StartGrams: intPtr(86000)}
+		uc := measurements.NewGetGoalWeightUC(repo, repo)
+
+		out, err := uc.Execute(context.Background(), userID)
+		require.NoError(t, err)
+		assert.True(t, out.Reached)
+		assert.Equal(t, 100.0, *out.ProgressPercent)
+	})
+}
@@ -0,0 +1,33 @@
+package measurements
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/google/uuid"
+	"github.com/kinetria/kinetria-back/internal/kinetria/domain/entities"
+	domainerrors "github.com/kinetria/kinetria-back/internal/kinetria/domain/errors"
+	"github.com/kinetria/kinetria-back/internal/kinetria/domain/ports"
+)
+
+// GetMeasurementUC returns one of the user's measurements.
+type GetMeasurementUC struct {
+	repo ports.BodyMeasurementRepository
+}
+
+// NewGetMeasurementUC creates a new GetMeasurementUC.
+func NewGetMeasurementUC(repo ports.BodyMeasurementRepository) *GetMeasurementUC {
+	return &GetMeasurementUC{repo: repo}
+}
+
+// Execute returns the measurement, or ErrNotFound if it does not exist or belongs to another user.
+func (uc *GetMeasurementUC) Execute(ctx context.Context, userID, id uuid.UUID) (*entities.BodyMeasurement, error) {
+	m, err := uc.repo.GetByID(ctx, userID, id)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get measurement: %w", err)
+	}
+	if m == nil {
+		return nil, domainerrors.ErrNotFound
+	}
+	return m, nil
+}
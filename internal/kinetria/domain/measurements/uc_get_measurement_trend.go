@@ -0,0 +1,139 @@
+package measurements
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	domainerrors "github.com/kinetria/kinetria-back/internal/kinetria/domain/errors"
+	"github.com/kinetria/kinetria-back/internal/kinetria/domain/ports"
+	"github.com/kinetria/kinetria-back/internal/kinetria/domain/profile"
+)
+
+const (
+	defaultTrendDays   = 90
+	maxTrendDays       = 730
+	defaultTrendWindow = 7
+	maxTrendWindow     = 90
+)
+
+// GetMeasurementTrendUC returns the daily series of a body metric with its moving average.
+type GetMeasurementTrendUC struct {
+	repo     ports.BodyMeasurementRepository
+	userRepo ports.UserRepository
+}
+
+// NewGetMeasurementTrendUC creates a new GetMeasurementTrendUC.
+func NewGetMeasurementTrendUC(repo ports.BodyMeasurementRepository, userRepo ports.UserRepository) *GetMeasurementTrendUC {
+	return &GetMeasurementTrendUC{repo: repo, userRepo: userRepo}
+}
+
+// Execute groups the metric by calendar day in the user's timezone (mean of the day's
+// entries) and computes a trailing moving average over WindowDays calendar days.
+// Measurements before StartDate still feed the first points' averages.
+func (uc *GetMeasurementTrendUC) Execute(ctx context.Context, input GetMeasurementTrendInput) (*MeasurementTrend, error) {
+	if err := input.Metric.Validate(); err != nil {
+		return nil, err
+	}
+	window := input.WindowDays
+	if window == 0 {
+		window = defaultTrendWindow
+	}
+	if window < 1 || window > maxTrendWindow {
+		return nil, fmt.Errorf("%w: window must be between 1 and 90 days", domainerrors.ErrMalformedParameters)
+	}
+
+	prefs, err := profile.LoadPreferences(ctx, uc.userRepo, input.UserID)
+	if err != nil {
+		return nil, err
+	}
+	loc := prefs.Location()
+
+	// Dias de calendário (meia-noite UTC) no fuso do usuário
+	now := time.Now().In(loc)
+	endDate := calendarDay(now)
+	if input.EndDate != nil {
+		endDate = calendarDay(*input.EndDate)
+	}
+	startDate := endDate.AddDate(0, 0, -(defaultTrendDays - 1))
+	if input.StartDate != nil {
+		startDate = calendarDay(*input.StartDate)
+	}
+	if startDate.After(endDate) {
+		return nil, domainerrors.ErrInvalidPeriod
+	}
+	if endDate.Sub(startDate).Hours()/24 > maxTrendDays {
+		return nil, domainerrors.ErrPeriodTooLong
+	}
+
+	// Inclui os dias anteriores que alimentam a janela do primeiro ponto
+	fetchFrom := inLocation(startDate.AddDate(0, 0, -(window-1)), loc)
+	fetchTo := inLocation(endDate.AddDate(0, 0, 1), loc).Add(-time.Nanosecond)
+	items, err := uc.repo.ListByUserInRange(ctx, input.UserID, fetchFrom, fetchTo)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list measurements: %w", err)
+	}
+
+	type dayTotal struct {
+		sum   float64
+		count int
+	}
+	totals := make(map[time.Time]*dayTotal)
+	var days []time.Time // ordem crescente, como a consulta
+	for _, m := range items {
+		v, ok := metricValue(m, input.Metric)
+		if !ok {
+			continue
+		}
+		day := calendarDay(m.MeasuredAt.In(loc))
+		t, exists := totals[day]
+		if !exists {
+			t = &dayTotal{}
+			totals[day] = t
+			days = append(days, day)
+		}
+		t.sum += v
+		t.count++
+	}
+
+	trend := &MeasurementTrend{
+		Metric:     input.Metric,
+		StartDate:  startDate,
+		EndDate:    endDate,
+		WindowDays: window,
+		Points:     []TrendPoint{},
+	}
+	for i, day := range days {
+		if day.Before(startDate) {
+			continue
+		}
+		windowStart := day.AddDate(0, 0, -(window - 1))
+		var sum float64
+		var n int
+		for j := i; j >= 0 && !days[j].Before(windowStart); j-- {
+			sum += totals[days[j]].sum / float64(totals[days[j]].count)
+			n++
+		}
+		trend.Points = append(trend.Points, TrendPoint{
+			Date:          day,
+			Value:         totals[day].sum / float64(totals[day].count),
+			MovingAverage: sum / float64(n),
+		})
+	}
+
+	if len(trend.Points) >= 2 {
+		change := trend.Points[len(trend.Points)-1].MovingAverage - trend.Points[0].MovingAverage
+		trend.Change = &change
+	}
+	return trend, nil
+}
+
+// calendarDay returns t's calendar day (in t's location) as midnight UTC.
+func calendarDay(t time.Time) time.Time {
+	return time.Date(t.Year(), t.Month(), t.Day(), 0, 0, 0, 0, time.UTC)
+}
+
+// inLocation returns the instant the calendar day starts in loc.
+func inLocation(day time.Time, loc *time.Location) time.Time {
+	return time.Date(day.Year(), day.Month(), day.Day(), 0, 0, 0, 0, loc)
+}
@@ -0,0 +1,98 @@
+package measurements_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	domainerrors "github.com/kinetria/kinetria-back/internal/kinetria/domain/errors"
+	"github.com/kinetria/kinetria-back/internal/kinetria/domain/measurements"
+	"github.com/kinetria/kinetria-back/internal/kinetria/domain/vos"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestGetMeasurementTrendUC_Execute(t *testing.T) {
+	userID := uuid.New()
+	day := func(d int) time.Time { return time.Date(2026, 3, d, 0, 0, 0, 0, time.UTC) }
+	ptr := func(t time.Time) *time.Time { return &t }
+
+	t.Run("daily_mean_and_moving_average", func(t *testing.T) {
+		repo := newMockMeasurementRepo(
+			weighIn(userID, day(1).Add(8*time.Hour), 80000),
+			// Duas pesagens no mesmo dia viram a média do dia
+			weighIn(userID, day(3).Add(8*time.Hour), 79000),
+			weighIn(userID, day(3).Add(20*time.Hour), 80000),
+			weighIn(userID, day(10).Add(8*time.Hour), 78000),
+		)
+		uc := measurements.NewGetMeasurementTrendUC(repo, newMockUserRepo("UTC"))
+
+		trend, err := uc.Execute(context.Background(), measurements.GetMeasurementTrendInput{
+			UserID:     userID,
+			Metric:     vos.BodyMetricWeight,
+			StartDate:  ptr(day(1)),
+			EndDate:    ptr(day(10)),
+			WindowDays: 7,
+		})
+		require.NoError(t, err)
+		require.Len(t, trend.Points, 3)
+
+		assert.Equal(t, day(3), trend.Points[1].Date)
+		assert.Equal(t, 79500.0, trend.Points[1].Value)
+		assert.Equal(t, 79750.0, trend.Points[1].MovingAverage)
+		// Day 10's window (4–10) excludes days 1 and 3
+		assert.Equal(t, 78000.0, trend.Points[2].MovingAverage)
+		require.NotNil(t, trend.Change)
+		assert.Equal(t, -2000.0, *trend.Change)
+	})
+
+	t.Run("days_follow_user_timezone", func(t *testing.T) {
+		// 01:00 UTC on the 5th is still the 4th in São Paulo
+		repo := newMockMeasurementRepo(weighIn(userID, day(5).Add(time.Hour), 80000))
+		uc := measurements.NewGetMeasurementTrendUC(repo, newMockUserRepo("America/Sao_Paulo"))
+
+		trend, err := uc.Execute(context.Background(), measurements.GetMeasurementTrendInput{
+			UserID:    userID,
+			Metric:    vos.BodyMetricWeight,
+			StartDate: ptr(day(1)),
+			EndDate:   ptr(day(10)),
+		})
+		require.NoError(t, err)
+		require.Len(t, trend.Points, 1)
+		assert.Equal(t, day(4), trend.Points[0].Date)
+		assert.Nil(t, trend.Change)
+	})
+
+	t.Run("circumference_metric_skips_entries_without_it", func(t *testing.T) {
+		withWaist := weighIn(userID, day(2), 80000)
+		withWaist.Circumferences[vos.BodySiteWaist] = 850
+		repo := newMockMeasurementRepo(withWaist, weighIn(userID, day(3), 80000))
+		uc := measurements.NewGetMeasurementTrendUC(repo, newMockUserRepo("UTC"))
+
+		trend, err := uc.Execute(context.Background(), measurements.GetMeasurementTrendInput{
+			UserID:    userID,
+			Metric:    vos.BodyMetric(vos.BodySiteWaist),
+			StartDate: ptr(day(1)),
+			EndDate:   ptr(day(10)),
+		})
+		require.NoError(t, err)
+		require.Len(t, trend.Points, 1)
+		assert.Equal(t, 850.0, trend.Points[0].Value)
+	})
+
+	t.Run("invalid_parameters", func(t *testing.T) {
+		uc := measurements.NewGetMeasurementTrendUC(newMockMeasurementRepo(), newMockUserRepo("UTC"))
+
+		_, err := uc.Execute(context.Background(), measurements.GetMeasurementTrendInput{UserID: userID, Metric: "height"})
+		assert.ErrorIs(t, err, domainerrors.ErrMalformedParameters)
+
+		_, err = uc.Execute(context.Background(), measurements.GetMeasurementTrendInput{UserID: userID, Metric: vos.BodyMetricWeight, WindowDays: 91})
+		assert.ErrorIs(t, err, domainerrors.ErrMalformedParameters)
+
+		_, err = uc.Execute(context.Background(), measurements.GetMeasurementTrendInput{
+			UserID: userID, Metric: vos.BodyMetricWeight, StartDate: ptr(day(10)), EndDate: ptr(day(1)),
+		})
+		assert.ErrorIs(t, err, domainerrors.ErrInvalidPeriod)
+	})
+}
@@ -0,0 +1,67 @@
+package measurements
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"time"
+
+	domainerrors "github.com/kinetria/kinetria-back/internal/kinetria/domain/errors"
+	"github.com/kinetria/kinetria-back/internal/kinetria/domain/ports"
+)
+
+// openPeriodStart/openPeriodEnd bound the period when the caller leaves a side open.
+var (
+	openPeriodStart = time.Date(1900, 1, 1, 0, 0, 0, 0, time.UTC)
+	openPeriodEnd   = time.Date(9999, 12, 31, 0, 0, 0, 0, time.UTC)
+)
+
+// ListMeasurementsUC returns the user's measurement log.
+type ListMeasurementsUC struct {
+	repo ports.BodyMeasurementRepository
+}
+
+// NewListMeasurementsUC creates a new ListMeasurementsUC.
+func NewListMeasurementsUC(repo ports.BodyMeasurementRepository) *ListMeasurementsUC {
+	return &ListMeasurementsUC{repo: repo}
+}
+
+// Execute returns a page of measurements (default 20, max 100 per page), most recent first.
+// EndDate is inclusive of its whole day.
+func (uc *ListMeasurementsUC) Execute(ctx context.Context, input ListMeasurementsInput) (ListMeasurementsOutput, error) {
+	page := input.Page
+	if page <= 0 {
+		page = 1
+	}
+	pageSize := input.PageSize
+	if pageSize <= 0 {
+		pageSize = 20
+	}
+	if pageSize > 100 {
+		return ListMeasurementsOutput{}, fmt.Errorf("%w: pageSize must be between 1 and 100", domainerrors.ErrMalformedParameters)
+	}
+
+	start, end := openPeriodStart, openPeriodEnd
+	if input.StartDate != nil {
+		start = *input.StartDate
+	}
+	if input.EndDate != nil {
+		end = input.EndDate.AddDate(0, 0, 1).Add(-time.Nanosecond)
+	}
+	if start.After(end) {
+		return ListMeasurementsOutput{}, domainerrors.ErrInvalidPeriod
+	}
+
+	items, total, err := uc.repo.ListByUser(ctx, input.UserID, start, end, pageSize, (page-1)*pageSize)
+	if err != nil {
+		return ListMeasurementsOutput{}, fmt.Errorf("failed to list measurements: %w", err)
+	}
+
+	return ListMeasurementsOutput{
+		Measurements: items,
+		Total:        total,
+		Page:         page,
+		PageSize:     pageSize,
+		TotalPages:   int(math.Ceil(float64(total) / float64(pageSize))),
+	}, nil
+}
@@ -0,0 +1,61 @@
+package measurements
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/kinetria/kinetria-back/internal/kinetria/domain/entities"
+	domainerrors "github.com/kinetria/kinetria-back/internal/kinetria/domain/errors"
+	"github.com/kinetria/kinetria-back/internal/kinetria/domain/ports"
+	"github.com/kinetria/kinetria-back/internal/kinetria/domain/profile"
+)
+
+// SetGoalWeightUC sets (or replaces) the user's goal weight.
+type SetGoalWeightUC struct {
+	repo           ports.BodyMeasurementRepository
+	bodyWeightRepo ports.BodyWeightRepository
+	userRepo       ports.UserRepository
+}
+
+// NewSetGoalWeightUC creates a new SetGoalWeightUC.
+func NewSetGoalWeightUC(repo ports.BodyMeasurementRepository, bodyWeightRepo ports.BodyWeightRepository, userRepo ports.UserRepository) *SetGoalWeightUC {
+	return &SetGoalWeightUC{repo: repo, bodyWeightRepo: bodyWeightRepo, userRepo: userRepo}
+}
+
+// Execute stores the goal, using the latest logged weight as the starting point
+// progress is measured from. A target date, when given, must not be before today in the
+// user's timezone.
+func (uc *SetGoalWeightUC) Execute(ctx context.Context, input SetGoalWeightInput) (*entities.BodyWeightGoal, error) {
+	if err := validateWeight(input.TargetGrams); err != nil {
+		return nil, err
+	}
+	now := time.Now().UTC()
+	if input.TargetDate != nil {
+		prefs, err := profile.LoadPreferences(ctx, uc.userRepo, input.UserID)
+		if err != nil {
+			return nil, err
+		}
+		if calendarDay(*input.TargetDate).Before(calendarDay(now.In(prefs.Location()))) {
+			return nil, fmt.Errorf("%w: targetDate must not be in the past", domainerrors.ErrMalformedParameters)
+		}
+	}
+
+	start, err := uc.bodyWeightRepo.GetLatestBodyWeight(ctx, input.UserID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get latest body weight: %w", err)
+	}
+
+	goal := &entities.BodyWeightGoal{
+		UserID:      input.UserID,
+		TargetGrams: input.TargetGrams,
+		StartGrams:  start,
+		TargetDate:  input.TargetDate,
+		CreatedAt:   now,
+		UpdatedAt:   now,
+	}
+	if err := uc.repo.UpsertGoalWeight(ctx, goal); err != nil {
+		return nil, fmt.Errorf("failed to set goal weight: %w", err)
+	}
+	return goal, nil
+}
@@ -0,0 +1,86 @@
+package measurements
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/kinetria/kinetria-back/internal/kinetria/domain/entities"
+	domainerrors "github.com/kinetria/kinetria-back/internal/kinetria/domain/errors"
+	"github.com/kinetria/kinetria-back/internal/kinetria/domain/ports"
+	"github.com/kinetria/kinetria-back/internal/kinetria/domain/vos"
+)
+
+// UpdateMeasurementUC partially updates one of the user's measurements.
+type UpdateMeasurementUC struct {
+	repo  ports.BodyMeasurementRepository
+	goals ports.GoalEvaluator
+}
+
+// NewUpdateMeasurementUC creates a new UpdateMeasurementUC.
+// goals may be nil, in which case body weight goals are not evaluated.
+func NewUpdateMeasurementUC(repo ports.BodyMeasurementRepository, goals ports.GoalEvaluator) *UpdateMeasurementUC {
+	return &UpdateMeasurementUC{repo: repo, goals: goals}
+}
+
+// Execute applies the non-nil fields of input. The entry must still record at least one value.
+func (uc *UpdateMeasurementUC) Execute(ctx context.Context, input UpdateMeasurementInput) (*entities.BodyMeasurement, error) {
+	if err := validateValues(&input.Values, true); err != nil {
+		return nil, err
+	}
+	if input.Notes != nil {
+		if err := validateNotes(*input.Notes); err != nil {
+			return nil, err
+		}
+	}
+	if input.MeasuredAt != nil {
+		if err := validateMeasuredAt(*input.MeasuredAt); err != nil {
+			return nil, err
+		}
+	}
+
+	m, err := uc.repo.GetByID(ctx, input.UserID, input.ID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get measurement: %w", err)
+	}
+	if m == nil {
+		return nil, domainerrors.ErrNotFound
+	}
+
+	if input.MeasuredAt != nil {
+		m.MeasuredAt = input.MeasuredAt.UTC()
+	}
+	if input.Values.WeightGrams != nil {
+		m.WeightGrams = input.Values.WeightGrams
+	}
+	if input.Values.BodyFatPercent != nil {
+		m.BodyFatPercent = input.Values.BodyFatPercent
+	}
+	for site, mm := range input.Values.Circumferences {
+		if m.Circumferences == nil {
+			m.Circumferences = make(map[vos.BodySite]int)
+		}
+		if mm == 0 {
+			delete(m.Circumferences, site)
+			continue
+		}
+		m.Circumferences[site] = mm
+	}
+	if input.Notes != nil {
+		m.Notes = *input.Notes
+	}
+	if !hasAnyValue(m) {
+		return nil, fmt.Errorf("%w: at least one measurement value must remain", domainerrors.ErrMalformedParameters)
+	}
+	m.UpdatedAt = time.Now().UTC()
+
+	if err := uc.repo.Update(ctx, m); err != nil {
+		return nil, fmt.Errorf("failed to update measurement: %w", err)
+	}
+
+	// Uma pesagem corrigida pode alcançar uma meta de peso corporal (best-effort)
+	if m.WeightGrams != nil && uc.goals != nil {
+		_, _ = uc.goals.Execute(ctx, input.UserID)
+	}
+	return m, nil
+}
@@ -0,0 +1,107 @@
+package measurements
+
+import (
+	"fmt"
+	"math"
+	"time"
+
+	"github.com/kinetria/kinetria-back/internal/kinetria/domain/entities"
+	domainerrors "github.com/kinetria/kinetria-back/internal/kinetria/domain/errors"
+	"github.com/kinetria/kinetria-back/internal/kinetria/domain/vos"
+)
+
+const (
+	minWeightGrams      = 20000
+	maxWeightGrams      = 400000
+	minBodyFatPercent   = 2.0
+	maxBodyFatPercent   = 75.0
+	minCircumferenceMM  = 100
+	maxCircumferenceMM  = 3000
+	maxNotesLength      = 500
+	futureMeasuredAtTol = 5 * time.Minute
+)
+
+func validateWeight(grams int) error {
+	if grams < minWeightGrams || grams > maxWeightGrams {
+		return fmt.Errorf("%w: weight must be between 20 and 400 kg", domainerrors.ErrMalformedParameters)
+	}
+	return nil
+}
+
+// validateValues checks the provided values; removals (circumference 0) are allowed
+// only when allowRemoval is set. Body fat is rounded to one decimal in place.
+func validateValues(v *MeasurementValues, allowRemoval bool) error {
+	if v.WeightGrams != nil {
+		if err := validateWeight(*v.WeightGrams); err != nil {
+			return err
+		}
+	}
+	if v.BodyFatPercent != nil {
+		if *v.BodyFatPercent < minBodyFatPercent || *v.BodyFatPercent > maxBodyFatPercent {
+			return fmt.Errorf("%w: bodyFat must be between 2 and 75 percent", domainerrors.ErrMalformedParameters)
+		}
+		rounded := math.Round(*v.BodyFatPercent*10) / 10
+		v.BodyFatPercent = &rounded
+	}
+	for site, mm := range v.Circumferences {
+		if err := site.Validate(); err != nil {
+			return err
+		}
+		if mm == 0 && allowRemoval {
+			continue
+		}
+		if mm < minCircumferenceMM || mm > maxCircumferenceMM {
+			return fmt.Errorf("%w: %s must be between 10 and 300 cm", domainerrors.ErrMalformedParameters, site)
+		}
+	}
+	return nil
+}
+
+func validateMeasuredAt(t time.Time) error {
+	if t.After(time.Now().Add(futureMeasuredAtTol)) {
+		return fmt.Errorf("%w: measuredAt must not be in the future", domainerrors.ErrMalformedParameters)
+	}
+	return nil
+}
+
+func validateNotes(notes string) error {
+	if len(notes) > maxNotesLength {
+		return fmt.Errorf("%w: notes must be at most 500 characters", domainerrors.ErrMalformedParameters)
+	}
+	return nil
+}
+
+// hasAnyValue reports whether m records at least one measurement.
+func hasAnyValue(m *entities.BodyMeasurement) bool {
+	if m.WeightGrams != nil || m.BodyFatPercent != nil {
+		return true
+	}
+	for _, mm := range m.Circumferences {
+		if mm > 0 {
+			return true
+		}
+	}
+	return false
+}
+
+// metricValue returns the canonical value of metric in m, if recorded.
+func metricValue(m entities.BodyMeasurement, metric vos.BodyMetric) (float64, bool) {
+	switch metric {
+	case vos.BodyMetricWeight:
+		if m.WeightGrams != nil {
+			return float64(*m.WeightGrams), true
+		}
+		return 0, false
+	case vos.BodyMetricBodyFat:
+		if m.BodyFatPercent != nil {
+			return *m.BodyFatPercent, true
+		}
+		return 0, false
+	}
+	if site, ok := metric.Site(); ok {
+		if mm, ok := m.Circumferences[site]; ok && mm > 0 {
+			return float64(mm), true
+		}
+	}
+	return 0, false
+}
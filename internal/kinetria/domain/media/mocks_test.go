@@ -0,0 +1,116 @@
+package media_test
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+	"github.com/kinetria/kinetria-back/internal/kinetria/domain/entities"
+	"github.com/kinetria/kinetria-back/internal/kinetria/domain/ports"
+)
+
+// mockStorage is an in-memory ports.MediaStorage.
+type mockStorage struct {
+	objects map[string][]byte
+	putErr  error
+}
+
+func newMockStorage() *mockStorage {
+	return &mockStorage{objects: map[string][]byte{}}
+}
+
+func (m *mockStorage) Put(_ context.Context, key, _ string, data []byte) (string, error) {
+	if m.putErr != nil {
+		return "", m.putErr
+	}
+	m.objects[key] = data
+	return "https://cdn.test/" + key, nil
+}
+
+func (m *mockStorage) Delete(_ context.Context, key string) error {
+	delete(m.objects, key)
+	return nil
+}
+
+// mockMediaRepo implements ports.MediaRepository.
+type mockMediaRepo struct {
+	created   *entities.MediaAsset
+	createErr error
+}
+
+func (m *mockMediaRepo) Create(_ context.Context, asset *entities.MediaAsset) error {
+	if m.createErr != nil {
+		return m.createErr
+	}
+	m.created = asset
+	return nil
+}
+
+// mockExerciseRepo implements ports.ExerciseRepository.
+type mockExerciseRepo struct {
+	exercise *entities.Exercise
+}
+
+func (m *mockExerciseRepo) ExistsByIDAndWorkoutID(_ context.Context, _, _ uuid.UUID) (bool, error) {
+	return false, nil
+}
+
+func (m *mockExerciseRepo) FindWorkoutExerciseID(_ context.Context, _, _ uuid.UUID) (uuid.UUID, error) {
+	return uuid.Nil, nil
+}
+
+func (m *mockExerciseRepo) List(_ context.Context, _ ports.ExerciseFilters, _, _ int) ([]*entities.Exercise, int, error) {
+	return nil, 0, nil
+}
+
+func (m *mockExerciseRepo) GetByID(_ context.Context, _ uuid.UUID) (*entities.Exercise, error) {
+	return m.exercise, nil
+}
+
+func (m *mockExerciseRepo) GetUserStats(_ context.Context, _, _ uuid.UUID) (*ports.ExerciseUserStats, error) {
+	return nil, nil
+}
+
+func (m *mockExerciseRepo) GetHistory(_ context.Context, _, _ uuid.UUID, _, _ int) ([]*ports.ExerciseHistoryEntry, int, error) {
+	return nil, 0, nil
+}
+
+// mockWorkoutRepo implements ports.WorkoutRepository.
+type mockWorkoutRepo struct {
+	workout *entities.Workout
+}
+
+func (m *mockWorkoutRepo) ExistsByIDAndUserID(_ context.Context, _, _ uuid.UUID) (bool, error) {
+	return false, nil
+}
+
+func (m *mockWorkoutRepo) ListByUserID(_ context.Context, _ uuid.UUID, _ bool, _, _ int) ([]entities.Workout, int, error) {
+	return nil, 0, nil
+}
+
+func (m *mockWorkoutRepo) GetFirstByUserID(_ context.Context, _ uuid.UUID) (*entities.Workout, error) {
+	return nil, nil
+}
+
+func (m *mockWorkoutRepo) GetByID(_ context.Context, _, _ uuid.UUID) (*entities.Workout, []entities.Exercise, error) {
+	return nil, nil, nil
+}
+
+func (m *mockWorkoutRepo) GetByIDOnly(_ context.Context, _ uuid.UUID) (*entities.Workout, error) {
+	return m.workout, nil
+}
+
+func (m *mockWorkoutRepo) Create(_ context.Context, _ entities.Workout, _ []entities.WorkoutExercise) error {
+	return nil
+}
+
+func (m *mockWorkoutRepo) Update(_ context.Context, _ entities.Workout, _ []entities.WorkoutExercise) error {
+	return nil
+}
+
+func (m *mockWorkoutRepo) Delete(_ context.Context, _ uuid.UUID) error {
+	return nil
+}
+
+func (m *mockWorkoutRepo) HasActiveSessions(_ context.Context, _ uuid.UUID) (bool, error) {
+	return false, nil
+}
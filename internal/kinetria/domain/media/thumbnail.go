@@ -0,0 +1,114 @@
+package media
+
+import (
+	"bytes"
+	"fmt"
+	"image"
+	"image/color"
+	"image/draw"
+	_ "image/gif" // registra decoder GIF
+	"image/jpeg"
+	_ "image/png" // registra decoder PNG
+
+	domerrors "github.com/kinetria/kinetria-back/internal/kinetria/domain/errors"
+)
+
+// thumbnailMaxSize is the longest side, in pixels, of generated thumbnails.
+const thumbnailMaxSize = 320
+
+// thumbnailJPEGQuality is the JPEG quality used to encode thumbnails.
+const thumbnailJPEGQuality = 85
+
+// Limits on the dimensions declared by an uploaded image. Decoding allocates memory for
+// every pixel, so a small, highly compressed file can declare a size that exhausts it.
+const (
+	maxImageSide   = 8192
+	maxImagePixels = 40_000_000
+)
+
+// makeThumbnail decodes an image and returns a JPEG copy scaled to fit within
+// maxSize×maxSize, preserving the aspect ratio. Images that already fit are only re-encoded.
+// Transparent areas are flattened onto white. Images larger than maxImageSide or
+// maxImagePixels are rejected with ErrMediaTooLarge before being decoded.
+func makeThumbnail(data []byte, maxSize int) ([]byte, error) {
+	cfg, _, err := image.DecodeConfig(bytes.NewReader(data))
+	if err != nil {
+		return nil, fmt.Errorf("%w: cannot decode image: %v", domerrors.ErrUnsupportedMediaType, err)
+	}
+	if cfg.Width > maxImageSide || cfg.Height > maxImageSide || cfg.Width*cfg.Height > maxImagePixels {
+		return nil, fmt.Errorf("%w: image is %dx%d, the limit is %dx%d and %d pixels",
+			domerrors.ErrMediaTooLarge, cfg.Width, cfg.Height, maxImageSide, maxImageSide, maxImagePixels)
+	}
+
+	src, _, err := image.Decode(bytes.NewReader(data))
+	if err != nil {
+		return nil, fmt.Errorf("%w: cannot decode image: %v", domerrors.ErrUnsupportedMediaType, err)
+	}
+
+	b := src.Bounds()
+	w, h := b.Dx(), b.Dy()
+	if w == 0 || h == 0 {
+		return nil, fmt.Errorf("%w: empty image", domerrors.ErrUnsupportedMediaType)
+	}
+
+	dstW, dstH := w, h
+	if w > maxSize || h > maxSize {
+		if w >= h {
+			dstW, dstH = maxSize, max(1, h*maxSize/w)
+		} else {
+			dstW, dstH = max(1, w*maxSize/h), maxSize
+		}
+	}
+
+	// Flatten onto white so transparent PNG/GIF pixels do not turn black in JPEG
+	flat := image.NewRGBA(image.Rect(0, 0, w, h))
+	draw.Draw(flat, flat.Bounds(), &image.Uniform{C: color.White}, image.Point{}, draw.Src)
+	draw.Draw(flat, flat.Bounds(), src, b.Min, draw.Over)
+
+	dst := resizeBox(flat, dstW, dstH)
+
+	var buf bytes.Buffer
+	if err := jpeg.Encode(&buf, dst, &jpeg.Options{Quality: thumbnailJPEGQuality}); err != nil {
+		return nil, fmt.Errorf("encode thumbnail: %w", err)
+	}
+	return buf.Bytes(), nil
+}
+
+// resizeBox downsamples src to dstW×dstH by averaging every source pixel that
+// falls into each destination pixel (box filter).
+func resizeBox(src *image.RGBA, dstW, dstH int) *image.RGBA {
+	sw, sh := src.Bounds().Dx(), src.Bounds().Dy()
+	dst := image.NewRGBA(image.Rect(0, 0, dstW, dstH))
+	if sw == dstW && sh == dstH {
+		copy(dst.Pix, src.Pix)
+		return dst
+	}
+
+	for y := 0; y < dstH; y++ {
+		y0 := y * sh / dstH
+		y1 := max(y0+1, (y+1)*sh/dstH)
+		for x := 0; x < dstW; x++ {
+			x0 := x * sw / dstW
+			x1 := max(x0+1, (x+1)*sw/dstW)
+
+			var r, g, bl, a, n uint32
+			for sy := y0; sy < y1; sy++ {
+				off := sy*src.Stride + x0*4
+				for sx := x0; sx < x1; sx++ {
+					r += uint32(src.Pix[off])
+					g += uint32(src.Pix[off+1])
+					bl += uint32(src.Pix[off+2])
+					a += uint32(src.Pix[off+3])
+					off += 4
+					n++
+				}
+			}
+			i := y*dst.Stride + x*4
+			dst.Pix[i] = uint8(r / n)
+			dst.Pix[i+1] = uint8(g / n)
+			dst.Pix[i+2] = uint8(bl / n)
+			dst.Pix[i+3] = uint8(a / n)
+		}
+	}
+	return dst
+}
@@ -0,0 +1,214 @@
+package media
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/kinetria/kinetria-back/internal/kinetria/domain/entities"
+	domerrors "github.com/kinetria/kinetria-back/internal/kinetria/domain/errors"
+	"github.com/kinetria/kinetria-back/internal/kinetria/domain/ports"
+	"github.com/kinetria/kinetria-back/internal/kinetria/domain/vos"
+)
+
+// imageContentTypes maps accepted image content types to the stored file extension.
+var imageContentTypes = map[string]string{
+	"image/jpeg": ".jpg",
+	"image/png":  ".png",
+	"image/gif":  ".gif",
+}
+
+// videoContentTypes maps accepted video content types to the stored file extension.
+var videoContentTypes = map[string]string{
+	"video/mp4":  ".mp4",
+	"video/webm": ".webm",
+}
+
+// UploadLimits holds the maximum accepted size, in bytes, for each media kind.
+type UploadLimits struct {
+	MaxImageBytes int64
+	MaxVideoBytes int64
+}
+
+// UploadMediaInput holds the input for UploadMediaUC.
+// ContentType must be the sniffed type of Data, not the one declared by the client.
+type UploadMediaInput struct {
+	UserID      uuid.UUID
+	OwnerType   vos.MediaOwnerType
+	OwnerID     uuid.UUID
+	Kind        vos.MediaKind
+	ContentType string
+	Data        []byte
+	// Admin is whether the request carries the admin key. Library exercises are shared by
+	// every user, so only administrators may change their media.
+	Admin bool
+}
+
+// UploadMediaOutput holds the stored asset.
+type UploadMediaOutput struct {
+	Asset entities.MediaAsset
+}
+
+// UploadMediaUC validates an uploaded file, stores it (plus a thumbnail for images)
+// and attaches the resulting URL to the owning exercise, workout or user.
+type UploadMediaUC struct {
+	storage      ports.MediaStorage
+	mediaRepo    ports.MediaRepository
+	exerciseRepo ports.ExerciseRepository
+	workoutRepo  ports.WorkoutRepository
+	limits       UploadLimits
+}
+
+// NewUploadMediaUC creates a new UploadMediaUC.
+func NewUploadMediaUC(
+	storage ports.MediaStorage,
+	mediaRepo ports.MediaRepository,
+	exerciseRepo ports.ExerciseRepository,
+	workoutRepo ports.WorkoutRepository,
+	limits UploadLimits,
+) *UploadMediaUC {
+	return &UploadMediaUC{
+		storage:      storage,
+		mediaRepo:    mediaRepo,
+		exerciseRepo: exerciseRepo,
+		workoutRepo:  workoutRepo,
+		limits:       limits,
+	}
+}
+
+// Execute stores the file and writes its URL back to the owner.
+// Exercises accept images (thumbnail) and videos, uploaded by administrators only;
+// workouts and users accept images only.
+func (uc *UploadMediaUC) Execute(ctx context.Context, input UploadMediaInput) (UploadMediaOutput, error) {
+	if err := input.OwnerType.Validate(); err != nil {
+		return UploadMediaOutput{}, err
+	}
+	if err := input.Kind.Validate(); err != nil {
+		return UploadMediaOutput{}, err
+	}
+	if input.OwnerID == uuid.Nil {
+		return UploadMediaOutput{}, fmt.Errorf("ownerID is required: %w", domerrors.ErrMalformedParameters)
+	}
+	if input.Kind == vos.MediaKindVideo && input.OwnerType != vos.MediaOwnerExercise {
+		return UploadMediaOutput{}, fmt.Errorf("%w: videos can only be attached to exercises", domerrors.ErrUnsupportedMediaType)
+	}
+
+	ext, err := uc.validateFile(input)
+	if err != nil {
+		return UploadMediaOutput{}, err
+	}
+
+	if err := uc.checkOwner(ctx, input); err != nil {
+		return UploadMediaOutput{}, err
+	}
+
+	asset := entities.MediaAsset{
+		ID:          uuid.New(),
+		UploadedBy:  input.UserID,
+		OwnerType:   input.OwnerType,
+		OwnerID:     input.OwnerID,
+		Kind:        input.Kind,
+		ContentType: input.ContentType,
+		SizeBytes:   int64(len(input.Data)),
+		CreatedAt:   time.Now(),
+	}
+	prefix := fmt.Sprintf("%ss/%s/%s", input.OwnerType, input.OwnerID, asset.ID)
+	asset.StorageKey = prefix + ext
+
+	var thumbnail []byte
+	if input.Kind == vos.MediaKindImage {
+		thumbnail, err = makeThumbnail(input.Data, thumbnailMaxSize)
+		if err != nil {
+			return UploadMediaOutput{}, err
+		}
+		asset.ThumbnailKey = prefix + "_thumb.jpg"
+	}
+
+	asset.URL, err = uc.storage.Put(ctx, asset.StorageKey, asset.ContentType, input.Data)
+	if err != nil {
+		return UploadMediaOutput{}, fmt.Errorf("failed to store media: %w", err)
+	}
+	if thumbnail != nil {
+		asset.ThumbnailURL, err = uc.storage.Put(ctx, asset.ThumbnailKey, "image/jpeg", thumbnail)
+		if err != nil {
+			_ = uc.storage.Delete(ctx, asset.StorageKey)
+			return UploadMediaOutput{}, fmt.Errorf("failed to store thumbnail: %w", err)
+		}
+	}
+
+	if err := uc.mediaRepo.Create(ctx, &asset); err != nil {
+		// Best effort: do not leave orphan objects behind
+		_ = uc.storage.Delete(ctx, asset.StorageKey)
+		if asset.ThumbnailKey != "" {
+			_ = uc.storage.Delete(ctx, asset.ThumbnailKey)
+		}
+		return UploadMediaOutput{}, fmt.Errorf("failed to save media asset: %w", err)
+	}
+
+	return UploadMediaOutput{Asset: asset}, nil
+}
+
+// validateFile checks content type and size against the limits for the media kind
+// and returns the file extension to store it with.
+func (uc *UploadMediaUC) validateFile(input UploadMediaInput) (string, error) {
+	if len(input.Data) == 0 {
+		return "", fmt.Errorf("file is empty: %w", domerrors.ErrMalformedParameters)
+	}
+
+	allowed, limit := imageContentTypes, uc.limits.MaxImageBytes
+	if input.Kind == vos.MediaKindVideo {
+		allowed, limit = videoContentTypes, uc.limits.MaxVideoBytes
+	}
+
+	ext, ok := allowed[input.ContentType]
+	if !ok {
+		return "", fmt.Errorf("%w: %q is not an accepted %s type", domerrors.ErrUnsupportedMediaType, input.ContentType, input.Kind)
+	}
+	if int64(len(input.Data)) > limit {
+		return "", fmt.Errorf("%w: %d bytes exceeds the %d byte limit", domerrors.ErrMediaTooLarge, len(input.Data), limit)
+	}
+	return ext, nil
+}
+
+// checkOwner verifies that the owner exists and the user may attach media to it.
+func (uc *UploadMediaUC) checkOwner(ctx context.Context, input UploadMediaInput) error {
+	switch input.OwnerType {
+	case vos.MediaOwnerUser:
+		if input.OwnerID != input.UserID {
+			return domerrors.ErrForbidden
+		}
+	case vos.MediaOwnerWorkout:
+		workout, err := uc.workoutRepo.GetByIDOnly(ctx, input.OwnerID)
+		if err != nil {
+			return fmt.Errorf("failed to get workout: %w", err)
+		}
+		if workout == nil {
+			return domerrors.ErrWorkoutNotFound
+		}
+		if workout.CreatedBy == nil {
+			return fmt.Errorf("%w: cannot change template workout images", domerrors.ErrCannotModifyTemplate)
+		}
+		if *workout.CreatedBy != input.UserID {
+			return domerrors.ErrForbidden
+		}
+	case vos.MediaOwnerExercise:
+		exercise, err := uc.exerciseRepo.GetByID(ctx, input.OwnerID)
+		if err != nil {
+			return fmt.Errorf("failed to get exercise: %w", err)
+		}
+		if exercise == nil {
+			return domerrors.ErrExerciseNotFound
+		}
+		if !input.Admin {
+			return fmt.Errorf("%w: only administrators can change library exercise media", domerrors.ErrForbidden)
+		}
+	}
+	return nil
+}
+
+// MaxUploadBytes returns the largest file size accepted for any media kind.
+// HTTP handlers use it to cap request bodies before parsing.
+func (uc *UploadMediaUC) MaxUploadBytes() int64 {
+	return max(uc.limits.MaxImageBytes, uc.limits.MaxVideoBytes)
+}
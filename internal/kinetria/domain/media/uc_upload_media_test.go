@@ -0,0 +1,228 @@
+package media_test
+
+import (
+	"bytes"
+	"context"
+	"encoding/binary"
+	"errors"
+	"hash/crc32"
+	"image"
+	"image/color"
+	"image/jpeg"
+	"image/png"
+	"strings"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/kinetria/kinetria-back/internal/kinetria/domain/entities"
+	domerrors "github.com/kinetria/kinetria-back/internal/kinetria/domain/errors"
+	"github.com/kinetria/kinetria-back/internal/kinetria/domain/media"
+	"github.com/kinetria/kinetria-back/internal/kinetria/domain/vos"
+)
+
+func pngBytes(t *testing.T, w, h int) []byte {
+	t.Helper()
+	img := image.NewRGBA(image.Rect(0, 0, w, h))
+	for y := 0; y < h; y++ {
+		for x := 0; x < w; x++ {
+			img.Set(x, y, color.RGBA{R: uint8(x), G: uint8(y), B: 128, A: 255})
+		}
+	}
+	var buf bytes.Buffer
+	if err := png.Encode(&buf, img); err != nil {
+		t.Fatalf("encode png: %v", err)
+	}
+	return buf.Bytes()
+}
+
+// pngHeader returns the signature and IHDR chunk of a PNG declaring a w×h image, without
+// any pixel data.
+func pngHeader(w, h uint32) []byte {
+	ihdr := make([]byte, 13)
+	binary.BigEndian.PutUint32(ihdr[0:], w)
+	binary.BigEndian.PutUint32(ihdr[4:], h)
+	ihdr[8], ihdr[9] = 8, 6 // 8 bits, RGBA
+
+	var buf bytes.Buffer
+	buf.WriteString("\x89PNG\r\n\x1a\n")
+	_ = binary.Write(&buf, binary.BigEndian, uint32(len(ihdr)))
+	chunk := append([]byte("IHDR"), ihdr...)
+	buf.Write(chunk)
+	_ = binary.Write(&buf, binary.BigEndian, crc32.ChecksumIEEE(chunk))
+	return buf.Bytes()
+}
+
+func TestUploadMediaUC_Execute(t *testing.T) {
+	userID := uuid.New()
+	otherUserID := uuid.New()
+	workoutID := uuid.New()
+	exerciseID := uuid.New()
+	limits := media.UploadLimits{MaxImageBytes: 1 << 20, MaxVideoBytes: 4 << 20}
+	img := pngBytes(t, 800, 400)
+
+	tests := []struct {
+		name          string
+		input         media.UploadMediaInput
+		workout       *entities.Workout
+		exercise      *entities.Exercise
+		expectedError error
+	}{
+		{
+			name:  "success - profile image",
+			input: media.UploadMediaInput{UserID: userID, OwnerType: vos.MediaOwnerUser, OwnerID: userID, Kind: vos.MediaKindImage, ContentType: "image/png", Data: img},
+		},
+		{
+			name:    "success - own workout image",
+			input:   media.UploadMediaInput{UserID: userID, OwnerType: vos.MediaOwnerWorkout, OwnerID: workoutID, Kind: vos.MediaKindImage, ContentType: "image/png", Data: img},
+			workout: &entities.Workout{ID: workoutID, CreatedBy: &userID},
+		},
+		{
+			name:     "success - exercise video",
+			input:    media.UploadMediaInput{UserID: userID, OwnerType: vos.MediaOwnerExercise, OwnerID: exerciseID, Kind: vos.MediaKindVideo, ContentType: "video/mp4", Data: []byte("fake mp4"), Admin: true},
+			exercise: &entities.Exercise{ID: exerciseID},
+		},
+		{
+			name:     "success - exercise thumbnail",
+			input:    media.UploadMediaInput{UserID: userID, OwnerType: vos.MediaOwnerExercise, OwnerID: exerciseID, Kind: vos.MediaKindImage, ContentType: "image/png", Data: img, Admin: true},
+			exercise: &entities.Exercise{ID: exerciseID},
+		},
+		{
+			name:          "error - another user's profile",
+			input:         media.UploadMediaInput{UserID: userID, OwnerType: vos.MediaOwnerUser, OwnerID: otherUserID, Kind: vos.MediaKindImage, ContentType: "image/png", Data: img},
+			expectedError: domerrors.ErrForbidden,
+		},
+		{
+			name:          "error - another user's workout",
+			input:         media.UploadMediaInput{UserID: userID, OwnerType: vos.MediaOwnerWorkout, OwnerID: workoutID, Kind: vos.MediaKindImage, ContentType: "image/png", Data: img},
+			workout:       &entities.Workout{ID: workoutID, CreatedBy: &otherUserID},
+			expectedError: domerrors.ErrForbidden,
+		},
+		{
+			name:          "error - template workout",
+			input:         media.UploadMediaInput{UserID: userID, OwnerType: vos.MediaOwnerWorkout, OwnerID: workoutID, Kind: vos.MediaKindImage, ContentType: "image/png", Data: img},
+			workout:       &entities.Workout{ID: workoutID},
+			expectedError: domerrors.ErrCannotModifyTemplate,
+		},
+		{
+			name:          "error - workout not found",
+			input:         media.UploadMediaInput{UserID: userID, OwnerType: vos.MediaOwnerWorkout, OwnerID: workoutID, Kind: vos.MediaKindImage, ContentType: "image/png", Data: img},
+			expectedError: domerrors.ErrWorkoutNotFound,
+		},
+		{
+			name:          "error - exercise thumbnail by non-admin",
+			input:         media.UploadMediaInput{UserID: userID, OwnerType: vos.MediaOwnerExercise, OwnerID: exerciseID, Kind: vos.MediaKindImage, ContentType: "image/png", Data: img},
+			exercise:      &entities.Exercise{ID: exerciseID},
+			expectedError: domerrors.ErrForbidden,
+		},
+		{
+			name:          "error - exercise video by non-admin",
+			input:         media.UploadMediaInput{UserID: userID, OwnerType: vos.MediaOwnerExercise, OwnerID: exerciseID, Kind: vos.MediaKindVideo, ContentType: "video/mp4", Data: []byte("fake mp4")},
+			exercise:      &entities.Exercise{ID: exerciseID},
+			expectedError: domerrors.ErrForbidden,
+		},
+		{
+			name:          "error - exercise not found",
+			input:         media.UploadMediaInput{UserID: userID, OwnerType: vos.MediaOwnerExercise, OwnerID: exerciseID, Kind: vos.MediaKindImage, ContentType: "image/png", Data: img, Admin: true},
+			expectedError: domerrors.ErrExerciseNotFound,
+		},
+		{
+			name:          "error - unsupported content type",
+			input:         media.UploadMediaInput{UserID: userID, OwnerType: vos.MediaOwnerUser, OwnerID: userID, Kind: vos.MediaKindImage, ContentType: "application/pdf", Data: []byte("%PDF")},
+			expectedError: domerrors.ErrUnsupportedMediaType,
+		},
+		{
+			name:          "error - video on workout",
+			input:         media.UploadMediaInput{UserID: userID, OwnerType: vos.MediaOwnerWorkout, OwnerID: workoutID, Kind: vos.MediaKindVideo, ContentType: "video/mp4", Data: []byte("fake mp4")},
+			expectedError: domerrors.ErrUnsupportedMediaType,
+		},
+		{
+			name:          "error - image too large",
+			input:         media.UploadMediaInput{UserID: userID, OwnerType: vos.MediaOwnerUser, OwnerID: userID, Kind: vos.MediaKindImage, ContentType: "image/png", Data: make([]byte, limits.MaxImageBytes+1)},
+			expectedError: domerrors.ErrMediaTooLarge,
+		},
+		{
+			name:          "error - image dimensions too large",
+			input:         media.UploadMediaInput{UserID: userID, OwnerType: vos.MediaOwnerUser, OwnerID: userID, Kind: vos.MediaKindImage, ContentType: "image/png", Data: pngHeader(100000, 100000)},
+			expectedError: domerrors.ErrMediaTooLarge,
+		},
+		{
+			name:          "error - corrupt image",
+			input:         media.UploadMediaInput{UserID: userID, OwnerType: vos.MediaOwnerUser, OwnerID: userID, Kind: vos.MediaKindImage, ContentType: "image/png", Data: []byte("not a png")},
+			expectedError: domerrors.ErrUnsupportedMediaType,
+		},
+		{
+			name:          "error - empty file",
+			input:         media.UploadMediaInput{UserID: userID, OwnerType: vos.MediaOwnerUser, OwnerID: userID, Kind: vos.MediaKindImage, ContentType: "image/png"},
+			expectedError: domerrors.ErrMalformedParameters,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			storage := newMockStorage()
+			mediaRepo := &mockMediaRepo{}
+			uc := media.NewUploadMediaUC(storage, mediaRepo, &mockExerciseRepo{exercise: tt.exercise}, &mockWorkoutRepo{workout: tt.workout}, limits)
+
+			out, err := uc.Execute(context.Background(), tt.input)
+
+			if tt.expectedError != nil {
+				if !errors.Is(err, tt.expectedError) {
+					t.Fatalf("expected error %v, got %v", tt.expectedError, err)
+				}
+				if len(storage.objects) != 0 {
+					t.Errorf("expected nothing stored, got %d objects", len(storage.objects))
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if mediaRepo.created == nil {
+				t.Fatal("expected media asset to be saved")
+			}
+			if !strings.HasPrefix(out.Asset.StorageKey, tt.input.OwnerType.String()+"s/"+tt.input.OwnerID.String()+"/") {
+				t.Errorf("unexpected storage key %q", out.Asset.StorageKey)
+			}
+			if out.Asset.URL != "https://cdn.test/"+out.Asset.StorageKey {
+				t.Errorf("unexpected URL %q", out.Asset.URL)
+			}
+			if tt.input.Kind == vos.MediaKindVideo {
+				if out.Asset.ThumbnailURL != "" {
+					t.Errorf("expected no thumbnail for video, got %q", out.Asset.ThumbnailURL)
+				}
+				return
+			}
+
+			thumb, ok := storage.objects[out.Asset.ThumbnailKey]
+			if !ok {
+				t.Fatal("expected thumbnail to be stored")
+			}
+			decoded, err := jpeg.Decode(bytes.NewReader(thumb))
+			if err != nil {
+				t.Fatalf("thumbnail is not a JPEG: %v", err)
+			}
+			if b := decoded.Bounds(); b.Dx() != 320 || b.Dy() != 160 {
+				t.Errorf("expected 320x160 thumbnail, got %dx%d", b.Dx(), b.Dy())
+			}
+		})
+	}
+}
+
+func TestUploadMediaUC_Execute_RepoErrorRemovesObjects(t *testing.T) {
+	userID := uuid.New()
+	storage := newMockStorage()
+	repoErr := errors.New("db down")
+	uc := media.NewUploadMediaUC(storage, &mockMediaRepo{createErr: repoErr}, &mockExerciseRepo{}, &mockWorkoutRepo{},
+		media.UploadLimits{MaxImageBytes: 1 << 20, MaxVideoBytes: 1 << 20})
+
+	_, err := uc.Execute(context.Background(), media.UploadMediaInput{
+		UserID: userID, OwnerType: vos.MediaOwnerUser, OwnerID: userID, Kind: vos.MediaKindImage,
+		ContentType: "image/png", Data: pngBytes(t, 10, 10),
+	})
+	if !errors.Is(err, repoErr) {
+		t.Fatalf("expected repo error, got %v", err)
+	}
+	if len(storage.objects) != 0 {
+		t.Errorf("expected stored objects to be removed, got %d", len(storage.objects))
+	}
+}
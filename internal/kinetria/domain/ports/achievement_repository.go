@@ -0,0 +1,37 @@
+package ports
+
+import (
+	"context"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/kinetria/kinetria-back/internal/kinetria/domain/entities"
+)
+
+// AchievementStats holds the all-time counters achievement rules are evaluated on.
+// Set counters include completed sets of the active session; abandoned sessions are ignored.
+type AchievementStats struct {
+	Sessions    int
+	Sets        int
+	Reps        int64
+	Volume      int64 // gramas * reps
+	HeaviestSet int   // gramas
+	Exercises   int   // exercícios distintos
+}
+
+// AchievementRepository defines persistence operations for awarded achievements.
+type AchievementRepository interface {
+	// GetStats returns the user's all-time counters.
+	GetStats(ctx context.Context, userID uuid.UUID) (*AchievementStats, error)
+	// ListByUser returns the user's awarded achievements, oldest first.
+	ListByUser(ctx context.Context, userID uuid.UUID) ([]entities.UserAchievement, error)
+	// Award records the achievement; it returns false if the user already had it.
+	Award(ctx context.Context, userID uuid.UUID, code string, awardedAt time.Time) (bool, error)
+}
+
+// AchievementEvaluator awards the achievements whose rules the user now meets.
+// It lets other domains trigger the evaluation without depending on the achievements domain.
+type AchievementEvaluator interface {
+	// Execute returns the achievements awarded by this evaluation.
+	Execute(ctx context.Context, userID uuid.UUID) ([]entities.UserAchievement, error)
+}
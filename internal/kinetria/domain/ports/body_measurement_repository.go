@@ -0,0 +1,44 @@
+package ports
+
+import (
+	"context"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/kinetria/kinetria-back/internal/kinetria/domain/entities"
+)
+
+// BodyMeasurementRepository defines persistence operations for body measurements and the goal weight.
+// Lookups are scoped by user: another user's measurement is reported as not found.
+type BodyMeasurementRepository interface {
+	Create(ctx context.Context, m *entities.BodyMeasurement) error
+	// GetByID returns (nil, nil) if the measurement does not exist or belongs to another user.
+	GetByID(ctx context.Context, userID, id uuid.UUID) (*entities.BodyMeasurement, error)
+	Update(ctx context.Context, m *entities.BodyMeasurement) error
+	// Delete returns false if no measurement was deleted.
+	Delete(ctx context.Context, userID, id uuid.UUID) (bool, error)
+	// ListByUser returns a page of measurements taken in [start, end], most recent first, and the total count.
+	ListByUser(ctx context.Context, userID uuid.UUID, start, end time.Time, limit, offset int) ([]entities.BodyMeasurement, int, error)
+	// ListByUserInRange returns every measurement taken in [start, end], oldest first.
+	ListByUserInRange(ctx context.Context, userID uuid.UUID, start, end time.Time) ([]entities.BodyMeasurement, error)
+
+	// GetGoalWeight returns (nil, nil) if the user has no goal.
+	GetGoalWeight(ctx context.Context, userID uuid.UUID) (*entities.BodyWeightGoal, error)
+	UpsertGoalWeight(ctx context.Context, goal *entities.BodyWeightGoal) error
+	DeleteGoalWeight(ctx context.Context, userID uuid.UUID) error
+}
+
+// BodyWeightEntry is a single weigh-in.
+type BodyWeightEntry struct {
+	MeasuredAt  time.Time
+	WeightGrams int
+}
+
+// BodyWeightRepository exposes the user's body weight to other domains.
+type BodyWeightRepository interface {
+	// GetLatestBodyWeight returns the latest body weight in grams, or nil if none was recorded.
+	GetLatestBodyWeight(ctx context.Context, userID uuid.UUID) (*int, error)
+	// ListBodyWeights returns the weigh-ins taken in [start, end], oldest first, preceded by
+	// the last weigh-in before start (if any) so the weight on start is known.
+	ListBodyWeights(ctx context.Context, userID uuid.UUID, start, end time.Time) ([]BodyWeightEntry, error)
+}
@@ -0,0 +1,29 @@
+package ports
+
+import (
+	"context"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/kinetria/kinetria-back/internal/kinetria/domain/entities"
+	"github.com/kinetria/kinetria-back/internal/kinetria/domain/vos"
+)
+
+// RecentExercise is a library exercise the user has performed, with usage totals.
+type RecentExercise struct {
+	Exercise        *entities.Exercise
+	LastPerformedAt time.Time
+	TimesPerformed  int // sessões concluídas com pelo menos uma série concluída
+}
+
+// ExerciseUsageRepository defines read and batch operations over exercise usage (set_records).
+type ExerciseUsageRepository interface {
+	// ListRecentByUser returns up to limit exercises the user performed in completed sessions,
+	// ordered by last performance (recent) or by number of sessions (frequent).
+	ListRecentByUser(ctx context.Context, userID uuid.UUID, sort vos.RecentExerciseSort, limit int) ([]RecentExercise, error)
+
+	// RefreshPopularity recomputes popularity_score for every exercise from completed sessions
+	// started since `since`, each session weighted by 0.5^(age/halfLife) relative to now.
+	// Returns the number of exercises updated.
+	RefreshPopularity(ctx context.Context, now, since time.Time, halfLife time.Duration) (int64, error)
+}
@@ -0,0 +1,22 @@
+package ports
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+)
+
+// FavoriteRepository defines persistence operations for a user's favorite exercises and workouts.
+// Adding an existing favorite or removing a missing one is not an error.
+type FavoriteRepository interface {
+	AddExercise(ctx context.Context, userID, exerciseID uuid.UUID) error
+	RemoveExercise(ctx context.Context, userID, exerciseID uuid.UUID) error
+	AddWorkout(ctx context.Context, userID, workoutID uuid.UUID) error
+	RemoveWorkout(ctx context.Context, userID, workoutID uuid.UUID) error
+
+	// FavoriteExerciseIDs returns which of the given exercises the user has favorited.
+	FavoriteExerciseIDs(ctx context.Context, userID uuid.UUID, exerciseIDs []uuid.UUID) (map[uuid.UUID]bool, error)
+
+	// FavoriteWorkoutIDs returns which of the given workouts the user has favorited.
+	FavoriteWorkoutIDs(ctx context.Context, userID uuid.UUID, workoutIDs []uuid.UUID) (map[uuid.UUID]bool, error)
+}
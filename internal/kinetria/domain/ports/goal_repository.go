@@ -0,0 +1,34 @@
+package ports
+
+import (
+	"context"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/kinetria/kinetria-back/internal/kinetria/domain/entities"
+)
+
+// GoalRepository defines persistence operations for training goals.
+// Lookups are scoped by user: another user's goal is reported as not found.
+type GoalRepository interface {
+	Create(ctx context.Context, goal *entities.Goal) error
+	// GetByID returns (nil, nil) if the goal does not exist or belongs to another user.
+	GetByID(ctx context.Context, userID, id uuid.UUID) (*entities.Goal, error)
+	// ListByUser returns the user's goals, active first and then most recent first.
+	ListByUser(ctx context.Context, userID uuid.UUID) ([]entities.Goal, error)
+	// Update stores the target value and target date of the goal.
+	Update(ctx context.Context, goal *entities.Goal) error
+	// Delete returns false if no goal was deleted.
+	Delete(ctx context.Context, userID, id uuid.UUID) (bool, error)
+	// MarkAchieved sets an active goal as achieved at the time its target was reached. It
+	// returns false when the goal was already achieved, so concurrent evaluations record it
+	// only once.
+	MarkAchieved(ctx context.Context, userID, id uuid.UUID, achievedAt time.Time) (bool, error)
+}
+
+// GoalEvaluator records as achieved the active goals whose target the user has now reached.
+// It lets other domains trigger the evaluation without depending on the goals domain.
+type GoalEvaluator interface {
+	// Execute returns the goals achieved by this evaluation.
+	Execute(ctx context.Context, userID uuid.UUID) ([]entities.Goal, error)
+}
@@ -0,0 +1,45 @@
+package ports
+
+import (
+	"context"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// IdempotencyRecord is a request made with an Idempotency-Key and, once it finishes, its response.
+type IdempotencyRecord struct {
+	UserID uuid.UUID
+	Key    string
+	// Fingerprint identifies the request (method, path and body) the key was first used with.
+	Fingerprint string
+	// ClaimID identifies the request that claimed the key.
+	ClaimID uuid.UUID
+	// StatusCode is 0 while the first request with the key is still running.
+	StatusCode  int
+	ContentType string
+	Body        []byte
+	CreatedAt   time.Time
+	// HeartbeatAt is when the running request last renewed its claim.
+	HeartbeatAt time.Time
+	ExpiresAt   time.Time
+}
+
+// IdempotencyRepository stores the responses of requests made with an Idempotency-Key.
+type IdempotencyRepository interface {
+	// Claim stores record as a running request, unless the key is already stored for the user.
+	// It returns (nil, nil) when the key was claimed and the stored record otherwise. An
+	// expired record, or a running one whose claim was last renewed before staleBefore, is
+	// replaced.
+	Claim(ctx context.Context, record *IdempotencyRecord, staleBefore time.Time) (*IdempotencyRecord, error)
+	// Renew records at as the heartbeat of the running request holding claimID. It returns
+	// false when that request no longer holds the key.
+	Renew(ctx context.Context, userID uuid.UUID, key string, claimID uuid.UUID, at time.Time) (bool, error)
+	// Complete stores the response of the request holding claimID.
+	Complete(ctx context.Context, userID uuid.UUID, key string, claimID uuid.UUID, statusCode int, contentType string, body []byte) error
+	// Release deletes the key claimed by a request that failed, so that it can be retried.
+	// A key claimed again by another request is kept.
+	Release(ctx context.Context, userID uuid.UUID, key string, claimID uuid.UUID) error
+	// PurgeExpired deletes the records expired at now and returns how many were deleted.
+	PurgeExpired(ctx context.Context, now time.Time) (int64, error)
+}
@@ -0,0 +1,25 @@
+package ports
+
+import (
+	"context"
+
+	"github.com/kinetria/kinetria-back/internal/kinetria/domain/entities"
+)
+
+// MediaStorage stores uploaded files and returns the URL they are served from.
+// Implementations: local filesystem and S3-compatible object storage.
+type MediaStorage interface {
+	// Put stores data under key, overwriting any existing object, and returns its public URL.
+	Put(ctx context.Context, key, contentType string, data []byte) (string, error)
+
+	// Delete removes the object stored under key. Deleting a missing key is not an error.
+	Delete(ctx context.Context, key string) error
+}
+
+// MediaRepository defines persistence operations for media assets.
+type MediaRepository interface {
+	// Create records the asset and writes its URL back to the owning entity (transactional):
+	// exercise images → thumbnail_url, exercise videos → video_url,
+	// workout images → image_url, user images → profile_image_url.
+	Create(ctx context.Context, asset *entities.MediaAsset) error
+}
@@ -0,0 +1,36 @@
+package ports
+
+import (
+	"context"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/kinetria/kinetria-back/internal/kinetria/domain/entities"
+	"github.com/kinetria/kinetria-back/internal/kinetria/domain/vos"
+)
+
+// ReadinessRepository defines persistence operations for readiness check-ins.
+type ReadinessRepository interface {
+	Create(ctx context.Context, checkIn *entities.ReadinessCheckIn) error
+	// ListByUser returns the check-ins made in [from, to], oldest first.
+	ListByUser(ctx context.Context, userID uuid.UUID, from, to time.Time) ([]entities.ReadinessCheckIn, error)
+	// FindLatestUnlinked returns the latest check-in made since since and not yet used
+	// to start a session, or nil if there is none.
+	FindLatestUnlinked(ctx context.Context, userID uuid.UUID, since time.Time) (*entities.ReadinessCheckIn, error)
+	// LinkSession records the session started with the check-in and the adjustment applied.
+	LinkSession(ctx context.Context, checkInID, sessionID uuid.UUID, adjustment *vos.ReadinessAdjustment) error
+	// AverageHRV returns the mean HRV of the check-ins made in [from, to), or nil without any.
+	AverageHRV(ctx context.Context, userID uuid.UUID, from, to time.Time) (*float64, error)
+}
+
+// ReadinessCheckInInput holds the markers of a new readiness check-in.
+type ReadinessCheckInInput struct {
+	UserID  uuid.UUID
+	Markers vos.ReadinessMarkers
+}
+
+// ReadinessRecorder scores and stores readiness check-ins.
+// It lets other domains record a check-in without depending on the readiness domain.
+type ReadinessRecorder interface {
+	Execute(ctx context.Context, input ReadinessCheckInInput) (*entities.ReadinessCheckIn, error)
+}
@@ -6,6 +6,7 @@ import (
 
 	"github.com/google/uuid"
 	"github.com/kinetria/kinetria-back/internal/kinetria/domain/entities"
+	"github.com/kinetria/kinetria-back/internal/kinetria/domain/vos"
 )
 
 // UserRepository defines persistence operations for users.
@@ -29,6 +30,7 @@ type RefreshTokenRepository interface {
 type SessionStats struct {
 	TotalWorkouts int
 	TotalTime     int // minutos
+	TotalCalories int // kcal, soma das estimativas gravadas em cada sessão
 }
 
 // FrequencyData holds the workout count for a specific date.
@@ -61,6 +63,34 @@ type ProgressionPoint struct {
 	TotalVolume int64
 }
 
+// MuscleVolumeRow holds the weighted sets and volume credited to a muscle group in one week.
+type MuscleVolumeRow struct {
+	WeekStart   time.Time // primeiro dia da semana (segunda ou domingo, conforme preferência)
+	MuscleGroup string
+	HardSets    float64 // séries ponderadas pelo envolvimento do músculo
+	Volume      int64   // gramas * reps, ponderado
+}
+
+// BigLiftSetRow holds the heaviest completed set of a big lift for one rep count on one day.
+type BigLiftSetRow struct {
+	Date   time.Time // dia de calendário no fuso do usuário
+	Lift   vos.BigLift
+	Reps   int
+	Weight int // gramas
+}
+
+// ExerciseSetSummaryRow summarizes the completed sets of one rep count of an exercise in a session.
+type ExerciseSetSummaryRow struct {
+	SessionID    uuid.UUID
+	Date         time.Time // dia de calendário no fuso do usuário
+	StartedAt    time.Time
+	ExerciseID   uuid.UUID
+	ExerciseName string
+	Reps         int
+	MaxWeight    int   // gramas
+	Volume       int64 // gramas * reps das séries com esse número de repetições
+}
+
 // SessionRepository defines persistence operations for workout sessions.
 type SessionRepository interface {
 	Create(ctx context.Context, session *entities.Session) error
@@ -69,16 +99,21 @@ type SessionRepository interface {
 	UpdateStatus(ctx context.Context, sessionID uuid.UUID, status string, finishedAt *time.Time, notes string) (bool, error)
 	// GetCompletedSessionsByUserAndDateRange retorna todas as sessões completed do usuário
 	// no intervalo de datas (inclusive).
-	// Datas devem estar em UTC. Usa DATE(started_at) para determinar o dia.
+	// Datas são dias de calendário (meia-noite UTC); o dia de cada sessão é
+	// DATE(started_at) no fuso loc.
 	GetCompletedSessionsByUserAndDateRange(
 		ctx context.Context,
 		userID uuid.UUID,
 		startDate time.Time,
 		endDate time.Time,
+		loc *time.Location,
 	) ([]entities.Session, error)
+	// GetStatsByUserAndPeriod soma os rollups diários dos dias (no fuso do usuário) de start a end.
 	GetStatsByUserAndPeriod(ctx context.Context, userID uuid.UUID, start, end time.Time) (*SessionStats, error)
-	GetFrequencyByUserAndPeriod(ctx context.Context, userID uuid.UUID, start, end time.Time) ([]FrequencyData, error)
-	GetSessionsForStreak(ctx context.Context, userID uuid.UUID) ([]time.Time, error)
+	// GetFrequencyByUserAndPeriod agrupa as sessões por dia de calendário no fuso loc.
+	GetFrequencyByUserAndPeriod(ctx context.Context, userID uuid.UUID, start, end time.Time, loc *time.Location) ([]FrequencyData, error)
+	// GetSessionsForStreak retorna os dias (no fuso loc) com sessões completed, do mais recente ao mais antigo.
+	GetSessionsForStreak(ctx context.Context, userID uuid.UUID, loc *time.Location) ([]time.Time, error)
 }
 
 // SetRecordRepository defines persistence operations for set records.
@@ -87,7 +122,18 @@ type SetRecordRepository interface {
 	FindBySessionExerciseSet(ctx context.Context, sessionID, workoutExerciseID uuid.UUID, setNumber int) (*entities.SetRecord, error)
 	GetTotalSetsRepsVolume(ctx context.Context, userID uuid.UUID, start, end time.Time) (*SetRecordStats, error)
 	GetPersonalRecordsByUser(ctx context.Context, userID uuid.UUID) ([]PersonalRecord, error)
-	GetProgressionByUserAndExercise(ctx context.Context, userID uuid.UUID, exerciseID *uuid.UUID, start, end time.Time) ([]ProgressionPoint, error)
+	// GetProgressionByUserAndExercise agrupa por dia de calendário no fuso loc.
+	GetProgressionByUserAndExercise(ctx context.Context, userID uuid.UUID, exerciseID *uuid.UUID, start, end time.Time, loc *time.Location) ([]ProgressionPoint, error)
+	// GetMuscleVolumeByUserAndPeriod agrupa por semana (iniciando em weekStart) no fuso loc as sessões
+	// iniciadas em [start, end).
+	GetMuscleVolumeByUserAndPeriod(ctx context.Context, userID uuid.UUID, start, end time.Time, loc *time.Location, weekStart vos.WeekStart) ([]MuscleVolumeRow, error)
+	// GetBigLiftSetsByUser retorna, por dia (no fuso loc), levantamento e repetições, a série mais pesada
+	// de exercícios marcados com as tags dos levantamentos básicos (1 a vos.MaxEstimateReps repetições),
+	// das sessões completed iniciadas em [start, end).
+	GetBigLiftSetsByUser(ctx context.Context, userID uuid.UUID, start, end time.Time, loc *time.Location) ([]BigLiftSetRow, error)
+	// GetExerciseSetSummariesByUser retorna, por sessão completed iniciada em [start, end], exercício e
+	// número de repetições, a carga máxima e o volume das séries com peso, em ordem cronológica.
+	GetExerciseSetSummariesByUser(ctx context.Context, userID uuid.UUID, start, end time.Time, loc *time.Location) ([]ExerciseSetSummaryRow, error)
 }
 
 // ExerciseFilters holds optional filter parameters for querying the exercise library.
@@ -96,6 +142,12 @@ type ExerciseFilters struct {
 	Equipment   *string
 	Difficulty  *string
 	Search      *string
+
+	// FavoritesOf restricts the result to exercises favorited by this user.
+	FavoritesOf *uuid.UUID
+
+	// Sort selects the ordering; empty sorts by name.
+	Sort vos.ExerciseSort
 }
 
 // ExerciseUserStats holds performance statistics for a user on a specific exercise.
@@ -146,3 +198,13 @@ type ExerciseRepository interface {
 type AuditLogRepository interface {
 	Append(ctx context.Context, entry *entities.AuditLog) error
 }
+
+// ExerciseLibraryRepository defines bulk operations on the shared exercise library.
+type ExerciseLibraryRepository interface {
+	// ListAll returns every library exercise (with muscle groups), ordered by slug.
+	ListAll(ctx context.Context) ([]*entities.Exercise, error)
+
+	// UpsertBySlug inserts or updates exercises matched by Slug and replaces their
+	// muscle groups, all in a single transaction. IDs of new exercises are generated by the caller.
+	UpsertBySlug(ctx context.Context, exercises []*entities.Exercise) error
+}
@@ -0,0 +1,51 @@
+package ports
+
+import (
+	"context"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/kinetria/kinetria-back/internal/kinetria/domain/vos"
+)
+
+// ExerciseEffort groups the completed sets of one exercise in a session.
+type ExerciseEffort struct {
+	MET           *float64 // nil quando o exercício não define MET próprio
+	CompletedSets int
+}
+
+// SessionEffort is the raw data needed to estimate the energy spent in a session.
+type SessionEffort struct {
+	WorkoutType string
+	StartedAt   time.Time
+	LastSetAt   *time.Time // última série concluída; nil se nenhuma
+	Exercises   []ExerciseEffort
+}
+
+// SessionLoad is the raw data needed to compute the training load of a completed session.
+type SessionLoad struct {
+	SessionID       uuid.UUID
+	StartedAt       time.Time
+	WorkoutID       uuid.UUID
+	WorkoutName     string
+	Date            time.Time // dia de calendário no fuso do usuário
+	DurationMinutes int
+	RPE             *int  // nil quando o usuário não informou
+	Tonnage         int64 // gramas * reps das séries concluídas
+	// Mood, Energy and SleepQuality are the post-session ratings (1-5); nil when not given.
+	Mood         *int
+	Energy       *int
+	SleepQuality *int
+}
+
+// SessionEffortRepository reads session effort and stores the resulting calorie estimate
+// and the post-session feedback (session RPE, mood, energy, sleep quality and soreness).
+type SessionEffortRepository interface {
+	GetSessionEffort(ctx context.Context, sessionID uuid.UUID) (*SessionEffort, error)
+	SetSessionCalories(ctx context.Context, sessionID uuid.UUID, kcal int) error
+	// SetSessionFeedback replaces the whole feedback of the session.
+	SetSessionFeedback(ctx context.Context, sessionID uuid.UUID, feedback vos.SessionFeedback) error
+	GetSessionFeedback(ctx context.Context, sessionID uuid.UUID) (*vos.SessionFeedback, error)
+	// ListSessionLoads retorna as sessões completed iniciadas em [start, end), com o dia no fuso loc.
+	ListSessionLoads(ctx context.Context, userID uuid.UUID, start, end time.Time, loc *time.Location) ([]SessionLoad, error)
+}
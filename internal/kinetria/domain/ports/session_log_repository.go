@@ -0,0 +1,20 @@
+package ports
+
+import (
+	"context"
+
+	"github.com/kinetria/kinetria-back/internal/kinetria/domain/entities"
+)
+
+// SessionLogRepository persists sessions logged or corrected after the fact, with explicit times.
+type SessionLogRepository interface {
+	// CreateCompleted inserts a completed session and its sets in a single transaction.
+	// It returns errors.ErrSessionOverlap, inserting nothing, when [StartedAt, FinishedAt)
+	// overlaps another session of the user that was not abandoned; an active session
+	// runs until now.
+	CreateCompleted(ctx context.Context, session *entities.Session, sets []entities.SetRecord) error
+	// UpdateCompleted stores the StartedAt, FinishedAt, Notes and UpdatedAt of a completed
+	// session. It returns false if the session is not completed and errors.ErrSessionOverlap,
+	// changing nothing, when the new times overlap another session as in CreateCompleted.
+	UpdateCompleted(ctx context.Context, session *entities.Session) (bool, error)
+}
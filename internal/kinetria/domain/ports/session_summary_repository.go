@@ -0,0 +1,35 @@
+package ports
+
+import (
+	"context"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/kinetria/kinetria-back/internal/kinetria/domain/entities"
+)
+
+// SessionSetRow is one recorded set of a session alongside the prescription of its
+// exercise in the session's workout.
+type SessionSetRow struct {
+	WorkoutExerciseID uuid.UUID
+	ExerciseID        uuid.UUID
+	ExerciseName      string
+	PrescribedSets    int
+	PrescribedReps    string // faixa prescrita, ex.: "8-12"
+	// SetNumber is nil for a prescribed exercise without recorded sets; Weight, Reps and
+	// Status are then zero.
+	SetNumber *int
+	Weight    int // gramas
+	Reps      int
+	Status    string
+}
+
+// SessionSummaryRepository reads the data a session summary is built from.
+type SessionSummaryRepository interface {
+	// ListSessionSets retorna cada exercício do treino da sessão, na ordem do treino, com
+	// as séries registradas na sessão (uma linha por série, ou uma linha sem série).
+	ListSessionSets(ctx context.Context, sessionID uuid.UUID) ([]SessionSetRow, error)
+	// FindPreviousCompleted returns the latest completed session of the workout started
+	// before the given time, or (nil, nil) if there is none.
+	FindPreviousCompleted(ctx context.Context, userID, workoutID uuid.UUID, before time.Time) (*entities.Session, error)
+}
@@ -0,0 +1,22 @@
+package ports
+
+import (
+	"context"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// StatsRollupRepository maintains the per-user daily and weekly statistics rollups read by
+// the statistics and dashboard queries. Days and weeks follow the timezone and weekStart
+// stored in the user's preferences.
+type StatsRollupRepository interface {
+	// RefreshDay recomputes the rollup of the day (in the user's timezone) containing at and
+	// of its week. Sets only count once their session is completed, so it runs whenever a
+	// completed session (or one of its sets) changes.
+	RefreshDay(ctx context.Context, userID uuid.UUID, at time.Time) error
+	// RebuildUser recomputes every rollup of the user, e.g. after a timezone change.
+	RebuildUser(ctx context.Context, userID uuid.UUID) error
+	// ListUserIDs returns the IDs of all users, for backfills.
+	ListUserIDs(ctx context.Context) ([]uuid.UUID, error)
+}
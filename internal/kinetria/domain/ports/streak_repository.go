@@ -0,0 +1,51 @@
+package ports
+
+import (
+	"context"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/kinetria/kinetria-back/internal/kinetria/domain/vos"
+)
+
+// StreakDay is a calendar day, in the user's timezone, with completed sessions.
+type StreakDay struct {
+	Day      time.Time
+	Sessions int
+}
+
+// StreakStatus is a user's training streak in their chosen mode: Current and Longest
+// count days in daily mode and weeks in weekly mode.
+type StreakStatus struct {
+	Mode         vos.StreakMode
+	WeeklyTarget int // sessões por semana exigidas no modo weekly
+	Current      int
+	Longest      int
+	// FreezesAvailable are the earned freezes left; one covers a missed day or week.
+	FreezesAvailable int
+	// FreezesUsed are the freezes spent within the current streak.
+	FreezesUsed int
+	// PeriodSessions and PeriodTarget are the sessions of the current day or week and
+	// the sessions it needs to extend the streak (zero when planned as rest).
+	PeriodSessions int
+	PeriodTarget   int
+}
+
+// StreakRepository defines persistence operations for streaks and planned rest days.
+// Days are calendar dates in the user's timezone.
+type StreakRepository interface {
+	// ListActivityDays returns every day with completed sessions, oldest first.
+	ListActivityDays(ctx context.Context, userID uuid.UUID) ([]StreakDay, error)
+	// ListRestDays returns the planned rest days between from and to (inclusive), oldest first.
+	ListRestDays(ctx context.Context, userID uuid.UUID, from, to time.Time) ([]time.Time, error)
+	// AddRestDay plans a rest day; it returns false if the day was already planned.
+	AddRestDay(ctx context.Context, userID uuid.UUID, day time.Time) (bool, error)
+	// DeleteRestDay removes a planned rest day; it returns false if it was not planned.
+	DeleteRestDay(ctx context.Context, userID uuid.UUID, day time.Time) (bool, error)
+}
+
+// StreakCalculator computes a user's streak in their chosen mode.
+// It lets other domains report the streak without depending on the streaks domain.
+type StreakCalculator interface {
+	Execute(ctx context.Context, userID uuid.UUID) (*StreakStatus, error)
+}
@@ -0,0 +1,61 @@
+package ports
+
+import (
+	"context"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/kinetria/kinetria-back/internal/kinetria/domain/entities"
+	"github.com/kinetria/kinetria-back/internal/kinetria/domain/vos"
+)
+
+// SyncOperationRecord is the stored outcome of an offline operation, so that replaying the
+// operation returns the same outcome instead of applying it twice.
+type SyncOperationRecord struct {
+	ID        uuid.UUID // gerado pelo cliente
+	UserID    uuid.UUID
+	Type      vos.SyncOperationType
+	EntityID  uuid.UUID               // sessão ou série afetada
+	Status    vos.SyncOperationStatus // applied ou rejected
+	Code      string                  // motivo da rejeição; vazio quando aplicada
+	Message   string
+	AppliedAt time.Time
+}
+
+// SyncCursor is a position in the change feed of a user's sessions. Changes are numbered by
+// a per-user sequence in the order they were committed, so a change committed after a sync
+// is always after the cursor it returned. The zero cursor is the beginning.
+type SyncCursor struct {
+	ChangeSeq int64
+}
+
+// SyncedSet is a recorded set as seen by the sync clients.
+type SyncedSet struct {
+	ID         uuid.UUID
+	ExerciseID uuid.UUID
+	SetNumber  int
+	Weight     int // gramas
+	Reps       int
+	Status     string
+	RecordedAt time.Time
+}
+
+// SessionChange is a session changed after a cursor, with all of its sets.
+type SessionChange struct {
+	Session entities.Session
+	Sets    []SyncedSet
+	// Cursor is the position of this change in the feed.
+	Cursor SyncCursor
+}
+
+// SyncRepository stores the outcome of offline operations and reads the change feed of
+// sessions. Recording a set counts as a change of its session.
+type SyncRepository interface {
+	// FindOperation returns the stored outcome of an operation, or (nil, nil) if it was never applied.
+	FindOperation(ctx context.Context, userID, operationID uuid.UUID) (*SyncOperationRecord, error)
+	// SaveOperation stores the outcome of an operation. It returns false, keeping the stored
+	// outcome, if the operation was already stored, e.g. by a concurrent sync of the same batch.
+	SaveOperation(ctx context.Context, record *SyncOperationRecord) (bool, error)
+	// ListSessionChanges returns up to limit sessions of the user changed after the cursor, in feed order.
+	ListSessionChanges(ctx context.Context, userID uuid.UUID, after SyncCursor, limit int) ([]SessionChange, error)
+}
@@ -0,0 +1,10 @@
+package ports
+
+import "context"
+
+// Transactor runs a unit of work in a single database transaction. The repositories called
+// with the context given to fn take part in it. A nested call runs in a savepoint, so its
+// failure only undoes its own writes and the enclosing unit of work can go on.
+type Transactor interface {
+	WithinTx(ctx context.Context, fn func(ctx context.Context) error) error
+}
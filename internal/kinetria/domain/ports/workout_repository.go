@@ -16,15 +16,16 @@ type WorkoutRepository interface {
 	// Parameters:
 	//   - ctx: context for cancellation/timeout
 	//   - userID: UUID of the authenticated user
+	//   - favoritesOnly: restrict to workouts the user has favorited
 	//   - offset: number of records to skip
 	//   - limit: maximum number of records to return
 	// Returns:
 	//   - workouts slice
 	//   - total count of workouts for the user
 	//   - error if query fails
-	ListByUserID(ctx context.Context, userID uuid.UUID, offset, limit int) ([]entities.Workout, int, error)
+	ListByUserID(ctx context.Context, userID uuid.UUID, favoritesOnly bool, offset, limit int) ([]entities.Workout, int, error)
 
-	// GetFirstByUserID retorna o primeiro workout do usuário (ordenado por created_at ASC).
+	// GetFirstByUserID retorna o primeiro workout do usuário: favoritos primeiro, depois por created_at ASC.
 	// Retorna nil se o usuário não tiver workouts.
 	GetFirstByUserID(ctx context.Context, userID uuid.UUID) (*entities.Workout, error)
 
@@ -13,6 +13,14 @@
 //     the domain layer.
 //   - Preferences.Theme: must be one of "dark" or "light".
 //   - Preferences.Language: must be one of "pt-BR" or "en-US".
+//   - Preferences.Timezone: a valid IANA timezone name (e.g. "America/Sao_Paulo").
+//   - Preferences.WeekStart: must be one of "monday" or "sunday".
+//   - Preferences.Units: must be one of "metric" or "imperial".
+//   - Preferences.Sex: optional; "male" or "female" (used by relative strength scores).
+//   - Preferences.StreakMode: must be one of "daily" or "weekly".
+//   - Preferences.StreakWeeklyTarget: sessions per week of the weekly streak mode, 1–7.
+//     An empty Timezone, WeekStart, Units, Sex, StreakMode or StreakWeeklyTarget keeps
+//     the user's current value.
 //
 // # Errors
 //
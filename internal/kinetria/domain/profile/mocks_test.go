@@ -2,6 +2,7 @@ package profile_test
 
 import (
 	"context"
+	"time"
 
 	"github.com/google/uuid"
 	"github.com/kinetria/kinetria-back/internal/kinetria/domain/entities"
@@ -13,6 +14,8 @@ type mockProfileUserRepo struct {
 	byID       map[uuid.UUID]*entities.User
 	getByIDErr error
 	updateErr  error
+	// updatedInTx reports whether Update ran in a unit of work.
+	updatedInTx bool
 }
 
 func (m *mockProfileUserRepo) Create(_ context.Context, _ *entities.User) error {
@@ -33,7 +36,8 @@ func (m *mockProfileUserRepo) GetByID(_ context.Context, id uuid.UUID) (*entitie
 	return nil, domainerrors.ErrNotFound
 }
 
-func (m *mockProfileUserRepo) Update(_ context.Context, user *entities.User) error {
+func (m *mockProfileUserRepo) Update(ctx context.Context, user *entities.User) error {
+	m.updatedInTx = ctx.Value(unitOfWorkKey{}) != nil
 	if m.updateErr != nil {
 		return m.updateErr
 	}
@@ -44,3 +48,38 @@ func (m *mockProfileUserRepo) Update(_ context.Context, user *entities.User) err
 	m.byID[user.ID] = user
 	return nil
 }
+
+// mockProfileRollupRepo is an inline mock for ports.StatsRollupRepository that counts rebuilds.
+type mockProfileRollupRepo struct {
+	rebuilds   int
+	rebuildErr error
+}
+
+func (m *mockProfileRollupRepo) RefreshDay(_ context.Context, _ uuid.UUID, _ time.Time) error {
+	return nil
+}
+
+func (m *mockProfileRollupRepo) RebuildUser(_ context.Context, _ uuid.UUID) error {
+	m.rebuilds++
+	return m.rebuildErr
+}
+
+func (m *mockProfileRollupRepo) ListUserIDs(_ context.Context) ([]uuid.UUID, error) {
+	return nil, nil
+}
+
+type unitOfWorkKey struct{}
+
+// mockProfileTransactor runs fn in a context marked as a unit of work and records the
+// errors that would roll it back.
+type mockProfileTransactor struct {
+	rolledBack []error
+}
+
+func (m *mockProfileTransactor) WithinTx(ctx context.Context, fn func(ctx context.Context) error) error {
+	if err := fn(context.WithValue(ctx, unitOfWorkKey{}, true)); err != nil {
+		m.rolledBack = append(m.rolledBack, err)
+		return err
+	}
+	return nil
+}
@@ -0,0 +1,28 @@
+package profile
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/google/uuid"
+	domainerrors "github.com/kinetria/kinetria-back/internal/kinetria/domain/errors"
+	"github.com/kinetria/kinetria-back/internal/kinetria/domain/ports"
+	"github.com/kinetria/kinetria-back/internal/kinetria/domain/vos"
+)
+
+// LoadPreferences returns the user's preferences, such as the timezone and week start used
+// to bucket statistics, goals and streaks by calendar day. Unknown users get the defaults.
+func LoadPreferences(ctx context.Context, userRepo ports.UserRepository, userID uuid.UUID) (vos.UserPreferences, error) {
+	user, err := userRepo.GetByID(ctx, userID)
+	if err != nil {
+		if errors.Is(err, domainerrors.ErrNotFound) {
+			return vos.DefaultUserPreferences(), nil
+		}
+		return vos.UserPreferences{}, fmt.Errorf("get user preferences: %w", err)
+	}
+	if user == nil {
+		return vos.DefaultUserPreferences(), nil
+	}
+	return user.Preferences, nil
+}
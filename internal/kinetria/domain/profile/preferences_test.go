@@ -0,0 +1,50 @@
+package profile_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/kinetria/kinetria-back/internal/kinetria/domain/entities"
+	domainprofile "github.com/kinetria/kinetria-back/internal/kinetria/domain/profile"
+	"github.com/kinetria/kinetria-back/internal/kinetria/domain/vos"
+)
+
+func TestLoadPreferences(t *testing.T) {
+	userID := uuid.New()
+
+	t.Run("returns the user's preferences", func(t *testing.T) {
+		prefs := vos.DefaultUserPreferences()
+		prefs.Timezone = "Asia/Tokyo"
+		prefs.WeekStart = vos.WeekStartSunday
+		repo := &mockProfileUserRepo{byID: map[uuid.UUID]*entities.User{userID: {ID: userID, Preferences: prefs}}}
+
+		got, err := domainprofile.LoadPreferences(context.Background(), repo, userID)
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if got.Timezone != "Asia/Tokyo" || got.WeekStart != vos.WeekStartSunday {
+			t.Errorf("expected the user's preferences, got %+v", got)
+		}
+	})
+
+	t.Run("unknown user falls back to defaults", func(t *testing.T) {
+		got, err := domainprofile.LoadPreferences(context.Background(), &mockProfileUserRepo{}, userID)
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if got != vos.DefaultUserPreferences() {
+			t.Errorf("expected the defaults, got %+v", got)
+		}
+	})
+
+	t.Run("repository error propagates", func(t *testing.T) {
+		repoErr := errors.New("db down")
+
+		_, err := domainprofile.LoadPreferences(context.Background(), &mockProfileUserRepo{getByIDErr: repoErr}, userID)
+		if !errors.Is(err, repoErr) {
+			t.Errorf("expected repo error, got %v", err)
+		}
+	})
+}
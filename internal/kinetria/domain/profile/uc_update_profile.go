@@ -19,27 +19,35 @@ import (
 // Validation rules (enforced by [UpdateProfileUC.Execute]):
 //   - Name: 2–100 characters after whitespace trimming.
 //   - Preferences: [vos.UserPreferences.Validate] must pass (theme/language must be
-//     one of their allowed values).
+//     one of their allowed values, timezone a valid IANA name, weekStart monday/sunday,
+//     units metric/imperial, streakMode daily/weekly, streakWeeklyTarget 1–7). An empty
+//     timezone, weekStart, units, streakMode or streakWeeklyTarget keeps the user's
+//     current value.
 type UpdateProfileInput struct {
 	// Name, when non-nil, replaces the user's display name.
 	Name *string
 	// ProfileImageURL, when non-nil, replaces the user's profile image URL.
 	ProfileImageURL *string
-	// Preferences, when non-nil, replaces the user's preferences entirely.
+	// Preferences, when non-nil, replaces the user's preferences entirely
+	// (except an empty Timezone/WeekStart, which keep their current values).
 	Preferences *vos.UserPreferences
 }
 
 // UpdateProfileUC implements the use case for updating a user's profile.
 // It performs a partial update: only fields explicitly set (non-nil) in
-// [UpdateProfileInput] are written to the repository.
+// [UpdateProfileInput] are written to the repository. A change of timezone or
+// weekStart rebuilds the user's statistics rollups, whose days and weeks follow them, in
+// the same transaction as the update.
 type UpdateProfileUC struct {
-	tracer   trace.Tracer
-	userRepo ports.UserRepository
+	tracer     trace.Tracer
+	transactor ports.Transactor
+	userRepo   ports.UserRepository
+	rollupRepo ports.StatsRollupRepository
 }
 
-// NewUpdateProfileUC creates a new [UpdateProfileUC] wired with the given tracer and repository.
-func NewUpdateProfileUC(tracer trace.Tracer, userRepo ports.UserRepository) *UpdateProfileUC {
-	return &UpdateProfileUC{tracer: tracer, userRepo: userRepo}
+// NewUpdateProfileUC creates a new [UpdateProfileUC] wired with the given tracer, transactor and repositories.
+func NewUpdateProfileUC(tracer trace.Tracer, transactor ports.Transactor, userRepo ports.UserRepository, rollupRepo ports.StatsRollupRepository) *UpdateProfileUC {
+	return &UpdateProfileUC{tracer: tracer, transactor: transactor, userRepo: userRepo, rollupRepo: rollupRepo}
 }
 
 // Execute updates the profile of the user identified by userID.
@@ -77,7 +85,7 @@ func (uc *UpdateProfileUC) Execute(ctx context.Context, userID uuid.UUID, input
 
 	// Validate preferences if provided
 	if input.Preferences != nil {
-		if err := input.Preferences.Validate(); err != nil {
+		if err := input.Preferences.WithUnsetFrom(vos.DefaultUserPreferences()).Validate(); err != nil {
 			return nil, fmt.Errorf("%w: %s", domainerrors.ErrMalformedParameters, err.Error())
 		}
 	}
@@ -95,12 +103,26 @@ func (uc *UpdateProfileUC) Execute(ctx context.Context, userID uuid.UUID, input
 	if input.ProfileImageURL != nil {
 		user.ProfileImageURL = *input.ProfileImageURL
 	}
+	previous := user.Preferences
 	if input.Preferences != nil {
-		user.Preferences = *input.Preferences
+		user.Preferences = input.Preferences.WithUnsetFrom(user.Preferences)
 	}
 
-	// Persist
-	if err := uc.userRepo.Update(ctx, user); err != nil {
+	// Persist; as preferências e os rollups refeitos com elas são gravados juntos ou nenhum
+	err = uc.transactor.WithinTx(ctx, func(ctx context.Context) error {
+		if err := uc.userRepo.Update(ctx, user); err != nil {
+			return err
+		}
+
+		// Os rollups de estatísticas agrupam por dia e semana nas preferências do usuário
+		if user.Preferences.Timezone != previous.Timezone || user.Preferences.WeekStart != previous.WeekStart {
+			if err := uc.rollupRepo.RebuildUser(ctx, userID); err != nil {
+				return fmt.Errorf("rebuild stats rollups: %w", err)
+			}
+		}
+		return nil
+	})
+	if err != nil {
 		return nil, err
 	}
 
@@ -177,7 +177,7 @@ func TestUpdateProfileUC_Execute(t *testing.T) {
 				tt.setupRepo(repo)
 			}
 
-			uc := domainprofile.NewUpdateProfileUC(tracer, repo)
+			uc := domainprofile.NewUpdateProfileUC(tracer, &mockProfileTransactor{}, repo, &mockProfileRollupRepo{})
 			user, err := uc.Execute(context.Background(), userID, tt.input)
 
 			if tt.wantErr != nil {
@@ -206,3 +206,71 @@ func TestUpdateProfileUC_Execute(t *testing.T) {
 		})
 	}
 }
+
+func TestUpdateProfileUC_Execute_RebuildsRollups(t *testing.T) {
+	tracer := noop.NewTracerProvider().Tracer("test")
+	userID := uuid.New()
+
+	tests := []struct {
+		name         string
+		prefs        vos.UserPreferences
+		rebuildErr   error
+		wantRebuilds int
+		wantErr      bool
+	}{
+		{
+			name:         "timezone change rebuilds",
+			prefs:        vos.UserPreferences{Theme: vos.ThemeDark, Language: vos.LanguageEnUS, Timezone: "Europe/Lisbon"},
+			wantRebuilds: 1,
+		},
+		{
+			name:         "weekStart change rebuilds",
+			prefs:        vos.UserPreferences{Theme: vos.ThemeDark, Language: vos.LanguageEnUS, WeekStart: vos.WeekStartSunday},
+			wantRebuilds: 1,
+		},
+		{
+			name:         "other preferences keep rollups",
+			prefs:        vos.UserPreferences{Theme: vos.ThemeDark, Language: vos.LanguageEnUS},
+			wantRebuilds: 0,
+		},
+		{
+			name:         "rebuild error",
+			prefs:        vos.UserPreferences{Theme: vos.ThemeDark, Language: vos.LanguageEnUS, Timezone: "Europe/Lisbon"},
+			rebuildErr:   errors.New("db down"),
+			wantRebuilds: 1,
+			wantErr:      true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			repo := &mockProfileUserRepo{byID: map[uuid.UUID]*entities.User{
+				userID: {ID: userID, Name: "Alice", Preferences: vos.DefaultUserPreferences()},
+			}}
+			rollupRepo := &mockProfileRollupRepo{rebuildErr: tt.rebuildErr}
+
+			transactor := &mockProfileTransactor{}
+
+			uc := domainprofile.NewUpdateProfileUC(tracer, transactor, repo, rollupRepo)
+			prefs := tt.prefs
+			_, err := uc.Execute(context.Background(), userID, domainprofile.UpdateProfileInput{Preferences: &prefs})
+
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("Execute() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if rollupRepo.rebuilds != tt.wantRebuilds {
+				t.Errorf("rebuilds = %d, want %d", rollupRepo.rebuilds, tt.wantRebuilds)
+			}
+			if !repo.updatedInTx {
+				t.Error("expected the preferences to be updated in the unit of work")
+			}
+			wantRollbacks := 0
+			if tt.wantErr {
+				wantRollbacks = 1
+			}
+			if len(transactor.rolledBack) != wantRollbacks {
+				t.Errorf("rollbacks = %v, want %d", transactor.rolledBack, wantRollbacks)
+			}
+		})
+	}
+}
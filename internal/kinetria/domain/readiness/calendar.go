@@ -0,0 +1,8 @@
+package readiness
+
+import "time"
+
+// calendarDay returns t's date as midnight UTC, the representation of calendar days.
+func calendarDay(t time.Time) time.Time {
+	return time.Date(t.Year(), t.Month(), t.Day(), 0, 0, 0, 0, time.UTC)
+}
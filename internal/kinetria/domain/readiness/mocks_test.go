@@ -0,0 +1,74 @@
+package readiness_test
+
+import (
+	"context"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/kinetria/kinetria-back/internal/kinetria/domain/entities"
+	domainerrors "github.com/kinetria/kinetria-back/internal/kinetria/domain/errors"
+	"github.com/kinetria/kinetria-back/internal/kinetria/domain/vos"
+)
+
+// mockReadinessRepo is an in-memory ports.ReadinessRepository.
+type mockReadinessRepo struct {
+	checkIns    []entities.ReadinessCheckIn
+	hrvBaseline *float64
+}
+
+func (m *mockReadinessRepo) Create(_ context.Context, checkIn *entities.ReadinessCheckIn) error {
+	m.checkIns = append(m.checkIns, *checkIn)
+	return nil
+}
+
+func (m *mockReadinessRepo) ListByUser(_ context.Context, _ uuid.UUID, from, to time.Time) ([]entities.ReadinessCheckIn, error) {
+	var out []entities.ReadinessCheckIn
+	for _, c := range m.checkIns {
+		if !c.CheckedAt.Before(from) && !c.CheckedAt.After(to) {
+			out = append(out, c)
+		}
+	}
+	return out, nil
+}
+
+func (m *mockReadinessRepo) FindLatestUnlinked(_ context.Context, _ uuid.UUID, _ time.Time) (*entities.ReadinessCheckIn, error) {
+	return nil, nil
+}
+
+func (m *mockReadinessRepo) LinkSession(_ context.Context, _, _ uuid.UUID, _ *vos.ReadinessAdjustment) error {
+	return nil
+}
+
+func (m *mockReadinessRepo) AverageHRV(_ context.Context, _ uuid.UUID, _, _ time.Time) (*float64, error) {
+	return m.hrvBaseline, nil
+}
+
+// mockAuditRepo is a ports.AuditLogRepository recording the entries appended.
+type mockAuditRepo struct {
+	entries []entities.AuditLog
+}
+
+func (m *mockAuditRepo) Append(_ context.Context, entry *entities.AuditLog) error {
+	m.entries = append(m.entries, *entry)
+	return nil
+}
+
+// mockUserRepo is a ports.UserRepository returning a single user in UTC.
+type mockUserRepo struct {
+	user *entities.User
+}
+
+func newMockUserRepo() *mockUserRepo {
+	prefs := vos.DefaultUserPreferences()
+	prefs.Timezone = "UTC"
+	return &mockUserRepo{user: &entities.User{ID: uuid.New(), Preferences: prefs}}
+}
+
+func (m *mockUserRepo) Create(_ context.Context, _ *entities.User) error { return nil }
+func (m *mockUserRepo) GetByEmail(_ context.Context, _ string) (*entities.User, error) {
+	return nil, domainerrors.ErrNotFound
+}
+func (m *mockUserRepo) GetByID(_ context.Context, _ uuid.UUID) (*entities.User, error) {
+	return m.user, nil
+}
+func (m *mockUserRepo) Update(_ context.Context, _ *entities.User) error { return nil }
@@ -0,0 +1,79 @@
+package readiness
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/kinetria/kinetria-back/internal/kinetria/domain/entities"
+	"github.com/kinetria/kinetria-back/internal/kinetria/domain/ports"
+	"github.com/kinetria/kinetria-back/internal/kinetria/domain/vos"
+)
+
+// hrvBaselineDays is the look-back of the HRV baseline a check-in's HRV is scored against.
+const hrvBaselineDays = 28
+
+// CheckInUC records a pre-workout readiness check-in and scores it.
+type CheckInUC struct {
+	readinessRepo ports.ReadinessRepository
+	auditLogRepo  ports.AuditLogRepository
+}
+
+// NewCheckInUC creates a new CheckInUC.
+func NewCheckInUC(readinessRepo ports.ReadinessRepository, auditLogRepo ports.AuditLogRepository) *CheckInUC {
+	return &CheckInUC{readinessRepo: readinessRepo, auditLogRepo: auditLogRepo}
+}
+
+// Execute validates the markers, scores them (see vos.ReadinessMarkers.Score) and stores
+// the check-in. The HRV baseline is the mean HRV of the user's check-ins in the previous
+// 28 days; without it the HRV is stored but not scored.
+func (uc *CheckInUC) Execute(ctx context.Context, input ports.ReadinessCheckInInput) (*entities.ReadinessCheckIn, error) {
+	if err := input.Markers.Validate(); err != nil {
+		return nil, err
+	}
+
+	now := time.Now()
+	var baseline *float64
+	if input.Markers.HRV != nil {
+		var err error
+		baseline, err = uc.readinessRepo.AverageHRV(ctx, input.UserID, now.AddDate(0, 0, -hrvBaselineDays), now)
+		if err != nil {
+			return nil, fmt.Errorf("failed to get hrv baseline: %w", err)
+		}
+	}
+
+	score := input.Markers.Score(baseline)
+	checkIn := &entities.ReadinessCheckIn{
+		ID:               uuid.New(),
+		UserID:           input.UserID,
+		ReadinessMarkers: input.Markers,
+		Score:            score,
+		Level:            vos.ReadinessLevelFor(score),
+		CheckedAt:        now,
+	}
+	if err := uc.readinessRepo.Create(ctx, checkIn); err != nil {
+		return nil, fmt.Errorf("failed to create readiness check-in: %w", err)
+	}
+
+	actionData, _ := json.Marshal(map[string]interface{}{
+		"sleepHours": checkIn.SleepHours,
+		"stress":     checkIn.Stress,
+		"soreness":   checkIn.Soreness,
+		"hrv":        checkIn.HRV,
+		"score":      checkIn.Score,
+	})
+	auditEntry := entities.AuditLog{
+		ID:         uuid.New(),
+		UserID:     input.UserID,
+		EntityType: "readiness_check_in",
+		EntityID:   checkIn.ID,
+		Action:     "created",
+		ActionData: actionData,
+		OccurredAt: now,
+	}
+	_ = uc.auditLogRepo.Append(ctx, &auditEntry)
+
+	return checkIn, nil
+}
@@ -0,0 +1,74 @@
+package readiness_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/uuid"
+	domainerrors "github.com/kinetria/kinetria-back/internal/kinetria/domain/errors"
+	"github.com/kinetria/kinetria-back/internal/kinetria/domain/ports"
+	"github.com/kinetria/kinetria-back/internal/kinetria/domain/readiness"
+	"github.com/kinetria/kinetria-back/internal/kinetria/domain/vos"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCheckInUC_Execute(t *testing.T) {
+	userID := uuid.New()
+	hrv := func(v int) *int { return &v }
+
+	t.Run("scores_stores_and_audits", func(t *testing.T) {
+		repo := &mockReadinessRepo{}
+		audit := &mockAuditRepo{}
+		uc := readiness.NewCheckInUC(repo, audit)
+
+		out, err := uc.Execute(context.Background(), ports.ReadinessCheckInInput{
+			UserID:  userID,
+			Markers: vos.ReadinessMarkers{SleepHours: 6, Stress: 3, Soreness: 4},
+		})
+		require.NoError(t, err)
+		assert.Equal(t, 53, out.Score)
+		assert.Equal(t, vos.ReadinessLevelModerate, out.Level)
+		assert.Nil(t, out.SessionID)
+		require.Len(t, repo.checkIns, 1)
+		require.Len(t, audit.entries, 1)
+		assert.Equal(t, "readiness_check_in", audit.entries[0].EntityType)
+	})
+
+	t.Run("hrv_scored_against_baseline", func(t *testing.T) {
+		baseline := 60.0
+		uc := readiness.NewCheckInUC(&mockReadinessRepo{hrvBaseline: &baseline}, &mockAuditRepo{})
+
+		out, err := uc.Execute(context.Background(), ports.ReadinessCheckInInput{
+			UserID:  userID,
+			Markers: vos.ReadinessMarkers{SleepHours: 8, Stress: 1, Soreness: 1, HRV: hrv(48)},
+		})
+		require.NoError(t, err)
+		// HRV a 80% da linha de base não pontua: 30 + 20 + 20
+		assert.Equal(t, 70, out.Score)
+	})
+
+	t.Run("hrv_without_baseline", func(t *testing.T) {
+		uc := readiness.NewCheckInUC(&mockReadinessRepo{}, &mockAuditRepo{})
+
+		out, err := uc.Execute(context.Background(), ports.ReadinessCheckInInput{
+			UserID:  userID,
+			Markers: vos.ReadinessMarkers{SleepHours: 8, Stress: 1, Soreness: 1, HRV: hrv(48)},
+		})
+		require.NoError(t, err)
+		assert.Equal(t, 100, out.Score)
+		assert.Equal(t, 48, *out.HRV)
+	})
+
+	t.Run("invalid_markers", func(t *testing.T) {
+		repo := &mockReadinessRepo{}
+		uc := readiness.NewCheckInUC(repo, &mockAuditRepo{})
+
+		_, err := uc.Execute(context.Background(), ports.ReadinessCheckInInput{
+			UserID:  userID,
+			Markers: vos.ReadinessMarkers{SleepHours: 30, Stress: 1, Soreness: 1},
+		})
+		assert.ErrorIs(t, err, domainerrors.ErrMalformedParameters)
+		assert.Empty(t, repo.checkIns)
+	})
+}
@@ -0,0 +1,114 @@
+package readiness
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/kinetria/kinetria-back/internal/kinetria/domain/entities"
+	domainerrors "github.com/kinetria/kinetria-back/internal/kinetria/domain/errors"
+	"github.com/kinetria/kinetria-back/internal/kinetria/domain/ports"
+	"github.com/kinetria/kinetria-back/internal/kinetria/domain/profile"
+)
+
+const (
+	// defaultTrendDays is the range listed when no start date is given.
+	defaultTrendDays = 30
+	// maxTrendDays is the longest range that can be listed at once.
+	maxTrendDays = 366
+)
+
+// ListCheckInsInput selects the days listed. Nil dates default to the last 30 days.
+type ListCheckInsInput struct {
+	UserID uuid.UUID
+	From   *time.Time
+	To     *time.Time
+}
+
+// ReadinessDay is the mean score of the check-ins of one calendar day.
+type ReadinessDay struct {
+	Date     time.Time
+	Score    float64
+	CheckIns int
+}
+
+// ReadinessTrend holds the user's check-ins over a range of days.
+type ReadinessTrend struct {
+	From     time.Time
+	To       time.Time
+	CheckIns []entities.ReadinessCheckIn // mais antigos primeiro
+	Days     []ReadinessDay              // só dias com check-in
+	// AverageScore is the mean score of the range; nil without check-ins.
+	AverageScore *float64
+}
+
+// ListCheckInsUC lists the user's readiness check-ins and their daily trend.
+type ListCheckInsUC struct {
+	readinessRepo ports.ReadinessRepository
+	userRepo      ports.UserRepository
+}
+
+// NewListCheckInsUC creates a new ListCheckInsUC.
+func NewListCheckInsUC(readinessRepo ports.ReadinessRepository, userRepo ports.UserRepository) *ListCheckInsUC {
+	return &ListCheckInsUC{readinessRepo: readinessRepo, userRepo: userRepo}
+}
+
+// Execute returns the check-ins made from From to To (inclusive days in the user's
+// timezone). The range may not be reversed nor longer than 366 days.
+func (uc *ListCheckInsUC) Execute(ctx context.Context, input ListCheckInsInput) (*ReadinessTrend, error) {
+	prefs, err := profile.LoadPreferences(ctx, uc.userRepo, input.UserID)
+	if err != nil {
+		return nil, err
+	}
+	loc := prefs.Location()
+
+	to := calendarDay(time.Now().In(loc))
+	if input.To != nil {
+		to = calendarDay(*input.To)
+	}
+	from := to.AddDate(0, 0, -(defaultTrendDays - 1))
+	if input.From != nil {
+		from = calendarDay(*input.From)
+	}
+
+	if to.Before(from) {
+		return nil, domainerrors.ErrInvalidPeriod
+	}
+	if to.Sub(from) > maxTrendDays*24*time.Hour {
+		return nil, fmt.Errorf("%w: range must not exceed 366 days", domainerrors.ErrMalformedParameters)
+	}
+
+	start := time.Date(from.Year(), from.Month(), from.Day(), 0, 0, 0, 0, loc)
+	end := time.Date(to.Year(), to.Month(), to.Day(), 0, 0, 0, 0, loc).AddDate(0, 0, 1).Add(-time.Nanosecond)
+	checkIns, err := uc.readinessRepo.ListByUser(ctx, input.UserID, start, end)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list readiness check-ins: %w", err)
+	}
+
+	trend := &ReadinessTrend{From: from, To: to, CheckIns: checkIns, Days: []ReadinessDay{}}
+	if trend.CheckIns == nil {
+		trend.CheckIns = []entities.ReadinessCheckIn{}
+	}
+	var total int
+	for _, c := range checkIns {
+		day := calendarDay(c.CheckedAt.In(loc))
+		if n := len(trend.Days); n == 0 || !trend.Days[n-1].Date.Equal(day) {
+			trend.Days = append(trend.Days, ReadinessDay{Date: day})
+		}
+		d := &trend.Days[len(trend.Days)-1]
+		// Média incremental das notas do dia
+		d.CheckIns++
+		d.Score += (float64(c.Score) - d.Score) / float64(d.CheckIns)
+		total += c.Score
+	}
+	for i := range trend.Days {
+		trend.Days[i].Score = math.Round(trend.Days[i].Score*10) / 10
+	}
+	if len(checkIns) > 0 {
+		avg := math.Round(float64(total)/float64(len(checkIns))*10) / 10
+		trend.AverageScore = &avg
+	}
+	return trend, nil
+}
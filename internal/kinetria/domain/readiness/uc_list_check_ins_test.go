@@ -0,0 +1,54 @@
+package readiness_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/kinetria/kinetria-back/internal/kinetria/domain/entities"
+	domainerrors "github.com/kinetria/kinetria-back/internal/kinetria/domain/errors"
+	"github.com/kinetria/kinetria-back/internal/kinetria/domain/readiness"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestListCheckInsUC_Execute(t *testing.T) {
+	userID := uuid.New()
+	day := func(d, h int) time.Time { return time.Date(2026, 3, d, h, 0, 0, 0, time.UTC) }
+	ptr := func(t time.Time) *time.Time { return &t }
+
+	repo := &mockReadinessRepo{checkIns: []entities.ReadinessCheckIn{
+		{ID: uuid.New(), UserID: userID, Score: 40, CheckedAt: day(2, 7)},
+		{ID: uuid.New(), UserID: userID, Score: 60, CheckedAt: day(2, 18)},
+		{ID: uuid.New(), UserID: userID, Score: 85, CheckedAt: day(5, 7)},
+		{ID: uuid.New(), UserID: userID, Score: 90, CheckedAt: day(20, 7)},
+	}}
+	uc := readiness.NewListCheckInsUC(repo, newMockUserRepo())
+
+	t.Run("daily_trend", func(t *testing.T) {
+		trend, err := uc.Execute(context.Background(), readiness.ListCheckInsInput{UserID: userID, From: ptr(day(1, 0)), To: ptr(day(10, 0))})
+		require.NoError(t, err)
+		require.Len(t, trend.CheckIns, 3)
+		require.Len(t, trend.Days, 2)
+		assert.Equal(t, readiness.ReadinessDay{Date: day(2, 0), Score: 50, CheckIns: 2}, trend.Days[0])
+		assert.Equal(t, 85.0, trend.Days[1].Score)
+		require.NotNil(t, trend.AverageScore)
+		assert.Equal(t, 61.7, *trend.AverageScore)
+	})
+
+	t.Run("empty_range", func(t *testing.T) {
+		trend, err := uc.Execute(context.Background(), readiness.ListCheckInsInput{UserID: userID, From: ptr(day(10, 0)), To: ptr(day(15, 0))})
+		require.NoError(t, err)
+		assert.Empty(t, trend.CheckIns)
+		assert.Nil(t, trend.AverageScore)
+	})
+
+	t.Run("invalid_ranges", func(t *testing.T) {
+		_, err := uc.Execute(context.Background(), readiness.ListCheckInsInput{UserID: userID, From: ptr(day(10, 0)), To: ptr(day(1, 0))})
+		assert.ErrorIs(t, err, domainerrors.ErrInvalidPeriod)
+
+		_, err = uc.Execute(context.Background(), readiness.ListCheckInsInput{UserID: userID, From: ptr(day(1, 0).AddDate(-2, 0, 0)), To: ptr(day(1, 0))})
+		assert.ErrorIs(t, err, domainerrors.ErrMalformedParameters)
+	})
+}
@@ -0,0 +1,15 @@
+package sessions
+
+import (
+	"context"
+
+	"github.com/kinetria/kinetria-back/internal/kinetria/domain/ports"
+)
+
+// bestEffort runs a step whose failure must not fail the use case, ignoring its error. The
+// step runs in its own unit of work: inside a transaction, such as the one of a synced
+// operation, that is a savepoint rolled back when it fails, so that a failed statement
+// doesn't abort the enclosing transaction.
+func bestEffort(ctx context.Context, transactor ports.Transactor, step func(ctx context.Context) error) {
+	_ = transactor.WithinTx(ctx, step)
+}
@@ -0,0 +1,73 @@
+package sessions
+
+import (
+	"context"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/kinetria/kinetria-back/internal/kinetria/domain/ports"
+	"github.com/kinetria/kinetria-back/internal/kinetria/domain/vos"
+)
+
+// lastSetGrace is the time credited after the last completed set (final rest and
+// cool-down) before the session counts as idle.
+const lastSetGrace = 3 * time.Minute
+
+// activeDuration returns how long the user was actually training: from the start
+// until the last completed set plus lastSetGrace, capped at finishedAt. Sessions
+// without completed sets count from start to finish.
+func activeDuration(effort ports.SessionEffort, finishedAt time.Time) time.Duration {
+	end := finishedAt
+	if effort.LastSetAt != nil {
+		if lastActive := effort.LastSetAt.Add(lastSetGrace); lastActive.Before(end) {
+			end = lastActive
+		}
+	}
+	if end.Before(effort.StartedAt) {
+		return 0
+	}
+	return end.Sub(effort.StartedAt)
+}
+
+// sessionMET averages the MET of each exercise, weighted by completed sets.
+// Exercises without their own MET use the workout type's.
+func sessionMET(effort ports.SessionEffort) float64 {
+	typeMET := vos.WorkoutType(effort.WorkoutType).MET()
+	var sum float64
+	var sets int
+	for _, ex := range effort.Exercises {
+		if ex.CompletedSets <= 0 {
+			continue
+		}
+		met := typeMET
+		if ex.MET != nil && *ex.MET > 0 {
+			met = *ex.MET
+		}
+		sum += met * float64(ex.CompletedSets)
+		sets += ex.CompletedSets
+	}
+	if sets == 0 {
+		return typeMET
+	}
+	return sum / float64(sets)
+}
+
+// estimateSessionCalories returns the kcal spent in a session finished at finishedAt
+// by a user weighing bodyWeightGrams (<= 0 when unknown).
+func estimateSessionCalories(effort ports.SessionEffort, bodyWeightGrams int, finishedAt time.Time) int {
+	return vos.EstimateCalories(sessionMET(effort), bodyWeightGrams, activeDuration(effort, finishedAt))
+}
+
+// latestBodyWeight returns the user's latest body weight in grams, or 0 when unknown or
+// when repo is nil.
+func latestBodyWeight(ctx context.Context, repo ports.BodyWeightRepository, userID uuid.UUID) (int, error) {
+	if repo == nil {
+		return 0, nil
+	}
+	w, err := repo.GetLatestBodyWeight(ctx, userID)
+	if err != nil || w == nil {
+		return 0, err
+	}
+	return *w, nil
+}
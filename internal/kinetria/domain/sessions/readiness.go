@@ -0,0 +1,98 @@
+package sessions
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/kinetria/kinetria-back/internal/kinetria/domain/entities"
+	"github.com/kinetria/kinetria-back/internal/kinetria/domain/ports"
+	"github.com/kinetria/kinetria-back/internal/kinetria/domain/vos"
+)
+
+// checkInMaxAge is how long a standalone readiness check-in can still start a session.
+const checkInMaxAge = 12 * time.Hour
+
+// ExerciseTarget is the day's prescription of one exercise of the workout. Sets and
+// Weight are the prescribed values scaled by the readiness adjustment, if any.
+type ExerciseTarget struct {
+	ExerciseID       uuid.UUID
+	ExerciseName     string
+	Reps             string
+	PrescribedSets   int
+	PrescribedWeight int // gramas
+	Sets             int
+	Weight           int // gramas
+}
+
+// SessionReadiness is the readiness check-in a session was started with and the
+// resulting targets.
+type SessionReadiness struct {
+	CheckIn entities.ReadinessCheckIn
+	// Adjustment is the adjustment applied to the prescription; nil when none was
+	// requested or readiness was not low enough to need one.
+	Adjustment *vos.ReadinessAdjustment
+	// Reduction is the share the prescription was cut by, 0–1.
+	Reduction float64
+	Targets   []ExerciseTarget
+}
+
+// readinessPlanner resolves the check-in a session starts with and adjusts the day's
+// prescription to it.
+type readinessPlanner struct {
+	readinessRepo ports.ReadinessRepository
+	recorder      ports.ReadinessRecorder
+	workoutRepo   ports.WorkoutRepository
+}
+
+// plan returns the session readiness for the workout: markers are recorded as a new
+// check-in; without them the latest check-in of the last 12 hours not used by another
+// session is taken. It returns nil when there is no check-in.
+func (p readinessPlanner) plan(ctx context.Context, userID, workoutID uuid.UUID, markers *vos.ReadinessMarkers, adjustment *vos.ReadinessAdjustment, now time.Time) (*SessionReadiness, error) {
+	var checkIn *entities.ReadinessCheckIn
+	var err error
+	if markers != nil {
+		checkIn, err = p.recorder.Execute(ctx, ports.ReadinessCheckInInput{UserID: userID, Markers: *markers})
+		if err != nil {
+			return nil, err
+		}
+	} else {
+		checkIn, err = p.readinessRepo.FindLatestUnlinked(ctx, userID, now.Add(-checkInMaxAge))
+		if err != nil {
+			return nil, fmt.Errorf("failed to find readiness check-in: %w", err)
+		}
+	}
+	if checkIn == nil {
+		return nil, nil
+	}
+
+	_, exercises, err := p.workoutRepo.GetByID(ctx, workoutID, userID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get workout exercises: %w", err)
+	}
+
+	out := &SessionReadiness{CheckIn: *checkIn, Targets: make([]ExerciseTarget, 0, len(exercises))}
+	if adjustment != nil {
+		out.Reduction = checkIn.Level.Reduction()
+		if out.Reduction > 0 {
+			out.Adjustment = adjustment
+		}
+	}
+	for _, ex := range exercises {
+		target := ExerciseTarget{
+			ExerciseID:       ex.ID,
+			ExerciseName:     ex.Name,
+			Reps:             ex.Reps,
+			PrescribedSets:   ex.Sets,
+			PrescribedWeight: ex.Weight,
+			Sets:             ex.Sets,
+			Weight:           ex.Weight,
+		}
+		if out.Adjustment != nil {
+			target.Sets, target.Weight = out.Adjustment.Apply(ex.Sets, ex.Weight, out.Reduction)
+		}
+		out.Targets = append(out.Targets, target)
+	}
+	return out, nil
+}
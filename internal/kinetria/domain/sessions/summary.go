@@ -0,0 +1,235 @@
+package sessions
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/kinetria/kinetria-back/internal/kinetria/domain/entities"
+	"github.com/kinetria/kinetria-back/internal/kinetria/domain/ports"
+	"github.com/kinetria/kinetria-back/internal/kinetria/domain/vos"
+)
+
+// Kinds of personal record a session can set.
+const (
+	PRKindWeight = "weight" // série mais pesada do exercício
+	PRKindE1RM   = "e1rm"   // maior 1RM estimada (Epley)
+)
+
+// SessionTotals are the aggregate numbers of a session. Sets, reps and volume count
+// completed sets only.
+type SessionTotals struct {
+	DurationMinutes int
+	TotalSets       int
+	TotalReps       int
+	TotalVolume     int64 // gramas * reps
+}
+
+// ExerciseSummary is the work done on one exercise of the session's workout against
+// its prescription.
+type ExerciseSummary struct {
+	ExerciseID     uuid.UUID
+	ExerciseName   string
+	PrescribedSets int
+	PrescribedReps string
+	CompletedSets  int
+	SkippedSets    int
+	TotalReps      int
+	Volume         int64 // gramas * reps
+	MaxWeight      int   // gramas
+	// CompletionRate is the share of the prescribed sets completed, 0–100.
+	CompletionRate float64
+}
+
+// SessionPR is a personal record set in the session: Value beats Previous, the best of
+// the exercise in the user's earlier completed sessions.
+type SessionPR struct {
+	ExerciseID   uuid.UUID
+	ExerciseName string
+	Kind         string
+	Value        int // gramas
+	Previous     int // gramas
+}
+
+// SessionComparison holds the totals of the previous completed session of the same workout.
+type SessionComparison struct {
+	PreviousSessionID uuid.UUID
+	PreviousStartedAt time.Time
+	Previous          SessionTotals
+}
+
+// SessionSummary is the recap of a session.
+type SessionSummary struct {
+	Session entities.Session
+	SessionTotals
+	// CompletionRate is the share of the workout's prescribed sets completed, 0–100.
+	// Sets beyond an exercise's prescription do not make up for another exercise.
+	CompletionRate  float64
+	Exercises       []ExerciseSummary
+	PersonalRecords []SessionPR
+	// Comparison is nil when the workout had no earlier completed session.
+	Comparison *SessionComparison
+}
+
+// summarizer builds session summaries; it is shared by the use cases that return one.
+type summarizer struct {
+	summaryRepo   ports.SessionSummaryRepository
+	setRecordRepo ports.SetRecordRepository
+}
+
+// summarize builds the summary of session at now (used as the end of a session still active).
+func (s summarizer) summarize(ctx context.Context, session entities.Session, now time.Time) (*SessionSummary, error) {
+	rows, err := s.summaryRepo.ListSessionSets(ctx, session.ID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list session sets: %w", err)
+	}
+	out := &SessionSummary{Session: session}
+	out.Exercises, out.SessionTotals, out.CompletionRate = breakdown(rows)
+	out.DurationMinutes = durationMinutes(session, now)
+
+	// Recordes comparam com as sessões concluídas iniciadas antes desta
+	history, err := s.setRecordRepo.GetExerciseSetSummariesByUser(ctx, session.UserID, time.Time{}, session.StartedAt, time.UTC)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get exercise history: %w", err)
+	}
+	out.PersonalRecords = personalRecords(session.ID, rows, history)
+
+	prev, err := s.summaryRepo.FindPreviousCompleted(ctx, session.UserID, session.WorkoutID, session.StartedAt)
+	if err != nil {
+		return nil, fmt.Errorf("failed to find previous session: %w", err)
+	}
+	if prev != nil {
+		prevRows, err := s.summaryRepo.ListSessionSets(ctx, prev.ID)
+		if err != nil {
+			return nil, fmt.Errorf("failed to list previous session sets: %w", err)
+		}
+		_, totals, _ := breakdown(prevRows)
+		totals.DurationMinutes = durationMinutes(*prev, now)
+		out.Comparison = &SessionComparison{
+			PreviousSessionID: prev.ID,
+			PreviousStartedAt: prev.StartedAt,
+			Previous:          totals,
+		}
+	}
+	return out, nil
+}
+
+// breakdown groups the rows per exercise, in workout order, and returns the per-exercise
+// summaries, the session totals (without duration) and the overall completion rate.
+func breakdown(rows []ports.SessionSetRow) ([]ExerciseSummary, SessionTotals, float64) {
+	exercises := []ExerciseSummary{}
+	index := make(map[uuid.UUID]int)
+	var totals SessionTotals
+	for _, row := range rows {
+		i, ok := index[row.WorkoutExerciseID]
+		if !ok {
+			i = len(exercises)
+			index[row.WorkoutExerciseID] = i
+			exercises = append(exercises, ExerciseSummary{
+				ExerciseID:     row.ExerciseID,
+				ExerciseName:   row.ExerciseName,
+				PrescribedSets: row.PrescribedSets,
+				PrescribedReps: row.PrescribedReps,
+			})
+		}
+		if row.SetNumber == nil {
+			continue
+		}
+		ex := &exercises[i]
+		if row.Status != vos.SetRecordStatusCompleted.String() {
+			ex.SkippedSets++
+			continue
+		}
+		volume := int64(row.Weight) * int64(row.Reps)
+		ex.CompletedSets++
+		ex.TotalReps += row.Reps
+		ex.Volume += volume
+		if row.Weight > ex.MaxWeight {
+			ex.MaxWeight = row.Weight
+		}
+		totals.TotalSets++
+		totals.TotalReps += row.Reps
+		totals.TotalVolume += volume
+	}
+
+	var prescribed, done int
+	for i := range exercises {
+		ex := &exercises[i]
+		ex.CompletionRate = completionRate(ex.CompletedSets, ex.PrescribedSets)
+		prescribed += ex.PrescribedSets
+		done += min(ex.CompletedSets, ex.PrescribedSets)
+	}
+	return exercises, totals, completionRate(done, prescribed)
+}
+
+// completionRate is done/prescribed as a percentage capped at 100, rounded to one decimal.
+func completionRate(done, prescribed int) float64 {
+	if prescribed <= 0 {
+		return 0
+	}
+	pct := math.Min(100, float64(done)/float64(prescribed)*100)
+	return math.Round(pct*10) / 10
+}
+
+// personalRecords returns the exercises of the session whose heaviest set or best
+// estimated 1RM beats the earlier history. An exercise never trained before sets no record.
+func personalRecords(sessionID uuid.UUID, rows []ports.SessionSetRow, history []ports.ExerciseSetSummaryRow) []SessionPR {
+	type best struct{ weight, e1rm int }
+	previous := make(map[uuid.UUID]best)
+	for _, h := range history {
+		if h.SessionID == sessionID {
+			continue
+		}
+		b := previous[h.ExerciseID]
+		b.weight = max(b.weight, h.MaxWeight)
+		b.e1rm = max(b.e1rm, vos.EstimateOneRepMax(h.MaxWeight, h.Reps))
+		previous[h.ExerciseID] = b
+	}
+
+	current := make(map[uuid.UUID]best)
+	var order []uuid.UUID
+	names := make(map[uuid.UUID]string)
+	for _, row := range rows {
+		if row.SetNumber == nil || row.Status != vos.SetRecordStatusCompleted.String() || row.Weight <= 0 {
+			continue
+		}
+		b, ok := current[row.ExerciseID]
+		if !ok {
+			order = append(order, row.ExerciseID)
+			names[row.ExerciseID] = row.ExerciseName
+		}
+		b.weight = max(b.weight, row.Weight)
+		b.e1rm = max(b.e1rm, vos.EstimateOneRepMax(row.Weight, row.Reps))
+		current[row.ExerciseID] = b
+	}
+
+	prs := []SessionPR{}
+	for _, id := range order {
+		prev, ok := previous[id]
+		if !ok {
+			continue
+		}
+		cur := current[id]
+		if cur.weight > prev.weight && prev.weight > 0 {
+			prs = append(prs, SessionPR{ExerciseID: id, ExerciseName: names[id], Kind: PRKindWeight, Value: cur.weight, Previous: prev.weight})
+		}
+		if cur.e1rm > prev.e1rm && prev.e1rm > 0 {
+			prs = append(prs, SessionPR{ExerciseID: id, ExerciseName: names[id], Kind: PRKindE1RM, Value: cur.e1rm, Previous: prev.e1rm})
+		}
+	}
+	return prs
+}
+
+// durationMinutes is the time from start to finish, or to now while the session is active.
+func durationMinutes(session entities.Session, now time.Time) int {
+	end := now
+	if session.FinishedAt != nil {
+		end = *session.FinishedAt
+	}
+	if end.Before(session.StartedAt) {
+		return 0
+	}
+	return int(end.Sub(session.StartedAt).Minutes())
+}
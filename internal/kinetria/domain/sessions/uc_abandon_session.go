@@ -19,6 +19,9 @@ import (
 type AbandonSessionInput struct {
 	UserID    uuid.UUID
 	SessionID uuid.UUID
+	// AbandonedAt is when the session was abandoned; nil means now. It must not be in the
+	// future nor before the session started.
+	AbandonedAt *time.Time
 }
 
 // AbandonSessionOutput represents output after abandoning a session.
@@ -28,16 +31,19 @@ type AbandonSessionOutput struct {
 
 // AbandonSessionUseCase orchestrates abandoning an active session.
 type AbandonSessionUseCase struct {
+	transactor   ports.Transactor
 	sessionRepo  ports.SessionRepository
 	auditLogRepo ports.AuditLogRepository
 }
 
 // NewAbandonSessionUseCase creates a new instance of AbandonSessionUseCase.
 func NewAbandonSessionUseCase(
+	transactor ports.Transactor,
 	sessionRepo ports.SessionRepository,
 	auditLogRepo ports.AuditLogRepository,
 ) *AbandonSessionUseCase {
 	return &AbandonSessionUseCase{
+		transactor:   transactor,
 		sessionRepo:  sessionRepo,
 		auditLogRepo: auditLogRepo,
 	}
@@ -66,10 +72,17 @@ func (uc *AbandonSessionUseCase) Execute(ctx context.Context, input AbandonSessi
 	if session.Status != vos.SessionStatusActive {
 		return AbandonSessionOutput{}, errors.ErrSessionAlreadyClosed
 	}
+	if input.AbandonedAt != nil && (input.AbandonedAt.After(time.Now()) || input.AbandonedAt.Before(session.StartedAt)) {
+		return AbandonSessionOutput{}, fmt.Errorf("abandonedAt must be between the session start and now: %w", errors.ErrMalformedParameters)
+	}
 
 	// Update session
 	now := time.Now()
-	updated, err := uc.sessionRepo.UpdateStatus(ctx, input.SessionID, vos.SessionStatusAbandoned.String(), &now, "")
+	finishedAt := now
+	if input.AbandonedAt != nil {
+		finishedAt = *input.AbandonedAt
+	}
+	updated, err := uc.sessionRepo.UpdateStatus(ctx, input.SessionID, vos.SessionStatusAbandoned.String(), &finishedAt, "")
 	if err != nil {
 		return AbandonSessionOutput{}, fmt.Errorf("failed to update session: %w", err)
 	}
@@ -79,12 +92,12 @@ func (uc *AbandonSessionUseCase) Execute(ctx context.Context, input AbandonSessi
 
 	// Update local entity
 	session.Status = vos.SessionStatusAbandoned
-	session.FinishedAt = &now
+	session.FinishedAt = &finishedAt
 	session.UpdatedAt = now
 
 	// Audit log
 	actionData, _ := json.Marshal(map[string]interface{}{
-		"finishedAt": now,
+		"finishedAt": finishedAt,
 	})
 	auditEntry := entities.AuditLog{
 		ID:         uuid.New(),
@@ -95,7 +108,9 @@ func (uc *AbandonSessionUseCase) Execute(ctx context.Context, input AbandonSessi
 		ActionData: actionData,
 		OccurredAt: now,
 	}
-	_ = uc.auditLogRepo.Append(ctx, &auditEntry)
+	bestEffort(ctx, uc.transactor, func(ctx context.Context) error {
+		return uc.auditLogRepo.Append(ctx, &auditEntry)
+	})
 
 	return AbandonSessionOutput{Session: *session}, nil
 }
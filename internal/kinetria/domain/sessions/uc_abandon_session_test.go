@@ -141,7 +141,7 @@ func TestAbandonSessionUC_Execute(t *testing.T) {
 
 			tt.mockSetup(repo)
 
-			uc := sessions.NewAbandonSessionUseCase(repo, auditRepo)
+			uc := sessions.NewAbandonSessionUseCase(&mockTransactor{}, repo, auditRepo)
 			output, err := uc.Execute(context.Background(), tt.input)
 
 			if tt.expectedError != nil {
@@ -188,7 +188,7 @@ func (m *mockAbandonSessionRepo) UpdateStatus(ctx context.Context, sessionID uui
 	return true, nil
 }
 
-func (m *mockAbandonSessionRepo) GetCompletedSessionsByUserAndDateRange(_ context.Context, _ uuid.UUID, _, _ time.Time) ([]entities.Session, error) {
+func (m *mockAbandonSessionRepo) GetCompletedSessionsByUserAndDateRange(_ context.Context, _ uuid.UUID, _, _ time.Time, _ *time.Location) ([]entities.Session, error) {
 	return nil, nil
 }
 
@@ -196,10 +196,10 @@ func (m *mockAbandonSessionRepo) GetStatsByUserAndPeriod(_ context.Context, _ uu
 	return &ports.SessionStats{}, nil
 }
 
-func (m *mockAbandonSessionRepo) GetFrequencyByUserAndPeriod(_ context.Context, _ uuid.UUID, _, _ time.Time) ([]ports.FrequencyData, error) {
+func (m *mockAbandonSessionRepo) GetFrequencyByUserAndPeriod(_ context.Context, _ uuid.UUID, _, _ time.Time, _ *time.Location) ([]ports.FrequencyData, error) {
 	return nil, nil
 }
 
-func (m *mockAbandonSessionRepo) GetSessionsForStreak(_ context.Context, _ uuid.UUID) ([]time.Time, error) {
+func (m *mockAbandonSessionRepo) GetSessionsForStreak(_ context.Context, _ uuid.UUID, _ *time.Location) ([]time.Time, error) {
 	return nil, nil
 }
@@ -20,27 +20,70 @@ type FinishSessionInput struct {
 	UserID    uuid.UUID
 	SessionID uuid.UUID
 	Notes     string
+	// RPE is the optional session rating of perceived exertion, 1-10.
+	RPE *int
+	// Mood, Energy and SleepQuality are optional 1-5 ratings; Soreness optionally rates
+	// sore muscle groups 1-5. See vos.SessionFeedback.
+	Mood         *int
+	Energy       *int
+	SleepQuality *int
+	Soreness     map[vos.MuscleGroup]int
+	// FinishedAt is when the session ended; nil means now. It must not be in the future nor
+	// before the session started.
+	FinishedAt *time.Time
 }
 
 // FinishSessionOutput represents output after finishing a session.
 type FinishSessionOutput struct {
 	Session entities.Session
+	// Achievements are the badges awarded by this session.
+	Achievements []entities.UserAchievement
+	// Summary is the recap of the finished session; nil if it could not be built.
+	Summary *SessionSummary
 }
 
 // FinishSessionUseCase orchestrates finishing an active session.
+// On finish it estimates the calories spent (MET × body weight × active time),
+// stores them on the session and refreshes the statistics rollups of the session's day,
+// all in one transaction with the status change.
+// It returns the session summary along with the finished session.
 type FinishSessionUseCase struct {
-	sessionRepo  ports.SessionRepository
-	auditLogRepo ports.AuditLogRepository
+	transactor     ports.Transactor
+	sessionRepo    ports.SessionRepository
+	effortRepo     ports.SessionEffortRepository
+	bodyWeightRepo ports.BodyWeightRepository
+	rollupRepo     ports.StatsRollupRepository
+	auditLogRepo   ports.AuditLogRepository
+	summarizer     summarizer
+	achievements   ports.AchievementEvaluator
+	goals          ports.GoalEvaluator
 }
 
 // NewFinishSessionUseCase creates a new instance of FinishSessionUseCase.
+// bodyWeightRepo may be nil, in which case the reference body weight is used;
+// achievements and goals may be nil, in which case they are not evaluated.
 func NewFinishSessionUseCase(
+	transactor ports.Transactor,
 	sessionRepo ports.SessionRepository,
+	effortRepo ports.SessionEffortRepository,
+	bodyWeightRepo ports.BodyWeightRepository,
+	rollupRepo ports.StatsRollupRepository,
 	auditLogRepo ports.AuditLogRepository,
+	summaryRepo ports.SessionSummaryRepository,
+	setRecordRepo ports.SetRecordRepository,
+	achievements ports.AchievementEvaluator,
+	goals ports.GoalEvaluator,
 ) *FinishSessionUseCase {
 	return &FinishSessionUseCase{
-		sessionRepo:  sessionRepo,
-		auditLogRepo: auditLogRepo,
+		transactor:     transactor,
+		sessionRepo:    sessionRepo,
+		effortRepo:     effortRepo,
+		bodyWeightRepo: bodyWeightRepo,
+		rollupRepo:     rollupRepo,
+		auditLogRepo:   auditLogRepo,
+		summarizer:     summarizer{summaryRepo: summaryRepo, setRecordRepo: setRecordRepo},
+		achievements:   achievements,
+		goals:          goals,
 	}
 }
 
@@ -50,6 +93,16 @@ func (uc *FinishSessionUseCase) Execute(ctx context.Context, input FinishSession
 	if input.SessionID == uuid.Nil {
 		return FinishSessionOutput{}, errors.ErrMalformedParameters
 	}
+	feedback := vos.SessionFeedback{
+		RPE:          input.RPE,
+		Mood:         input.Mood,
+		Energy:       input.Energy,
+		SleepQuality: input.SleepQuality,
+		Soreness:     input.Soreness,
+	}
+	if err := feedback.Validate(); err != nil {
+		return FinishSessionOutput{}, err
+	}
 
 	// Find session and validate ownership
 	session, err := uc.sessionRepo.FindByID(ctx, input.SessionID)
@@ -67,27 +120,75 @@ func (uc *FinishSessionUseCase) Execute(ctx context.Context, input FinishSession
 	if session.Status != vos.SessionStatusActive {
 		return FinishSessionOutput{}, errors.ErrSessionAlreadyClosed
 	}
+	if input.FinishedAt != nil && (input.FinishedAt.After(time.Now()) || input.FinishedAt.Before(session.StartedAt)) {
+		return FinishSessionOutput{}, fmt.Errorf("finishedAt must be between the session start and now: %w", errors.ErrMalformedParameters)
+	}
+
+	// Gather effort data before closing so a failure leaves the session active
+	effort, err := uc.effortRepo.GetSessionEffort(ctx, input.SessionID)
+	if err != nil {
+		return FinishSessionOutput{}, fmt.Errorf("failed to get session effort: %w", err)
+	}
+	bodyWeight, err := latestBodyWeight(ctx, uc.bodyWeightRepo, input.UserID)
+	if err != nil {
+		return FinishSessionOutput{}, fmt.Errorf("failed to get body weight: %w", err)
+	}
 
 	// Update session
 	now := time.Now()
-	updated, err := uc.sessionRepo.UpdateStatus(ctx, input.SessionID, vos.SessionStatusCompleted.String(), &now, input.Notes)
-	if err != nil {
-		return FinishSessionOutput{}, fmt.Errorf("failed to update session: %w", err)
+	finishedAt := now
+	if input.FinishedAt != nil {
+		finishedAt = *input.FinishedAt
 	}
-	if !updated {
-		return FinishSessionOutput{}, errors.ErrSessionAlreadyClosed
+	calories := estimateSessionCalories(*effort, bodyWeight, finishedAt)
+
+	// Status, calorias, feedback e rollups são gravados juntos ou nenhum deles
+	err = uc.transactor.WithinTx(ctx, func(ctx context.Context) error {
+		updated, err := uc.sessionRepo.UpdateStatus(ctx, input.SessionID, vos.SessionStatusCompleted.String(), &finishedAt, input.Notes)
+		if err != nil {
+			return fmt.Errorf("failed to update session: %w", err)
+		}
+		if !updated {
+			return errors.ErrSessionAlreadyClosed
+		}
+
+		if err := uc.effortRepo.SetSessionCalories(ctx, input.SessionID, calories); err != nil {
+			return fmt.Errorf("failed to store session calories: %w", err)
+		}
+		if !feedback.IsZero() {
+			if err := uc.effortRepo.SetSessionFeedback(ctx, input.SessionID, feedback); err != nil {
+				return fmt.Errorf("failed to store session feedback: %w", err)
+			}
+		}
+
+		// Estatísticas e dashboard leem dos rollups; o dia é o de início da sessão
+		if err := uc.rollupRepo.RefreshDay(ctx, input.UserID, session.StartedAt); err != nil {
+			return fmt.Errorf("failed to refresh stats rollups: %w", err)
+		}
+		return nil
+	})
+	if err != nil {
+		return FinishSessionOutput{}, err
 	}
 
 	// Update local entity
 	session.Status = vos.SessionStatusCompleted
-	session.FinishedAt = &now
+	session.Calories = &calories
+	session.RPE = input.RPE
+	session.FinishedAt = &finishedAt
 	session.Notes = input.Notes
 	session.UpdatedAt = now
 
 	// Audit log
 	actionData, _ := json.Marshal(map[string]interface{}{
-		"finishedAt": now,
-		"notes":      input.Notes,
+		"finishedAt":   finishedAt,
+		"notes":        input.Notes,
+		"calories":     calories,
+		"rpe":          input.RPE,
+		"mood":         input.Mood,
+		"energy":       input.Energy,
+		"sleepQuality": input.SleepQuality,
+		"soreness":     input.Soreness,
 	})
 	auditEntry := entities.AuditLog{
 		ID:         uuid.New(),
@@ -98,7 +199,39 @@ func (uc *FinishSessionUseCase) Execute(ctx context.Context, input FinishSession
 		ActionData: actionData,
 		OccurredAt: now,
 	}
-	_ = uc.auditLogRepo.Append(ctx, &auditEntry)
+	bestEffort(ctx, uc.transactor, func(ctx context.Context) error {
+		return uc.auditLogRepo.Append(ctx, &auditEntry)
+	})
+
+	// Conquistas são best-effort: uma falha não desfaz a sessão concluída
+	var awarded []entities.UserAchievement
+	if uc.achievements != nil {
+		bestEffort(ctx, uc.transactor, func(ctx context.Context) error {
+			a, err := uc.achievements.Execute(ctx, input.UserID)
+			if err != nil {
+				return err
+			}
+			awarded = a
+			return nil
+		})
+	}
+	if uc.goals != nil {
+		bestEffort(ctx, uc.transactor, func(ctx context.Context) error {
+			_, err := uc.goals.Execute(ctx, input.UserID)
+			return err
+		})
+	}
+
+	// O resumo também é best-effort: a sessão já está concluída e pode ser consultada depois
+	var summary *SessionSummary
+	bestEffort(ctx, uc.transactor, func(ctx context.Context) error {
+		s, err := uc.summarizer.summarize(ctx, *session, now)
+		if err != nil {
+			return err
+		}
+		summary = s
+		return nil
+	})
 
-	return FinishSessionOutput{Session: *session}, nil
+	return FinishSessionOutput{Session: *session, Achievements: awarded, Summary: summary}, nil
 }
@@ -60,6 +60,26 @@ func TestFinishSessionUC_Execute(t *testing.T) {
 			mockSetup:     func(r *mockFinishSessionRepo) {},
 			expectedError: domainerrors.ErrMalformedParameters,
 		},
+		{
+			name: "error - rpe out of range",
+			input: sessions.FinishSessionInput{
+				UserID:    userID,
+				SessionID: sessionID,
+				RPE:       intPtr(11),
+			},
+			mockSetup:     func(r *mockFinishSessionRepo) {},
+			expectedError: domainerrors.ErrMalformedParameters,
+		},
+		{
+			name: "error - mood out of range",
+			input: sessions.FinishSessionInput{
+				UserID:    userID,
+				SessionID: sessionID,
+				Mood:      intPtr(6),
+			},
+			mockSetup:     func(r *mockFinishSessionRepo) {},
+			expectedError: domainerrors.ErrMalformedParameters,
+		},
 		{
 			name: "error - session not found (sql.ErrNoRows)",
 			input: sessions.FinishSessionInput{
@@ -142,7 +162,7 @@ func TestFinishSessionUC_Execute(t *testing.T) {
 
 			tt.mockSetup(repo)
 
-			uc := sessions.NewFinishSessionUseCase(repo, auditRepo)
+			uc := sessions.NewFinishSessionUseCase(&mockTransactor{}, repo, &mockSessionEffortRepo{}, nil, &mockStatsRollupRepo{}, auditRepo, &mockSessionSummaryRepo{}, &mockSetRecordRepo{}, nil, nil)
 			output, err := uc.Execute(context.Background(), tt.input)
 
 			if tt.expectedError != nil {
@@ -189,7 +209,7 @@ func (m *mockFinishSessionRepo) UpdateStatus(ctx context.Context, sessionID uuid
 	return true, nil
 }
 
-func (m *mockFinishSessionRepo) GetCompletedSessionsByUserAndDateRange(_ context.Context, _ uuid.UUID, _, _ time.Time) ([]entities.Session, error) {
+func (m *mockFinishSessionRepo) GetCompletedSessionsByUserAndDateRange(_ context.Context, _ uuid.UUID, _, _ time.Time, _ *time.Location) ([]entities.Session, error) {
 	return nil, nil
 }
 
@@ -197,10 +217,408 @@ func (m *mockFinishSessionRepo) GetStatsByUserAndPeriod(_ context.Context, _ uui
 	return &ports.SessionStats{}, nil
 }
 
-func (m *mockFinishSessionRepo) GetFrequencyByUserAndPeriod(_ context.Context, _ uuid.UUID, _, _ time.Time) ([]ports.FrequencyData, error) {
+func (m *mockFinishSessionRepo) GetFrequencyByUserAndPeriod(_ context.Context, _ uuid.UUID, _, _ time.Time, _ *time.Location) ([]ports.FrequencyData, error) {
 	return nil, nil
 }
 
-func (m *mockFinishSessionRepo) GetSessionsForStreak(_ context.Context, _ uuid.UUID) ([]time.Time, error) {
+func (m *mockFinishSessionRepo) GetSessionsForStreak(_ context.Context, _ uuid.UUID, _ *time.Location) ([]time.Time, error) {
 	return nil, nil
 }
+
+func TestFinishSessionUC_Execute_StoresCalories(t *testing.T) {
+	userID := uuid.New()
+	sessionID := uuid.New()
+	startedAt := time.Now().Add(-2 * time.Hour)
+	lastSetAt := startedAt.Add(57 * time.Minute) // ativo por 1h com a tolerância de 3min
+	strengthMET := 3.5
+
+	repo := &mockFinishSessionRepo{
+		findByID: func(ctx context.Context, id uuid.UUID) (*entities.Session, error) {
+			return &entities.Session{ID: sessionID, UserID: userID, Status: vos.SessionStatusActive, StartedAt: startedAt}, nil
+		},
+	}
+	effortRepo := &mockSessionEffortRepo{
+		effort: &ports.SessionEffort{
+			WorkoutType: string(vos.WorkoutTypeForca),
+			StartedAt:   startedAt,
+			LastSetAt:   &lastSetAt,
+			Exercises: []ports.ExerciseEffort{
+				{MET: &strengthMET, CompletedSets: 2},
+				{CompletedSets: 2}, // sem MET próprio: usa o do tipo (6.0)
+			},
+		},
+	}
+	weight := 80000
+	bodyWeightRepo := &mockBodyWeightRepo{weight: &weight}
+	auditRepo := &mockAuditRepo{append: func(ctx context.Context, entry *entities.AuditLog) error { return nil }}
+
+	uc := sessions.NewFinishSessionUseCase(&mockTransactor{}, repo, effortRepo, bodyWeightRepo, &mockStatsRollupRepo{}, auditRepo, &mockSessionSummaryRepo{}, &mockSetRecordRepo{}, nil, nil)
+	output, err := uc.Execute(context.Background(), sessions.FinishSessionInput{UserID: userID, SessionID: sessionID})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	// MET médio (3.5×2 + 6×2)/4 = 4.75 × 80kg × 1h = 380 kcal
+	if effortRepo.storedCalories == nil || *effortRepo.storedCalories != 380 {
+		t.Fatalf("expected 380 kcal stored, got %v", effortRepo.storedCalories)
+	}
+	if output.Session.Calories == nil || *output.Session.Calories != 380 {
+		t.Errorf("expected session calories 380, got %v", output.Session.Calories)
+	}
+}
+
+func TestFinishSessionUC_Execute_StoresRPE(t *testing.T) {
+	userID := uuid.New()
+	sessionID := uuid.New()
+
+	repo := &mockFinishSessionRepo{
+		findByID: func(ctx context.Context, id uuid.UUID) (*entities.Session, error) {
+			return &entities.Session{ID: sessionID, UserID: userID, Status: vos.SessionStatusActive, StartedAt: time.Now().Add(-time.Hour)}, nil
+		},
+	}
+	effortRepo := &mockSessionEffortRepo{}
+	auditRepo := &mockAuditRepo{append: func(ctx context.Context, entry *entities.AuditLog) error { return nil }}
+
+	uc := sessions.NewFinishSessionUseCase(&mockTransactor{}, repo, effortRepo, nil, &mockStatsRollupRepo{}, auditRepo, &mockSessionSummaryRepo{}, &mockSetRecordRepo{}, nil, nil)
+	output, err := uc.Execute(context.Background(), sessions.FinishSessionInput{UserID: userID, SessionID: sessionID, RPE: intPtr(8)})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if effortRepo.storedFeedback == nil || effortRepo.storedFeedback.RPE == nil || *effortRepo.storedFeedback.RPE != 8 {
+		t.Errorf("expected RPE 8 stored, got %+v", effortRepo.storedFeedback)
+	}
+	if output.Session.RPE == nil || *output.Session.RPE != 8 {
+		t.Errorf("expected session RPE 8, got %v", output.Session.RPE)
+	}
+}
+
+func TestFinishSessionUC_Execute_StoresFeedback(t *testing.T) {
+	userID := uuid.New()
+	sessionID := uuid.New()
+
+	repo := &mockFinishSessionRepo{
+		findByID: func(ctx context.Context, id uuid.UUID) (*entities.Session, error) {
+			return &entities.Session{ID: sessionID, UserID: userID, Status: vos.SessionStatusActive, StartedAt: time.Now().Add(-time.Hour)}, nil
+		},
+	}
+	effortRepo := &mockSessionEffortRepo{}
+	auditRepo := &mockAuditRepo{append: func(ctx context.Context, entry *entities.AuditLog) error { return nil }}
+
+	uc := sessions.NewFinishSessionUseCase(&mockTransactor{}, repo, effortRepo, nil, &mockStatsRollupRepo{}, auditRepo, &mockSessionSummaryRepo{}, &mockSetRecordRepo{}, nil, nil)
+	_, err := uc.Execute(context.Background(), sessions.FinishSessionInput{
+		UserID:       userID,
+		SessionID:    sessionID,
+		Mood:         intPtr(4),
+		SleepQuality: intPtr(2),
+		Soreness:     map[vos.MuscleGroup]int{vos.MuscleGroupQuadriceps: 3},
+	})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	fb := effortRepo.storedFeedback
+	if fb == nil || fb.RPE != nil || *fb.Mood != 4 || fb.Energy != nil || *fb.SleepQuality != 2 {
+		t.Fatalf("unexpected stored feedback: %+v", fb)
+	}
+	if fb.Soreness[vos.MuscleGroupQuadriceps] != 3 {
+		t.Errorf("expected quadriceps soreness 3, got %v", fb.Soreness)
+	}
+}
+
+func TestFinishSessionUC_Execute_WithoutFeedbackStoresNothing(t *testing.T) {
+	userID := uuid.New()
+	sessionID := uuid.New()
+
+	repo := &mockFinishSessionRepo{
+		findByID: func(ctx context.Context, id uuid.UUID) (*entities.Session, error) {
+			return &entities.Session{ID: sessionID, UserID: userID, Status: vos.SessionStatusActive, StartedAt: time.Now().Add(-time.Hour)}, nil
+		},
+	}
+	effortRepo := &mockSessionEffortRepo{}
+	auditRepo := &mockAuditRepo{append: func(ctx context.Context, entry *entities.AuditLog) error { return nil }}
+
+	uc := sessions.NewFinishSessionUseCase(&mockTransactor{}, repo, effortRepo, nil, &mockStatsRollupRepo{}, auditRepo, &mockSessionSummaryRepo{}, &mockSetRecordRepo{}, nil, nil)
+	if _, err := uc.Execute(context.Background(), sessions.FinishSessionInput{UserID: userID, SessionID: sessionID}); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if effortRepo.storedFeedback != nil {
+		t.Errorf("expected no feedback stored, got %+v", effortRepo.storedFeedback)
+	}
+}
+
+func TestFinishSessionUC_Execute_CaloriesWithoutSetsOrWeight(t *testing.T) {
+	userID := uuid.New()
+	sessionID := uuid.New()
+	startedAt := time.Now().Add(-30 * time.Minute)
+
+	repo := &mockFinishSessionRepo{
+		findByID: func(ctx context.Context, id uuid.UUID) (*entities.Session, error) {
+			return &entities.Session{ID: sessionID, UserID: userID, Status: vos.SessionStatusActive, StartedAt: startedAt}, nil
+		},
+	}
+	effortRepo := &mockSessionEffortRepo{
+		effort: &ports.SessionEffort{WorkoutType: string(vos.WorkoutTypeMobilidade), StartedAt: startedAt},
+	}
+	auditRepo := &mockAuditRepo{append: func(ctx context.Context, entry *entities.AuditLog) error { return nil }}
+
+	uc := sessions.NewFinishSessionUseCase(&mockTransactor{}, repo, effortRepo, &mockBodyWeightRepo{}, &mockStatsRollupRepo{}, auditRepo, &mockSessionSummaryRepo{}, &mockSetRecordRepo{}, nil, nil)
+	if _, err := uc.Execute(context.Background(), sessions.FinishSessionInput{UserID: userID, SessionID: sessionID}); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	// 2.5 MET × 70kg (padrão) × 0.5h ≈ 88 kcal
+	if effortRepo.storedCalories == nil || *effortRepo.storedCalories != 88 {
+		t.Errorf("expected 88 kcal stored, got %v", effortRepo.storedCalories)
+	}
+}
+
+func TestFinishSessionUC_Execute_EffortError(t *testing.T) {
+	userID := uuid.New()
+	sessionID := uuid.New()
+	updated := false
+
+	repo := &mockFinishSessionRepo{
+		findByID: func(ctx context.Context, id uuid.UUID) (*entities.Session, error) {
+			return &entities.Session{ID: sessionID, UserID: userID, Status: vos.SessionStatusActive, StartedAt: time.Now()}, nil
+		},
+		updateStatus: func(ctx context.Context, id uuid.UUID, status string, finishedAt *time.Time, notes string) (bool, error) {
+			updated = true
+			return true, nil
+		},
+	}
+	effortRepo := &mockSessionEffortRepo{err: errors.New("db down")}
+	auditRepo := &mockAuditRepo{append: func(ctx context.Context, entry *entities.AuditLog) error { return nil }}
+
+	uc := sessions.NewFinishSessionUseCase(&mockTransactor{}, repo, effortRepo, nil, &mockStatsRollupRepo{}, auditRepo, &mockSessionSummaryRepo{}, &mockSetRecordRepo{}, nil, nil)
+	if _, err := uc.Execute(context.Background(), sessions.FinishSessionInput{UserID: userID, SessionID: sessionID}); err == nil {
+		t.Fatal("expected error")
+	}
+	if updated {
+		t.Error("session must stay active when effort cannot be read")
+	}
+}
+
+func TestFinishSessionUC_Execute_RefreshesRollups(t *testing.T) {
+	userID := uuid.New()
+	sessionID := uuid.New()
+	startedAt := time.Now().Add(-time.Hour)
+
+	repo := &mockFinishSessionRepo{
+		findByID: func(ctx context.Context, id uuid.UUID) (*entities.Session, error) {
+			return &entities.Session{ID: sessionID, UserID: userID, Status: vos.SessionStatusActive, StartedAt: startedAt}, nil
+		},
+	}
+	auditRepo := &mockAuditRepo{append: func(ctx context.Context, entry *entities.AuditLog) error { return nil }}
+
+	t.Run("refreshes the session day", func(t *testing.T) {
+		rollupRepo := &mockStatsRollupRepo{}
+		uc := sessions.NewFinishSessionUseCase(&mockTransactor{}, repo, &mockSessionEffortRepo{}, nil, rollupRepo, auditRepo, &mockSessionSummaryRepo{}, &mockSetRecordRepo{}, nil, nil)
+		if _, err := uc.Execute(context.Background(), sessions.FinishSessionInput{UserID: userID, SessionID: sessionID}); err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if len(rollupRepo.refreshed) != 1 || !rollupRepo.refreshed[0].Equal(startedAt) {
+			t.Errorf("expected rollups refreshed at %v, got %v", startedAt, rollupRepo.refreshed)
+		}
+	})
+
+	t.Run("refresh error rolls back the finish", func(t *testing.T) {
+		var updateInTx bool
+		repo := &mockFinishSessionRepo{
+			findByID: repo.findByID,
+			updateStatus: func(ctx context.Context, id uuid.UUID, status string, finishedAt *time.Time, notes string) (bool, error) {
+				updateInTx = inUnitOfWork(ctx)
+				return true, nil
+			},
+		}
+		rollupRepo := &mockStatsRollupRepo{err: errors.New("db down")}
+		transactor := &mockTransactor{}
+		uc := sessions.NewFinishSessionUseCase(transactor, repo, &mockSessionEffortRepo{}, nil, rollupRepo, auditRepo, &mockSessionSummaryRepo{}, &mockSetRecordRepo{}, nil, nil)
+		if _, err := uc.Execute(context.Background(), sessions.FinishSessionInput{UserID: userID, SessionID: sessionID}); err == nil {
+			t.Fatal("expected error")
+		}
+		if !updateInTx {
+			t.Error("expected the status update in the unit of work")
+		}
+		if len(transactor.rolledBack) != 1 {
+			t.Errorf("expected the unit of work to be rolled back, got %v", transactor.rolledBack)
+		}
+	})
+}
+
+func TestFinishSessionUC_Execute_ReturnsSummary(t *testing.T) {
+	userID := uuid.New()
+	sessionID := uuid.New()
+	weID := uuid.New()
+
+	repo := &mockFinishSessionRepo{
+		findByID: func(ctx context.Context, id uuid.UUID) (*entities.Session, error) {
+			return &entities.Session{ID: sessionID, UserID: userID, Status: vos.SessionStatusActive, StartedAt: time.Now().Add(-45 * time.Minute)}, nil
+		},
+	}
+	auditRepo := &mockAuditRepo{append: func(ctx context.Context, entry *entities.AuditLog) error { return nil }}
+	summaryRepo := &mockSessionSummaryRepo{sets: map[uuid.UUID][]ports.SessionSetRow{
+		sessionID: {{WorkoutExerciseID: weID, ExerciseID: uuid.New(), PrescribedSets: 2, SetNumber: intPtr(1), Weight: 50000, Reps: 10, Status: "completed"}},
+	}}
+
+	uc := sessions.NewFinishSessionUseCase(&mockTransactor{}, repo, &mockSessionEffortRepo{}, nil, &mockStatsRollupRepo{}, auditRepo, summaryRepo, &mockSetRecordRepo{}, nil, nil)
+	out, err := uc.Execute(context.Background(), sessions.FinishSessionInput{UserID: userID, SessionID: sessionID})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if out.Summary == nil {
+		t.Fatal("expected a summary")
+	}
+	if out.Summary.DurationMinutes != 45 || out.Summary.TotalVolume != 500000 || out.Summary.CompletionRate != 50 {
+		t.Errorf("unexpected summary: %+v", out.Summary.SessionTotals)
+	}
+	if out.Summary.Session.Status != vos.SessionStatusCompleted {
+		t.Errorf("expected the summary of the completed session, got %s", out.Summary.Session.Status)
+	}
+}
+
+func TestFinishSessionUC_Execute_EvaluatesAchievements(t *testing.T) {
+	userID := uuid.New()
+	sessionID := uuid.New()
+
+	repo := &mockFinishSessionRepo{
+		findByID: func(ctx context.Context, id uuid.UUID) (*entities.Session, error) {
+			return &entities.Session{ID: sessionID, UserID: userID, Status: vos.SessionStatusActive, StartedAt: time.Now()}, nil
+		},
+	}
+	auditRepo := &mockAuditRepo{append: func(ctx context.Context, entry *entities.AuditLog) error { return nil }}
+
+	t.Run("returns awarded achievements", func(t *testing.T) {
+		evaluator := &mockAchievementEvaluator{awarded: []entities.UserAchievement{{UserID: userID, Code: "first_workout"}}}
+		uc := sessions.NewFinishSessionUseCase(&mockTransactor{}, repo, &mockSessionEffortRepo{}, nil, &mockStatsRollupRepo{}, auditRepo, &mockSessionSummaryRepo{}, &mockSetRecordRepo{}, evaluator, nil)
+		out, err := uc.Execute(context.Background(), sessions.FinishSessionInput{UserID: userID, SessionID: sessionID})
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if evaluator.calls != 1 {
+			t.Errorf("expected 1 evaluation, got %d", evaluator.calls)
+		}
+		if len(out.Achievements) != 1 || out.Achievements[0].Code != "first_workout" {
+			t.Errorf("expected first_workout, got %v", out.Achievements)
+		}
+	})
+
+	t.Run("evaluation error does not fail the finish", func(t *testing.T) {
+		evaluator := &mockAchievementEvaluator{err: errors.New("db down")}
+		uc := sessions.NewFinishSessionUseCase(&mockTransactor{}, repo, &mockSessionEffortRepo{}, nil, &mockStatsRollupRepo{}, auditRepo, &mockSessionSummaryRepo{}, &mockSetRecordRepo{}, evaluator, nil)
+		out, err := uc.Execute(context.Background(), sessions.FinishSessionInput{UserID: userID, SessionID: sessionID})
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if len(out.Achievements) != 0 {
+			t.Errorf("expected no achievements, got %v", out.Achievements)
+		}
+	})
+}
+
+// mockAchievementEvaluator is a mock AchievementEvaluator returning fixed achievements.
+type mockAchievementEvaluator struct {
+	awarded []entities.UserAchievement
+	err     error
+	calls   int
+}
+
+func (m *mockAchievementEvaluator) Execute(_ context.Context, _ uuid.UUID) ([]entities.UserAchievement, error) {
+	m.calls++
+	if m.err != nil {
+		return nil, m.err
+	}
+	return m.awarded, nil
+}
+
+// unitOfWorkKey marks the contexts passed to units of work by mockTransactor.
+type unitOfWorkKey struct{}
+
+func inUnitOfWork(ctx context.Context) bool {
+	return ctx.Value(unitOfWorkKey{}) != nil
+}
+
+// mockTransactor is a mock Transactor that runs each unit of work directly and records the
+// errors of those a database would have rolled back.
+type mockTransactor struct {
+	rolledBack []error
+}
+
+func (m *mockTransactor) WithinTx(ctx context.Context, fn func(ctx context.Context) error) error {
+	if err := fn(context.WithValue(ctx, unitOfWorkKey{}, true)); err != nil {
+		m.rolledBack = append(m.rolledBack, err)
+		return err
+	}
+	return nil
+}
+
+// mockStatsRollupRepo is a mock StatsRollupRepository that records the refreshed instants.
+type mockStatsRollupRepo struct {
+	refreshed []time.Time
+	err       error
+}
+
+func (m *mockStatsRollupRepo) RefreshDay(_ context.Context, _ uuid.UUID, at time.Time) error {
+	m.refreshed = append(m.refreshed, at)
+	return m.err
+}
+
+func (m *mockStatsRollupRepo) RebuildUser(_ context.Context, _ uuid.UUID) error {
+	return m.err
+}
+
+func (m *mockStatsRollupRepo) ListUserIDs(_ context.Context) ([]uuid.UUID, error) {
+	return nil, m.err
+}
+
+// mockSessionEffortRepo is a mock SessionEffortRepository that records the stored calories and feedback.
+type mockSessionEffortRepo struct {
+	effort         *ports.SessionEffort
+	err            error
+	storedCalories *int
+	storedFeedback *vos.SessionFeedback
+}
+
+func (m *mockSessionEffortRepo) GetSessionEffort(_ context.Context, _ uuid.UUID) (*ports.SessionEffort, error) {
+	if m.err != nil {
+		return nil, m.err
+	}
+	if m.effort == nil {
+		return &ports.SessionEffort{StartedAt: time.Now()}, nil
+	}
+	return m.effort, nil
+}
+
+func (m *mockSessionEffortRepo) SetSessionCalories(_ context.Context, _ uuid.UUID, kcal int) error {
+	m.storedCalories = &kcal
+	return nil
+}
+
+func (m *mockSessionEffortRepo) SetSessionFeedback(_ context.Context, _ uuid.UUID, feedback vos.SessionFeedback) error {
+	m.storedFeedback = &feedback
+	return nil
+}
+
+func (m *mockSessionEffortRepo) GetSessionFeedback(_ context.Context, _ uuid.UUID) (*vos.SessionFeedback, error) {
+	if m.storedFeedback == nil {
+		return &vos.SessionFeedback{Soreness: map[vos.MuscleGroup]int{}}, nil
+	}
+	return m.storedFeedback, nil
+}
+
+func (m *mockSessionEffortRepo) ListSessionLoads(_ context.Context, _ uuid.UUID, _, _ time.Time, _ *time.Location) ([]ports.SessionLoad, error) {
+	return nil, nil
+}
+
+// mockBodyWeightRepo is a mock BodyWeightRepository.
+type mockBodyWeightRepo struct {
+	weight *int
+}
+
+func (m *mockBodyWeightRepo) GetLatestBodyWeight(_ context.Context, _ uuid.UUID) (*int, error) {
+	return m.weight, nil
+}
+
+func (m *mockBodyWeightRepo) ListBodyWeights(_ context.Context, _ uuid.UUID, _, _ time.Time) ([]ports.BodyWeightEntry, error) {
+	return nil, nil
+}
+
+func intPtr(v int) *int { return &v }
@@ -0,0 +1,44 @@
+package sessions
+
+import (
+	"context"
+	"database/sql"
+	stdErrors "errors"
+	"fmt"
+
+	"github.com/google/uuid"
+	"github.com/kinetria/kinetria-back/internal/kinetria/domain/errors"
+	"github.com/kinetria/kinetria-back/internal/kinetria/domain/ports"
+	"github.com/kinetria/kinetria-back/internal/kinetria/domain/vos"
+)
+
+// GetSessionFeedbackUC returns the post-session feedback of one of the user's sessions.
+type GetSessionFeedbackUC struct {
+	sessionRepo ports.SessionRepository
+	effortRepo  ports.SessionEffortRepository
+}
+
+// NewGetSessionFeedbackUC creates a new GetSessionFeedbackUC.
+func NewGetSessionFeedbackUC(sessionRepo ports.SessionRepository, effortRepo ports.SessionEffortRepository) *GetSessionFeedbackUC {
+	return &GetSessionFeedbackUC{sessionRepo: sessionRepo, effortRepo: effortRepo}
+}
+
+// Execute returns the feedback of the session; a session without feedback has every rating nil.
+func (uc *GetSessionFeedbackUC) Execute(ctx context.Context, userID, sessionID uuid.UUID) (*vos.SessionFeedback, error) {
+	session, err := uc.sessionRepo.FindByID(ctx, sessionID)
+	if err != nil {
+		if stdErrors.Is(err, sql.ErrNoRows) {
+			return nil, errors.ErrNotFound
+		}
+		return nil, fmt.Errorf("failed to find session: %w", err)
+	}
+	if session == nil || session.UserID != userID {
+		return nil, errors.ErrNotFound
+	}
+
+	feedback, err := uc.effortRepo.GetSessionFeedback(ctx, sessionID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get session feedback: %w", err)
+	}
+	return feedback, nil
+}
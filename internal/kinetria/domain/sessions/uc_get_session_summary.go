@@ -0,0 +1,60 @@
+package sessions
+
+import (
+	"context"
+	"database/sql"
+	stdErrors "errors"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/kinetria/kinetria-back/internal/kinetria/domain/errors"
+	"github.com/kinetria/kinetria-back/internal/kinetria/domain/ports"
+)
+
+// GetSessionSummaryInput identifies the session to summarize.
+type GetSessionSummaryInput struct {
+	UserID    uuid.UUID
+	SessionID uuid.UUID
+}
+
+// GetSessionSummaryUC returns the summary of one of the user's sessions: totals, the
+// per-exercise breakdown, personal records, the comparison with the previous session of
+// the same workout and the completion rate against the workout's prescription.
+type GetSessionSummaryUC struct {
+	sessionRepo ports.SessionRepository
+	summarizer  summarizer
+}
+
+// NewGetSessionSummaryUC creates a new GetSessionSummaryUC.
+func NewGetSessionSummaryUC(
+	sessionRepo ports.SessionRepository,
+	summaryRepo ports.SessionSummaryRepository,
+	setRecordRepo ports.SetRecordRepository,
+) *GetSessionSummaryUC {
+	return &GetSessionSummaryUC{
+		sessionRepo: sessionRepo,
+		summarizer:  summarizer{summaryRepo: summaryRepo, setRecordRepo: setRecordRepo},
+	}
+}
+
+// Execute returns the summary of the session. The duration of an active session is the
+// time elapsed so far.
+func (uc *GetSessionSummaryUC) Execute(ctx context.Context, input GetSessionSummaryInput) (*SessionSummary, error) {
+	if input.SessionID == uuid.Nil {
+		return nil, errors.ErrMalformedParameters
+	}
+
+	session, err := uc.sessionRepo.FindByID(ctx, input.SessionID)
+	if err != nil {
+		if stdErrors.Is(err, sql.ErrNoRows) {
+			return nil, errors.ErrNotFound
+		}
+		return nil, fmt.Errorf("failed to find session: %w", err)
+	}
+	if session == nil || session.UserID != input.UserID {
+		return nil, errors.ErrNotFound
+	}
+
+	return uc.summarizer.summarize(ctx, *session, time.Now())
+}
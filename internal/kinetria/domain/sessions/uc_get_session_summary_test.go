@@ -0,0 +1,155 @@
+package sessions_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/kinetria/kinetria-back/internal/kinetria/domain/entities"
+	domainerrors "github.com/kinetria/kinetria-back/internal/kinetria/domain/errors"
+	"github.com/kinetria/kinetria-back/internal/kinetria/domain/ports"
+	"github.com/kinetria/kinetria-back/internal/kinetria/domain/sessions"
+	"github.com/kinetria/kinetria-back/internal/kinetria/domain/vos"
+)
+
+func TestGetSessionSummaryUC_Execute(t *testing.T) {
+	userID := uuid.New()
+	workoutID := uuid.New()
+	sessionID := uuid.New()
+	previousID := uuid.New()
+	bench := uuid.New()
+	squat := uuid.New()
+	benchWE := uuid.New()
+	squatWE := uuid.New()
+
+	startedAt := time.Date(2026, 3, 10, 18, 0, 0, 0, time.UTC)
+	finishedAt := startedAt.Add(62 * time.Minute)
+	session := &entities.Session{
+		ID:         sessionID,
+		UserID:     userID,
+		WorkoutID:  workoutID,
+		Status:     vos.SessionStatusCompleted,
+		StartedAt:  startedAt,
+		FinishedAt: &finishedAt,
+	}
+	repo := &mockFinishSessionRepo{
+		findByID: func(ctx context.Context, id uuid.UUID) (*entities.Session, error) {
+			return session, nil
+		},
+	}
+
+	set := func(we, ex uuid.UUID, name string, n, weight, reps int, status string) ports.SessionSetRow {
+		return ports.SessionSetRow{
+			WorkoutExerciseID: we, ExerciseID: ex, ExerciseName: name,
+			PrescribedSets: 3, PrescribedReps: "8-12",
+			SetNumber: intPtr(n), Weight: weight, Reps: reps, Status: status,
+		}
+	}
+	summaryRepo := &mockSessionSummaryRepo{
+		sets: map[uuid.UUID][]ports.SessionSetRow{
+			sessionID: {
+				set(benchWE, bench, "Supino", 1, 80000, 8, "completed"),
+				set(benchWE, bench, "Supino", 2, 85000, 6, "completed"),
+				set(benchWE, bench, "Supino", 3, 85000, 5, "completed"),
+				set(benchWE, bench, "Supino", 4, 60000, 12, "completed"),
+				set(squatWE, squat, "Agachamento", 1, 100000, 5, "completed"),
+				set(squatWE, squat, "Agachamento", 2, 0, 0, "skipped"),
+			},
+			previousID: {
+				set(benchWE, bench, "Supino", 1, 80000, 8, "completed"),
+				set(squatWE, squat, "Agachamento", 1, 100000, 5, "completed"),
+			},
+		},
+		previous: &entities.Session{ID: previousID, UserID: userID, WorkoutID: workoutID, StartedAt: startedAt.AddDate(0, 0, -3), FinishedAt: ptrTime(startedAt.AddDate(0, 0, -3).Add(50 * time.Minute))},
+	}
+	setRecordRepo := &mockSetRecordRepo{exerciseSetSummaries: []ports.ExerciseSetSummaryRow{
+		{SessionID: previousID, ExerciseID: bench, Reps: 8, MaxWeight: 80000},
+		{SessionID: previousID, ExerciseID: squat, Reps: 5, MaxWeight: 100000},
+	}}
+	uc := sessions.NewGetSessionSummaryUC(repo, summaryRepo, setRecordRepo)
+
+	t.Run("totals_breakdown_and_completion", func(t *testing.T) {
+		out, err := uc.Execute(context.Background(), sessions.GetSessionSummaryInput{UserID: userID, SessionID: sessionID})
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if out.DurationMinutes != 62 || out.TotalSets != 5 || out.TotalReps != 36 {
+			t.Errorf("unexpected totals: %+v", out.SessionTotals)
+		}
+		if want := int64(80000*8 + 85000*6 + 85000*5 + 60000*12 + 100000*5); out.TotalVolume != want {
+			t.Errorf("expected volume %d, got %d", want, out.TotalVolume)
+		}
+		if len(out.Exercises) != 2 {
+			t.Fatalf("expected 2 exercises, got %d", len(out.Exercises))
+		}
+		if ex := out.Exercises[0]; ex.CompletedSets != 4 || ex.MaxWeight != 85000 || ex.CompletionRate != 100 {
+			t.Errorf("unexpected bench summary: %+v", ex)
+		}
+		if ex := out.Exercises[1]; ex.CompletedSets != 1 || ex.SkippedSets != 1 || ex.CompletionRate != 33.3 {
+			t.Errorf("unexpected squat summary: %+v", ex)
+		}
+		// O quarto set do supino não compensa o agachamento: (3 + 1) / 6
+		if out.CompletionRate != 66.7 {
+			t.Errorf("expected completion 66.7, got %v", out.CompletionRate)
+		}
+	})
+
+	t.Run("personal_records_and_comparison", func(t *testing.T) {
+		out, err := uc.Execute(context.Background(), sessions.GetSessionSummaryInput{UserID: userID, SessionID: sessionID})
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if len(out.PersonalRecords) != 2 {
+			t.Fatalf("expected weight and e1rm records for bench, got %+v", out.PersonalRecords)
+		}
+		if pr := out.PersonalRecords[0]; pr.ExerciseID != bench || pr.Kind != sessions.PRKindWeight || pr.Value != 85000 || pr.Previous != 80000 {
+			t.Errorf("unexpected weight record: %+v", pr)
+		}
+		if pr := out.PersonalRecords[1]; pr.Kind != sessions.PRKindE1RM || pr.Value != vos.EstimateOneRepMax(85000, 6) {
+			t.Errorf("unexpected e1rm record: %+v", pr)
+		}
+
+		if out.Comparison == nil {
+			t.Fatal("expected comparison with the previous session")
+		}
+		if c := out.Comparison; c.PreviousSessionID != previousID || c.Previous.TotalSets != 2 || c.Previous.DurationMinutes != 50 {
+			t.Errorf("unexpected comparison: %+v", c)
+		}
+	})
+
+	t.Run("first_session_has_no_records_nor_comparison", func(t *testing.T) {
+		uc := sessions.NewGetSessionSummaryUC(repo, &mockSessionSummaryRepo{sets: summaryRepo.sets}, &mockSetRecordRepo{})
+		out, err := uc.Execute(context.Background(), sessions.GetSessionSummaryInput{UserID: userID, SessionID: sessionID})
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if len(out.PersonalRecords) != 0 || out.Comparison != nil {
+			t.Errorf("expected no records nor comparison, got %+v / %+v", out.PersonalRecords, out.Comparison)
+		}
+	})
+
+	t.Run("other_users_session", func(t *testing.T) {
+		_, err := uc.Execute(context.Background(), sessions.GetSessionSummaryInput{UserID: uuid.New(), SessionID: sessionID})
+		if !errors.Is(err, domainerrors.ErrNotFound) {
+			t.Errorf("expected ErrNotFound, got %v", err)
+		}
+	})
+}
+
+// mockSessionSummaryRepo is a mock SessionSummaryRepository keyed by session.
+type mockSessionSummaryRepo struct {
+	sets     map[uuid.UUID][]ports.SessionSetRow
+	previous *entities.Session
+}
+
+func (m *mockSessionSummaryRepo) ListSessionSets(_ context.Context, sessionID uuid.UUID) ([]ports.SessionSetRow, error) {
+	return m.sets[sessionID], nil
+}
+
+func (m *mockSessionSummaryRepo) FindPreviousCompleted(_ context.Context, _, _ uuid.UUID, _ time.Time) (*entities.Session, error) {
+	return m.previous, nil
+}
+
+func ptrTime(t time.Time) *time.Time { return &t }
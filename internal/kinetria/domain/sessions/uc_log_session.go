@@ -0,0 +1,276 @@
+package sessions
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	stdErrors "errors"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/kinetria/kinetria-back/internal/kinetria/domain/entities"
+	"github.com/kinetria/kinetria-back/internal/kinetria/domain/errors"
+	"github.com/kinetria/kinetria-back/internal/kinetria/domain/ports"
+	"github.com/kinetria/kinetria-back/internal/kinetria/domain/vos"
+)
+
+const (
+	// maxPastSessionDuration is the longest session that can be logged or corrected after the fact.
+	maxPastSessionDuration = 12 * time.Hour
+	// maxLoggedSets is the most sets a logged session can carry.
+	maxLoggedSets = 200
+)
+
+// LoggedSet is one set of a session logged after the fact.
+type LoggedSet struct {
+	ExerciseID uuid.UUID
+	SetNumber  int
+	Weight     int // grams
+	Reps       int
+	Status     vos.SetRecordStatus
+}
+
+// LogSessionInput holds a session that already happened: its times, sets and optional feedback.
+type LogSessionInput struct {
+	UserID     uuid.UUID
+	WorkoutID  uuid.UUID
+	StartedAt  time.Time
+	FinishedAt time.Time
+	Notes      string
+	Sets       []LoggedSet
+	// Feedback is the optional post-session feedback, as on finish.
+	Feedback vos.SessionFeedback
+}
+
+// LogSessionOutput holds the logged session.
+type LogSessionOutput struct {
+	Session entities.Session
+	Sets    []entities.SetRecord
+	// Achievements are the badges awarded by this session.
+	Achievements []entities.UserAchievement
+	// Summary is the recap of the logged session; nil if it could not be built.
+	Summary *SessionSummary
+}
+
+// LogSessionUC records a completed session after the fact (e.g. the user forgot the app at
+// the gym). It must not overlap another session of the user. It is handled as a finished
+// session: the session, its sets, its calorie estimate and feedback and the rollups of its
+// day are written in one transaction; achievements and goals are evaluated afterwards.
+type LogSessionUC struct {
+	transactor     ports.Transactor
+	logRepo        ports.SessionLogRepository
+	workoutRepo    ports.WorkoutRepository
+	exerciseRepo   ports.ExerciseRepository
+	effortRepo     ports.SessionEffortRepository
+	bodyWeightRepo ports.BodyWeightRepository
+	rollupRepo     ports.StatsRollupRepository
+	auditLogRepo   ports.AuditLogRepository
+	summarizer     summarizer
+	achievements   ports.AchievementEvaluator
+	goals          ports.GoalEvaluator
+}
+
+// NewLogSessionUC creates a new LogSessionUC.
+// bodyWeightRepo may be nil, in which case the reference body weight is used;
+// achievements and goals may be nil, in which case they are not evaluated.
+func NewLogSessionUC(
+	transactor ports.Transactor,
+	logRepo ports.SessionLogRepository,
+	workoutRepo ports.WorkoutRepository,
+	exerciseRepo ports.ExerciseRepository,
+	effortRepo ports.SessionEffortRepository,
+	bodyWeightRepo ports.BodyWeightRepository,
+	rollupRepo ports.StatsRollupRepository,
+	auditLogRepo ports.AuditLogRepository,
+	summaryRepo ports.SessionSummaryRepository,
+	setRecordRepo ports.SetRecordRepository,
+	achievements ports.AchievementEvaluator,
+	goals ports.GoalEvaluator,
+) *LogSessionUC {
+	return &LogSessionUC{
+		transactor:     transactor,
+		logRepo:        logRepo,
+		workoutRepo:    workoutRepo,
+		exerciseRepo:   exerciseRepo,
+		effortRepo:     effortRepo,
+		bodyWeightRepo: bodyWeightRepo,
+		rollupRepo:     rollupRepo,
+		auditLogRepo:   auditLogRepo,
+		summarizer:     summarizer{summaryRepo: summaryRepo, setRecordRepo: setRecordRepo},
+		achievements:   achievements,
+		goals:          goals,
+	}
+}
+
+// Execute logs the session. It returns ErrWorkoutNotFound for a workout the user cannot
+// use, ErrExerciseNotFound for a set of an exercise outside the workout and
+// ErrSessionOverlap when the times overlap another session.
+func (uc *LogSessionUC) Execute(ctx context.Context, input LogSessionInput) (LogSessionOutput, error) {
+	now := time.Now()
+	if err := validateLogSessionInput(input, now); err != nil {
+		return LogSessionOutput{}, err
+	}
+
+	exists, err := uc.workoutRepo.ExistsByIDAndUserID(ctx, input.WorkoutID, input.UserID)
+	if err != nil {
+		return LogSessionOutput{}, fmt.Errorf("failed to check workout ownership: %w", err)
+	}
+	if !exists {
+		return LogSessionOutput{}, errors.ErrWorkoutNotFound
+	}
+
+	session := entities.Session{
+		ID:         uuid.New(),
+		UserID:     input.UserID,
+		WorkoutID:  input.WorkoutID,
+		Status:     vos.SessionStatusCompleted,
+		Notes:      input.Notes,
+		StartedAt:  input.StartedAt,
+		FinishedAt: &input.FinishedAt,
+		CreatedAt:  now,
+		UpdatedAt:  now,
+	}
+
+	// Sem horário por série, todas contam como registradas ao fim da sessão
+	workoutExerciseIDs := make(map[uuid.UUID]uuid.UUID)
+	sets := make([]entities.SetRecord, 0, len(input.Sets))
+	for _, set := range input.Sets {
+		weID, ok := workoutExerciseIDs[set.ExerciseID]
+		if !ok {
+			weID, err = uc.exerciseRepo.FindWorkoutExerciseID(ctx, set.ExerciseID, input.WorkoutID)
+			if err != nil {
+				if stdErrors.Is(err, sql.ErrNoRows) {
+					return LogSessionOutput{}, errors.ErrExerciseNotFound
+				}
+				return LogSessionOutput{}, fmt.Errorf("failed to find workout exercise: %w", err)
+			}
+			workoutExerciseIDs[set.ExerciseID] = weID
+		}
+		sets = append(sets, entities.SetRecord{
+			ID:                uuid.New(),
+			SessionID:         session.ID,
+			WorkoutExerciseID: weID,
+			SetNumber:         set.SetNumber,
+			Weight:            set.Weight,
+			Reps:              set.Reps,
+			Status:            set.Status.String(),
+			RecordedAt:        input.FinishedAt,
+		})
+	}
+
+	bodyWeight, err := latestBodyWeight(ctx, uc.bodyWeightRepo, input.UserID)
+	if err != nil {
+		return LogSessionOutput{}, fmt.Errorf("failed to get body weight: %w", err)
+	}
+
+	// Sessão, séries, calorias, feedback e rollups são gravados juntos ou nenhum deles
+	var calories int
+	err = uc.transactor.WithinTx(ctx, func(ctx context.Context) error {
+		if err := uc.logRepo.CreateCompleted(ctx, &session, sets); err != nil {
+			if stdErrors.Is(err, errors.ErrSessionOverlap) {
+				return err
+			}
+			return fmt.Errorf("failed to create session: %w", err)
+		}
+
+		// A partir daqui a sessão segue o fluxo de uma sessão finalizada
+		effort, err := uc.effortRepo.GetSessionEffort(ctx, session.ID)
+		if err != nil {
+			return fmt.Errorf("failed to get session effort: %w", err)
+		}
+		calories = estimateSessionCalories(*effort, bodyWeight, input.FinishedAt)
+		if err := uc.effortRepo.SetSessionCalories(ctx, session.ID, calories); err != nil {
+			return fmt.Errorf("failed to store session calories: %w", err)
+		}
+		if !input.Feedback.IsZero() {
+			if err := uc.effortRepo.SetSessionFeedback(ctx, session.ID, input.Feedback); err != nil {
+				return fmt.Errorf("failed to store session feedback: %w", err)
+			}
+		}
+
+		if err := uc.rollupRepo.RefreshDay(ctx, input.UserID, session.StartedAt); err != nil {
+			return fmt.Errorf("failed to refresh stats rollups: %w", err)
+		}
+		return nil
+	})
+	if err != nil {
+		return LogSessionOutput{}, err
+	}
+	session.Calories = &calories
+	if !input.Feedback.IsZero() {
+		session.RPE = input.Feedback.RPE
+	}
+
+	// Audit log
+	actionData, _ := json.Marshal(map[string]interface{}{
+		"workoutId":  session.WorkoutID,
+		"startedAt":  session.StartedAt,
+		"finishedAt": session.FinishedAt,
+		"notes":      session.Notes,
+		"sets":       len(sets),
+		"calories":   calories,
+		"feedback":   input.Feedback,
+	})
+	auditEntry := entities.AuditLog{
+		ID:         uuid.New(),
+		UserID:     input.UserID,
+		EntityType: "session",
+		EntityID:   session.ID,
+		Action:     "logged",
+		ActionData: actionData,
+		OccurredAt: now,
+	}
+	_ = uc.auditLogRepo.Append(ctx, &auditEntry)
+
+	// Conquistas, metas e resumo são best-effort, como no fim de uma sessão
+	var awarded []entities.UserAchievement
+	if uc.achievements != nil {
+		awarded, _ = uc.achievements.Execute(ctx, input.UserID)
+	}
+	if uc.goals != nil {
+		_, _ = uc.goals.Execute(ctx, input.UserID)
+	}
+	summary, _ := uc.summarizer.summarize(ctx, session, now)
+
+	return LogSessionOutput{Session: session, Sets: sets, Achievements: awarded, Summary: summary}, nil
+}
+
+// validateLogSessionInput checks the times, sets and feedback of a logged session.
+func validateLogSessionInput(input LogSessionInput, now time.Time) error {
+	if input.WorkoutID == uuid.Nil || input.StartedAt.IsZero() || input.FinishedAt.IsZero() {
+		return errors.ErrMalformedParameters
+	}
+	if !input.FinishedAt.After(input.StartedAt) {
+		return fmt.Errorf("finishedAt must be after startedAt: %w", errors.ErrMalformedParameters)
+	}
+	if input.FinishedAt.After(now) {
+		return fmt.Errorf("finishedAt must not be in the future: %w", errors.ErrMalformedParameters)
+	}
+	if input.FinishedAt.Sub(input.StartedAt) > maxPastSessionDuration {
+		return fmt.Errorf("session must not last more than %d hours: %w", int(maxPastSessionDuration.Hours()), errors.ErrMalformedParameters)
+	}
+	if len(input.Sets) > maxLoggedSets {
+		return fmt.Errorf("session must not have more than %d sets: %w", maxLoggedSets, errors.ErrMalformedParameters)
+	}
+
+	type setKey struct {
+		exerciseID uuid.UUID
+		setNumber  int
+	}
+	seen := make(map[setKey]bool, len(input.Sets))
+	for _, set := range input.Sets {
+		if set.ExerciseID == uuid.Nil || set.SetNumber < 1 || set.Weight < 0 || set.Reps < 0 {
+			return fmt.Errorf("invalid set: %w", errors.ErrMalformedParameters)
+		}
+		if err := set.Status.Validate(); err != nil {
+			return err
+		}
+		key := setKey{set.ExerciseID, set.SetNumber}
+		if seen[key] {
+			return fmt.Errorf("set %d of exercise %s is repeated: %w", set.SetNumber, set.ExerciseID, errors.ErrMalformedParameters)
+		}
+		seen[key] = true
+	}
+	return input.Feedback.Validate()
+}
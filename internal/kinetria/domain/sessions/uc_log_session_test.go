@@ -0,0 +1,231 @@
+package sessions_test
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/kinetria/kinetria-back/internal/kinetria/domain/entities"
+	domainerrors "github.com/kinetria/kinetria-back/internal/kinetria/domain/errors"
+	"github.com/kinetria/kinetria-back/internal/kinetria/domain/sessions"
+	"github.com/kinetria/kinetria-back/internal/kinetria/domain/vos"
+)
+
+func TestLogSessionUC_Execute(t *testing.T) {
+	userID := uuid.New()
+	workoutID := uuid.New()
+	bench := uuid.New()
+	benchWE := uuid.New()
+	startedAt := time.Now().Add(-26 * time.Hour).Truncate(time.Minute)
+	finishedAt := startedAt.Add(70 * time.Minute)
+
+	validInput := func() sessions.LogSessionInput {
+		return sessions.LogSessionInput{
+			UserID:     userID,
+			WorkoutID:  workoutID,
+			StartedAt:  startedAt,
+			FinishedAt: finishedAt,
+			Notes:      "esqueci o app",
+			Sets: []sessions.LoggedSet{
+				{ExerciseID: bench, SetNumber: 1, Weight: 80000, Reps: 8, Status: vos.SetRecordStatusCompleted},
+				{ExerciseID: bench, SetNumber: 2, Weight: 80000, Reps: 7, Status: vos.SetRecordStatusCompleted},
+			},
+			Feedback: vos.SessionFeedback{RPE: intPtr(8)},
+		}
+	}
+
+	type deps struct {
+		transactor *mockTransactor
+		logRepo    *mockSessionLogRepo
+		effortRepo *mockSessionEffortRepo
+		rollupRepo *mockStatsRollupRepo
+		audit      *[]entities.AuditLog
+	}
+	newUC := func(workoutExists bool) (*sessions.LogSessionUC, deps) {
+		var audit []entities.AuditLog
+		d := deps{
+			transactor: &mockTransactor{},
+			logRepo:    &mockSessionLogRepo{},
+			effortRepo: &mockSessionEffortRepo{},
+			rollupRepo: &mockStatsRollupRepo{},
+			audit:      &audit,
+		}
+		exerciseRepo := &mockExerciseRepo{
+			findWorkoutExerciseID: func(_ context.Context, exerciseID, _ uuid.UUID) (uuid.UUID, error) {
+				if exerciseID != bench {
+					return uuid.Nil, sql.ErrNoRows
+				}
+				return benchWE, nil
+			},
+		}
+		auditRepo := &mockAuditRepo{append: func(_ context.Context, entry *entities.AuditLog) error {
+			audit = append(audit, *entry)
+			return nil
+		}}
+		uc := sessions.NewLogSessionUC(
+			d.transactor,
+			d.logRepo,
+			&mockWorkoutRepository{existsResponse: workoutExists},
+			exerciseRepo,
+			d.effortRepo,
+			nil,
+			d.rollupRepo,
+			auditRepo,
+			&mockSessionSummaryRepo{},
+			&mockSetRecordRepo{},
+			nil,
+			nil,
+		)
+		return uc, d
+	}
+
+	t.Run("creates_completed_session_with_sets", func(t *testing.T) {
+		uc, d := newUC(true)
+		out, err := uc.Execute(context.Background(), validInput())
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if out.Session.Status != vos.SessionStatusCompleted || !out.Session.StartedAt.Equal(startedAt) || !out.Session.FinishedAt.Equal(finishedAt) {
+			t.Errorf("unexpected session: %+v", out.Session)
+		}
+		if d.logRepo.session == nil || len(d.logRepo.sets) != 2 {
+			t.Fatalf("expected the session and 2 sets to be persisted together, got %+v / %d sets", d.logRepo.session, len(d.logRepo.sets))
+		}
+		for _, set := range d.logRepo.sets {
+			if set.SessionID != out.Session.ID || set.WorkoutExerciseID != benchWE || !set.RecordedAt.Equal(finishedAt) {
+				t.Errorf("unexpected set: %+v", set)
+			}
+		}
+		if d.effortRepo.storedCalories == nil || out.Session.Calories == nil {
+			t.Error("expected calories to be estimated and stored")
+		}
+		if d.effortRepo.storedFeedback == nil || *d.effortRepo.storedFeedback.RPE != 8 || *out.Session.RPE != 8 {
+			t.Error("expected the feedback to be stored")
+		}
+		// O rollup atualizado é o do dia em que a sessão aconteceu, não o de hoje
+		if len(d.rollupRepo.refreshed) != 1 || !d.rollupRepo.refreshed[0].Equal(startedAt) {
+			t.Errorf("expected rollup refresh at %v, got %v", startedAt, d.rollupRepo.refreshed)
+		}
+		if len(*d.audit) != 1 || (*d.audit)[0].Action != "logged" {
+			t.Errorf("expected a logged audit entry, got %+v", *d.audit)
+		}
+	})
+
+	t.Run("overlap_is_reported", func(t *testing.T) {
+		uc, d := newUC(true)
+		d.logRepo.err = domainerrors.ErrSessionOverlap
+		_, err := uc.Execute(context.Background(), validInput())
+		if !errors.Is(err, domainerrors.ErrSessionOverlap) {
+			t.Errorf("expected ErrSessionOverlap, got %v", err)
+		}
+		if len(d.rollupRepo.refreshed) != 0 || d.effortRepo.storedCalories != nil {
+			t.Error("expected nothing to happen after a rejected session")
+		}
+	})
+
+	t.Run("refresh_error_rolls_back_the_logged_session", func(t *testing.T) {
+		uc, d := newUC(true)
+		d.rollupRepo.err = errors.New("db down")
+		if _, err := uc.Execute(context.Background(), validInput()); err == nil {
+			t.Fatal("expected error")
+		}
+		if !d.logRepo.inUnitOfWork {
+			t.Error("expected the session to be created in the unit of work")
+		}
+		if len(d.transactor.rolledBack) != 1 {
+			t.Errorf("expected the unit of work to be rolled back, got %v", d.transactor.rolledBack)
+		}
+		if len(*d.audit) != 0 {
+			t.Errorf("expected no audit entry, got %+v", *d.audit)
+		}
+	})
+
+	t.Run("unknown_workout", func(t *testing.T) {
+		uc, d := newUC(false)
+		_, err := uc.Execute(context.Background(), validInput())
+		if !errors.Is(err, domainerrors.ErrWorkoutNotFound) {
+			t.Errorf("expected ErrWorkoutNotFound, got %v", err)
+		}
+		if d.logRepo.session != nil {
+			t.Error("expected no session to be created")
+		}
+	})
+
+	t.Run("exercise_outside_workout", func(t *testing.T) {
+		uc, d := newUC(true)
+		input := validInput()
+		input.Sets = append(input.Sets, sessions.LoggedSet{ExerciseID: uuid.New(), SetNumber: 1, Reps: 10, Status: vos.SetRecordStatusCompleted})
+		_, err := uc.Execute(context.Background(), input)
+		if !errors.Is(err, domainerrors.ErrExerciseNotFound) {
+			t.Errorf("expected ErrExerciseNotFound, got %v", err)
+		}
+		if d.logRepo.session != nil {
+			t.Error("expected no session to be created")
+		}
+	})
+
+	invalid := []struct {
+		name   string
+		mutate func(*sessions.LogSessionInput)
+	}{
+		{"finish_before_start", func(in *sessions.LogSessionInput) { in.FinishedAt = in.StartedAt.Add(-time.Minute) }},
+		{"finish_in_the_future", func(in *sessions.LogSessionInput) { in.FinishedAt = time.Now().Add(time.Hour) }},
+		{"too_long", func(in *sessions.LogSessionInput) { in.StartedAt = in.FinishedAt.Add(-13 * time.Hour) }},
+		{"missing_times", func(in *sessions.LogSessionInput) { in.StartedAt = time.Time{} }},
+		{"repeated_set", func(in *sessions.LogSessionInput) { in.Sets[1].SetNumber = 1 }},
+		{"invalid_set_status", func(in *sessions.LogSessionInput) { in.Sets[0].Status = "done" }},
+		{"negative_weight", func(in *sessions.LogSessionInput) { in.Sets[0].Weight = -1 }},
+		{"invalid_feedback", func(in *sessions.LogSessionInput) { in.Feedback.RPE = intPtr(11) }},
+	}
+	for _, tc := range invalid {
+		t.Run(tc.name, func(t *testing.T) {
+			uc, d := newUC(true)
+			input := validInput()
+			tc.mutate(&input)
+			_, err := uc.Execute(context.Background(), input)
+			if !errors.Is(err, domainerrors.ErrMalformedParameters) {
+				t.Errorf("expected ErrMalformedParameters, got %v", err)
+			}
+			if d.logRepo.session != nil {
+				t.Error("expected no session to be created")
+			}
+		})
+	}
+}
+
+// mockSessionLogRepo is a mock SessionLogRepository that records the created or updated session.
+type mockSessionLogRepo struct {
+	err     error
+	session *entities.Session
+	sets    []entities.SetRecord
+	// notCompleted makes UpdateCompleted report a session that is no longer completed.
+	notCompleted bool
+	// inUnitOfWork records whether the last write ran in a unit of work.
+	inUnitOfWork bool
+}
+
+func (m *mockSessionLogRepo) CreateCompleted(ctx context.Context, session *entities.Session, sets []entities.SetRecord) error {
+	m.inUnitOfWork = inUnitOfWork(ctx)
+	if m.err != nil {
+		return m.err
+	}
+	m.session = session
+	m.sets = sets
+	return nil
+}
+
+func (m *mockSessionLogRepo) UpdateCompleted(ctx context.Context, session *entities.Session) (bool, error) {
+	m.inUnitOfWork = inUnitOfWork(ctx)
+	if m.err != nil {
+		return false, m.err
+	}
+	if m.notCompleted {
+		return false, nil
+	}
+	updated := *session
+	m.session = &updated
+	return true, nil
+}
@@ -24,33 +24,46 @@ type RecordSetInput struct {
 	Weight     int // grams
 	Reps       int
 	Status     vos.SetRecordStatus
+	// SetRecordID is an optional client-generated ID (offline sync); a new one is generated when Nil.
+	SetRecordID uuid.UUID
+	// RecordedAt is when the set was done; nil means now. It must not be in the future.
+	RecordedAt *time.Time
 }
 
 // RecordSetOutput represents output after recording a set.
 type RecordSetOutput struct {
 	SetRecord entities.SetRecord
+	// Achievements are the badges awarded by this set.
+	Achievements []entities.UserAchievement
 }
 
 // RecordSetUseCase orchestrates recording a set during an active session.
 type RecordSetUseCase struct {
+	transactor    ports.Transactor
 	sessionRepo   ports.SessionRepository
 	setRecordRepo ports.SetRecordRepository
 	exerciseRepo  ports.ExerciseRepository
 	auditLogRepo  ports.AuditLogRepository
+	achievements  ports.AchievementEvaluator
 }
 
 // NewRecordSetUseCase creates a new instance of RecordSetUseCase.
+// achievements may be nil, in which case no achievements are evaluated.
 func NewRecordSetUseCase(
+	transactor ports.Transactor,
 	sessionRepo ports.SessionRepository,
 	setRecordRepo ports.SetRecordRepository,
 	exerciseRepo ports.ExerciseRepository,
 	auditLogRepo ports.AuditLogRepository,
+	achievements ports.AchievementEvaluator,
 ) *RecordSetUseCase {
 	return &RecordSetUseCase{
+		transactor:    transactor,
 		sessionRepo:   sessionRepo,
 		setRecordRepo: setRecordRepo,
 		exerciseRepo:  exerciseRepo,
 		auditLogRepo:  auditLogRepo,
+		achievements:  achievements,
 	}
 }
 
@@ -66,6 +79,9 @@ func (uc *RecordSetUseCase) Execute(ctx context.Context, input RecordSetInput) (
 	if err := input.Status.Validate(); err != nil {
 		return RecordSetOutput{}, errors.ErrMalformedParameters
 	}
+	if input.RecordedAt != nil && input.RecordedAt.After(time.Now()) {
+		return RecordSetOutput{}, fmt.Errorf("recordedAt must not be in the future: %w", errors.ErrMalformedParameters)
+	}
 
 	// Find session and validate ownership
 	session, err := uc.sessionRepo.FindByID(ctx, input.SessionID)
@@ -104,15 +120,22 @@ func (uc *RecordSetUseCase) Execute(ctx context.Context, input RecordSetInput) (
 
 	// Create SetRecord
 	now := time.Now()
+	setRecordID, recordedAt := input.SetRecordID, now
+	if setRecordID == uuid.Nil {
+		setRecordID = uuid.New()
+	}
+	if input.RecordedAt != nil {
+		recordedAt = *input.RecordedAt
+	}
 	setRecord := entities.SetRecord{
-		ID:                uuid.New(),
+		ID:                setRecordID,
 		SessionID:         input.SessionID,
 		WorkoutExerciseID: workoutExerciseID,
 		SetNumber:         input.SetNumber,
 		Weight:            input.Weight,
 		Reps:              input.Reps,
 		Status:            input.Status.String(),
-		RecordedAt:        now,
+		RecordedAt:        recordedAt,
 	}
 
 	// Persist
@@ -131,7 +154,22 @@ func (uc *RecordSetUseCase) Execute(ctx context.Context, input RecordSetInput) (
 		ActionData: actionData,
 		OccurredAt: now,
 	}
-	_ = uc.auditLogRepo.Append(ctx, &auditEntry)
+	bestEffort(ctx, uc.transactor, func(ctx context.Context) error {
+		return uc.auditLogRepo.Append(ctx, &auditEntry)
+	})
+
+	// Conquistas são best-effort: uma falha não desfaz a série registrada
+	var awarded []entities.UserAchievement
+	if uc.achievements != nil {
+		bestEffort(ctx, uc.transactor, func(ctx context.Context) error {
+			a, err := uc.achievements.Execute(ctx, input.UserID)
+			if err != nil {
+				return err
+			}
+			awarded = a
+			return nil
+		})
+	}
 
-	return RecordSetOutput{SetRecord: setRecord}, nil
+	return RecordSetOutput{SetRecord: setRecord, Achievements: awarded}, nil
 }
@@ -166,7 +166,7 @@ func TestRecordSetUC_Execute(t *testing.T) {
 
 			tt.mockSetup(sessionRepo, setRecordRepo, exerciseRepo)
 
-			uc := sessions.NewRecordSetUseCase(sessionRepo, setRecordRepo, exerciseRepo, auditRepo)
+			uc := sessions.NewRecordSetUseCase(&mockTransactor{}, sessionRepo, setRecordRepo, exerciseRepo, auditRepo, nil)
 			output, err := uc.Execute(context.Background(), tt.input)
 
 			if tt.expectedError != nil {
@@ -209,7 +209,7 @@ func (m *mockSessionRepo) UpdateStatus(ctx context.Context, sessionID uuid.UUID,
 	return true, nil
 }
 
-func (m *mockSessionRepo) GetCompletedSessionsByUserAndDateRange(_ context.Context, _ uuid.UUID, _, _ time.Time) ([]entities.Session, error) {
+func (m *mockSessionRepo) GetCompletedSessionsByUserAndDateRange(_ context.Context, _ uuid.UUID, _, _ time.Time, _ *time.Location) ([]entities.Session, error) {
 	return nil, nil
 }
 
@@ -217,17 +217,18 @@ func (m *mockSessionRepo) GetStatsByUserAndPeriod(_ context.Context, _ uuid.UUID
 	return &ports.SessionStats{}, nil
 }
 
-func (m *mockSessionRepo) GetFrequencyByUserAndPeriod(_ context.Context, _ uuid.UUID, _, _ time.Time) ([]ports.FrequencyData, error) {
+func (m *mockSessionRepo) GetFrequencyByUserAndPeriod(_ context.Context, _ uuid.UUID, _, _ time.Time, _ *time.Location) ([]ports.FrequencyData, error) {
 	return nil, nil
 }
 
-func (m *mockSessionRepo) GetSessionsForStreak(_ context.Context, _ uuid.UUID) ([]time.Time, error) {
+func (m *mockSessionRepo) GetSessionsForStreak(_ context.Context, _ uuid.UUID, _ *time.Location) ([]time.Time, error) {
 	return nil, nil
 }
 
 type mockSetRecordRepo struct {
 	create                   func(context.Context, *entities.SetRecord) error
 	findBySessionExerciseSet func(context.Context, uuid.UUID, uuid.UUID, int) (*entities.SetRecord, error)
+	exerciseSetSummaries     []ports.ExerciseSetSummaryRow
 }
 
 func (m *mockSetRecordRepo) Create(ctx context.Context, setRecord *entities.SetRecord) error {
@@ -252,10 +253,22 @@ func (m *mockSetRecordRepo) GetPersonalRecordsByUser(_ context.Context, _ uuid.U
 	return nil, nil
 }
 
-func (m *mockSetRecordRepo) GetProgressionByUserAndExercise(_ context.Context, _ uuid.UUID, _ *uuid.UUID, _, _ time.Time) ([]ports.ProgressionPoint, error) {
+func (m *mockSetRecordRepo) GetProgressionByUserAndExercise(_ context.Context, _ uuid.UUID, _ *uuid.UUID, _, _ time.Time, _ *time.Location) ([]ports.ProgressionPoint, error) {
 	return nil, nil
 }
 
+func (m *mockSetRecordRepo) GetMuscleVolumeByUserAndPeriod(_ context.Context, _ uuid.UUID, _, _ time.Time, _ *time.Location, _ vos.WeekStart) ([]ports.MuscleVolumeRow, error) {
+	return nil, nil
+}
+
+func (m *mockSetRecordRepo) GetBigLiftSetsByUser(_ context.Context, _ uuid.UUID, _, _ time.Time, _ *time.Location) ([]ports.BigLiftSetRow, error) {
+	return nil, nil
+}
+
+func (m *mockSetRecordRepo) GetExerciseSetSummariesByUser(_ context.Context, _ uuid.UUID, _, _ time.Time, _ *time.Location) ([]ports.ExerciseSetSummaryRow, error) {
+	return m.exerciseSetSummaries, nil
+}
+
 type mockExerciseRepo struct {
 	existsByIDAndWorkoutID func(context.Context, uuid.UUID, uuid.UUID) (bool, error)
 	findWorkoutExerciseID  func(context.Context, uuid.UUID, uuid.UUID) (uuid.UUID, error)
@@ -17,30 +17,52 @@ domainerrors "github.com/kinetria/kinetria-back/internal/kinetria/domain/errors"
 type StartSessionInput struct {
 UserID    uuid.UUID
 WorkoutID uuid.UUID
+// SessionID is an optional client-generated ID (offline sync); a new one is generated when Nil.
+SessionID uuid.UUID
+// StartedAt is when the session started; nil means now. It must not be in the future.
+StartedAt *time.Time
+// Readiness optionally records a readiness check-in with the session. Without it the
+// latest check-in of the last 12 hours not yet used by a session is taken, if any.
+Readiness *vos.ReadinessMarkers
+// Adjustment optionally scales the day's prescription down when readiness is low.
+Adjustment *vos.ReadinessAdjustment
 }
 
 // StartSessionOutput holds the result of starting a session.
 type StartSessionOutput struct {
 Session entities.Session
+// Readiness is the check-in the session started with and the day's targets; nil without a check-in.
+Readiness *SessionReadiness
 }
 
 // StartSessionUC orchestrates creating a new workout session.
+// A readiness check-in, given or made earlier, is linked to the session and may scale
+// the day's prescription down (see SessionReadiness).
 type StartSessionUC struct {
+transactor   ports.Transactor
 sessionRepo  ports.SessionRepository
 workoutRepo  ports.WorkoutRepository
 auditLogRepo ports.AuditLogRepository
+readinessRepo ports.ReadinessRepository
+readiness     readinessPlanner
 }
 
 // NewStartSessionUC creates a new StartSessionUC.
 func NewStartSessionUC(
+transactor ports.Transactor,
 sessionRepo ports.SessionRepository,
 workoutRepo ports.WorkoutRepository,
 auditLogRepo ports.AuditLogRepository,
+readinessRepo ports.ReadinessRepository,
+readinessRecorder ports.ReadinessRecorder,
 ) *StartSessionUC {
 return &StartSessionUC{
+transactor:   transactor,
 sessionRepo:  sessionRepo,
 workoutRepo:  workoutRepo,
 auditLogRepo: auditLogRepo,
+readinessRepo: readinessRepo,
+readiness:     readinessPlanner{readinessRepo: readinessRepo, recorder: readinessRecorder, workoutRepo: workoutRepo},
 }
 }
 
@@ -49,6 +71,19 @@ func (uc *StartSessionUC) Execute(ctx context.Context, input StartSessionInput)
 if input.WorkoutID == uuid.Nil {
 return StartSessionOutput{}, domainerrors.ErrMalformedParameters
 }
+if input.StartedAt != nil && input.StartedAt.After(time.Now()) {
+return StartSessionOutput{}, fmt.Errorf("startedAt must not be in the future: %w", domainerrors.ErrMalformedParameters)
+}
+if input.Readiness != nil {
+if err := input.Readiness.Validate(); err != nil {
+return StartSessionOutput{}, err
+}
+}
+if input.Adjustment != nil {
+if err := input.Adjustment.Validate(); err != nil {
+return StartSessionOutput{}, err
+}
+}
 
 exists, err := uc.workoutRepo.ExistsByIDAndUserID(ctx, input.WorkoutID, input.UserID)
 if err != nil {
@@ -67,11 +102,24 @@ return StartSessionOutput{}, domainerrors.ErrActiveSessionExists
 }
 
 now := time.Now()
+readiness, err := uc.readiness.plan(ctx, input.UserID, input.WorkoutID, input.Readiness, input.Adjustment, now)
+if err != nil {
+return StartSessionOutput{}, err
+}
+
+sessionID, startedAt := input.SessionID, now
+if sessionID == uuid.Nil {
+sessionID = uuid.New()
+}
+if input.StartedAt != nil {
+startedAt = *input.StartedAt
+}
+
 session := entities.Session{
-ID:        uuid.New(),
+ID:        sessionID,
 UserID:    input.UserID,
 WorkoutID: input.WorkoutID,
-StartedAt: now,
+StartedAt: startedAt,
 Status:    vos.SessionStatusActive,
 Notes:     "",
 CreatedAt: now,
@@ -82,6 +130,15 @@ if err := uc.sessionRepo.Create(ctx, &session); err != nil {
 return StartSessionOutput{}, fmt.Errorf("failed to create session: %w", err)
 }
 
+// O vínculo só alimenta as tendências de prontidão: uma falha não desfaz a sessão
+if readiness != nil {
+bestEffort(ctx, uc.transactor, func(ctx context.Context) error {
+return uc.readinessRepo.LinkSession(ctx, readiness.CheckIn.ID, session.ID, readiness.Adjustment)
+})
+readiness.CheckIn.SessionID = &session.ID
+readiness.CheckIn.Adjustment = readiness.Adjustment
+}
+
 actionData, _ := json.Marshal(map[string]string{
 "workoutId": session.WorkoutID.String(),
 "startedAt": session.StartedAt.Format(time.RFC3339),
@@ -95,7 +152,9 @@ Action:     "created",
 ActionData: actionData,
 OccurredAt: now,
 }
-_ = uc.auditLogRepo.Append(ctx, auditEntry)
+bestEffort(ctx, uc.transactor, func(ctx context.Context) error {
+return uc.auditLogRepo.Append(ctx, auditEntry)
+})
 
-return StartSessionOutput{Session: session}, nil
+return StartSessionOutput{Session: session, Readiness: readiness}, nil
 }
@@ -43,7 +43,7 @@ func (m *mockSessionRepository) UpdateStatus(ctx context.Context, sessionID uuid
 	return true, nil
 }
 
-func (m *mockSessionRepository) GetCompletedSessionsByUserAndDateRange(_ context.Context, _ uuid.UUID, _, _ time.Time) ([]entities.Session, error) {
+func (m *mockSessionRepository) GetCompletedSessionsByUserAndDateRange(_ context.Context, _ uuid.UUID, _, _ time.Time, _ *time.Location) ([]entities.Session, error) {
 	return nil, nil
 }
 
@@ -51,11 +51,11 @@ func (m *mockSessionRepository) GetStatsByUserAndPeriod(_ context.Context, _ uui
 	return &ports.SessionStats{}, nil
 }
 
-func (m *mockSessionRepository) GetFrequencyByUserAndPeriod(_ context.Context, _ uuid.UUID, _, _ time.Time) ([]ports.FrequencyData, error) {
+func (m *mockSessionRepository) GetFrequencyByUserAndPeriod(_ context.Context, _ uuid.UUID, _, _ time.Time, _ *time.Location) ([]ports.FrequencyData, error) {
 	return nil, nil
 }
 
-func (m *mockSessionRepository) GetSessionsForStreak(_ context.Context, _ uuid.UUID) ([]time.Time, error) {
+func (m *mockSessionRepository) GetSessionsForStreak(_ context.Context, _ uuid.UUID, _ *time.Location) ([]time.Time, error) {
 	return nil, nil
 }
 
@@ -63,6 +63,7 @@ func (m *mockSessionRepository) GetSessionsForStreak(_ context.Context, _ uuid.U
 type mockWorkoutRepository struct {
 	existsResponse bool
 	existsErr      error
+	exercises      []entities.Exercise
 }
 
 func (m *mockWorkoutRepository) ExistsByIDAndUserID(ctx context.Context, workoutID, userID uuid.UUID) (bool, error) {
@@ -72,7 +73,7 @@ func (m *mockWorkoutRepository) ExistsByIDAndUserID(ctx context.Context, workout
 	return m.existsResponse, nil
 }
 
-func (m *mockWorkoutRepository) ListByUserID(_ context.Context, _ uuid.UUID, _, _ int) ([]entities.Workout, int, error) {
+func (m *mockWorkoutRepository) ListByUserID(_ context.Context, _ uuid.UUID, _ bool, _, _ int) ([]entities.Workout, int, error) {
 	return nil, 0, nil
 }
 
@@ -81,7 +82,7 @@ func (m *mockWorkoutRepository) GetFirstByUserID(_ context.Context, _ uuid.UUID)
 }
 
 func (m *mockWorkoutRepository) GetByID(_ context.Context, _, _ uuid.UUID) (*entities.Workout, []entities.Exercise, error) {
-	return nil, nil, nil
+	return nil, m.exercises, nil
 }
 
 func (m *mockWorkoutRepository) GetByIDOnly(_ context.Context, _ uuid.UUID) (*entities.Workout, error) {
@@ -264,7 +265,7 @@ func TestStartSessionUC_Execute(t *testing.T) {
 				tt.setupMocks(sessionRepo, workoutRepo, auditRepo)
 			}
 
-			uc := sessions.NewStartSessionUC(sessionRepo, workoutRepo, auditRepo)
+			uc := sessions.NewStartSessionUC(&mockTransactor{}, sessionRepo, workoutRepo, auditRepo, &mockReadinessRepo{}, &mockReadinessRecorder{})
 			out, err := uc.Execute(context.Background(), tt.input)
 
 			// Special handling for wrapped errors
@@ -333,3 +334,139 @@ func TestStartSessionUC_Execute(t *testing.T) {
 		})
 	}
 }
+
+func TestStartSessionUC_Execute_Readiness(t *testing.T) {
+	userID := uuid.New()
+	workoutID := uuid.New()
+	bench := entities.Exercise{ID: uuid.New(), Name: "Supino", Sets: 4, Reps: "8-10", Weight: 80000}
+	load := vos.ReadinessAdjustmentLoad
+	volume := vos.ReadinessAdjustmentVolume
+	tired := vos.ReadinessMarkers{SleepHours: 4, Stress: 5, Soreness: 4}
+
+	newUC := func(readinessRepo *mockReadinessRepo) *sessions.StartSessionUC {
+		workoutRepo := &mockWorkoutRepository{existsResponse: true, exercises: []entities.Exercise{bench}}
+		return sessions.NewStartSessionUC(&mockTransactor{}, &mockSessionRepository{}, workoutRepo, &mockAuditLogRepository{}, readinessRepo, &mockReadinessRecorder{})
+	}
+
+	t.Run("low_readiness_scales_load", func(t *testing.T) {
+		readinessRepo := &mockReadinessRepo{}
+		out, err := newUC(readinessRepo).Execute(context.Background(), sessions.StartSessionInput{
+			UserID: userID, WorkoutID: workoutID, Readiness: &tired, Adjustment: &load,
+		})
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		r := out.Readiness
+		if r == nil || r.CheckIn.Level != vos.ReadinessLevelLow || r.Adjustment == nil || r.Reduction != 0.1 {
+			t.Fatalf("unexpected readiness: %+v", r)
+		}
+		if tg := r.Targets[0]; tg.Sets != 4 || tg.Weight != 72000 || tg.PrescribedWeight != 80000 {
+			t.Errorf("unexpected target: %+v", tg)
+		}
+		if readinessRepo.linkedSession != out.Session.ID || readinessRepo.linkedAdjustment == nil {
+			t.Errorf("expected check-in linked to the session with the adjustment")
+		}
+	})
+
+	t.Run("low_readiness_scales_volume", func(t *testing.T) {
+		out, err := newUC(&mockReadinessRepo{}).Execute(context.Background(), sessions.StartSessionInput{
+			UserID: userID, WorkoutID: workoutID, Readiness: &tired, Adjustment: &volume,
+		})
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if tg := out.Readiness.Targets[0]; tg.Sets != 3 || tg.Weight != 80000 {
+			t.Errorf("unexpected target: %+v", tg)
+		}
+	})
+
+	t.Run("high_readiness_keeps_prescription", func(t *testing.T) {
+		rested := vos.ReadinessMarkers{SleepHours: 8, Stress: 1, Soreness: 2}
+		out, err := newUC(&mockReadinessRepo{}).Execute(context.Background(), sessions.StartSessionInput{
+			UserID: userID, WorkoutID: workoutID, Readiness: &rested, Adjustment: &load,
+		})
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if r := out.Readiness; r.Adjustment != nil || r.Targets[0].Weight != 80000 {
+			t.Errorf("expected no adjustment, got %+v", r)
+		}
+	})
+
+	t.Run("uses_earlier_check_in", func(t *testing.T) {
+		earlier := &entities.ReadinessCheckIn{ID: uuid.New(), UserID: userID, Score: 40, Level: vos.ReadinessLevelLow}
+		readinessRepo := &mockReadinessRepo{latest: earlier}
+		out, err := newUC(readinessRepo).Execute(context.Background(), sessions.StartSessionInput{
+			UserID: userID, WorkoutID: workoutID, Adjustment: &load,
+		})
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if out.Readiness == nil || out.Readiness.CheckIn.ID != earlier.ID || out.Readiness.Targets[0].Weight != 72000 {
+			t.Errorf("expected the earlier check-in to adjust the targets, got %+v", out.Readiness)
+		}
+	})
+
+	t.Run("without_check_in", func(t *testing.T) {
+		out, err := newUC(&mockReadinessRepo{}).Execute(context.Background(), sessions.StartSessionInput{UserID: userID, WorkoutID: workoutID})
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if out.Readiness != nil {
+			t.Errorf("expected no readiness, got %+v", out.Readiness)
+		}
+	})
+
+	t.Run("invalid_markers", func(t *testing.T) {
+		_, err := newUC(&mockReadinessRepo{}).Execute(context.Background(), sessions.StartSessionInput{
+			UserID: userID, WorkoutID: workoutID, Readiness: &vos.ReadinessMarkers{SleepHours: 7, Stress: 9, Soreness: 1},
+		})
+		if !errors.Is(err, domainerrors.ErrMalformedParameters) {
+			t.Errorf("expected ErrMalformedParameters, got %v", err)
+		}
+	})
+}
+
+// mockReadinessRepo is a mock ReadinessRepository that records the session link.
+type mockReadinessRepo struct {
+	latest           *entities.ReadinessCheckIn
+	linkedSession    uuid.UUID
+	linkedAdjustment *vos.ReadinessAdjustment
+}
+
+func (m *mockReadinessRepo) Create(_ context.Context, _ *entities.ReadinessCheckIn) error {
+	return nil
+}
+
+func (m *mockReadinessRepo) ListByUser(_ context.Context, _ uuid.UUID, _, _ time.Time) ([]entities.ReadinessCheckIn, error) {
+	return nil, nil
+}
+
+func (m *mockReadinessRepo) FindLatestUnlinked(_ context.Context, _ uuid.UUID, _ time.Time) (*entities.ReadinessCheckIn, error) {
+	return m.latest, nil
+}
+
+func (m *mockReadinessRepo) LinkSession(_ context.Context, _, sessionID uuid.UUID, adjustment *vos.ReadinessAdjustment) error {
+	m.linkedSession = sessionID
+	m.linkedAdjustment = adjustment
+	return nil
+}
+
+func (m *mockReadinessRepo) AverageHRV(_ context.Context, _ uuid.UUID, _, _ time.Time) (*float64, error) {
+	return nil, nil
+}
+
+// mockReadinessRecorder scores check-ins without storing them.
+type mockReadinessRecorder struct{}
+
+func (m *mockReadinessRecorder) Execute(_ context.Context, input ports.ReadinessCheckInInput) (*entities.ReadinessCheckIn, error) {
+	score := input.Markers.Score(nil)
+	return &entities.ReadinessCheckIn{
+		ID:               uuid.New(),
+		UserID:           input.UserID,
+		ReadinessMarkers: input.Markers,
+		Score:            score,
+		Level:            vos.ReadinessLevelFor(score),
+		CheckedAt:        time.Now(),
+	}, nil
+}
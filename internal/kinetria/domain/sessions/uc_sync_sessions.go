@@ -0,0 +1,353 @@
+package sessions
+
+import (
+	"context"
+	"database/sql"
+	stdErrors "errors"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/kinetria/kinetria-back/internal/kinetria/domain/entities"
+	"github.com/kinetria/kinetria-back/internal/kinetria/domain/errors"
+	"github.com/kinetria/kinetria-back/internal/kinetria/domain/ports"
+	"github.com/kinetria/kinetria-back/internal/kinetria/domain/vos"
+)
+
+const (
+	// MaxSyncOperations is the most operations a sync batch can carry.
+	MaxSyncOperations = 500
+	// SyncPageSize is the most changed sessions returned by one sync.
+	SyncPageSize = 50
+)
+
+// errAlreadyApplied reports an operation whose effect is already in the server state, e.g. a
+// session started by an earlier sync that the client retried under a new operation ID.
+var errAlreadyApplied = stdErrors.New("operation already applied")
+
+// errOperationStored reports an operation whose outcome a concurrent sync stored first.
+var errOperationStored = stdErrors.New("sync operation already stored")
+
+// SyncOperation is an operation made by the app while offline. IDs are generated by the
+// client, so a session started offline can be referenced by the sets recorded after it.
+type SyncOperation struct {
+	ID   uuid.UUID
+	Type vos.SyncOperationType
+	// ClientTimestamp is when the operation happened on the device. Timestamps ahead of
+	// the server clock are taken as the time the batch was received.
+	ClientTimestamp time.Time
+	SessionID       uuid.UUID
+
+	// session.start
+	WorkoutID uuid.UUID
+
+	// set.record
+	SetID      uuid.UUID
+	ExerciseID uuid.UUID
+	SetNumber  int
+	Weight     int // grams
+	Reps       int
+	SetStatus  vos.SetRecordStatus
+
+	// session.finish
+	Notes string
+}
+
+// SyncInput holds a batch of offline operations and the cursor of the previous sync.
+type SyncInput struct {
+	UserID     uuid.UUID
+	Cursor     ports.SyncCursor
+	Operations []SyncOperation
+}
+
+// SyncOperationResult is the outcome of one operation of the batch.
+type SyncOperationResult struct {
+	OperationID uuid.UUID
+	Type        vos.SyncOperationType
+	EntityID    uuid.UUID
+	Status      vos.SyncOperationStatus
+	// Code and Message explain a rejection.
+	Code    string
+	Message string
+}
+
+// SyncOutput holds the outcome of each operation, in batch order, and the sessions changed
+// after the input cursor, including the changes made by the batch.
+type SyncOutput struct {
+	Results []SyncOperationResult
+	Changes []ports.SessionChange
+	// Cursor is where the next sync continues; HasMore means it should run again right away.
+	Cursor  ports.SyncCursor
+	HasMore bool
+	// Achievements are the badges awarded by the batch.
+	Achievements []entities.UserAchievement
+}
+
+// SyncSessionsUC applies a batch of offline session, set and status operations and returns
+// the server state. Operations run in batch order through the same use cases as the online
+// endpoints, and conflicts resolve deterministically:
+//   - an operation already synced (same ID) is not applied again and repeats its outcome;
+//   - the server state wins: an operation that conflicts with it (another active session, a
+//     set number already recorded, a session already closed) is rejected, as are the later
+//     operations that depend on it;
+//   - rejections are final, so retrying the batch always yields the same results.
+type SyncSessionsUC struct {
+	transactor  ports.Transactor
+	syncRepo    ports.SyncRepository
+	sessionRepo ports.SessionRepository
+	start       *StartSessionUC
+	recordSet   *RecordSetUseCase
+	finish      *FinishSessionUseCase
+	abandon     *AbandonSessionUseCase
+}
+
+// NewSyncSessionsUC creates a new SyncSessionsUC.
+func NewSyncSessionsUC(
+	transactor ports.Transactor,
+	syncRepo ports.SyncRepository,
+	sessionRepo ports.SessionRepository,
+	start *StartSessionUC,
+	recordSet *RecordSetUseCase,
+	finish *FinishSessionUseCase,
+	abandon *AbandonSessionUseCase,
+) *SyncSessionsUC {
+	return &SyncSessionsUC{
+		transactor:  transactor,
+		syncRepo:    syncRepo,
+		sessionRepo: sessionRepo,
+		start:       start,
+		recordSet:   recordSet,
+		finish:      finish,
+		abandon:     abandon,
+	}
+}
+
+// Execute applies the operations and reads the changes after the cursor. Each operation is
+// applied and its outcome stored in one transaction. An unexpected failure stops the batch;
+// the operations applied before it are kept and are reported as duplicates when the batch
+// is retried.
+func (uc *SyncSessionsUC) Execute(ctx context.Context, input SyncInput) (SyncOutput, error) {
+	if len(input.Operations) > MaxSyncOperations {
+		return SyncOutput{}, fmt.Errorf("a sync batch must not have more than %d operations: %w", MaxSyncOperations, errors.ErrMalformedParameters)
+	}
+	for _, op := range input.Operations {
+		if op.ID == uuid.Nil {
+			return SyncOutput{}, fmt.Errorf("every operation needs an id: %w", errors.ErrMalformedParameters)
+		}
+	}
+
+	out := SyncOutput{Results: make([]SyncOperationResult, 0, len(input.Operations))}
+	receivedAt := time.Now()
+	for _, op := range input.Operations {
+		result, awarded, err := uc.applyOnce(ctx, input.UserID, op, receivedAt)
+		if err != nil {
+			return SyncOutput{}, err
+		}
+		out.Results = append(out.Results, result)
+		out.Achievements = append(out.Achievements, awarded...)
+	}
+
+	// Uma página a mais indica se há mais mudanças depois desta
+	changes, err := uc.syncRepo.ListSessionChanges(ctx, input.UserID, input.Cursor, SyncPageSize+1)
+	if err != nil {
+		return SyncOutput{}, fmt.Errorf("failed to list session changes: %w", err)
+	}
+	if len(changes) > SyncPageSize {
+		changes, out.HasMore = changes[:SyncPageSize], true
+	}
+	out.Changes = changes
+	out.Cursor = input.Cursor
+	if len(changes) > 0 {
+		out.Cursor = changes[len(changes)-1].Cursor
+	}
+	return out, nil
+}
+
+// applyOnce applies op unless it was synced before, and stores its outcome in the same
+// transaction. When a concurrent sync of the same operation stores it first, this
+// transaction is rolled back and the stored outcome is returned.
+func (uc *SyncSessionsUC) applyOnce(ctx context.Context, userID uuid.UUID, op SyncOperation, receivedAt time.Time) (SyncOperationResult, []entities.UserAchievement, error) {
+	previous, err := uc.syncRepo.FindOperation(ctx, userID, op.ID)
+	if err != nil {
+		return SyncOperationResult{}, nil, fmt.Errorf("failed to find sync operation: %w", err)
+	}
+	if previous != nil {
+		return storedSyncResult(previous), nil, nil
+	}
+
+	var result SyncOperationResult
+	var awarded []entities.UserAchievement
+	err = uc.transactor.WithinTx(ctx, func(ctx context.Context) error {
+		result, awarded, err = uc.applyAndSave(ctx, userID, op, receivedAt)
+		return err
+	})
+	if err != nil {
+		// Outra sincronização pode ter gravado a operação enquanto esta a aplicava
+		previous, findErr := uc.syncRepo.FindOperation(ctx, userID, op.ID)
+		if findErr == nil && previous != nil {
+			return storedSyncResult(previous), nil, nil
+		}
+		return SyncOperationResult{}, nil, err
+	}
+	return result, awarded, nil
+}
+
+// applyAndSave applies op and stores its outcome. A rejected operation is applied in a
+// nested unit of work, so that its partial writes are undone but its outcome is stored.
+func (uc *SyncSessionsUC) applyAndSave(ctx context.Context, userID uuid.UUID, op SyncOperation, receivedAt time.Time) (SyncOperationResult, []entities.UserAchievement, error) {
+	result := SyncOperationResult{OperationID: op.ID, Type: op.Type, EntityID: op.SessionID, Status: vos.SyncOperationApplied}
+	if op.Type == vos.SyncOperationRecordSet {
+		result.EntityID = op.SetID
+	}
+	var awarded []entities.UserAchievement
+	err := uc.transactor.WithinTx(ctx, func(ctx context.Context) error {
+		var err error
+		awarded, err = uc.apply(ctx, userID, op, receivedAt)
+		return err
+	})
+	switch {
+	case stdErrors.Is(err, errAlreadyApplied):
+		result.Status = vos.SyncOperationDuplicate
+	case err != nil:
+		code, ok := syncRejectionCode(err)
+		if !ok {
+			return SyncOperationResult{}, nil, fmt.Errorf("failed to apply sync operation %s: %w", op.ID, err)
+		}
+		result.Status, result.Code, result.Message = vos.SyncOperationRejected, code, err.Error()
+		awarded = nil
+	}
+
+	// Uma duplicata fica registrada como aplicada: repeti-la continua sendo duplicata
+	status := result.Status
+	if status == vos.SyncOperationDuplicate {
+		status = vos.SyncOperationApplied
+	}
+	record := ports.SyncOperationRecord{
+		ID:        op.ID,
+		UserID:    userID,
+		Type:      op.Type,
+		EntityID:  result.EntityID,
+		Status:    status,
+		Code:      result.Code,
+		Message:   result.Message,
+		AppliedAt: receivedAt,
+	}
+	saved, err := uc.syncRepo.SaveOperation(ctx, &record)
+	if err != nil {
+		return SyncOperationResult{}, nil, fmt.Errorf("failed to save sync operation: %w", err)
+	}
+	if !saved {
+		return SyncOperationResult{}, nil, errOperationStored
+	}
+	return result, awarded, nil
+}
+
+// storedSyncResult returns the outcome of an operation synced before: applied operations
+// are reported as duplicates.
+func storedSyncResult(previous *ports.SyncOperationRecord) SyncOperationResult {
+	result := SyncOperationResult{
+		OperationID: previous.ID,
+		Type:        previous.Type,
+		EntityID:    previous.EntityID,
+		Status:      previous.Status,
+		Code:        previous.Code,
+		Message:     previous.Message,
+	}
+	if previous.Status == vos.SyncOperationApplied {
+		result.Status = vos.SyncOperationDuplicate
+	}
+	return result
+}
+
+// apply runs op through the use case of its type.
+func (uc *SyncSessionsUC) apply(ctx context.Context, userID uuid.UUID, op SyncOperation, receivedAt time.Time) ([]entities.UserAchievement, error) {
+	if err := op.Type.Validate(); err != nil {
+		return nil, err
+	}
+	if op.SessionID == uuid.Nil || op.ClientTimestamp.IsZero() {
+		return nil, fmt.Errorf("sessionId and clientTimestamp are required: %w", errors.ErrMalformedParameters)
+	}
+	at := op.ClientTimestamp
+	if at.After(receivedAt) {
+		at = receivedAt
+	}
+
+	switch op.Type {
+	case vos.SyncOperationStartSession:
+		// O ID da sessão vem do cliente: pode já existir, do próprio usuário ou de outro
+		existing, err := uc.sessionRepo.FindByID(ctx, op.SessionID)
+		if err != nil && !stdErrors.Is(err, sql.ErrNoRows) {
+			return nil, fmt.Errorf("failed to find session: %w", err)
+		}
+		if err == nil && existing != nil {
+			if existing.UserID == userID {
+				return nil, errAlreadyApplied
+			}
+			return nil, fmt.Errorf("session id %s is already in use: %w", op.SessionID, errors.ErrConflict)
+		}
+		_, err = uc.start.Execute(ctx, StartSessionInput{
+			UserID:    userID,
+			WorkoutID: op.WorkoutID,
+			SessionID: op.SessionID,
+			StartedAt: &at,
+		})
+		return nil, err
+	case vos.SyncOperationRecordSet:
+		if op.SetID == uuid.Nil {
+			return nil, fmt.Errorf("setId is required: %w", errors.ErrMalformedParameters)
+		}
+		out, err := uc.recordSet.Execute(ctx, RecordSetInput{
+			UserID:      userID,
+			SessionID:   op.SessionID,
+			ExerciseID:  op.ExerciseID,
+			SetNumber:   op.SetNumber,
+			Weight:      op.Weight,
+			Reps:        op.Reps,
+			Status:      op.SetStatus,
+			SetRecordID: op.SetID,
+			RecordedAt:  &at,
+		})
+		return out.Achievements, err
+	case vos.SyncOperationFinishSession:
+		out, err := uc.finish.Execute(ctx, FinishSessionInput{
+			UserID:     userID,
+			SessionID:  op.SessionID,
+			Notes:      op.Notes,
+			FinishedAt: &at,
+		})
+		return out.Achievements, err
+	default: // vos.SyncOperationAbandonSession
+		_, err := uc.abandon.Execute(ctx, AbandonSessionInput{
+			UserID:      userID,
+			SessionID:   op.SessionID,
+			AbandonedAt: &at,
+		})
+		return nil, err
+	}
+}
+
+// syncRejectionCode maps the domain errors that reject an operation to the code reported
+// to the client; other errors are unexpected failures.
+func syncRejectionCode(err error) (string, bool) {
+	switch {
+	case stdErrors.Is(err, errors.ErrMalformedParameters):
+		return "VALIDATION_ERROR", true
+	case stdErrors.Is(err, errors.ErrConflict):
+		return "SESSION_ID_CONFLICT", true
+	case stdErrors.Is(err, errors.ErrNotFound):
+		return "SESSION_NOT_FOUND", true
+	case stdErrors.Is(err, errors.ErrWorkoutNotFound):
+		return "WORKOUT_NOT_FOUND", true
+	case stdErrors.Is(err, errors.ErrExerciseNotFound):
+		return "EXERCISE_NOT_FOUND", true
+	case stdErrors.Is(err, errors.ErrActiveSessionExists):
+		return "ACTIVE_SESSION_EXISTS", true
+	case stdErrors.Is(err, errors.ErrSetAlreadyRecorded):
+		return "SET_ALREADY_RECORDED", true
+	case stdErrors.Is(err, errors.ErrSessionNotActive):
+		return "SESSION_NOT_ACTIVE", true
+	case stdErrors.Is(err, errors.ErrSessionAlreadyClosed):
+		return "SESSION_ALREADY_CLOSED", true
+	}
+	return "", false
+}
@@ -0,0 +1,372 @@
+package sessions_test
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"sort"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/kinetria/kinetria-back/internal/kinetria/domain/entities"
+	"github.com/kinetria/kinetria-back/internal/kinetria/domain/ports"
+	"github.com/kinetria/kinetria-back/internal/kinetria/domain/sessions"
+	"github.com/kinetria/kinetria-back/internal/kinetria/domain/vos"
+)
+
+func TestSyncSessionsUC_Execute(t *testing.T) {
+	userID := uuid.New()
+	workoutID := uuid.New()
+	bench := uuid.New()
+	startedAt := time.Now().Add(-3 * time.Hour).Truncate(time.Second)
+
+	newUCWith := func(transactor ports.Transactor, auditRepo *mockAuditRepo) (*sessions.SyncSessionsUC, *memorySessionRepo, *mockSyncRepo) {
+		sessionRepo := &memorySessionRepo{sessions: map[uuid.UUID]*entities.Session{}, changeSeqs: map[uuid.UUID]int64{}}
+		syncRepo := &mockSyncRepo{operations: map[uuid.UUID]ports.SyncOperationRecord{}, sessions: sessionRepo}
+		workoutRepo := &mockWorkoutRepository{existsResponse: true}
+		start := sessions.NewStartSessionUC(transactor, sessionRepo, workoutRepo, auditRepo, &mockReadinessRepo{}, &mockReadinessRecorder{})
+		recordSet := sessions.NewRecordSetUseCase(transactor, sessionRepo, &mockSetRecordRepo{}, &mockExerciseRepo{}, auditRepo, nil)
+		finish := sessions.NewFinishSessionUseCase(transactor, sessionRepo, &mockSessionEffortRepo{}, nil, &mockStatsRollupRepo{}, auditRepo, &mockSessionSummaryRepo{}, &mockSetRecordRepo{}, nil, nil)
+		abandon := sessions.NewAbandonSessionUseCase(transactor, sessionRepo, auditRepo)
+		return sessions.NewSyncSessionsUC(transactor, syncRepo, sessionRepo, start, recordSet, finish, abandon), sessionRepo, syncRepo
+	}
+	newUC := func() (*sessions.SyncSessionsUC, *memorySessionRepo, *mockSyncRepo, *mockTransactor) {
+		transactor := &mockTransactor{}
+		uc, sessionRepo, syncRepo := newUCWith(transactor, &mockAuditRepo{})
+		return uc, sessionRepo, syncRepo, transactor
+	}
+
+	// Treino completo feito offline: início, duas séries e fim
+	sessionID := uuid.New()
+	offlineWorkout := []sessions.SyncOperation{
+		{ID: uuid.New(), Type: vos.SyncOperationStartSession, ClientTimestamp: startedAt, SessionID: sessionID, WorkoutID: workoutID},
+		{ID: uuid.New(), Type: vos.SyncOperationRecordSet, ClientTimestamp: startedAt.Add(10 * time.Minute), SessionID: sessionID, SetID: uuid.New(), ExerciseID: bench, SetNumber: 1, Weight: 60000, Reps: 10, SetStatus: vos.SetRecordStatusCompleted},
+		{ID: uuid.New(), Type: vos.SyncOperationRecordSet, ClientTimestamp: startedAt.Add(15 * time.Minute), SessionID: sessionID, SetID: uuid.New(), ExerciseID: bench, SetNumber: 2, Weight: 60000, Reps: 8, SetStatus: vos.SetRecordStatusCompleted},
+		{ID: uuid.New(), Type: vos.SyncOperationFinishSession, ClientTimestamp: startedAt.Add(time.Hour), SessionID: sessionID, Notes: "offline"},
+	}
+
+	t.Run("applies_operations_in_order_with_client_ids_and_times", func(t *testing.T) {
+		uc, sessionRepo, _, _ := newUC()
+		out, err := uc.Execute(context.Background(), sessions.SyncInput{UserID: userID, Operations: offlineWorkout})
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		for i, r := range out.Results {
+			if r.Status != vos.SyncOperationApplied || r.OperationID != offlineWorkout[i].ID {
+				t.Errorf("operation %d: unexpected result %+v", i, r)
+			}
+		}
+		session := sessionRepo.sessions[sessionID]
+		if session == nil || session.Status != vos.SessionStatusCompleted || !session.StartedAt.Equal(startedAt) || !session.FinishedAt.Equal(startedAt.Add(time.Hour)) {
+			t.Fatalf("expected the session to be stored with the client times, got %+v", session)
+		}
+		if len(out.Changes) != 1 || out.Changes[0].Session.ID != sessionID || out.Cursor != out.Changes[0].Cursor || out.HasMore {
+			t.Errorf("expected the synced session in the changes, got %+v", out)
+		}
+	})
+
+	t.Run("replayed_batch_is_not_applied_twice", func(t *testing.T) {
+		uc, _, syncRepo, _ := newUC()
+		if _, err := uc.Execute(context.Background(), sessions.SyncInput{UserID: userID, Operations: offlineWorkout}); err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		saved := len(syncRepo.operations)
+		out, err := uc.Execute(context.Background(), sessions.SyncInput{UserID: userID, Operations: offlineWorkout})
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		for i, r := range out.Results {
+			if r.Status != vos.SyncOperationDuplicate {
+				t.Errorf("operation %d: expected duplicate, got %+v", i, r)
+			}
+		}
+		if len(syncRepo.operations) != saved {
+			t.Error("expected no new operation to be stored")
+		}
+	})
+
+	t.Run("server_state_wins_and_rejections_are_final", func(t *testing.T) {
+		uc, sessionRepo, _, _ := newUC()
+		// Outra sessão já está ativa no servidor
+		sessionRepo.sessions[uuid.New()] = &entities.Session{UserID: userID, Status: vos.SessionStatusActive, StartedAt: startedAt}
+
+		out, err := uc.Execute(context.Background(), sessions.SyncInput{UserID: userID, Operations: offlineWorkout})
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		want := []string{"ACTIVE_SESSION_EXISTS", "SESSION_NOT_FOUND", "SESSION_NOT_FOUND", "SESSION_NOT_FOUND"}
+		for i, r := range out.Results {
+			if r.Status != vos.SyncOperationRejected || r.Code != want[i] {
+				t.Errorf("operation %d: expected rejection %s, got %+v", i, want[i], r)
+			}
+		}
+
+		// Mesmo depois de a sessão ativa terminar, o retry repete as rejeições
+		for _, s := range sessionRepo.sessions {
+			s.Status = vos.SessionStatusAbandoned
+		}
+		out, err = uc.Execute(context.Background(), sessions.SyncInput{UserID: userID, Operations: offlineWorkout})
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if out.Results[0].Status != vos.SyncOperationRejected || out.Results[0].Code != "ACTIVE_SESSION_EXISTS" {
+			t.Errorf("expected the stored rejection, got %+v", out.Results[0])
+		}
+	})
+
+	t.Run("session_id_of_another_user", func(t *testing.T) {
+		uc, sessionRepo, _, _ := newUC()
+		sessionRepo.sessions[sessionID] = &entities.Session{ID: sessionID, UserID: uuid.New(), Status: vos.SessionStatusActive}
+		out, err := uc.Execute(context.Background(), sessions.SyncInput{UserID: userID, Operations: offlineWorkout[:1]})
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if out.Results[0].Status != vos.SyncOperationRejected || out.Results[0].Code != "SESSION_ID_CONFLICT" {
+			t.Errorf("expected SESSION_ID_CONFLICT, got %+v", out.Results[0])
+		}
+	})
+
+	t.Run("future_timestamps_are_clamped", func(t *testing.T) {
+		uc, sessionRepo, _, _ := newUC()
+		op := offlineWorkout[0]
+		op.ClientTimestamp = time.Now().Add(time.Hour)
+		before := time.Now()
+		if _, err := uc.Execute(context.Background(), sessions.SyncInput{UserID: userID, Operations: []sessions.SyncOperation{op}}); err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		session := sessionRepo.sessions[sessionID]
+		if session == nil || session.StartedAt.Before(before) || session.StartedAt.After(time.Now()) {
+			t.Errorf("expected the start to be clamped to the sync time, got %+v", session)
+		}
+	})
+
+	t.Run("invalid_operation_is_rejected", func(t *testing.T) {
+		uc, _, _, _ := newUC()
+		op := sessions.SyncOperation{ID: uuid.New(), Type: "session.pause", ClientTimestamp: startedAt, SessionID: sessionID}
+		out, err := uc.Execute(context.Background(), sessions.SyncInput{UserID: userID, Operations: []sessions.SyncOperation{op}})
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if out.Results[0].Status != vos.SyncOperationRejected || out.Results[0].Code != "VALIDATION_ERROR" {
+			t.Errorf("expected VALIDATION_ERROR, got %+v", out.Results[0])
+		}
+	})
+
+	t.Run("changes_are_paginated", func(t *testing.T) {
+		uc, sessionRepo, _, _ := newUC()
+		for i := 0; i < sessions.SyncPageSize+1; i++ {
+			_ = sessionRepo.Create(context.Background(), &entities.Session{ID: uuid.New(), UserID: userID, Status: vos.SessionStatusCompleted})
+		}
+		out, err := uc.Execute(context.Background(), sessions.SyncInput{UserID: userID})
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if len(out.Changes) != sessions.SyncPageSize || !out.HasMore {
+			t.Fatalf("expected a full page with more to come, got %d changes", len(out.Changes))
+		}
+		out, err = uc.Execute(context.Background(), sessions.SyncInput{UserID: userID, Cursor: out.Cursor})
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if len(out.Changes) != 1 || out.HasMore {
+			t.Errorf("expected the last change, got %d changes", len(out.Changes))
+		}
+	})
+
+	t.Run("operation_stored_by_a_concurrent_sync", func(t *testing.T) {
+		uc, _, syncRepo, transactor := newUC()
+		// A outra sincronização grava a operação depois da leitura desta
+		op := offlineWorkout[0]
+		syncRepo.concurrent = map[uuid.UUID]ports.SyncOperationRecord{
+			op.ID: {ID: op.ID, UserID: userID, Type: op.Type, EntityID: op.SessionID, Status: vos.SyncOperationApplied},
+		}
+		out, err := uc.Execute(context.Background(), sessions.SyncInput{UserID: userID, Operations: []sessions.SyncOperation{op}})
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if out.Results[0].Status != vos.SyncOperationDuplicate || out.Results[0].EntityID != sessionID {
+			t.Errorf("expected the stored outcome as a duplicate, got %+v", out.Results[0])
+		}
+		if len(transactor.rolledBack) != 1 {
+			t.Errorf("expected the unit of work to be rolled back, got %v", transactor.rolledBack)
+		}
+	})
+
+	t.Run("failed_audit_does_not_abort_the_operation", func(t *testing.T) {
+		// O Postgres aborta a transação de um comando que falha, mesmo com o erro ignorado
+		auditRepo := &mockAuditRepo{append: func(ctx context.Context, _ *entities.AuditLog) error {
+			return failStatement(ctx, errors.New("audit log unavailable"))
+		}}
+		uc, sessionRepo, _ := newUCWith(abortingTransactor{}, auditRepo)
+		out, err := uc.Execute(context.Background(), sessions.SyncInput{UserID: userID, Operations: offlineWorkout})
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		for i, r := range out.Results {
+			if r.Status != vos.SyncOperationApplied {
+				t.Errorf("operation %d: expected applied, got %+v", i, r)
+			}
+		}
+		if session := sessionRepo.sessions[sessionID]; session == nil || session.Status != vos.SessionStatusCompleted {
+			t.Errorf("expected the session to be finished, got %+v", session)
+		}
+	})
+
+	t.Run("too_many_operations", func(t *testing.T) {
+		uc, _, _, _ := newUC()
+		ops := make([]sessions.SyncOperation, sessions.MaxSyncOperations+1)
+		for i := range ops {
+			ops[i] = sessions.SyncOperation{ID: uuid.New()}
+		}
+		_, err := uc.Execute(context.Background(), sessions.SyncInput{UserID: userID, Operations: ops})
+		if err == nil {
+			t.Error("expected an error for an oversized batch")
+		}
+	})
+
+	t.Run("internal_error_aborts_the_batch", func(t *testing.T) {
+		uc, _, syncRepo, _ := newUC()
+		syncRepo.err = errors.New("connection reset")
+		if _, err := uc.Execute(context.Background(), sessions.SyncInput{UserID: userID, Operations: offlineWorkout}); err == nil {
+			t.Error("expected the error to be returned")
+		}
+	})
+}
+
+// memorySessionRepo is an in-memory SessionRepository, so that the operations of a sync
+// batch see the sessions created by the previous ones.
+type memorySessionRepo struct {
+	sessions map[uuid.UUID]*entities.Session
+	// changeSeqs numera as mudanças de cada sessão, como o change_seq do banco
+	changeSeqs map[uuid.UUID]int64
+	seq        int64
+}
+
+func (m *memorySessionRepo) Create(_ context.Context, session *entities.Session) error {
+	stored := *session
+	m.sessions[session.ID] = &stored
+	m.touch(session.ID)
+	return nil
+}
+
+func (m *memorySessionRepo) touch(sessionID uuid.UUID) {
+	m.seq++
+	m.changeSeqs[sessionID] = m.seq
+}
+
+func (m *memorySessionRepo) FindActiveByUserID(_ context.Context, userID uuid.UUID) (*entities.Session, error) {
+	for _, s := range m.sessions {
+		if s.UserID == userID && s.Status == vos.SessionStatusActive {
+			return s, nil
+		}
+	}
+	return nil, nil
+}
+
+func (m *memorySessionRepo) FindByID(_ context.Context, sessionID uuid.UUID) (*entities.Session, error) {
+	s, ok := m.sessions[sessionID]
+	if !ok {
+		return nil, sql.ErrNoRows
+	}
+	found := *s
+	return &found, nil
+}
+
+func (m *memorySessionRepo) UpdateStatus(_ context.Context, sessionID uuid.UUID, status string, finishedAt *time.Time, notes string) (bool, error) {
+	s, ok := m.sessions[sessionID]
+	if !ok || s.Status != vos.SessionStatusActive {
+		return false, nil
+	}
+	s.Status, s.FinishedAt, s.Notes = vos.SessionStatus(status), finishedAt, notes
+	m.touch(sessionID)
+	return true, nil
+}
+
+func (m *memorySessionRepo) GetCompletedSessionsByUserAndDateRange(_ context.Context, _ uuid.UUID, _, _ time.Time, _ *time.Location) ([]entities.Session, error) {
+	return nil, nil
+}
+
+func (m *memorySessionRepo) GetStatsByUserAndPeriod(_ context.Context, _ uuid.UUID, _, _ time.Time) (*ports.SessionStats, error) {
+	return &ports.SessionStats{}, nil
+}
+
+func (m *memorySessionRepo) GetFrequencyByUserAndPeriod(_ context.Context, _ uuid.UUID, _, _ time.Time, _ *time.Location) ([]ports.FrequencyData, error) {
+	return nil, nil
+}
+
+func (m *memorySessionRepo) GetSessionsForStreak(_ context.Context, _ uuid.UUID, _ *time.Location) ([]time.Time, error) {
+	return nil, nil
+}
+
+// mockSyncRepo is an in-memory SyncRepository whose change feed reads from a memorySessionRepo.
+type mockSyncRepo struct {
+	err        error
+	operations map[uuid.UUID]ports.SyncOperationRecord
+	// concurrent holds operations stored by another sync right before this one saves them
+	concurrent map[uuid.UUID]ports.SyncOperationRecord
+	sessions   *memorySessionRepo
+}
+
+func (m *mockSyncRepo) FindOperation(_ context.Context, _, operationID uuid.UUID) (*ports.SyncOperationRecord, error) {
+	if m.err != nil {
+		return nil, m.err
+	}
+	record, ok := m.operations[operationID]
+	if !ok {
+		return nil, nil
+	}
+	return &record, nil
+}
+
+func (m *mockSyncRepo) SaveOperation(_ context.Context, record *ports.SyncOperationRecord) (bool, error) {
+	if stored, ok := m.concurrent[record.ID]; ok {
+		m.operations[record.ID] = stored
+	}
+	if _, ok := m.operations[record.ID]; ok {
+		return false, nil
+	}
+	m.operations[record.ID] = *record
+	return true, nil
+}
+
+func (m *mockSyncRepo) ListSessionChanges(_ context.Context, userID uuid.UUID, after ports.SyncCursor, limit int) ([]ports.SessionChange, error) {
+	var changes []ports.SessionChange
+	for _, s := range m.sessions.sessions {
+		seq := m.sessions.changeSeqs[s.ID]
+		if s.UserID != userID || seq <= after.ChangeSeq {
+			continue
+		}
+		changes = append(changes, ports.SessionChange{Session: *s, Cursor: ports.SyncCursor{ChangeSeq: seq}})
+	}
+	sort.Slice(changes, func(i, j int) bool { return changes[i].Cursor.ChangeSeq < changes[j].Cursor.ChangeSeq })
+	if len(changes) > limit {
+		changes = changes[:limit]
+	}
+	return changes, nil
+}
+
+type abortedKey struct{}
+
+// abortingTransactor models Postgres transactions: a statement that fails aborts the unit of
+// work it runs in, which then cannot commit unless it is rolled back.
+type abortingTransactor struct{}
+
+func (abortingTransactor) WithinTx(ctx context.Context, fn func(ctx context.Context) error) error {
+	aborted := new(bool)
+	if err := fn(context.WithValue(ctx, abortedKey{}, aborted)); err != nil {
+		return err
+	}
+	if *aborted {
+		return errors.New("current transaction is aborted")
+	}
+	return nil
+}
+
+// failStatement aborts the unit of work of ctx and returns err.
+func failStatement(ctx context.Context, err error) error {
+	if aborted, ok := ctx.Value(abortedKey{}).(*bool); ok {
+		*aborted = true
+	}
+	return err
+}
@@ -0,0 +1,204 @@
+package sessions
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	stdErrors "errors"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/kinetria/kinetria-back/internal/kinetria/domain/entities"
+	"github.com/kinetria/kinetria-back/internal/kinetria/domain/errors"
+	"github.com/kinetria/kinetria-back/internal/kinetria/domain/ports"
+	"github.com/kinetria/kinetria-back/internal/kinetria/domain/vos"
+)
+
+// UpdateSessionInput holds the corrections to a completed session. Nil fields are kept.
+type UpdateSessionInput struct {
+	UserID     uuid.UUID
+	SessionID  uuid.UUID
+	StartedAt  *time.Time
+	FinishedAt *time.Time
+	Notes      *string
+}
+
+// UpdateSessionUC corrects the start and finish times and the notes of a completed session,
+// e.g. when it was finished in the app long after the workout ended. New times must not
+// overlap another session; the calorie estimate and the rollups of the days involved are
+// recomputed in the same transaction as the new times.
+type UpdateSessionUC struct {
+	transactor     ports.Transactor
+	sessionRepo    ports.SessionRepository
+	logRepo        ports.SessionLogRepository
+	effortRepo     ports.SessionEffortRepository
+	bodyWeightRepo ports.BodyWeightRepository
+	rollupRepo     ports.StatsRollupRepository
+	auditLogRepo   ports.AuditLogRepository
+	achievements   ports.AchievementEvaluator
+	goals          ports.GoalEvaluator
+}
+
+// NewUpdateSessionUC creates a new UpdateSessionUC.
+// bodyWeightRepo may be nil, in which case the reference body weight is used;
+// achievements and goals may be nil, in which case they are not evaluated.
+func NewUpdateSessionUC(
+	transactor ports.Transactor,
+	sessionRepo ports.SessionRepository,
+	logRepo ports.SessionLogRepository,
+	effortRepo ports.SessionEffortRepository,
+	bodyWeightRepo ports.BodyWeightRepository,
+	rollupRepo ports.StatsRollupRepository,
+	auditLogRepo ports.AuditLogRepository,
+	achievements ports.AchievementEvaluator,
+	goals ports.GoalEvaluator,
+) *UpdateSessionUC {
+	return &UpdateSessionUC{
+		transactor:     transactor,
+		sessionRepo:    sessionRepo,
+		logRepo:        logRepo,
+		effortRepo:     effortRepo,
+		bodyWeightRepo: bodyWeightRepo,
+		rollupRepo:     rollupRepo,
+		auditLogRepo:   auditLogRepo,
+		achievements:   achievements,
+		goals:          goals,
+	}
+}
+
+// Execute applies the corrections. Only completed sessions can be corrected; others return
+// ErrConflict. Overlapping times return ErrSessionOverlap.
+func (uc *UpdateSessionUC) Execute(ctx context.Context, input UpdateSessionInput) (entities.Session, error) {
+	if input.SessionID == uuid.Nil {
+		return entities.Session{}, errors.ErrMalformedParameters
+	}
+
+	session, err := uc.sessionRepo.FindByID(ctx, input.SessionID)
+	if err != nil {
+		if stdErrors.Is(err, sql.ErrNoRows) {
+			return entities.Session{}, errors.ErrNotFound
+		}
+		return entities.Session{}, fmt.Errorf("failed to find session: %w", err)
+	}
+	if session == nil || session.UserID != input.UserID {
+		return entities.Session{}, errors.ErrNotFound
+	}
+	if session.Status != vos.SessionStatusCompleted || session.FinishedAt == nil {
+		return entities.Session{}, errors.ErrConflict
+	}
+
+	before := *session
+	startedAt, finishedAt, notes := session.StartedAt, *session.FinishedAt, session.Notes
+	if input.StartedAt != nil {
+		startedAt = *input.StartedAt
+	}
+	if input.FinishedAt != nil {
+		finishedAt = *input.FinishedAt
+	}
+	if input.Notes != nil {
+		notes = *input.Notes
+	}
+
+	now := time.Now()
+	if !finishedAt.After(startedAt) {
+		return entities.Session{}, fmt.Errorf("finishedAt must be after startedAt: %w", errors.ErrMalformedParameters)
+	}
+	if finishedAt.After(now) {
+		return entities.Session{}, fmt.Errorf("finishedAt must not be in the future: %w", errors.ErrMalformedParameters)
+	}
+	if finishedAt.Sub(startedAt) > maxPastSessionDuration {
+		return entities.Session{}, fmt.Errorf("session must not last more than %d hours: %w", int(maxPastSessionDuration.Hours()), errors.ErrMalformedParameters)
+	}
+
+	session.StartedAt = startedAt
+	session.FinishedAt = &finishedAt
+	session.Notes = notes
+	session.UpdatedAt = now
+	timesChanged := !startedAt.Equal(before.StartedAt) || !finishedAt.Equal(*before.FinishedAt)
+	bodyWeight := 0
+	if timesChanged {
+		bodyWeight, err = latestBodyWeight(ctx, uc.bodyWeightRepo, input.UserID)
+		if err != nil {
+			return entities.Session{}, fmt.Errorf("failed to get body weight: %w", err)
+		}
+	}
+
+	// Horários, calorias e rollups são gravados juntos ou nenhum deles
+	err = uc.transactor.WithinTx(ctx, func(ctx context.Context) error {
+		updated, err := uc.logRepo.UpdateCompleted(ctx, session)
+		if err != nil {
+			if stdErrors.Is(err, errors.ErrSessionOverlap) {
+				return err
+			}
+			return fmt.Errorf("failed to update session: %w", err)
+		}
+		if !updated {
+			return errors.ErrConflict
+		}
+		if !timesChanged {
+			return nil
+		}
+
+		// A estimativa de calorias depende do tempo ativo, que mudou com os horários
+		effort, err := uc.effortRepo.GetSessionEffort(ctx, session.ID)
+		if err != nil {
+			return fmt.Errorf("failed to get session effort: %w", err)
+		}
+		calories := estimateSessionCalories(*effort, bodyWeight, finishedAt)
+		if err := uc.effortRepo.SetSessionCalories(ctx, session.ID, calories); err != nil {
+			return fmt.Errorf("failed to store session calories: %w", err)
+		}
+		session.Calories = &calories
+
+		// O rollup do dia antigo perde a sessão e o do novo dia a ganha
+		if err := uc.rollupRepo.RefreshDay(ctx, input.UserID, before.StartedAt); err != nil {
+			return fmt.Errorf("failed to refresh stats rollups: %w", err)
+		}
+		if !startedAt.Equal(before.StartedAt) {
+			if err := uc.rollupRepo.RefreshDay(ctx, input.UserID, startedAt); err != nil {
+				return fmt.Errorf("failed to refresh stats rollups: %w", err)
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		return entities.Session{}, err
+	}
+
+	// Audit log
+	actionData, _ := json.Marshal(map[string]interface{}{
+		"before": map[string]interface{}{
+			"startedAt":  before.StartedAt,
+			"finishedAt": before.FinishedAt,
+			"notes":      before.Notes,
+			"calories":   before.Calories,
+		},
+		"after": map[string]interface{}{
+			"startedAt":  session.StartedAt,
+			"finishedAt": session.FinishedAt,
+			"notes":      session.Notes,
+			"calories":   session.Calories,
+		},
+	})
+	auditEntry := entities.AuditLog{
+		ID:         uuid.New(),
+		UserID:     input.UserID,
+		EntityType: "session",
+		EntityID:   session.ID,
+		Action:     "updated",
+		ActionData: actionData,
+		OccurredAt: now,
+	}
+	_ = uc.auditLogRepo.Append(ctx, &auditEntry)
+
+	// Conquistas e metas já alcançadas não são revogadas; novos horários podem alcançar outras (best-effort)
+	if timesChanged && uc.achievements != nil {
+		_, _ = uc.achievements.Execute(ctx, input.UserID)
+	}
+	if timesChanged && uc.goals != nil {
+		_, _ = uc.goals.Execute(ctx, input.UserID)
+	}
+
+	return *session, nil
+}
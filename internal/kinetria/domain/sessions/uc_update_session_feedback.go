@@ -0,0 +1,112 @@
+package sessions
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	stdErrors "errors"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/kinetria/kinetria-back/internal/kinetria/domain/entities"
+	"github.com/kinetria/kinetria-back/internal/kinetria/domain/errors"
+	"github.com/kinetria/kinetria-back/internal/kinetria/domain/ports"
+	"github.com/kinetria/kinetria-back/internal/kinetria/domain/vos"
+)
+
+// UpdateSessionFeedbackInput holds the new feedback of a session.
+type UpdateSessionFeedbackInput struct {
+	UserID    uuid.UUID
+	SessionID uuid.UUID
+	Feedback  vos.SessionFeedback
+}
+
+// UpdateSessionFeedbackUC edits the post-session feedback of a completed session.
+type UpdateSessionFeedbackUC struct {
+	sessionRepo  ports.SessionRepository
+	effortRepo   ports.SessionEffortRepository
+	auditLogRepo ports.AuditLogRepository
+}
+
+// NewUpdateSessionFeedbackUC creates a new UpdateSessionFeedbackUC.
+func NewUpdateSessionFeedbackUC(
+	sessionRepo ports.SessionRepository,
+	effortRepo ports.SessionEffortRepository,
+	auditLogRepo ports.AuditLogRepository,
+) *UpdateSessionFeedbackUC {
+	return &UpdateSessionFeedbackUC{
+		sessionRepo:  sessionRepo,
+		effortRepo:   effortRepo,
+		auditLogRepo: auditLogRepo,
+	}
+}
+
+// Execute replaces the whole feedback of the session: ratings left nil are cleared.
+// Only completed sessions take feedback; others return ErrConflict.
+func (uc *UpdateSessionFeedbackUC) Execute(ctx context.Context, input UpdateSessionFeedbackInput) (*vos.SessionFeedback, error) {
+	if input.SessionID == uuid.Nil {
+		return nil, errors.ErrMalformedParameters
+	}
+	if err := input.Feedback.Validate(); err != nil {
+		return nil, err
+	}
+
+	session, err := uc.sessionRepo.FindByID(ctx, input.SessionID)
+	if err != nil {
+		if stdErrors.Is(err, sql.ErrNoRows) {
+			return nil, errors.ErrNotFound
+		}
+		return nil, fmt.Errorf("failed to find session: %w", err)
+	}
+	if session == nil || session.UserID != input.UserID {
+		return nil, errors.ErrNotFound
+	}
+	if session.Status != vos.SessionStatusCompleted {
+		return nil, fmt.Errorf("%w: only completed sessions take feedback", errors.ErrConflict)
+	}
+
+	previous, err := uc.effortRepo.GetSessionFeedback(ctx, input.SessionID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get session feedback: %w", err)
+	}
+	feedback := input.Feedback
+	if feedback.Soreness == nil {
+		feedback.Soreness = map[vos.MuscleGroup]int{}
+	}
+	if err := uc.effortRepo.SetSessionFeedback(ctx, input.SessionID, feedback); err != nil {
+		return nil, fmt.Errorf("failed to store session feedback: %w", err)
+	}
+
+	now := time.Now()
+	actionData, _ := json.Marshal(map[string]interface{}{
+		"before": feedbackAuditData(previous),
+		"after":  feedbackAuditData(&feedback),
+	})
+	auditEntry := entities.AuditLog{
+		ID:         uuid.New(),
+		UserID:     input.UserID,
+		EntityType: "session",
+		EntityID:   session.ID,
+		Action:     "feedback_updated",
+		ActionData: actionData,
+		OccurredAt: now,
+	}
+	_ = uc.auditLogRepo.Append(ctx, &auditEntry)
+
+	return &feedback, nil
+}
+
+// feedbackAuditData is the audit log representation of a feedback.
+func feedbackAuditData(f *vos.SessionFeedback) map[string]interface{} {
+	if f == nil {
+		return nil
+	}
+	return map[string]interface{}{
+		"rpe":          f.RPE,
+		"mood":         f.Mood,
+		"energy":       f.Energy,
+		"sleepQuality": f.SleepQuality,
+		"soreness":     f.Soreness,
+	}
+}
@@ -0,0 +1,85 @@
+package sessions_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/kinetria/kinetria-back/internal/kinetria/domain/entities"
+	domainerrors "github.com/kinetria/kinetria-back/internal/kinetria/domain/errors"
+	"github.com/kinetria/kinetria-back/internal/kinetria/domain/sessions"
+	"github.com/kinetria/kinetria-back/internal/kinetria/domain/vos"
+)
+
+func TestUpdateSessionFeedbackUC_Execute(t *testing.T) {
+	userID := uuid.New()
+	sessionID := uuid.New()
+	finishedAt := time.Now().Add(-time.Hour)
+
+	status := vos.SessionStatusCompleted
+	repo := &mockFinishSessionRepo{
+		findByID: func(ctx context.Context, id uuid.UUID) (*entities.Session, error) {
+			return &entities.Session{ID: sessionID, UserID: userID, Status: status, StartedAt: finishedAt.Add(-time.Hour), FinishedAt: &finishedAt}, nil
+		},
+	}
+
+	t.Run("replaces_feedback_and_audits", func(t *testing.T) {
+		effortRepo := &mockSessionEffortRepo{storedFeedback: &vos.SessionFeedback{RPE: intPtr(7), Mood: intPtr(2)}}
+		var audited *entities.AuditLog
+		auditRepo := &mockAuditRepo{append: func(ctx context.Context, entry *entities.AuditLog) error {
+			audited = entry
+			return nil
+		}}
+		uc := sessions.NewUpdateSessionFeedbackUC(repo, effortRepo, auditRepo)
+
+		out, err := uc.Execute(context.Background(), sessions.UpdateSessionFeedbackInput{
+			UserID:    userID,
+			SessionID: sessionID,
+			Feedback:  vos.SessionFeedback{RPE: intPtr(9), Energy: intPtr(3)},
+		})
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if *out.RPE != 9 || out.Mood != nil || *out.Energy != 3 || out.Soreness == nil {
+			t.Errorf("unexpected feedback: %+v", out)
+		}
+		if fb := effortRepo.storedFeedback; *fb.RPE != 9 || fb.Mood != nil {
+			t.Errorf("expected mood to be cleared, got %+v", fb)
+		}
+		if audited == nil || audited.Action != "feedback_updated" {
+			t.Errorf("expected feedback_updated audit entry, got %+v", audited)
+		}
+	})
+
+	t.Run("invalid_rating", func(t *testing.T) {
+		uc := sessions.NewUpdateSessionFeedbackUC(repo, &mockSessionEffortRepo{}, &mockAuditRepo{})
+		_, err := uc.Execute(context.Background(), sessions.UpdateSessionFeedbackInput{
+			UserID:    userID,
+			SessionID: sessionID,
+			Feedback:  vos.SessionFeedback{Soreness: map[vos.MuscleGroup]int{"elbows": 2}},
+		})
+		if !errors.Is(err, domainerrors.ErrMalformedParameters) {
+			t.Errorf("expected ErrMalformedParameters, got %v", err)
+		}
+	})
+
+	t.Run("other_users_session", func(t *testing.T) {
+		uc := sessions.NewUpdateSessionFeedbackUC(repo, &mockSessionEffortRepo{}, &mockAuditRepo{})
+		_, err := uc.Execute(context.Background(), sessions.UpdateSessionFeedbackInput{UserID: uuid.New(), SessionID: sessionID})
+		if !errors.Is(err, domainerrors.ErrNotFound) {
+			t.Errorf("expected ErrNotFound, got %v", err)
+		}
+	})
+
+	t.Run("active_session", func(t *testing.T) {
+		status = vos.SessionStatusActive
+		defer func() { status = vos.SessionStatusCompleted }()
+		uc := sessions.NewUpdateSessionFeedbackUC(repo, &mockSessionEffortRepo{}, &mockAuditRepo{})
+		_, err := uc.Execute(context.Background(), sessions.UpdateSessionFeedbackInput{UserID: userID, SessionID: sessionID, Feedback: vos.SessionFeedback{Mood: intPtr(3)}})
+		if !errors.Is(err, domainerrors.ErrConflict) {
+			t.Errorf("expected ErrConflict, got %v", err)
+		}
+	})
+}
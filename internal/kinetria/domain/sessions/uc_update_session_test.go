@@ -0,0 +1,215 @@
+package sessions_test
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/kinetria/kinetria-back/internal/kinetria/domain/entities"
+	domainerrors "github.com/kinetria/kinetria-back/internal/kinetria/domain/errors"
+	"github.com/kinetria/kinetria-back/internal/kinetria/domain/ports"
+	"github.com/kinetria/kinetria-back/internal/kinetria/domain/sessions"
+	"github.com/kinetria/kinetria-back/internal/kinetria/domain/vos"
+)
+
+func TestUpdateSessionUC_Execute(t *testing.T) {
+	userID := uuid.New()
+	sessionID := uuid.New()
+	startedAt := time.Now().Add(-48 * time.Hour).Truncate(time.Minute)
+	// Finalizada no app duas horas depois do fim real do treino
+	finishedAt := startedAt.Add(3 * time.Hour)
+	calories := 900
+
+	newSession := func(status vos.SessionStatus) *entities.Session {
+		f := finishedAt
+		return &entities.Session{
+			ID:         sessionID,
+			UserID:     userID,
+			WorkoutID:  uuid.New(),
+			Status:     status,
+			Notes:      "treino",
+			StartedAt:  startedAt,
+			FinishedAt: &f,
+			Calories:   &calories,
+		}
+	}
+
+	type deps struct {
+		transactor *mockTransactor
+		logRepo    *mockSessionLogRepo
+		effortRepo *mockSessionEffortRepo
+		rollupRepo *mockStatsRollupRepo
+		audit      *[]entities.AuditLog
+	}
+	newUC := func(session *entities.Session) (*sessions.UpdateSessionUC, deps) {
+		var audit []entities.AuditLog
+		d := deps{
+			transactor: &mockTransactor{},
+			logRepo:    &mockSessionLogRepo{},
+			effortRepo: &mockSessionEffortRepo{effort: &ports.SessionEffort{StartedAt: startedAt}},
+			rollupRepo: &mockStatsRollupRepo{},
+			audit:      &audit,
+		}
+		sessionRepo := &mockFinishSessionRepo{
+			findByID: func(context.Context, uuid.UUID) (*entities.Session, error) { return session, nil },
+		}
+		auditRepo := &mockAuditRepo{append: func(_ context.Context, entry *entities.AuditLog) error {
+			audit = append(audit, *entry)
+			return nil
+		}}
+		uc := sessions.NewUpdateSessionUC(d.transactor, sessionRepo, d.logRepo, d.effortRepo, nil, d.rollupRepo, auditRepo, nil, nil)
+		return uc, d
+	}
+
+	t.Run("corrects_finish_and_recomputes_calories", func(t *testing.T) {
+		uc, d := newUC(newSession(vos.SessionStatusCompleted))
+		realFinish := startedAt.Add(time.Hour)
+		out, err := uc.Execute(context.Background(), sessions.UpdateSessionInput{UserID: userID, SessionID: sessionID, FinishedAt: &realFinish})
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if !out.FinishedAt.Equal(realFinish) || !out.StartedAt.Equal(startedAt) || out.Notes != "treino" {
+			t.Errorf("unexpected session: %+v", out)
+		}
+		if d.logRepo.session == nil || !d.logRepo.session.FinishedAt.Equal(realFinish) {
+			t.Fatal("expected the new times to be persisted")
+		}
+		if d.effortRepo.storedCalories == nil || *d.effortRepo.storedCalories >= calories {
+			t.Errorf("expected lower calories for a shorter session, got %v", d.effortRepo.storedCalories)
+		}
+		if len(d.rollupRepo.refreshed) != 1 || !d.rollupRepo.refreshed[0].Equal(startedAt) {
+			t.Errorf("expected one rollup refresh on the session day, got %v", d.rollupRepo.refreshed)
+		}
+		if len(*d.audit) != 1 || (*d.audit)[0].Action != "updated" {
+			t.Fatalf("expected an updated audit entry, got %+v", *d.audit)
+		}
+		var data map[string]map[string]interface{}
+		if err := json.Unmarshal((*d.audit)[0].ActionData, &data); err != nil || data["before"] == nil || data["after"] == nil {
+			t.Errorf("expected before and after in the audit entry, got %s", (*d.audit)[0].ActionData)
+		}
+	})
+
+	t.Run("moving_start_refreshes_both_days", func(t *testing.T) {
+		uc, d := newUC(newSession(vos.SessionStatusCompleted))
+		newStart := startedAt.Add(-24 * time.Hour)
+		newFinish := newStart.Add(time.Hour)
+		if _, err := uc.Execute(context.Background(), sessions.UpdateSessionInput{UserID: userID, SessionID: sessionID, StartedAt: &newStart, FinishedAt: &newFinish}); err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if len(d.rollupRepo.refreshed) != 2 || !d.rollupRepo.refreshed[0].Equal(startedAt) || !d.rollupRepo.refreshed[1].Equal(newStart) {
+			t.Errorf("expected refreshes of the old and new days, got %v", d.rollupRepo.refreshed)
+		}
+	})
+
+	t.Run("refresh_error_rolls_back_the_new_times", func(t *testing.T) {
+		uc, d := newUC(newSession(vos.SessionStatusCompleted))
+		d.rollupRepo.err = errors.New("db down")
+		realFinish := startedAt.Add(time.Hour)
+		if _, err := uc.Execute(context.Background(), sessions.UpdateSessionInput{UserID: userID, SessionID: sessionID, FinishedAt: &realFinish}); err == nil {
+			t.Fatal("expected error")
+		}
+		if !d.logRepo.inUnitOfWork {
+			t.Error("expected the new times to be written in the unit of work")
+		}
+		if len(d.transactor.rolledBack) != 1 {
+			t.Errorf("expected the unit of work to be rolled back, got %v", d.transactor.rolledBack)
+		}
+		if len(*d.audit) != 0 {
+			t.Errorf("expected no audit entry, got %+v", *d.audit)
+		}
+	})
+
+	t.Run("notes_only_keep_derived_stats", func(t *testing.T) {
+		uc, d := newUC(newSession(vos.SessionStatusCompleted))
+		notes := "corrigido"
+		out, err := uc.Execute(context.Background(), sessions.UpdateSessionInput{UserID: userID, SessionID: sessionID, Notes: &notes})
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if out.Notes != notes || d.effortRepo.storedCalories != nil || len(d.rollupRepo.refreshed) != 0 {
+			t.Errorf("expected only the notes to change, got %+v", out)
+		}
+	})
+
+	t.Run("overlap_is_reported", func(t *testing.T) {
+		uc, d := newUC(newSession(vos.SessionStatusCompleted))
+		d.logRepo.err = domainerrors.ErrSessionOverlap
+		newStart := startedAt.Add(-30 * time.Minute)
+		_, err := uc.Execute(context.Background(), sessions.UpdateSessionInput{UserID: userID, SessionID: sessionID, StartedAt: &newStart})
+		if !errors.Is(err, domainerrors.ErrSessionOverlap) {
+			t.Errorf("expected ErrSessionOverlap, got %v", err)
+		}
+		if len(d.rollupRepo.refreshed) != 0 || len(*d.audit) != 0 {
+			t.Error("expected nothing to happen after a rejected update")
+		}
+	})
+
+	t.Run("only_completed_sessions", func(t *testing.T) {
+		for _, status := range []vos.SessionStatus{vos.SessionStatusActive, vos.SessionStatusAbandoned} {
+			uc, _ := newUC(newSession(status))
+			notes := "x"
+			_, err := uc.Execute(context.Background(), sessions.UpdateSessionInput{UserID: userID, SessionID: sessionID, Notes: &notes})
+			if !errors.Is(err, domainerrors.ErrConflict) {
+				t.Errorf("%s: expected ErrConflict, got %v", status, err)
+			}
+		}
+	})
+
+	t.Run("session_reopened_concurrently", func(t *testing.T) {
+		uc, d := newUC(newSession(vos.SessionStatusCompleted))
+		d.logRepo.notCompleted = true
+		notes := "x"
+		_, err := uc.Execute(context.Background(), sessions.UpdateSessionInput{UserID: userID, SessionID: sessionID, Notes: &notes})
+		if !errors.Is(err, domainerrors.ErrConflict) {
+			t.Errorf("expected ErrConflict, got %v", err)
+		}
+	})
+
+	t.Run("other_users_session", func(t *testing.T) {
+		uc, _ := newUC(newSession(vos.SessionStatusCompleted))
+		notes := "x"
+		_, err := uc.Execute(context.Background(), sessions.UpdateSessionInput{UserID: uuid.New(), SessionID: sessionID, Notes: &notes})
+		if !errors.Is(err, domainerrors.ErrNotFound) {
+			t.Errorf("expected ErrNotFound, got %v", err)
+		}
+	})
+
+	invalid := []struct {
+		name  string
+		input func() sessions.UpdateSessionInput
+	}{
+		{"finish_before_start", func() sessions.UpdateSessionInput {
+			f := startedAt.Add(-time.Minute)
+			return sessions.UpdateSessionInput{FinishedAt: &f}
+		}},
+		{"start_after_finish", func() sessions.UpdateSessionInput {
+			s := finishedAt.Add(time.Minute)
+			return sessions.UpdateSessionInput{StartedAt: &s}
+		}},
+		{"finish_in_the_future", func() sessions.UpdateSessionInput {
+			f := time.Now().Add(time.Hour)
+			return sessions.UpdateSessionInput{FinishedAt: &f}
+		}},
+		{"too_long", func() sessions.UpdateSessionInput {
+			s := finishedAt.Add(-13 * time.Hour)
+			return sessions.UpdateSessionInput{StartedAt: &s}
+		}},
+	}
+	for _, tc := range invalid {
+		t.Run(tc.name, func(t *testing.T) {
+			uc, d := newUC(newSession(vos.SessionStatusCompleted))
+			input := tc.input()
+			input.UserID, input.SessionID = userID, sessionID
+			_, err := uc.Execute(context.Background(), input)
+			if !errors.Is(err, domainerrors.ErrMalformedParameters) {
+				t.Errorf("expected ErrMalformedParameters, got %v", err)
+			}
+			if d.logRepo.session != nil {
+				t.Error("expected nothing to be persisted")
+			}
+		})
+	}
+}
@@ -0,0 +1,46 @@
+package statistics
+
+import (
+	"math"
+	"time"
+
+	domainerrors "github.com/kinetria/kinetria-back/internal/kinetria/domain/errors"
+)
+
+// resolve returns the comparison period for the current period [start, end]. gap is the
+// distance between the end of the default comparison period and start.
+func (p ComparePeriod) resolve(start, end time.Time, gap time.Duration) (time.Time, time.Time, error) {
+	length := end.Sub(start)
+
+	var prevStart, prevEnd time.Time
+	switch {
+	case p.StartDate != nil && p.EndDate != nil:
+		prevStart, prevEnd = *p.StartDate, *p.EndDate
+	case p.StartDate != nil:
+		prevStart, prevEnd = *p.StartDate, p.StartDate.Add(length)
+	case p.EndDate != nil:
+		prevStart, prevEnd = p.EndDate.Add(-length), *p.EndDate
+	default:
+		prevEnd = start.Add(-gap)
+		prevStart = prevEnd.Add(-length)
+	}
+
+	if prevStart.After(prevEnd) {
+		return time.Time{}, time.Time{}, domainerrors.ErrInvalidPeriod
+	}
+	if prevEnd.Sub(prevStart).Hours()/24 > maxPeriodDays {
+		return time.Time{}, time.Time{}, domainerrors.ErrPeriodTooLong
+	}
+	return prevStart, prevEnd, nil
+}
+
+// newMetricDelta returns the change from previous to current. The percentage is rounded to
+// one decimal and omitted when previous is zero.
+func newMetricDelta(current, previous float64) MetricDelta {
+	d := MetricDelta{Absolute: math.Round((current-previous)*100) / 100}
+	if previous != 0 {
+		pct := percentChange(current, previous)
+		d.Percent = &pct
+	}
+	return d
+}
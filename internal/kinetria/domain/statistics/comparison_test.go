@@ -0,0 +1,55 @@
+package statistics
+
+import (
+	"testing"
+	"time"
+
+	domainerrors "github.com/kinetria/kinetria-back/internal/kinetria/domain/errors"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestComparePeriod_Resolve(t *testing.T) {
+	day := func(m time.Month, d int) time.Time { return time.Date(2026, m, d, 0, 0, 0, 0, time.UTC) }
+	ptr := func(t time.Time) *time.Time { return &t }
+	start, end := day(3, 1), day(3, 31)
+
+	tests := []struct {
+		name      string
+		period    ComparePeriod
+		wantStart time.Time
+		wantEnd   time.Time
+	}{
+		{"previous_equal_length", ComparePeriod{}, day(1, 29), day(2, 28)},
+		{"custom", ComparePeriod{StartDate: ptr(day(2, 1)), EndDate: ptr(day(2, 28))}, day(2, 1), day(2, 28)},
+		{"from_start", ComparePeriod{StartDate: ptr(day(1, 1))}, day(1, 1), day(1, 31)},
+		{"until_end", ComparePeriod{EndDate: ptr(day(1, 31))}, day(1, 1), day(1, 31)},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			gotStart, gotEnd, err := tt.period.resolve(start, end, 24*time.Hour)
+			require.NoError(t, err)
+			assert.Equal(t, tt.wantStart, gotStart)
+			assert.Equal(t, tt.wantEnd, gotEnd)
+		})
+	}
+
+	t.Run("invalid_custom_period", func(t *testing.T) {
+		_, _, err := ComparePeriod{StartDate: ptr(day(2, 28)), EndDate: ptr(day(2, 1))}.resolve(start, end, 24*time.Hour)
+		assert.ErrorIs(t, err, domainerrors.ErrInvalidPeriod)
+
+		_, _, err = ComparePeriod{StartDate: ptr(day(1, 1).AddDate(-3, 0, 0)), EndDate: ptr(day(1, 1))}.resolve(start, end, 24*time.Hour)
+		assert.ErrorIs(t, err, domainerrors.ErrPeriodTooLong)
+	})
+}
+
+func TestNewMetricDelta(t *testing.T) {
+	d := newMetricDelta(12, 8)
+	assert.Equal(t, 4.0, d.Absolute)
+	require.NotNil(t, d.Percent)
+	assert.Equal(t, 50.0, *d.Percent)
+
+	d = newMetricDelta(5, 0)
+	assert.Equal(t, 5.0, d.Absolute)
+	assert.Nil(t, d.Percent)
+}
@@ -0,0 +1,38 @@
+package statistics
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+	"github.com/kinetria/kinetria-back/internal/kinetria/domain/entities"
+	"github.com/kinetria/kinetria-back/internal/kinetria/domain/vos"
+)
+
+// --- Mock UserRepository ---
+
+type mockUserRepoPrefs struct {
+	user *entities.User
+	err  error
+}
+
+func (m *mockUserRepoPrefs) Create(_ context.Context, _ *entities.User) error { return nil }
+func (m *mockUserRepoPrefs) GetByEmail(_ context.Context, _ string) (*entities.User, error) {
+	return nil, nil
+}
+func (m *mockUserRepoPrefs) GetByID(_ context.Context, _ uuid.UUID) (*entities.User, error) {
+	return m.user, m.err
+}
+func (m *mockUserRepoPrefs) Update(_ context.Context, _ *entities.User) error { return nil }
+
+// newPrefsRepo returns a user repository whose user has the given calendar settings.
+func newPrefsRepo(timezone string, weekStart vos.WeekStart) *mockUserRepoPrefs {
+	prefs := vos.DefaultUserPreferences()
+	prefs.Timezone = timezone
+	prefs.WeekStart = weekStart
+	return &mockUserRepoPrefs{user: &entities.User{ID: uuid.New(), Preferences: prefs}}
+}
+
+// utcPrefsRepo buckets statistics in UTC with Monday weeks.
+func utcPrefsRepo() *mockUserRepoPrefs {
+	return newPrefsRepo("UTC", vos.WeekStartMonday)
+}
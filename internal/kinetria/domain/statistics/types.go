@@ -5,6 +5,7 @@ import (
 	"time"
 
 	"github.com/google/uuid"
+	"github.com/kinetria/kinetria-back/internal/kinetria/domain/vos"
 )
 
 // OverviewStats holds aggregated workout statistics for a user over a period.
@@ -20,14 +21,66 @@ type OverviewStats struct {
 	// Time
 	TotalTimeMinutes int
 
+	// Energy (soma das estimativas gravadas ao finalizar cada sessão)
+	TotalCalories int
+
 	// Sets/Reps/Volume
 	TotalSets   int
 	TotalReps   int
 	TotalVolume int64 // gramas
 
-	// Streak
+	// Streak, in days or weeks per StreakMode
 	CurrentStreak int
 	LongestStreak int
+	StreakMode    vos.StreakMode
+	StreakFreezes int // congelamentos disponíveis
+
+	// Comparação com outro período; nil fora do modo de comparação
+	Comparison *OverviewComparison
+}
+
+// ComparePeriod selects the period a statistic is compared with. With neither date it is the
+// period of equal length right before the current one; with only one date, the period of
+// equal length starting or ending on it.
+type ComparePeriod struct {
+	StartDate *time.Time
+	EndDate   *time.Time
+}
+
+// MetricDelta holds the change of a metric from the comparison period to the current one.
+type MetricDelta struct {
+	Absolute float64
+	Percent  *float64 // nil quando o valor anterior é zero
+}
+
+// OverviewComparison holds the comparison period's totals and the change of every metric.
+type OverviewComparison struct {
+	// Previous traz os totais do período de comparação; sequências (streaks) não dependem do período e ficam zeradas.
+	Previous OverviewStats
+	Deltas   OverviewDeltas
+
+	// Volume por exercício nos dois períodos, do maior volume atual para o menor
+	Exercises []ExerciseVolumeComparison
+}
+
+// OverviewDeltas holds the change of each overview metric.
+type OverviewDeltas struct {
+	TotalWorkouts    MetricDelta
+	AveragePerWeek   MetricDelta
+	TotalTimeMinutes MetricDelta
+	TotalCalories    MetricDelta
+	TotalSets        MetricDelta
+	TotalReps        MetricDelta
+	TotalVolume      MetricDelta // gramas
+}
+
+// ExerciseVolumeComparison holds an exercise's volume in the current and comparison periods.
+type ExerciseVolumeComparison struct {
+	ExerciseID     uuid.UUID
+	ExerciseName   string
+	Volume         int64 // gramas * reps
+	PreviousVolume int64 // gramas * reps
+	Delta          MetricDelta
 }
 
 // ProgressionData holds the progression data for a user and optionally a specific exercise.
@@ -60,3 +113,308 @@ type FrequencyData struct {
 	Date  time.Time
 	Count int
 }
+
+// FrequencyPeriod holds the daily workout counts of one period and their totals.
+type FrequencyPeriod struct {
+	StartDate     time.Time
+	EndDate       time.Time
+	Days          []FrequencyData
+	TotalWorkouts int
+	ActiveDays    int // dias com pelo menos um treino
+}
+
+// FrequencyComparison holds the workout frequency of the current and comparison periods.
+type FrequencyComparison struct {
+	Current       FrequencyPeriod
+	Previous      FrequencyPeriod
+	TotalWorkouts MetricDelta
+	ActiveDays    MetricDelta
+}
+
+// VolumeLandmarks holds the weekly hard-set range considered productive for a muscle group.
+type VolumeLandmarks struct {
+	MinSets float64 // abaixo disso: volume insuficiente
+	MaxSets float64 // acima disso: volume excessivo
+}
+
+// VolumeStatus classifies a muscle group's weekly hard sets against the landmarks.
+type VolumeStatus string
+
+const (
+	VolumeStatusBelow  VolumeStatus = "below"
+	VolumeStatusWithin VolumeStatus = "within"
+	VolumeStatusAbove  VolumeStatus = "above"
+)
+
+// MuscleVolumeData holds weekly per-muscle volume for a user over a period.
+type MuscleVolumeData struct {
+	StartDate time.Time
+	EndDate   time.Time
+	Landmarks VolumeLandmarks
+	Weeks     []MuscleVolumeWeek
+}
+
+// MuscleVolumeWeek holds the volume of every canonical muscle group for one week.
+type MuscleVolumeWeek struct {
+	WeekStart time.Time // segunda-feira
+	Muscles   []MuscleVolume
+}
+
+// MuscleVolume holds the weighted hard sets and volume credited to a muscle group in a week.
+type MuscleVolume struct {
+	MuscleGroup vos.MuscleGroup
+	HardSets    float64
+	Volume      int64 // gramas * reps, ponderado pelo envolvimento
+	Status      VolumeStatus
+}
+
+// RelativeStrengthData holds the user's big-lift strength relative to body weight over a period.
+type RelativeStrengthData struct {
+	StartDate  time.Time
+	EndDate    time.Time
+	WindowDays int     // janela (em dias) da melhor e1RM de cada levantamento
+	Sex        vos.Sex // vazio: DOTS, Wilks e IPF GL não são calculados
+	Points     []RelativeStrengthPoint
+}
+
+// RelativeStrengthPoint holds the relative strength scores on a day with a big-lift set or a weigh-in.
+type RelativeStrengthPoint struct {
+	Date       time.Time
+	BodyWeight *int // gramas, última pesagem até o dia
+
+	Lifts []LiftStrength
+
+	// Total, múltiplo e pontuações só existem quando os três levantamentos têm e1RM
+	Total         *int // gramas
+	TotalMultiple *float64
+	DOTS          *float64
+	Wilks         *float64
+	IPFGL         *float64
+}
+
+// LiftStrength holds the best estimated one-rep max of a big lift within the window.
+type LiftStrength struct {
+	Lift            vos.BigLift
+	EstimatedMax    int      // gramas
+	BodyWeightRatio *float64 // e1RM / peso corporal
+}
+
+// LoadZone classifies an acute:chronic workload ratio against the safe band.
+type LoadZone string
+
+const (
+	LoadZoneUnknown LoadZone = "unknown" // sem carga crônica para comparar
+	LoadZoneLow     LoadZone = "low"     // abaixo de 0.8: destreino
+	LoadZoneSafe    LoadZone = "safe"    // 0.8–1.3
+	LoadZoneHigh    LoadZone = "high"    // 1.3–1.5: atenção
+	LoadZoneSpike   LoadZone = "spike"   // acima de 1.5: risco elevado de lesão
+)
+
+// TrainingLoadData holds the user's daily training load model over a period.
+type TrainingLoadData struct {
+	StartDate time.Time
+	EndDate   time.Time
+	Method    vos.LoadMethod
+	Days      []TrainingLoadDay
+	Warnings  []TrainingLoadWarning // referentes ao último dia do período
+
+	// Sessões do período sem RPE informado (contam como carga zero no método srpe)
+	SessionsWithoutRPE int
+}
+
+// TrainingLoadDay holds the load metrics of one calendar day.
+type TrainingLoadDay struct {
+	Date     time.Time
+	Load     float64  // carga do dia (UA no srpe, gramas * reps na tonelagem)
+	Acute    float64  // soma dos últimos 7 dias
+	Chronic  float64  // média semanal dos últimos 28 dias
+	ACWR     *float64 // acute / chronic; nil sem carga crônica
+	Monotony *float64 // média / desvio padrão da carga diária dos últimos 7 dias
+	Strain   *float64 // acute * monotony
+	Zone     LoadZone
+}
+
+// TrainingLoadWarningCode identifies a training load warning.
+type TrainingLoadWarningCode string
+
+const (
+	LoadWarningACWRHigh     TrainingLoadWarningCode = "acwr_high"
+	LoadWarningACWRSpike    TrainingLoadWarningCode = "acwr_spike"
+	LoadWarningACWRLow      TrainingLoadWarningCode = "acwr_low"
+	LoadWarningHighMonotony TrainingLoadWarningCode = "high_monotony"
+)
+
+// TrainingLoadWarning flags a load metric outside its recommended range.
+type TrainingLoadWarning struct {
+	Code    TrainingLoadWarningCode
+	Message string
+	Value   float64
+}
+
+// InsightKind classifies a progress insight.
+type InsightKind string
+
+const (
+	InsightKindPlateau    InsightKind = "plateau"
+	InsightKindRegression InsightKind = "regression"
+)
+
+// SuggestionKind is the action recommended by a progress insight.
+type SuggestionKind string
+
+const (
+	SuggestionDeload         SuggestionKind = "deload"
+	SuggestionRepRangeChange SuggestionKind = "rep_range_change"
+	SuggestionExerciseSwap   SuggestionKind = "exercise_swap"
+)
+
+// ProgressInsight flags an exercise whose estimated max and volume stopped improving or dropped.
+// Recent values come from the analysis window (last N sessions or weeks); baseline values from
+// the sessions before it.
+type ProgressInsight struct {
+	ExerciseID       uuid.UUID
+	ExerciseName     string
+	Kind             InsightKind
+	SessionsAnalyzed int // sessões na janela recente
+	StalledSessions  int // sessões desde a última melhor e1RM
+	BaselineE1RM     int // gramas
+	RecentE1RM       int // gramas
+	E1RMChange       float64
+	BaselineVolume   int64 // maior volume de uma sessão (gramas * reps)
+	RecentVolume     int64
+	VolumeChange     float64
+	LastPerformed    time.Time
+	Suggestion       InsightSuggestion
+}
+
+// InsightSuggestion is a concrete next step for a progress insight.
+type InsightSuggestion struct {
+	Kind    SuggestionKind
+	Message string
+}
+
+// HeatmapData holds one year of daily training intensity for a calendar heatmap.
+type HeatmapData struct {
+	Year   int
+	Metric vos.HeatmapMetric
+	Days   []HeatmapDay // todos os dias do ano, em ordem
+
+	// Thresholds são os limites superiores dos níveis 1 a 3 (quartis dos dias com treino);
+	// valores acima do último são nível 4.
+	Thresholds    []float64
+	ActiveDays    int
+	TotalSessions int
+}
+
+// HeatmapDay holds the training of one calendar day.
+type HeatmapDay struct {
+	Date     time.Time
+	Sessions int
+	Value    float64 // gramas * reps (volume) ou minutos (duration)
+	Level    int     // 0 sem treino, 1 a 4 por quartil
+}
+
+// TimeOfDay is the part of the day a session started in.
+type TimeOfDay string
+
+const (
+	TimeOfDayMorning   TimeOfDay = "morning"   // 05:00–11:59
+	TimeOfDayAfternoon TimeOfDay = "afternoon" // 12:00–16:59
+	TimeOfDayEvening   TimeOfDay = "evening"   // 17:00–20:59
+	TimeOfDayNight     TimeOfDay = "night"     // 21:00–04:59
+)
+
+// RecapData summarizes a user's training in a calendar month or year.
+type RecapData struct {
+	Period    vos.RecapPeriod
+	StartDate time.Time
+	EndDate   time.Time
+
+	TotalSessions        int
+	TotalDurationMinutes int
+	TotalTonnage         int64 // gramas * reps
+	ActiveDays           int
+	LongestStreak        int // dias consecutivos com treino dentro do período
+
+	FavoriteWorkout *RecapWorkout // nil sem sessões no período
+	TopExercises    []RecapExercise
+	PersonalRecords []RecapRecord
+	TimeOfDay       []TimeOfDayShare
+}
+
+// RecapWorkout is the workout performed most often in the recap period.
+type RecapWorkout struct {
+	WorkoutID uuid.UUID
+	Name      string
+	Sessions  int
+}
+
+// RecapExercise is one of the exercises with the most volume in the recap period.
+type RecapExercise struct {
+	ExerciseID   uuid.UUID
+	ExerciseName string
+	Sessions     int
+	Volume       int64 // gramas * reps
+}
+
+// RecapRecord is a heaviest-weight personal record set in the recap period.
+type RecapRecord struct {
+	ExerciseID     uuid.UUID
+	ExerciseName   string
+	Weight         int // gramas
+	Reps           int
+	PreviousWeight int // gramas; melhor marca anterior
+	AchievedAt     time.Time
+}
+
+// TimeOfDayShare holds the sessions started in a part of the day.
+type TimeOfDayShare struct {
+	TimeOfDay TimeOfDay
+	Sessions  int
+	Percent   float64
+}
+
+// WellnessData relates the post-session feedback of a period to the training done.
+type WellnessData struct {
+	StartDate time.Time
+	EndDate   time.Time
+	Sessions  []WellnessSession // sessões concluídas do período, em ordem cronológica
+
+	SessionsWithFeedback int
+	Averages             WellnessAverages
+
+	// Tonelagem e RPE médios das sessões agrupadas por nota (1 a 5); só notas com sessões
+	ByMood         []WellnessBucket
+	ByEnergy       []WellnessBucket
+	BySleepQuality []WellnessBucket
+}
+
+// WellnessSession is a completed session with its feedback.
+type WellnessSession struct {
+	SessionID       uuid.UUID
+	WorkoutID       uuid.UUID
+	WorkoutName     string
+	Date            time.Time
+	DurationMinutes int
+	Tonnage         int64 // gramas * reps
+	RPE             *int
+	Mood            *int
+	Energy          *int
+	SleepQuality    *int
+}
+
+// WellnessAverages holds the mean of each rating over the sessions that have it.
+type WellnessAverages struct {
+	RPE          *float64
+	Mood         *float64
+	Energy       *float64
+	SleepQuality *float64
+}
+
+// WellnessBucket holds the sessions given one rating.
+type WellnessBucket struct {
+	Rating     int
+	Sessions   int
+	AvgTonnage int64    // gramas * reps
+	AvgRPE     *float64 // nil se nenhuma sessão da nota informou RPE
+}
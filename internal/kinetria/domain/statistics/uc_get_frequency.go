@@ -7,6 +7,7 @@ import (
 	"github.com/google/uuid"
 	domainerrors "github.com/kinetria/kinetria-back/internal/kinetria/domain/errors"
 	"github.com/kinetria/kinetria-back/internal/kinetria/domain/ports"
+	"github.com/kinetria/kinetria-back/internal/kinetria/domain/profile"
 )
 
 // GetFrequencyInput holds the input parameters for GetFrequencyUC.
@@ -19,18 +20,75 @@ type GetFrequencyInput struct {
 // GetFrequencyUC retrieves workout frequency data for a user.
 type GetFrequencyUC struct {
 	sessionRepo ports.SessionRepository
+	userRepo    ports.UserRepository
 }
 
 // NewGetFrequencyUC creates a new GetFrequencyUC.
-func NewGetFrequencyUC(sessionRepo ports.SessionRepository) *GetFrequencyUC {
-	return &GetFrequencyUC{sessionRepo: sessionRepo}
+func NewGetFrequencyUC(sessionRepo ports.SessionRepository, userRepo ports.UserRepository) *GetFrequencyUC {
+	return &GetFrequencyUC{sessionRepo: sessionRepo, userRepo: userRepo}
 }
 
 // Execute returns workout frequency (count per day) for the given user and period.
 // If StartDate/EndDate are nil, defaults to the last 365 days.
 // All days in the period are returned; days without workouts have Count=0.
+// Days are calendar days in the user's timezone.
 func (uc *GetFrequencyUC) Execute(ctx context.Context, input GetFrequencyInput) ([]FrequencyData, error) {
-	now := time.Now().UTC()
+	loc, start, end, err := uc.period(ctx, input)
+	if err != nil {
+		return nil, err
+	}
+	return uc.countDays(ctx, input.UserID, start, end, loc)
+}
+
+// Compare returns the workout frequency of the given period and of the comparison period,
+// with the change in total workouts and active days.
+func (uc *GetFrequencyUC) Compare(ctx context.Context, input GetFrequencyInput, compare ComparePeriod) (*FrequencyComparison, error) {
+	loc, start, end, err := uc.period(ctx, input)
+	if err != nil {
+		return nil, err
+	}
+
+	// O período de comparação também é de dias inteiros
+	if compare.StartDate != nil {
+		d := calendarDay(compare.StartDate.UTC(), time.UTC)
+		compare.StartDate = &d
+	}
+	if compare.EndDate != nil {
+		d := calendarDay(compare.EndDate.UTC(), time.UTC)
+		compare.EndDate = &d
+	}
+	prevStart, prevEnd, err := compare.resolve(start, end, 24*time.Hour)
+	if err != nil {
+		return nil, err
+	}
+
+	current, err := uc.countDays(ctx, input.UserID, start, end, loc)
+	if err != nil {
+		return nil, err
+	}
+	previous, err := uc.countDays(ctx, input.UserID, prevStart, prevEnd, loc)
+	if err != nil {
+		return nil, err
+	}
+
+	out := &FrequencyComparison{
+		Current:  newFrequencyPeriod(start, end, current),
+		Previous: newFrequencyPeriod(prevStart, prevEnd, previous),
+	}
+	out.TotalWorkouts = newMetricDelta(float64(out.Current.TotalWorkouts), float64(out.Previous.TotalWorkouts))
+	out.ActiveDays = newMetricDelta(float64(out.Current.ActiveDays), float64(out.Previous.ActiveDays))
+	return out, nil
+}
+
+// period applies the defaults and validates the requested period. start and end are
+// calendar days as UTC midnight; loc is the user's timezone.
+func (uc *GetFrequencyUC) period(ctx context.Context, input GetFrequencyInput) (*time.Location, time.Time, time.Time, error) {
+	prefs, err := profile.LoadPreferences(ctx, uc.userRepo, input.UserID)
+	if err != nil {
+		return nil, time.Time{}, time.Time{}, err
+	}
+	loc := prefs.Location()
+	now := time.Now().In(loc)
 
 	// Apply defaults
 	end := time.Date(now.Year(), now.Month(), now.Day(), 0, 0, 0, 0, time.UTC)
@@ -46,14 +104,20 @@ func (uc *GetFrequencyUC) Execute(ctx context.Context, input GetFrequencyInput)
 
 	// Validate period
 	if start.After(end) {
-		return nil, domainerrors.ErrInvalidPeriod
+		return nil, time.Time{}, time.Time{}, domainerrors.ErrInvalidPeriod
 	}
 	if end.Sub(start).Hours()/24 > maxPeriodDays {
-		return nil, domainerrors.ErrPeriodTooLong
+		return nil, time.Time{}, time.Time{}, domainerrors.ErrPeriodTooLong
 	}
+	return loc, start, end, nil
+}
 
-	// Fetch data from DB (only days with workouts)
-	dbRows, err := uc.sessionRepo.GetFrequencyByUserAndPeriod(ctx, input.UserID, start, end.Add(24*time.Hour-time.Second))
+// countDays returns the workout count of every day in [start, end].
+func (uc *GetFrequencyUC) countDays(ctx context.Context, userID uuid.UUID, start, end time.Time, loc *time.Location) ([]FrequencyData, error) {
+	// Fetch data from DB (only days with workouts), from local midnight of start to the end of the last day
+	rangeStart := time.Date(start.Year(), start.Month(), start.Day(), 0, 0, 0, 0, loc)
+	rangeEnd := time.Date(end.Year(), end.Month(), end.Day(), 0, 0, 0, 0, loc).AddDate(0, 0, 1).Add(-time.Second)
+	dbRows, err := uc.sessionRepo.GetFrequencyByUserAndPeriod(ctx, userID, rangeStart, rangeEnd, loc)
 	if err != nil {
 		return nil, err
 	}
@@ -77,3 +141,14 @@ func (uc *GetFrequencyUC) Execute(ctx context.Context, input GetFrequencyInput)
 	}
 	return result, nil
 }
+
+func newFrequencyPeriod(start, end time.Time, days []FrequencyData) FrequencyPeriod {
+	p := FrequencyPeriod{StartDate: start, EndDate: end, Days: days}
+	for _, d := range days {
+		p.TotalWorkouts += d.Count
+		if d.Count > 0 {
+			p.ActiveDays++
+		}
+	}
+	return p
+}
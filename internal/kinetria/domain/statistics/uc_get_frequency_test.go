@@ -7,6 +7,7 @@ import (
 
 	"github.com/google/uuid"
 	"github.com/kinetria/kinetria-back/internal/kinetria/domain/entities"
+	domainerrors "github.com/kinetria/kinetria-back/internal/kinetria/domain/errors"
 	"github.com/kinetria/kinetria-back/internal/kinetria/domain/ports"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
@@ -31,16 +32,16 @@ func (m *mockSessionRepoFreq) FindByID(_ context.Context, _ uuid.UUID) (*entitie
 func (m *mockSessionRepoFreq) UpdateStatus(_ context.Context, _ uuid.UUID, _ string, _ *time.Time, _ string) (bool, error) {
 	return false, nil
 }
-func (m *mockSessionRepoFreq) GetCompletedSessionsByUserAndDateRange(_ context.Context, _ uuid.UUID, _, _ time.Time) ([]entities.Session, error) {
+func (m *mockSessionRepoFreq) GetCompletedSessionsByUserAndDateRange(_ context.Context, _ uuid.UUID, _, _ time.Time, _ *time.Location) ([]entities.Session, error) {
 	return nil, nil
 }
 func (m *mockSessionRepoFreq) GetStatsByUserAndPeriod(_ context.Context, _ uuid.UUID, _, _ time.Time) (*ports.SessionStats, error) {
 	return nil, nil
 }
-func (m *mockSessionRepoFreq) GetFrequencyByUserAndPeriod(_ context.Context, _ uuid.UUID, _, _ time.Time) ([]ports.FrequencyData, error) {
+func (m *mockSessionRepoFreq) GetFrequencyByUserAndPeriod(_ context.Context, _ uuid.UUID, _, _ time.Time, _ *time.Location) ([]ports.FrequencyData, error) {
 	return m.frequencyResult, m.frequencyErr
 }
-func (m *mockSessionRepoFreq) GetSessionsForStreak(_ context.Context, _ uuid.UUID) ([]time.Time, error) {
+func (m *mockSessionRepoFreq) GetSessionsForStreak(_ context.Context, _ uuid.UUID, _ *time.Location) ([]time.Time, error) {
 	return nil, nil
 }
 
@@ -66,7 +67,7 @@ func TestGetFrequencyUC_Execute(t *testing.T) {
 			},
 		}
 
-		uc := NewGetFrequencyUC(sessRepo)
+		uc := NewGetFrequencyUC(sessRepo, utcPrefsRepo())
 		result, err := uc.Execute(context.Background(), GetFrequencyInput{
 			UserID:    userID,
 			StartDate: &startDate,
@@ -103,7 +104,7 @@ func TestGetFrequencyUC_Execute(t *testing.T) {
 		endDate := now.AddDate(0, 0, -7)
 
 		sessRepo := &mockSessionRepoFreq{}
-		uc := NewGetFrequencyUC(sessRepo)
+		uc := NewGetFrequencyUC(sessRepo, utcPrefsRepo())
 		_, err := uc.Execute(context.Background(), GetFrequencyInput{
 			UserID:    userID,
 			StartDate: &startDate,
@@ -122,7 +123,7 @@ func TestGetFrequencyUC_Execute(t *testing.T) {
 			frequencyResult: []ports.FrequencyData{},
 		}
 
-		uc := NewGetFrequencyUC(sessRepo)
+		uc := NewGetFrequencyUC(sessRepo, utcPrefsRepo())
 		result, err := uc.Execute(context.Background(), GetFrequencyInput{
 			UserID:    userID,
 			StartDate: &startDate,
@@ -137,3 +138,56 @@ func TestGetFrequencyUC_Execute(t *testing.T) {
 		}
 	})
 }
+
+func TestGetFrequencyUC_Compare(t *testing.T) {
+	userID := uuid.New()
+	day := func(d int) time.Time { return time.Date(2026, 3, d, 0, 0, 0, 0, time.UTC) }
+	start, end := day(8), day(14)
+
+	// O mock devolve os dias dos dois períodos; cada um só conta os seus
+	sessRepo := &mockSessionRepoFreq{
+		frequencyResult: []ports.FrequencyData{
+			{Date: day(2), Count: 1},
+			{Date: day(4), Count: 1},
+			{Date: day(9), Count: 2},
+			{Date: day(11), Count: 1},
+			{Date: day(13), Count: 1},
+		},
+	}
+	uc := NewGetFrequencyUC(sessRepo, utcPrefsRepo())
+
+	t.Run("previous equal-length period", func(t *testing.T) {
+		out, err := uc.Compare(context.Background(), GetFrequencyInput{UserID: userID, StartDate: &start, EndDate: &end}, ComparePeriod{})
+		require.NoError(t, err)
+
+		assert.Equal(t, day(1), out.Previous.StartDate)
+		assert.Equal(t, day(7), out.Previous.EndDate)
+		assert.Len(t, out.Previous.Days, 7)
+		assert.Len(t, out.Current.Days, 7)
+
+		assert.Equal(t, 4, out.Current.TotalWorkouts)
+		assert.Equal(t, 3, out.Current.ActiveDays)
+		assert.Equal(t, 2, out.Previous.TotalWorkouts)
+		assert.Equal(t, 2.0, out.TotalWorkouts.Absolute)
+		require.NotNil(t, out.TotalWorkouts.Percent)
+		assert.Equal(t, 100.0, *out.TotalWorkouts.Percent)
+		require.NotNil(t, out.ActiveDays.Percent)
+		assert.Equal(t, 50.0, *out.ActiveDays.Percent)
+	})
+
+	t.Run("custom period starting on a date", func(t *testing.T) {
+		from := day(2).Add(15 * time.Hour)
+		out, err := uc.Compare(context.Background(), GetFrequencyInput{UserID: userID, StartDate: &start, EndDate: &end}, ComparePeriod{StartDate: &from})
+		require.NoError(t, err)
+
+		assert.Equal(t, day(2), out.Previous.StartDate)
+		assert.Equal(t, day(8), out.Previous.EndDate)
+		assert.Equal(t, 2, out.Previous.TotalWorkouts)
+	})
+
+	t.Run("invalid comparison period", func(t *testing.T) {
+		from, to := day(7), day(1)
+		_, err := uc.Compare(context.Background(), GetFrequencyInput{UserID: userID, StartDate: &start, EndDate: &end}, ComparePeriod{StartDate: &from, EndDate: &to})
+		assert.ErrorIs(t, err, domainerrors.ErrInvalidPeriod)
+	})
+}
@@ -0,0 +1,142 @@
+package statistics
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"sort"
+	"time"
+
+	"github.com/google/uuid"
+	domainerrors "github.com/kinetria/kinetria-back/internal/kinetria/domain/errors"
+	"github.com/kinetria/kinetria-back/internal/kinetria/domain/ports"
+	"github.com/kinetria/kinetria-back/internal/kinetria/domain/profile"
+	"github.com/kinetria/kinetria-back/internal/kinetria/domain/vos"
+)
+
+// heatmapLevels is the number of intensity levels of a day with training.
+const heatmapLevels = 4
+
+// GetHeatmapInput holds the input parameters for GetHeatmapUC.
+type GetHeatmapInput struct {
+	UserID uuid.UUID
+	Year   int               // 0: ano atual
+	Metric vos.HeatmapMetric // vazio: volume
+}
+
+// GetHeatmapUC builds a yearly calendar heatmap of a user's training.
+type GetHeatmapUC struct {
+	effortRepo ports.SessionEffortRepository
+	userRepo   ports.UserRepository
+}
+
+// NewGetHeatmapUC creates a new GetHeatmapUC.
+func NewGetHeatmapUC(effortRepo ports.SessionEffortRepository, userRepo ports.UserRepository) *GetHeatmapUC {
+	return &GetHeatmapUC{effortRepo: effortRepo, userRepo: userRepo}
+}
+
+// Execute returns every day of the year (in the user's timezone) with its sessions, the
+// day's volume or duration and an intensity level. Days with training are bucketed by the
+// quartiles of the year's training days, so the levels adapt to each user.
+func (uc *GetHeatmapUC) Execute(ctx context.Context, input GetHeatmapInput) (*HeatmapData, error) {
+	metric := input.Metric
+	if metric == "" {
+		metric = vos.HeatmapMetricVolume
+	}
+	if err := metric.Validate(); err != nil {
+		return nil, err
+	}
+
+	prefs, err := profile.LoadPreferences(ctx, uc.userRepo, input.UserID)
+	if err != nil {
+		return nil, err
+	}
+	loc := prefs.Location()
+	now := time.Now().In(loc)
+
+	year := input.Year
+	if year == 0 {
+		year = now.Year()
+	}
+	if year < 1970 || year > now.Year() {
+		return nil, fmt.Errorf("year must be between 1970 and %d: %w", now.Year(), domainerrors.ErrMalformedParameters)
+	}
+
+	start := time.Date(year, time.January, 1, 0, 0, 0, 0, loc)
+	end := start.AddDate(1, 0, 0)
+	sessions, err := uc.effortRepo.ListSessionLoads(ctx, input.UserID, start, end, loc)
+	if err != nil {
+		return nil, fmt.Errorf("list session loads: %w", err)
+	}
+
+	type dayTotals struct {
+		sessions int
+		value    float64
+	}
+	byDay := make(map[time.Time]*dayTotals)
+	for _, s := range sessions {
+		d := byDay[s.Date]
+		if d == nil {
+			d = &dayTotals{}
+			byDay[s.Date] = d
+		}
+		d.sessions++
+		if metric == vos.HeatmapMetricDuration {
+			d.value += float64(max(s.DurationMinutes, 0))
+		} else {
+			d.value += float64(s.Tonnage)
+		}
+	}
+
+	values := make([]float64, 0, len(byDay))
+	for _, d := range byDay {
+		if d.value > 0 {
+			values = append(values, d.value)
+		}
+	}
+	thresholds := quartiles(values)
+
+	data := &HeatmapData{Year: year, Metric: metric, Thresholds: thresholds, Days: []HeatmapDay{}}
+	first := time.Date(year, time.January, 1, 0, 0, 0, 0, time.UTC)
+	for day := first; day.Year() == year; day = day.AddDate(0, 0, 1) {
+		out := HeatmapDay{Date: day}
+		if d := byDay[day]; d != nil {
+			out.Sessions = d.sessions
+			out.Value = d.value
+			out.Level = heatmapLevel(d.value, thresholds)
+			data.ActiveDays++
+			data.TotalSessions += d.sessions
+		}
+		data.Days = append(data.Days, out)
+	}
+	return data, nil
+}
+
+// quartiles returns the 25th, 50th and 75th percentiles (nearest rank) of values.
+func quartiles(values []float64) []float64 {
+	if len(values) == 0 {
+		return []float64{}
+	}
+	sorted := append([]float64(nil), values...)
+	sort.Float64s(sorted)
+	out := make([]float64, 0, heatmapLevels-1)
+	for i := 1; i < heatmapLevels; i++ {
+		rank := int(math.Ceil(float64(i) / heatmapLevels * float64(len(sorted))))
+		out = append(out, sorted[max(rank-1, 0)])
+	}
+	return out
+}
+
+// heatmapLevel returns the level (1 to 4) of a day with training. Days whose value is
+// zero (e.g. bodyweight-only sessions by volume) still show as level 1.
+func heatmapLevel(value float64, thresholds []float64) int {
+	for i, t := range thresholds {
+		if value <= t {
+			return i + 1
+		}
+	}
+	if len(thresholds) == 0 {
+		return 1
+	}
+	return heatmapLevels
+}
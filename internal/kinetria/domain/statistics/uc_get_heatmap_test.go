@@ -0,0 +1,91 @@
+package statistics
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	domainerrors "github.com/kinetria/kinetria-back/internal/kinetria/domain/errors"
+	"github.com/kinetria/kinetria-back/internal/kinetria/domain/ports"
+	"github.com/kinetria/kinetria-back/internal/kinetria/domain/vos"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestGetHeatmapUC_Execute(t *testing.T) {
+	userID := uuid.New()
+	day := func(m time.Month, d int) time.Time { return time.Date(2025, m, d, 0, 0, 0, 0, time.UTC) }
+
+	// Quatro dias de treino com volumes crescentes; 3 de março tem duas sessões
+	loads := []ports.SessionLoad{
+		{Date: day(1, 6), DurationMinutes: 30, Tonnage: 1000000},
+		{Date: day(2, 10), DurationMinutes: 45, Tonnage: 2000000},
+		{Date: day(3, 3), DurationMinutes: 60, Tonnage: 2000000},
+		{Date: day(3, 3), DurationMinutes: 20, Tonnage: 1000000},
+		{Date: day(12, 31), DurationMinutes: 90, Tonnage: 8000000},
+		{Date: day(7, 4), DurationMinutes: 40}, // só peso corporal
+	}
+
+	t.Run("volume_levels_by_quartile", func(t *testing.T) {
+		repo := &mockEffortRepoLoad{loads: loads}
+		uc := NewGetHeatmapUC(repo, utcPrefsRepo())
+
+		data, err := uc.Execute(context.Background(), GetHeatmapInput{UserID: userID, Year: 2025})
+		require.NoError(t, err)
+		assert.Equal(t, time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC), repo.gotStart)
+		assert.Equal(t, vos.HeatmapMetricVolume, data.Metric)
+		require.Len(t, data.Days, 365)
+		assert.Equal(t, 5, data.ActiveDays)
+		assert.Equal(t, 6, data.TotalSessions)
+		assert.Equal(t, []float64{1000000, 2000000, 3000000}, data.Thresholds)
+
+		levels := map[time.Time]int{}
+		for _, d := range data.Days {
+			levels[d.Date] = d.Level
+		}
+		assert.Equal(t, 1, levels[day(1, 6)])
+		assert.Equal(t, 2, levels[day(2, 10)])
+		assert.Equal(t, 3, levels[day(3, 3)])
+		assert.Equal(t, 4, levels[day(12, 31)])
+		assert.Equal(t, 1, levels[day(7, 4)])
+		assert.Equal(t, 0, levels[day(7, 5)])
+	})
+
+	t.Run("duration_metric", func(t *testing.T) {
+		uc := NewGetHeatmapUC(&mockEffortRepoLoad{loads: loads}, utcPrefsRepo())
+
+		data, err := uc.Execute(context.Background(), GetHeatmapInput{UserID: userID, Year: 2025, Metric: vos.HeatmapMetricDuration})
+		require.NoError(t, err)
+		assert.Equal(t, 80.0, data.Days[day(3, 3).YearDay()-1].Value)
+		assert.Equal(t, 4, data.Days[364].Level)
+	})
+
+	t.Run("no_training", func(t *testing.T) {
+		uc := NewGetHeatmapUC(&mockEffortRepoLoad{}, utcPrefsRepo())
+
+		data, err := uc.Execute(context.Background(), GetHeatmapInput{UserID: userID, Year: 2024})
+		require.NoError(t, err)
+		assert.Len(t, data.Days, 366)
+		assert.Empty(t, data.Thresholds)
+		assert.Zero(t, data.ActiveDays)
+	})
+
+	t.Run("invalid_parameters", func(t *testing.T) {
+		uc := NewGetHeatmapUC(&mockEffortRepoLoad{}, utcPrefsRepo())
+
+		_, err := uc.Execute(context.Background(), GetHeatmapInput{UserID: userID, Metric: "sets"})
+		assert.ErrorIs(t, err, domainerrors.ErrMalformedParameters)
+
+		_, err = uc.Execute(context.Background(), GetHeatmapInput{UserID: userID, Year: time.Now().Year() + 1})
+		assert.ErrorIs(t, err, domainerrors.ErrMalformedParameters)
+	})
+
+	t.Run("repository_error", func(t *testing.T) {
+		uc := NewGetHeatmapUC(&mockEffortRepoLoad{err: errors.New("db down")}, utcPrefsRepo())
+
+		_, err := uc.Execute(context.Background(), GetHeatmapInput{UserID: userID})
+		assert.Error(t, err)
+	})
+}
@@ -0,0 +1,126 @@
+package statistics
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+	domainerrors "github.com/kinetria/kinetria-back/internal/kinetria/domain/errors"
+	"github.com/kinetria/kinetria-back/internal/kinetria/domain/ports"
+	"github.com/kinetria/kinetria-back/internal/kinetria/domain/profile"
+	"github.com/kinetria/kinetria-back/internal/kinetria/domain/vos"
+)
+
+// defaultMuscleVolumeWeeks is the number of weeks returned when no period is given.
+const defaultMuscleVolumeWeeks = 4
+
+// GetMuscleVolumeInput holds the input parameters for GetMuscleVolumeUC.
+type GetMuscleVolumeInput struct {
+	UserID    uuid.UUID
+	StartDate *time.Time
+	EndDate   *time.Time
+}
+
+// GetMuscleVolumeUC retrieves weekly hard sets and volume per muscle group for a user.
+type GetMuscleVolumeUC struct {
+	setRecordRepo ports.SetRecordRepository
+	userRepo      ports.UserRepository
+	landmarks     VolumeLandmarks
+}
+
+// NewGetMuscleVolumeUC creates a new GetMuscleVolumeUC.
+func NewGetMuscleVolumeUC(setRecordRepo ports.SetRecordRepository, userRepo ports.UserRepository, landmarks VolumeLandmarks) *GetMuscleVolumeUC {
+	return &GetMuscleVolumeUC{setRecordRepo: setRecordRepo, userRepo: userRepo, landmarks: landmarks}
+}
+
+// Execute computes per-muscle weekly volume for the given user and period.
+// If StartDate/EndDate are nil, defaults to the current week and the 3 before it.
+// Weeks start on the user's preferred week day, in the user's timezone; the start
+// date is aligned to the first day of its week so every week is complete. The end date is
+// inclusive: the sessions of the whole day count.
+func (uc *GetMuscleVolumeUC) Execute(ctx context.Context, input GetMuscleVolumeInput) (*MuscleVolumeData, error) {
+	prefs, err := profile.LoadPreferences(ctx, uc.userRepo, input.UserID)
+	if err != nil {
+		return nil, err
+	}
+	loc := prefs.Location()
+	now := time.Now().In(loc)
+
+	// Apply defaults. end is exclusive: local midnight after the last day
+	end := time.Date(now.Year(), now.Month(), now.Day()+1, 0, 0, 0, 0, loc)
+	start := prefs.WeekStart.StartOf(now).AddDate(0, 0, -7*(defaultMuscleVolumeWeeks-1))
+	if input.EndDate != nil {
+		end = dayAfter(*input.EndDate, loc)
+	}
+	if input.StartDate != nil {
+		d := input.StartDate.UTC()
+		start = prefs.WeekStart.StartOf(time.Date(d.Year(), d.Month(), d.Day(), 0, 0, 0, 0, loc))
+	}
+	lastDay := end.AddDate(0, 0, -1)
+
+	// Validate period
+	if start.After(lastDay) {
+		return nil, domainerrors.ErrInvalidPeriod
+	}
+	if lastDay.Sub(start).Hours()/24 > maxPeriodDays {
+		return nil, domainerrors.ErrPeriodTooLong
+	}
+
+	rows, err := uc.setRecordRepo.GetMuscleVolumeByUserAndPeriod(ctx, input.UserID, start, end, loc, prefs.WeekStart)
+	if err != nil {
+		return nil, fmt.Errorf("get muscle volume: %w", err)
+	}
+
+	type weekMuscle struct {
+		week   string
+		muscle vos.MuscleGroup
+	}
+	byKey := make(map[weekMuscle]ports.MuscleVolumeRow, len(rows))
+	for _, r := range rows {
+		key := weekMuscle{week: r.WeekStart.Format("2006-01-02"), muscle: vos.MuscleGroup(r.MuscleGroup)}
+		byKey[key] = r
+	}
+
+	// Zero-fill every week in the period with every canonical muscle group
+	weeks := make([]MuscleVolumeWeek, 0)
+	for week := start; week.Before(end); week = week.AddDate(0, 0, 7) {
+		muscles := make([]MuscleVolume, 0, len(vos.AllMuscleGroups()))
+		for _, mg := range vos.AllMuscleGroups() {
+			r := byKey[weekMuscle{week: week.Format("2006-01-02"), muscle: mg}]
+			muscles = append(muscles, MuscleVolume{
+				MuscleGroup: mg,
+				HardSets:    r.HardSets,
+				Volume:      r.Volume,
+				Status:      uc.classify(r.HardSets),
+			})
+		}
+		weeks = append(weeks, MuscleVolumeWeek{WeekStart: week, Muscles: muscles})
+	}
+
+	return &MuscleVolumeData{
+		StartDate: start,
+		EndDate:   lastDay,
+		Landmarks: uc.landmarks,
+		Weeks:     weeks,
+	}, nil
+}
+
+// classify flags weekly hard sets against the configured volume landmarks.
+func (uc *GetMuscleVolumeUC) classify(hardSets float64) VolumeStatus {
+	switch {
+	case hardSets < uc.landmarks.MinSets:
+		return VolumeStatusBelow
+	case hardSets > uc.landmarks.MaxSets:
+		return VolumeStatusAbove
+	default:
+		return VolumeStatusWithin
+	}
+}
+
+// dayAfter returns local midnight after the calendar day of d, the exclusive end of a period
+// whose last day is d. API dates are parsed as UTC midnight, so the day is read in UTC.
+func dayAfter(d time.Time, loc *time.Location) time.Time {
+	d = d.UTC()
+	return time.Date(d.Year(), d.Month(), d.Day()+1, 0, 0, 0, 0, loc)
+}
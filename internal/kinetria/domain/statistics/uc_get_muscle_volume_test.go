@@ -0,0 +1,153 @@
+package statistics
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/kinetria/kinetria-back/internal/kinetria/domain/entities"
+	domainerrors "github.com/kinetria/kinetria-back/internal/kinetria/domain/errors"
+	"github.com/kinetria/kinetria-back/internal/kinetria/domain/ports"
+	"github.com/kinetria/kinetria-back/internal/kinetria/domain/vos"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// --- Mocks for GetMuscleVolumeUC ---
+
+type mockSetRecordRepoMuscleVolume struct {
+	volumeResult []ports.MuscleVolumeRow
+	volumeErr    error
+	gotStart     time.Time
+	gotEnd       time.Time
+}
+
+func (m *mockSetRecordRepoMuscleVolume) Create(_ context.Context, _ *entities.SetRecord) error {
+	return nil
+}
+func (m *mockSetRecordRepoMuscleVolume) FindBySessionExerciseSet(_ context.Context, _, _ uuid.UUID, _ int) (*entities.SetRecord, error) {
+	return nil, nil
+}
+func (m *mockSetRecordRepoMuscleVolume) GetTotalSetsRepsVolume(_ context.Context, _ uuid.UUID, _, _ time.Time) (*ports.SetRecordStats, error) {
+	return nil, nil
+}
+func (m *mockSetRecordRepoMuscleVolume) GetPersonalRecordsByUser(_ context.Context, _ uuid.UUID) ([]ports.PersonalRecord, error) {
+	return nil, nil
+}
+func (m *mockSetRecordRepoMuscleVolume) GetProgressionByUserAndExercise(_ context.Context, _ uuid.UUID, _ *uuid.UUID, _, _ time.Time, _ *time.Location) ([]ports.ProgressionPoint, error) {
+	return nil, nil
+}
+func (m *mockSetRecordRepoMuscleVolume) GetMuscleVolumeByUserAndPeriod(_ context.Context, _ uuid.UUID, start, end time.Time, _ *time.Location, _ vos.WeekStart) ([]ports.MuscleVolumeRow, error) {
+	m.gotStart, m.gotEnd = start, end
+	return m.volumeResult, m.volumeErr
+}
+func (m *mockSetRecordRepoMuscleVolume) GetBigLiftSetsByUser(_ context.Context, _ uuid.UUID, _, _ time.Time, _ *time.Location) ([]ports.BigLiftSetRow, error) {
+	return nil, nil
+}
+func (m *mockSetRecordRepoMuscleVolume) GetExerciseSetSummariesByUser(_ context.Context, _ uuid.UUID, _, _ time.Time, _ *time.Location) ([]ports.ExerciseSetSummaryRow, error) {
+	return nil, nil
+}
+
+// --- Tests ---
+
+func TestGetMuscleVolumeUC_Execute(t *testing.T) {
+	userID := uuid.New()
+	landmarks := VolumeLandmarks{MinSets: 10, MaxSets: 20}
+
+	// Wednesday → aligned to Monday 2024-03-04
+	start := time.Date(2024, 3, 6, 0, 0, 0, 0, time.UTC)
+	end := time.Date(2024, 3, 17, 23, 59, 59, 0, time.UTC)
+	week1 := time.Date(2024, 3, 4, 0, 0, 0, 0, time.UTC)
+	week2 := time.Date(2024, 3, 11, 0, 0, 0, 0, time.UTC)
+
+	t.Run("happy path: zero-fills weeks and flags landmarks", func(t *testing.T) {
+		repo := &mockSetRecordRepoMuscleVolume{
+			volumeResult: []ports.MuscleVolumeRow{
+				{WeekStart: week1, MuscleGroup: "chest", HardSets: 12, Volume: 1200000},
+				{WeekStart: week1, MuscleGroup: "triceps", HardSets: 6, Volume: 300000},
+				{WeekStart: week2, MuscleGroup: "quadriceps", HardSets: 22.5, Volume: 3000000},
+			},
+		}
+		uc := NewGetMuscleVolumeUC(repo, utcPrefsRepo(), landmarks)
+
+		out, err := uc.Execute(context.Background(), GetMuscleVolumeInput{UserID: userID, StartDate: &start, EndDate: &end})
+		require.NoError(t, err)
+
+		assert.Equal(t, week1, repo.gotStart)
+		assert.Equal(t, time.Date(2024, 3, 18, 0, 0, 0, 0, time.UTC), repo.gotEnd, "the end date is inclusive")
+		assert.Equal(t, week1, out.StartDate)
+		assert.Equal(t, time.Date(2024, 3, 17, 0, 0, 0, 0, time.UTC), out.EndDate)
+		assert.Equal(t, landmarks, out.Landmarks)
+		require.Len(t, out.Weeks, 2)
+		assert.Equal(t, week1, out.Weeks[0].WeekStart)
+		assert.Equal(t, week2, out.Weeks[1].WeekStart)
+
+		byMuscle := func(w MuscleVolumeWeek, mg vos.MuscleGroup) MuscleVolume {
+			for _, m := range w.Muscles {
+				if m.MuscleGroup == mg {
+					return m
+				}
+			}
+			t.Fatalf("muscle %s missing", mg)
+			return MuscleVolume{}
+		}
+
+		assert.Len(t, out.Weeks[0].Muscles, len(vos.AllMuscleGroups()))
+		chest := byMuscle(out.Weeks[0], vos.MuscleGroupChest)
+		assert.Equal(t, 12.0, chest.HardSets)
+		assert.Equal(t, int64(1200000), chest.Volume)
+		assert.Equal(t, VolumeStatusWithin, chest.Status)
+		assert.Equal(t, VolumeStatusBelow, byMuscle(out.Weeks[0], vos.MuscleGroupTriceps).Status)
+		assert.Equal(t, VolumeStatusAbove, byMuscle(out.Weeks[1], vos.MuscleGroupQuadriceps).Status)
+
+		calves := byMuscle(out.Weeks[1], vos.MuscleGroupCalves)
+		assert.Equal(t, 0.0, calves.HardSets)
+		assert.Equal(t, VolumeStatusBelow, calves.Status)
+	})
+
+	t.Run("default period: four weeks starting on a Monday", func(t *testing.T) {
+		repo := &mockSetRecordRepoMuscleVolume{}
+		uc := NewGetMuscleVolumeUC(repo, utcPrefsRepo(), landmarks)
+
+		out, err := uc.Execute(context.Background(), GetMuscleVolumeInput{UserID: userID})
+		require.NoError(t, err)
+		assert.Len(t, out.Weeks, 4)
+		assert.Equal(t, time.Monday, out.StartDate.Weekday())
+	})
+
+	t.Run("sunday week start: aligns weeks to Sunday in the user's timezone", func(t *testing.T) {
+		repo := &mockSetRecordRepoMuscleVolume{}
+		uc := NewGetMuscleVolumeUC(repo, newPrefsRepo("America/Sao_Paulo", vos.WeekStartSunday), landmarks)
+
+		out, err := uc.Execute(context.Background(), GetMuscleVolumeInput{UserID: userID, StartDate: &start, EndDate: &end})
+		require.NoError(t, err)
+
+		saoPaulo, err := time.LoadLocation("America/Sao_Paulo")
+		require.NoError(t, err)
+		sunday := time.Date(2024, 3, 3, 0, 0, 0, 0, saoPaulo)
+		assert.True(t, sunday.Equal(repo.gotStart), "got start %v", repo.gotStart)
+		dayAfterEnd := time.Date(2024, 3, 18, 0, 0, 0, 0, saoPaulo)
+		assert.True(t, dayAfterEnd.Equal(repo.gotEnd), "got end %v", repo.gotEnd)
+		require.NotEmpty(t, out.Weeks)
+		assert.Equal(t, time.Sunday, out.Weeks[0].WeekStart.Weekday())
+	})
+
+	t.Run("start after end: returns ErrInvalidPeriod", func(t *testing.T) {
+		uc := NewGetMuscleVolumeUC(&mockSetRecordRepoMuscleVolume{}, utcPrefsRepo(), landmarks)
+		s := time.Date(2024, 4, 1, 0, 0, 0, 0, time.UTC)
+		e := time.Date(2024, 3, 1, 0, 0, 0, 0, time.UTC)
+
+		_, err := uc.Execute(context.Background(), GetMuscleVolumeInput{UserID: userID, StartDate: &s, EndDate: &e})
+		assert.ErrorIs(t, err, domainerrors.ErrInvalidPeriod)
+	})
+
+	t.Run("repository error: propagates", func(t *testing.T) {
+		repoErr := errors.New("db down")
+		uc := NewGetMuscleVolumeUC(&mockSetRecordRepoMuscleVolume{volumeErr: repoErr}, utcPrefsRepo(), landmarks)
+
+		_, err := uc.Execute(context.Background(), GetMuscleVolumeInput{UserID: userID, StartDate: &start, EndDate: &end})
+		assert.ErrorIs(t, err, repoErr)
+	})
+}
@@ -3,11 +3,13 @@ package statistics
 import (
 	"context"
 	"fmt"
+	"sort"
 	"time"
 
 	"github.com/google/uuid"
 	domainerrors "github.com/kinetria/kinetria-back/internal/kinetria/domain/errors"
 	"github.com/kinetria/kinetria-back/internal/kinetria/domain/ports"
+	"github.com/kinetria/kinetria-back/internal/kinetria/domain/profile"
 )
 
 // maxPeriodDays is the maximum allowed period for statistics queries (2 years).
@@ -18,22 +20,28 @@ type GetOverviewInput struct {
 	UserID    uuid.UUID
 	StartDate *time.Time
 	EndDate   *time.Time
+	Compare   *ComparePeriod // nil: sem comparação
 }
 
 // GetOverviewUC retrieves aggregated workout statistics for a user.
+// The streak is counted in the user's chosen mode (days or weeks) by the streak calculator.
 type GetOverviewUC struct {
 	sessionRepo   ports.SessionRepository
 	setRecordRepo ports.SetRecordRepository
+	userRepo      ports.UserRepository
+	streaks       ports.StreakCalculator
 }
 
 // NewGetOverviewUC creates a new GetOverviewUC.
-func NewGetOverviewUC(sessionRepo ports.SessionRepository, setRecordRepo ports.SetRecordRepository) *GetOverviewUC {
-	return &GetOverviewUC{sessionRepo: sessionRepo, setRecordRepo: setRecordRepo}
+func NewGetOverviewUC(sessionRepo ports.SessionRepository, setRecordRepo ports.SetRecordRepository, userRepo ports.UserRepository, streaks ports.StreakCalculator) *GetOverviewUC {
+	return &GetOverviewUC{sessionRepo: sessionRepo, setRecordRepo: setRecordRepo, userRepo: userRepo, streaks: streaks}
 }
 
 // Execute computes overview statistics for the given user and period.
 // If StartDate/EndDate are nil, defaults to the last 30 days.
 // Returns an error if the period is invalid or exceeds 2 years.
+// With Compare set, the result also holds the comparison period's totals, the change of
+// every metric and the per-exercise volume of both periods.
 func (uc *GetOverviewUC) Execute(ctx context.Context, input GetOverviewInput) (*OverviewStats, error) {
 	now := time.Now().UTC()
 
@@ -55,26 +63,52 @@ func (uc *GetOverviewUC) Execute(ctx context.Context, input GetOverviewInput) (*
 		return nil, domainerrors.ErrPeriodTooLong
 	}
 
-	// Fetch session stats
-	sessionStats, err := uc.sessionRepo.GetStatsByUserAndPeriod(ctx, input.UserID, start, end)
+	stats, err := uc.periodTotals(ctx, input.UserID, start, end)
 	if err != nil {
-		return nil, fmt.Errorf("get session stats: %w", err)
+		return nil, err
 	}
 
-	// Fetch set record stats
-	setStats, err := uc.setRecordRepo.GetTotalSetsRepsVolume(ctx, input.UserID, start, end)
+	// Comparison days are bucketed in the user's timezone
+	prefs, err := profile.LoadPreferences(ctx, uc.userRepo, input.UserID)
 	if err != nil {
-		return nil, fmt.Errorf("get set record stats: %w", err)
+		return nil, err
+	}
+	loc := prefs.Location()
+
+	// Sequência no modo escolhido pelo usuário (dias ou semanas)
+	streak, err := uc.streaks.Execute(ctx, input.UserID)
+	if err != nil {
+		return nil, fmt.Errorf("get streak: %w", err)
+	}
+	stats.CurrentStreak, stats.LongestStreak = streak.Current, streak.Longest
+	stats.StreakMode, stats.StreakFreezes = streak.Mode, streak.FreezesAvailable
+
+	if input.Compare != nil {
+		prevStart, prevEnd, err := input.Compare.resolve(start, end, time.Second)
+		if err != nil {
+			return nil, err
+		}
+		if stats.Comparison, err = uc.compare(ctx, input.UserID, stats, prevStart, prevEnd, loc); err != nil {
+			return nil, err
+		}
 	}
 
-	// Fetch streak data (last 365 days)
-	streakDates, err := uc.sessionRepo.GetSessionsForStreak(ctx, input.UserID)
+	return stats, nil
+}
+
+// periodTotals computes the session and set totals of a period, without streaks.
+func (uc *GetOverviewUC) periodTotals(ctx context.Context, userID uuid.UUID, start, end time.Time) (*OverviewStats, error) {
+	// Fetch session stats
+	sessionStats, err := uc.sessionRepo.GetStatsByUserAndPeriod(ctx, userID, start, end)
 	if err != nil {
-		return nil, fmt.Errorf("get streak data: %w", err)
+		return nil, fmt.Errorf("get session stats: %w", err)
 	}
 
-	// Calculate streaks
-	currentStreak, longestStreak := calculateStreaks(streakDates, now)
+	// Fetch set record stats
+	setStats, err := uc.setRecordRepo.GetTotalSetsRepsVolume(ctx, userID, start, end)
+	if err != nil {
+		return nil, fmt.Errorf("get set record stats: %w", err)
+	}
 
 	// Calculate average per week
 	days := end.Sub(start).Hours() / 24
@@ -90,64 +124,80 @@ func (uc *GetOverviewUC) Execute(ctx context.Context, input GetOverviewInput) (*
 		TotalWorkouts:    sessionStats.TotalWorkouts,
 		AveragePerWeek:   avgPerWeek,
 		TotalTimeMinutes: sessionStats.TotalTime,
+		TotalCalories:    sessionStats.TotalCalories,
 		TotalSets:        setStats.TotalSets,
 		TotalReps:        setStats.TotalReps,
 		TotalVolume:      setStats.TotalVolume,
-		CurrentStreak:    currentStreak,
-		LongestStreak:    longestStreak,
 	}, nil
 }
 
-// calculateStreaks computes currentStreak and longestStreak from a list of workout dates.
-// dates must be sorted in descending order (most recent first), deduplicated by day.
-// now is used as the reference point for currentStreak.
-func calculateStreaks(dates []time.Time, now time.Time) (current, longest int) {
-	if len(dates) == 0 {
-		return 0, 0
-	}
-
-	today := time.Date(now.Year(), now.Month(), now.Day(), 0, 0, 0, 0, time.UTC)
-
-	// Build a set of dates for quick lookup
-	dateSet := make(map[string]bool, len(dates))
-	for _, d := range dates {
-		day := time.Date(d.Year(), d.Month(), d.Day(), 0, 0, 0, 0, time.UTC)
-		dateSet[day.Format("2006-01-02")] = true
-	}
-
-	// Current streak: count consecutive days back from today (or yesterday)
-	currentStreak := 0
-	checkDay := today
-	// If no workout today, start from yesterday
-	if !dateSet[checkDay.Format("2006-01-02")] {
-		checkDay = today.AddDate(0, 0, -1)
-	}
-	for dateSet[checkDay.Format("2006-01-02")] {
-		currentStreak++
-		checkDay = checkDay.AddDate(0, 0, -1)
-	}
-
-	// Longest streak: iterate all dates in descending order
-	longestStreak := 0
-	streak := 0
-	var prevDay time.Time
-	for _, d := range dates {
-		day := time.Date(d.Year(), d.Month(), d.Day(), 0, 0, 0, 0, time.UTC)
-		if prevDay.IsZero() {
-			streak = 1
-		} else {
-			expected := prevDay.AddDate(0, 0, -1)
-			if day.Equal(expected) {
-				streak++
-			} else {
-				streak = 1
-			}
-		}
-		prevDay = day
-		if streak > longestStreak {
-			longestStreak = streak
+// compare computes the comparison period's totals and the deltas against current.
+func (uc *GetOverviewUC) compare(ctx context.Context, userID uuid.UUID, current *OverviewStats, start, end time.Time, loc *time.Location) (*OverviewComparison, error) {
+	previous, err := uc.periodTotals(ctx, userID, start, end)
+	if err != nil {
+		return nil, err
+	}
+
+	currentVolumes, names, err := uc.exerciseVolumes(ctx, userID, current.StartDate, current.EndDate, loc)
+	if err != nil {
+		return nil, err
+	}
+	previousVolumes, previousNames, err := uc.exerciseVolumes(ctx, userID, start, end, loc)
+	if err != nil {
+		return nil, err
+	}
+	for id, name := range previousNames {
+		if _, ok := names[id]; !ok {
+			names[id] = name
 		}
 	}
 
-	return currentStreak, longestStreak
+	exercises := make([]ExerciseVolumeComparison, 0, len(names))
+	for id, name := range names {
+		exercises = append(exercises, ExerciseVolumeComparison{
+			ExerciseID:     id,
+			ExerciseName:   name,
+			Volume:         currentVolumes[id],
+			PreviousVolume: previousVolumes[id],
+			Delta:          newMetricDelta(float64(currentVolumes[id]), float64(previousVolumes[id])),
+		})
+	}
+	sort.Slice(exercises, func(i, j int) bool {
+		if exercises[i].Volume != exercises[j].Volume {
+			return exercises[i].Volume > exercises[j].Volume
+		}
+		if exercises[i].PreviousVolume != exercises[j].PreviousVolume {
+			return exercises[i].PreviousVolume > exercises[j].PreviousVolume
+		}
+		return exercises[i].ExerciseName < exercises[j].ExerciseName
+	})
+
+	return &OverviewComparison{
+		Previous: *previous,
+		Deltas: OverviewDeltas{
+			TotalWorkouts:    newMetricDelta(float64(current.TotalWorkouts), float64(previous.TotalWorkouts)),
+			AveragePerWeek:   newMetricDelta(current.AveragePerWeek, previous.AveragePerWeek),
+			TotalTimeMinutes: newMetricDelta(float64(current.TotalTimeMinutes), float64(previous.TotalTimeMinutes)),
+			TotalCalories:    newMetricDelta(float64(current.TotalCalories), float64(previous.TotalCalories)),
+			TotalSets:        newMetricDelta(float64(current.TotalSets), float64(previous.TotalSets)),
+			TotalReps:        newMetricDelta(float64(current.TotalReps), float64(previous.TotalReps)),
+			TotalVolume:      newMetricDelta(float64(current.TotalVolume), float64(previous.TotalVolume)),
+		},
+		Exercises: exercises,
+	}, nil
+}
+
+// exerciseVolumes sums the volume per exercise of the sessions started in [start, end].
+func (uc *GetOverviewUC) exerciseVolumes(ctx context.Context, userID uuid.UUID, start, end time.Time, loc *time.Location) (map[uuid.UUID]int64, map[uuid.UUID]string, error) {
+	rows, err := uc.setRecordRepo.GetExerciseSetSummariesByUser(ctx, userID, start, end, loc)
+	if err != nil {
+		return nil, nil, fmt.Errorf("get exercise volumes: %w", err)
+	}
+	volumes := make(map[uuid.UUID]int64)
+	names := make(map[uuid.UUID]string)
+	for _, r := range rows {
+		volumes[r.ExerciseID] += r.Volume
+		names[r.ExerciseID] = r.ExerciseName
+	}
+	return volumes, names, nil
 }
@@ -9,6 +9,7 @@ import (
 	"github.com/google/uuid"
 	"github.com/kinetria/kinetria-back/internal/kinetria/domain/entities"
 	"github.com/kinetria/kinetria-back/internal/kinetria/domain/ports"
+	"github.com/kinetria/kinetria-back/internal/kinetria/domain/vos"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 )
@@ -20,8 +21,6 @@ type mockSessionRepoOverview struct {
 	statsErr        error
 	frequencyResult []ports.FrequencyData
 	frequencyErr    error
-	streakResult    []time.Time
-	streakErr       error
 }
 
 func (m *mockSessionRepoOverview) Create(_ context.Context, _ *entities.Session) error {
@@ -36,17 +35,17 @@ func (m *mockSessionRepoOverview) FindByID(_ context.Context, _ uuid.UUID) (*ent
 func (m *mockSessionRepoOverview) UpdateStatus(_ context.Context, _ uuid.UUID, _ string, _ *time.Time, _ string) (bool, error) {
 	return false, nil
 }
-func (m *mockSessionRepoOverview) GetCompletedSessionsByUserAndDateRange(_ context.Context, _ uuid.UUID, _, _ time.Time) ([]entities.Session, error) {
+func (m *mockSessionRepoOverview) GetCompletedSessionsByUserAndDateRange(_ context.Context, _ uuid.UUID, _, _ time.Time, _ *time.Location) ([]entities.Session, error) {
 	return nil, nil
 }
 func (m *mockSessionRepoOverview) GetStatsByUserAndPeriod(_ context.Context, _ uuid.UUID, _, _ time.Time) (*ports.SessionStats, error) {
 	return m.statsResult, m.statsErr
 }
-func (m *mockSessionRepoOverview) GetFrequencyByUserAndPeriod(_ context.Context, _ uuid.UUID, _, _ time.Time) ([]ports.FrequencyData, error) {
+func (m *mockSessionRepoOverview) GetFrequencyByUserAndPeriod(_ context.Context, _ uuid.UUID, _, _ time.Time, _ *time.Location) ([]ports.FrequencyData, error) {
 	return m.frequencyResult, m.frequencyErr
 }
-func (m *mockSessionRepoOverview) GetSessionsForStreak(_ context.Context, _ uuid.UUID) ([]time.Time, error) {
-	return m.streakResult, m.streakErr
+func (m *mockSessionRepoOverview) GetSessionsForStreak(_ context.Context, _ uuid.UUID, _ *time.Location) ([]time.Time, error) {
+	return nil, nil
 }
 
 type mockSetRecordRepoOverview struct {
@@ -66,10 +65,59 @@ func (m *mockSetRecordRepoOverview) GetTotalSetsRepsVolume(_ context.Context, _
 func (m *mockSetRecordRepoOverview) GetPersonalRecordsByUser(_ context.Context, _ uuid.UUID) ([]ports.PersonalRecord, error) {
 	return nil, nil
 }
-func (m *mockSetRecordRepoOverview) GetProgressionByUserAndExercise(_ context.Context, _ uuid.UUID, _ *uuid.UUID, _, _ time.Time) ([]ports.ProgressionPoint, error) {
+func (m *mockSetRecordRepoOverview) GetProgressionByUserAndExercise(_ context.Context, _ uuid.UUID, _ *uuid.UUID, _, _ time.Time, _ *time.Location) ([]ports.ProgressionPoint, error) {
+	return nil, nil
+}
+func (m *mockSetRecordRepoOverview) GetMuscleVolumeByUserAndPeriod(_ context.Context, _ uuid.UUID, _, _ time.Time, _ *time.Location, _ vos.WeekStart) ([]ports.MuscleVolumeRow, error) {
 	return nil, nil
 }
 
+func (m *mockSetRecordRepoOverview) GetBigLiftSetsByUser(_ context.Context, _ uuid.UUID, _, _ time.Time, _ *time.Location) ([]ports.BigLiftSetRow, error) {
+	return nil, nil
+}
+
+func (m *mockSetRecordRepoOverview) GetExerciseSetSummariesByUser(_ context.Context, _ uuid.UUID, _, _ time.Time, _ *time.Location) ([]ports.ExerciseSetSummaryRow, error) {
+	return nil, nil
+}
+
+// mockStreakCalculator returns a fixed streak.
+type mockStreakCalculator struct {
+	status ports.StreakStatus
+	err    error
+}
+
+func (m *mockStreakCalculator) Execute(_ context.Context, _ uuid.UUID) (*ports.StreakStatus, error) {
+	if m.err != nil {
+		return nil, m.err
+	}
+	s := m.status
+	return &s, nil
+}
+
+// mockSessionRepoCompare and mockSetRecordRepoCompare return the totals of the period starting at start.
+type mockSessionRepoCompare struct {
+	mockSessionRepoOverview
+	statsByStart map[time.Time]*ports.SessionStats
+}
+
+func (m *mockSessionRepoCompare) GetStatsByUserAndPeriod(_ context.Context, _ uuid.UUID, start, _ time.Time) (*ports.SessionStats, error) {
+	return m.statsByStart[start], nil
+}
+
+type mockSetRecordRepoCompare struct {
+	mockSetRecordRepoOverview
+	statsByStart     map[time.Time]*ports.SetRecordStats
+	summariesByStart map[time.Time][]ports.ExerciseSetSummaryRow
+}
+
+func (m *mockSetRecordRepoCompare) GetTotalSetsRepsVolume(_ context.Context, _ uuid.UUID, start, _ time.Time) (*ports.SetRecordStats, error) {
+	return m.statsByStart[start], nil
+}
+
+func (m *mockSetRecordRepoCompare) GetExerciseSetSummariesByUser(_ context.Context, _ uuid.UUID, start, _ time.Time, _ *time.Location) ([]ports.ExerciseSetSummaryRow, error) {
+	return m.summariesByStart[start], nil
+}
+
 // --- Tests ---
 
 func TestGetOverviewUC_Execute(t *testing.T) {
@@ -81,18 +129,13 @@ func TestGetOverviewUC_Execute(t *testing.T) {
 
 	t.Run("happy path: returns stats correctly", func(t *testing.T) {
 		sessRepo := &mockSessionRepoOverview{
-			statsResult: &ports.SessionStats{TotalWorkouts: 5, TotalTime: 120},
-			streakResult: []time.Time{
-				now,
-				now.AddDate(0, 0, -1),
-				now.AddDate(0, 0, -2),
-			},
+			statsResult: &ports.SessionStats{TotalWorkouts: 5, TotalTime: 120, TotalCalories: 950},
 		}
 		setRepo := &mockSetRecordRepoOverview{
 			statsResult: &ports.SetRecordStats{TotalSets: 20, TotalReps: 100, TotalVolume: 50000},
 		}
 
-		uc := NewGetOverviewUC(sessRepo, setRepo)
+		uc := NewGetOverviewUC(sessRepo, setRepo, utcPrefsRepo(), &mockStreakCalculator{})
 		result, err := uc.Execute(context.Background(), GetOverviewInput{
 			UserID:    userID,
 			StartDate: &start,
@@ -102,6 +145,7 @@ func TestGetOverviewUC_Execute(t *testing.T) {
 		require.NoError(t, err)
 		assert.Equal(t, 5, result.TotalWorkouts)
 		assert.Equal(t, 120, result.TotalTimeMinutes)
+		assert.Equal(t, 950, result.TotalCalories)
 		assert.Equal(t, 20, result.TotalSets)
 		assert.Equal(t, 100, result.TotalReps)
 		assert.Equal(t, int64(50000), result.TotalVolume)
@@ -112,7 +156,7 @@ func TestGetOverviewUC_Execute(t *testing.T) {
 		sessRepo := &mockSessionRepoOverview{}
 		setRepo := &mockSetRecordRepoOverview{}
 
-		uc := NewGetOverviewUC(sessRepo, setRepo)
+		uc := NewGetOverviewUC(sessRepo, setRepo, utcPrefsRepo(), &mockStreakCalculator{})
 		badStart := end
 		badEnd := start
 		_, err := uc.Execute(context.Background(), GetOverviewInput{
@@ -129,7 +173,7 @@ func TestGetOverviewUC_Execute(t *testing.T) {
 		sessRepo := &mockSessionRepoOverview{}
 		setRepo := &mockSetRecordRepoOverview{}
 
-		uc := NewGetOverviewUC(sessRepo, setRepo)
+		uc := NewGetOverviewUC(sessRepo, setRepo, utcPrefsRepo(), &mockStreakCalculator{})
 		longStart := now.AddDate(-3, 0, 0) // 3 years ago
 		_, err := uc.Execute(context.Background(), GetOverviewInput{
 			UserID:    userID,
@@ -143,14 +187,13 @@ func TestGetOverviewUC_Execute(t *testing.T) {
 
 	t.Run("user with no workouts returns zeros", func(t *testing.T) {
 		sessRepo := &mockSessionRepoOverview{
-			statsResult:  &ports.SessionStats{TotalWorkouts: 0, TotalTime: 0},
-			streakResult: []time.Time{},
+			statsResult: &ports.SessionStats{TotalWorkouts: 0, TotalTime: 0},
 		}
 		setRepo := &mockSetRecordRepoOverview{
 			statsResult: &ports.SetRecordStats{TotalSets: 0, TotalReps: 0, TotalVolume: 0},
 		}
 
-		uc := NewGetOverviewUC(sessRepo, setRepo)
+		uc := NewGetOverviewUC(sessRepo, setRepo, utcPrefsRepo(), &mockStreakCalculator{})
 		result, err := uc.Execute(context.Background(), GetOverviewInput{
 			UserID:    userID,
 			StartDate: &start,
@@ -165,21 +208,18 @@ func TestGetOverviewUC_Execute(t *testing.T) {
 		assert.Equal(t, 0, result.LongestStreak)
 	})
 
-	t.Run("streak calculated correctly: 3 consecutive days", func(t *testing.T) {
-		today := time.Date(now.Year(), now.Month(), now.Day(), 0, 0, 0, 0, time.UTC)
+	t.Run("streak in the user's mode", func(t *testing.T) {
 		sessRepo := &mockSessionRepoOverview{
 			statsResult: &ports.SessionStats{TotalWorkouts: 3, TotalTime: 90},
-			streakResult: []time.Time{
-				today,
-				today.AddDate(0, 0, -1),
-				today.AddDate(0, 0, -2),
-			},
 		}
 		setRepo := &mockSetRecordRepoOverview{
 			statsResult: &ports.SetRecordStats{},
 		}
+		streaks := &mockStreakCalculator{status: ports.StreakStatus{
+			Mode: vos.StreakModeWeekly, WeeklyTarget: 3, Current: 3, Longest: 5, FreezesAvailable: 1,
+		}}
 
-		uc := NewGetOverviewUC(sessRepo, setRepo)
+		uc := NewGetOverviewUC(sessRepo, setRepo, utcPrefsRepo(), streaks)
 		result, err := uc.Execute(context.Background(), GetOverviewInput{
 			UserID:    userID,
 			StartDate: &start,
@@ -188,7 +228,23 @@ func TestGetOverviewUC_Execute(t *testing.T) {
 
 		require.NoError(t, err)
 		assert.Equal(t, 3, result.CurrentStreak)
-		assert.Equal(t, 3, result.LongestStreak)
+		assert.Equal(t, 5, result.LongestStreak)
+		assert.Equal(t, vos.StreakModeWeekly, result.StreakMode)
+		assert.Equal(t, 1, result.StreakFreezes)
+	})
+
+	t.Run("streak error returns error", func(t *testing.T) {
+		sessRepo := &mockSessionRepoOverview{statsResult: &ports.SessionStats{}}
+		setRepo := &mockSetRecordRepoOverview{statsResult: &ports.SetRecordStats{}}
+
+		uc := NewGetOverviewUC(sessRepo, setRepo, utcPrefsRepo(), &mockStreakCalculator{err: errors.New("db down")})
+		_, err := uc.Execute(context.Background(), GetOverviewInput{
+			UserID:    userID,
+			StartDate: &start,
+			EndDate:   &end,
+		})
+
+		require.Error(t, err)
 	})
 
 	t.Run("sessionRepo error returns error", func(t *testing.T) {
@@ -197,7 +253,7 @@ func TestGetOverviewUC_Execute(t *testing.T) {
 		}
 		setRepo := &mockSetRecordRepoOverview{}
 
-		uc := NewGetOverviewUC(sessRepo, setRepo)
+		uc := NewGetOverviewUC(sessRepo, setRepo, utcPrefsRepo(), &mockStreakCalculator{})
 		_, err := uc.Execute(context.Background(), GetOverviewInput{
 			UserID:    userID,
 			StartDate: &start,
@@ -208,3 +264,81 @@ func TestGetOverviewUC_Execute(t *testing.T) {
 		assert.Contains(t, err.Error(), "db connection error")
 	})
 }
+
+func TestGetOverviewUC_Execute_Compare(t *testing.T) {
+	userID := uuid.New()
+	start := time.Date(2026, 3, 8, 0, 0, 0, 0, time.UTC)
+	end := start.AddDate(0, 0, 7)
+	prevStart := start.Add(-time.Second).AddDate(0, 0, -7)
+
+	squat, bench, row := uuid.New(), uuid.New(), uuid.New()
+	sessRepo := &mockSessionRepoCompare{statsByStart: map[time.Time]*ports.SessionStats{
+		start:     {TotalWorkouts: 4, TotalTime: 240, TotalCalories: 1200},
+		prevStart: {TotalWorkouts: 2, TotalTime: 150, TotalCalories: 0},
+	}}
+	setRepo := &mockSetRecordRepoCompare{
+		statsByStart: map[time.Time]*ports.SetRecordStats{
+			start:     {TotalSets: 40, TotalReps: 300, TotalVolume: 9000000},
+			prevStart: {TotalSets: 32, TotalReps: 250, TotalVolume: 6000000},
+		},
+		summariesByStart: map[time.Time][]ports.ExerciseSetSummaryRow{
+			start: {
+				{ExerciseID: squat, ExerciseName: "Agachamento", Volume: 3000000},
+				{ExerciseID: squat, ExerciseName: "Agachamento", Volume: 2000000},
+				{ExerciseID: bench, ExerciseName: "Supino", Volume: 4000000},
+			},
+			prevStart: {
+				{ExerciseID: squat, ExerciseName: "Agachamento", Volume: 4000000},
+				{ExerciseID: row, ExerciseName: "Remada", Volume: 2000000},
+			},
+		},
+	}
+	uc := NewGetOverviewUC(sessRepo, setRepo, utcPrefsRepo(), &mockStreakCalculator{})
+
+	result, err := uc.Execute(context.Background(), GetOverviewInput{
+		UserID:    userID,
+		StartDate: &start,
+		EndDate:   &end,
+		Compare:   &ComparePeriod{},
+	})
+	require.NoError(t, err)
+	require.NotNil(t, result.Comparison)
+
+	cmp := result.Comparison
+	assert.Equal(t, prevStart, cmp.Previous.StartDate)
+	assert.Equal(t, start.Add(-time.Second), cmp.Previous.EndDate)
+	assert.Equal(t, 2, cmp.Previous.TotalWorkouts)
+
+	assert.Equal(t, 2.0, cmp.Deltas.TotalWorkouts.Absolute)
+	require.NotNil(t, cmp.Deltas.TotalWorkouts.Percent)
+	assert.Equal(t, 100.0, *cmp.Deltas.TotalWorkouts.Percent)
+	assert.Equal(t, 90.0, cmp.Deltas.TotalTimeMinutes.Absolute)
+	assert.Nil(t, cmp.Deltas.TotalCalories.Percent)
+	assert.Equal(t, 3000000.0, cmp.Deltas.TotalVolume.Absolute)
+	require.NotNil(t, cmp.Deltas.TotalVolume.Percent)
+	assert.Equal(t, 50.0, *cmp.Deltas.TotalVolume.Percent)
+
+	require.Len(t, cmp.Exercises, 3)
+	assert.Equal(t, squat, cmp.Exercises[0].ExerciseID)
+	assert.Equal(t, int64(5000000), cmp.Exercises[0].Volume)
+	assert.Equal(t, int64(4000000), cmp.Exercises[0].PreviousVolume)
+	assert.Equal(t, 25.0, *cmp.Exercises[0].Delta.Percent)
+	assert.Equal(t, bench, cmp.Exercises[1].ExerciseID)
+	assert.Nil(t, cmp.Exercises[1].Delta.Percent)
+	assert.Equal(t, row, cmp.Exercises[2].ExerciseID)
+	assert.Equal(t, -100.0, *cmp.Exercises[2].Delta.Percent)
+}
+
+func TestGetOverviewUC_Execute_WithoutCompare(t *testing.T) {
+	start := time.Now().UTC().AddDate(0, 0, -7)
+	uc := NewGetOverviewUC(
+		&mockSessionRepoOverview{statsResult: &ports.SessionStats{}},
+		&mockSetRecordRepoOverview{statsResult: &ports.SetRecordStats{}},
+		utcPrefsRepo(),
+		&mockStreakCalculator{},
+	)
+
+	result, err := uc.Execute(context.Background(), GetOverviewInput{UserID: uuid.New(), StartDate: &start})
+	require.NoError(t, err)
+	assert.Nil(t, result.Comparison)
+}
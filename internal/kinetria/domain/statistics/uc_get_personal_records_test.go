@@ -8,6 +8,7 @@ import (
 	"github.com/google/uuid"
 	"github.com/kinetria/kinetria-back/internal/kinetria/domain/entities"
 	"github.com/kinetria/kinetria-back/internal/kinetria/domain/ports"
+	"github.com/kinetria/kinetria-back/internal/kinetria/domain/vos"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 )
@@ -31,7 +32,18 @@ func (m *mockSetRecordRepoPR) GetTotalSetsRepsVolume(_ context.Context, _ uuid.U
 func (m *mockSetRecordRepoPR) GetPersonalRecordsByUser(_ context.Context, _ uuid.UUID) ([]ports.PersonalRecord, error) {
 	return m.prResult, m.prErr
 }
-func (m *mockSetRecordRepoPR) GetProgressionByUserAndExercise(_ context.Context, _ uuid.UUID, _ *uuid.UUID, _, _ time.Time) ([]ports.ProgressionPoint, error) {
+func (m *mockSetRecordRepoPR) GetProgressionByUserAndExercise(_ context.Context, _ uuid.UUID, _ *uuid.UUID, _, _ time.Time, _ *time.Location) ([]ports.ProgressionPoint, error) {
+	return nil, nil
+}
+func (m *mockSetRecordRepoPR) GetMuscleVolumeByUserAndPeriod(_ context.Context, _ uuid.UUID, _, _ time.Time, _ *time.Location, _ vos.WeekStart) ([]ports.MuscleVolumeRow, error) {
+	return nil, nil
+}
+
+func (m *mockSetRecordRepoPR) GetBigLiftSetsByUser(_ context.Context, _ uuid.UUID, _, _ time.Time, _ *time.Location) ([]ports.BigLiftSetRow, error) {
+	return nil, nil
+}
+
+func (m *mockSetRecordRepoPR) GetExerciseSetSummariesByUser(_ context.Context, _ uuid.UUID, _, _ time.Time, _ *time.Location) ([]ports.ExerciseSetSummaryRow, error) {
 	return nil, nil
 }
 
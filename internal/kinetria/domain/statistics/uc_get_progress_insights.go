@@ -0,0 +1,269 @@
+package statistics
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"sort"
+	"time"
+
+	"github.com/google/uuid"
+	domainerrors "github.com/kinetria/kinetria-back/internal/kinetria/domain/errors"
+	"github.com/kinetria/kinetria-back/internal/kinetria/domain/ports"
+	"github.com/kinetria/kinetria-back/internal/kinetria/domain/profile"
+	"github.com/kinetria/kinetria-back/internal/kinetria/domain/vos"
+)
+
+const (
+	// defaultInsightSessions is the analysis window when neither Sessions nor Weeks is given.
+	defaultInsightSessions = 4
+	minInsightWindow       = 2
+	maxInsightWindow       = 12
+
+	// insightLookbackWeeks limits how far back the baseline goes.
+	insightLookbackWeeks = 26
+	// insightStaleDays skips exercises not performed recently: a break is not a plateau.
+	insightStaleDays = 28
+
+	// Variação mínima para contar como progresso, e queda que caracteriza regressão (percentual)
+	progressThreshold   = 1.0
+	regressionThreshold = -5.0
+)
+
+// GetProgressInsightsInput holds the input parameters for GetProgressInsightsUC.
+// Sessions and Weeks are mutually exclusive; with neither the window is the last 4 sessions.
+type GetProgressInsightsInput struct {
+	UserID   uuid.UUID
+	Sessions int // janela: últimas N sessões de cada exercício
+	Weeks    int // janela: sessões das últimas N semanas
+	Limit    int // 0: sem limite
+}
+
+// GetProgressInsightsUC detects per-exercise plateaus and regressions.
+type GetProgressInsightsUC struct {
+	setRecordRepo ports.SetRecordRepository
+	userRepo      ports.UserRepository
+}
+
+// NewGetProgressInsightsUC creates a new GetProgressInsightsUC.
+func NewGetProgressInsightsUC(setRecordRepo ports.SetRecordRepository, userRepo ports.UserRepository) *GetProgressInsightsUC {
+	return &GetProgressInsightsUC{setRecordRepo: setRecordRepo, userRepo: userRepo}
+}
+
+// exerciseSession is one session's performance on an exercise.
+type exerciseSession struct {
+	date      time.Time
+	startedAt time.Time
+	e1rm      int   // melhor e1RM (Epley) da sessão; 0 sem séries de até 10 repetições
+	volume    int64 // gramas * reps
+	topReps   int   // repetições com maior volume na sessão
+}
+
+// Execute returns the exercises that plateaued or regressed, regressions first.
+//
+// For each exercise performed in the last 28 days, the best e1RM and best session volume in
+// the window are compared with the best before it (up to 26 weeks back). A drop of 5% or more
+// in e1RM is a regression; less than 1% gain in both e1RM and volume is a plateau.
+func (uc *GetProgressInsightsUC) Execute(ctx context.Context, input GetProgressInsightsInput) ([]ProgressInsight, error) {
+	if input.Sessions != 0 && input.Weeks != 0 {
+		return nil, fmt.Errorf("sessions and weeks are mutually exclusive: %w", domainerrors.ErrMalformedParameters)
+	}
+	if input.Sessions == 0 && input.Weeks == 0 {
+		input.Sessions = defaultInsightSessions
+	}
+	for _, n := range []int{input.Sessions, input.Weeks} {
+		if n != 0 && (n < minInsightWindow || n > maxInsightWindow) {
+			return nil, fmt.Errorf("window must be between %d and %d: %w", minInsightWindow, maxInsightWindow, domainerrors.ErrMalformedParameters)
+		}
+	}
+	if input.Limit < 0 {
+		return nil, fmt.Errorf("limit must not be negative: %w", domainerrors.ErrMalformedParameters)
+	}
+
+	prefs, err := profile.LoadPreferences(ctx, uc.userRepo, input.UserID)
+	if err != nil {
+		return nil, err
+	}
+	loc := prefs.Location()
+	now := time.Now().In(loc)
+	today := calendarDay(now, loc)
+
+	rows, err := uc.setRecordRepo.GetExerciseSetSummariesByUser(ctx, input.UserID, now.AddDate(0, 0, -7*insightLookbackWeeks), now, loc)
+	if err != nil {
+		return nil, fmt.Errorf("get exercise set summaries: %w", err)
+	}
+
+	history, names, order := groupExerciseSessions(rows)
+
+	insights := []ProgressInsight{}
+	for _, exerciseID := range order {
+		sessions := history[exerciseID]
+		last := sessions[len(sessions)-1]
+		if today.Sub(last.date).Hours()/24 > insightStaleDays {
+			continue
+		}
+
+		split := len(sessions) - input.Sessions
+		if input.Weeks > 0 {
+			windowStart := today.AddDate(0, 0, -7*input.Weeks+1)
+			split = sort.Search(len(sessions), func(i int) bool { return !sessions[i].date.Before(windowStart) })
+			if len(sessions)-split < minInsightWindow {
+				continue
+			}
+		}
+		if split < 1 {
+			continue // sem base de comparação
+		}
+
+		insight, ok := analyzeExercise(sessions[:split], sessions[split:])
+		if !ok {
+			continue
+		}
+		insight.ExerciseID = exerciseID
+		insight.ExerciseName = names[exerciseID]
+		insight.StalledSessions = stalledSessions(sessions)
+		insight.LastPerformed = last.date
+		insight.Suggestion = suggestFor(insight, sessions[split:])
+		insights = append(insights, insight)
+	}
+
+	sort.SliceStable(insights, func(i, j int) bool {
+		if insights[i].Kind != insights[j].Kind {
+			return insights[i].Kind == InsightKindRegression
+		}
+		return insights[i].E1RMChange < insights[j].E1RMChange
+	})
+	if input.Limit > 0 && len(insights) > input.Limit {
+		insights = insights[:input.Limit]
+	}
+	return insights, nil
+}
+
+// groupExerciseSessions folds the set summaries into per-exercise sessions in chronological order.
+func groupExerciseSessions(rows []ports.ExerciseSetSummaryRow) (map[uuid.UUID][]exerciseSession, map[uuid.UUID]string, []uuid.UUID) {
+	history := make(map[uuid.UUID][]exerciseSession)
+	names := make(map[uuid.UUID]string)
+	var order []uuid.UUID
+
+	type key struct{ session, exercise uuid.UUID }
+	index := make(map[key]int)
+	topVolume := make(map[key]int64)
+
+	for _, r := range rows {
+		k := key{r.SessionID, r.ExerciseID}
+		i, ok := index[k]
+		if !ok {
+			if _, seen := names[r.ExerciseID]; !seen {
+				order = append(order, r.ExerciseID)
+				names[r.ExerciseID] = r.ExerciseName
+			}
+			history[r.ExerciseID] = append(history[r.ExerciseID], exerciseSession{date: r.Date, startedAt: r.StartedAt})
+			i = len(history[r.ExerciseID]) - 1
+			index[k] = i
+		}
+		s := &history[r.ExerciseID][i]
+		s.volume += r.Volume
+		if e1rm := vos.EstimateOneRepMax(r.MaxWeight, r.Reps); e1rm > s.e1rm {
+			s.e1rm = e1rm
+		}
+		if r.Volume > topVolume[k] {
+			topVolume[k] = r.Volume
+			s.topReps = r.Reps
+		}
+	}
+	for _, sessions := range history {
+		sort.SliceStable(sessions, func(i, j int) bool { return sessions[i].startedAt.Before(sessions[j].startedAt) })
+	}
+	return history, names, order
+}
+
+// analyzeExercise compares the window with the baseline and reports whether it is an insight.
+func analyzeExercise(baseline, window []exerciseSession) (ProgressInsight, bool) {
+	out := ProgressInsight{SessionsAnalyzed: len(window)}
+	for _, s := range baseline {
+		out.BaselineE1RM = max(out.BaselineE1RM, s.e1rm)
+		out.BaselineVolume = max(out.BaselineVolume, s.volume)
+	}
+	for _, s := range window {
+		out.RecentE1RM = max(out.RecentE1RM, s.e1rm)
+		out.RecentVolume = max(out.RecentVolume, s.volume)
+	}
+	out.E1RMChange = percentChange(float64(out.RecentE1RM), float64(out.BaselineE1RM))
+	out.VolumeChange = percentChange(float64(out.RecentVolume), float64(out.BaselineVolume))
+
+	// Só séries acima de 10 repetições: a e1RM não se aplica e o volume decide
+	hasE1RM := out.BaselineE1RM > 0
+	switch {
+	case hasE1RM && out.E1RMChange <= regressionThreshold:
+		out.Kind = InsightKindRegression
+	case !hasE1RM && out.VolumeChange <= 2*regressionThreshold:
+		out.Kind = InsightKindRegression
+	case (!hasE1RM || out.E1RMChange < progressThreshold) && out.VolumeChange < progressThreshold:
+		out.Kind = InsightKindPlateau
+	default:
+		return out, false
+	}
+	return out, true
+}
+
+// stalledSessions counts the sessions since the exercise's last new best e1RM.
+func stalledSessions(sessions []exerciseSession) int {
+	best, since := 0, 0
+	for _, s := range sessions {
+		if s.e1rm > best {
+			best, since = s.e1rm, 0
+			continue
+		}
+		since++
+	}
+	return since
+}
+
+// suggestFor picks the next step: a deload for regressions, an exercise swap for long
+// plateaus (no new best in twice the window) and otherwise a different rep range.
+func suggestFor(insight ProgressInsight, window []exerciseSession) InsightSuggestion {
+	if insight.Kind == InsightKindRegression {
+		return InsightSuggestion{
+			Kind:    SuggestionDeload,
+			Message: fmt.Sprintf("Performance on %s dropped %.0f%%. Take a deload week with about 10%% less load and a third fewer sets, then build back up.", insight.ExerciseName, math.Abs(insight.changeForMessage())),
+		}
+	}
+	if insight.StalledSessions >= 2*len(window) {
+		return InsightSuggestion{
+			Kind:    SuggestionExerciseSwap,
+			Message: fmt.Sprintf("%s has not improved in %d sessions. Swap it for a close variation for the next 4–6 weeks.", insight.ExerciseName, insight.StalledSessions),
+		}
+	}
+	reps := make(map[int]int)
+	typical := 0
+	for _, s := range window {
+		reps[s.topReps]++
+		if reps[s.topReps] > reps[typical] {
+			typical = s.topReps
+		}
+	}
+	target := "3–5"
+	if typical <= 5 {
+		target = "6–10"
+	}
+	return InsightSuggestion{
+		Kind:    SuggestionRepRangeChange,
+		Message: fmt.Sprintf("%s has stalled around %d reps. Train it in the %s rep range for a few weeks.", insight.ExerciseName, typical, target),
+	}
+}
+
+// changeForMessage is the change that characterized the insight: e1RM, or volume when there is no e1RM.
+func (i ProgressInsight) changeForMessage() float64 {
+	if i.BaselineE1RM > 0 {
+		return i.E1RMChange
+	}
+	return i.VolumeChange
+}
+
+// percentChange returns the change from base to v in percent, rounded to one decimal.
+func percentChange(v, base float64) float64 {
+	if base == 0 {
+		return 0
+	}
+	return math.Round((v-base)/base*1000) / 10
+}
@@ -0,0 +1,161 @@
+package statistics
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	domainerrors "github.com/kinetria/kinetria-back/internal/kinetria/domain/errors"
+	"github.com/kinetria/kinetria-back/internal/kinetria/domain/ports"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// --- Mocks for GetProgressInsightsUC ---
+
+type mockSetRecordRepoInsights struct {
+	mockSetRecordRepoPR
+	rows []ports.ExerciseSetSummaryRow
+	err  error
+}
+
+func (m *mockSetRecordRepoInsights) GetExerciseSetSummariesByUser(_ context.Context, _ uuid.UUID, _, _ time.Time, _ *time.Location) ([]ports.ExerciseSetSummaryRow, error) {
+	return m.rows, m.err
+}
+
+// exerciseLog builds one set summary per session, spaced weekly and ending this week.
+type exerciseLog struct {
+	id   uuid.UUID
+	name string
+}
+
+func (e exerciseLog) sessions(reps int, weights ...int) []ports.ExerciseSetSummaryRow {
+	today := time.Now().UTC().Truncate(24 * time.Hour)
+	rows := make([]ports.ExerciseSetSummaryRow, 0, len(weights))
+	for i, w := range weights {
+		day := today.AddDate(0, 0, -7*(len(weights)-1-i))
+		rows = append(rows, ports.ExerciseSetSummaryRow{
+			SessionID:    uuid.New(),
+			Date:         day,
+			StartedAt:    day.Add(18 * time.Hour),
+			ExerciseID:   e.id,
+			ExerciseName: e.name,
+			Reps:         reps,
+			MaxWeight:    w,
+			Volume:       int64(w * reps * 3),
+		})
+	}
+	return rows
+}
+
+// --- Tests ---
+
+func TestGetProgressInsightsUC_Execute(t *testing.T) {
+	userID := uuid.New()
+	squat := exerciseLog{uuid.New(), "Agachamento"}
+	bench := exerciseLog{uuid.New(), "Supino"}
+	row := exerciseLog{uuid.New(), "Remada"}
+
+	t.Run("plateau_and_regression", func(t *testing.T) {
+		var rows []ports.ExerciseSetSummaryRow
+		// Supino parado em 80 kg x 5 nas últimas 4 sessões
+		rows = append(rows, bench.sessions(5, 75000, 80000, 80000, 80000, 80000, 80000)...)
+		// Agachamento caiu de 120 kg para 100 kg
+		rows = append(rows, squat.sessions(5, 110000, 120000, 110000, 105000, 100000, 100000)...)
+		// Remada progredindo
+		rows = append(rows, row.sessions(8, 60000, 62500, 65000, 67500, 70000, 72500)...)
+		uc := NewGetProgressInsightsUC(&mockSetRecordRepoInsights{rows: rows}, utcPrefsRepo())
+
+		insights, err := uc.Execute(context.Background(), GetProgressInsightsInput{UserID: userID})
+		require.NoError(t, err)
+		require.Len(t, insights, 2)
+
+		reg := insights[0]
+		assert.Equal(t, InsightKindRegression, reg.Kind)
+		assert.Equal(t, squat.id, reg.ExerciseID)
+		assert.Equal(t, 4, reg.SessionsAnalyzed)
+		assert.Equal(t, 140000, reg.BaselineE1RM)
+		assert.Equal(t, 128333, reg.RecentE1RM)
+		assert.Equal(t, -8.3, reg.E1RMChange)
+		assert.Equal(t, SuggestionDeload, reg.Suggestion.Kind)
+
+		plateau := insights[1]
+		assert.Equal(t, InsightKindPlateau, plateau.Kind)
+		assert.Equal(t, bench.id, plateau.ExerciseID)
+		assert.Equal(t, 0.0, plateau.E1RMChange)
+		assert.Equal(t, 4, plateau.StalledSessions)
+		assert.Equal(t, SuggestionRepRangeChange, plateau.Suggestion.Kind)
+		assert.Contains(t, plateau.Suggestion.Message, "6–10")
+	})
+
+	t.Run("long_plateau_suggests_swap", func(t *testing.T) {
+		rows := bench.sessions(8, 80000, 80000, 80000, 80000, 80000, 80000, 80000, 80000, 80000)
+		uc := NewGetProgressInsightsUC(&mockSetRecordRepoInsights{rows: rows}, utcPrefsRepo())
+
+		insights, err := uc.Execute(context.Background(), GetProgressInsightsInput{UserID: userID, Sessions: 3})
+		require.NoError(t, err)
+		require.Len(t, insights, 1)
+		assert.Equal(t, 8, insights[0].StalledSessions)
+		assert.Equal(t, SuggestionExerciseSwap, insights[0].Suggestion.Kind)
+	})
+
+	t.Run("weeks_window", func(t *testing.T) {
+		rows := bench.sessions(5, 80000, 80000, 80000, 70000, 70000)
+		uc := NewGetProgressInsightsUC(&mockSetRecordRepoInsights{rows: rows}, utcPrefsRepo())
+
+		insights, err := uc.Execute(context.Background(), GetProgressInsightsInput{UserID: userID, Weeks: 2})
+		require.NoError(t, err)
+		require.Len(t, insights, 1)
+		assert.Equal(t, 2, insights[0].SessionsAnalyzed)
+		assert.Equal(t, InsightKindRegression, insights[0].Kind)
+		assert.Equal(t, -12.5, insights[0].E1RMChange)
+	})
+
+	t.Run("not_enough_history_or_stale", func(t *testing.T) {
+		rows := bench.sessions(5, 80000, 80000, 80000, 80000)
+		stale := squat.sessions(5, 100000, 100000, 100000, 100000, 100000, 100000)
+		for i := range stale {
+			stale[i].Date = stale[i].Date.AddDate(0, 0, -60)
+			stale[i].StartedAt = stale[i].StartedAt.AddDate(0, 0, -60)
+		}
+		uc := NewGetProgressInsightsUC(&mockSetRecordRepoInsights{rows: append(rows, stale...)}, utcPrefsRepo())
+
+		insights, err := uc.Execute(context.Background(), GetProgressInsightsInput{UserID: userID})
+		require.NoError(t, err)
+		assert.Empty(t, insights)
+	})
+
+	t.Run("limit", func(t *testing.T) {
+		var rows []ports.ExerciseSetSummaryRow
+		rows = append(rows, bench.sessions(5, 80000, 80000, 80000, 80000, 80000)...)
+		rows = append(rows, squat.sessions(5, 100000, 100000, 100000, 100000, 100000)...)
+		uc := NewGetProgressInsightsUC(&mockSetRecordRepoInsights{rows: rows}, utcPrefsRepo())
+
+		insights, err := uc.Execute(context.Background(), GetProgressInsightsInput{UserID: userID, Limit: 1})
+		require.NoError(t, err)
+		assert.Len(t, insights, 1)
+	})
+
+	t.Run("invalid_parameters", func(t *testing.T) {
+		uc := NewGetProgressInsightsUC(&mockSetRecordRepoInsights{}, utcPrefsRepo())
+
+		for _, in := range []GetProgressInsightsInput{
+			{UserID: userID, Sessions: 4, Weeks: 4},
+			{UserID: userID, Sessions: 1},
+			{UserID: userID, Weeks: 13},
+			{UserID: userID, Limit: -1},
+		} {
+			_, err := uc.Execute(context.Background(), in)
+			assert.ErrorIs(t, err, domainerrors.ErrMalformedParameters)
+		}
+	})
+
+	t.Run("repository_error", func(t *testing.T) {
+		uc := NewGetProgressInsightsUC(&mockSetRecordRepoInsights{err: errors.New("db down")}, utcPrefsRepo())
+
+		_, err := uc.Execute(context.Background(), GetProgressInsightsInput{UserID: userID})
+		assert.Error(t, err)
+	})
+}
@@ -8,6 +8,7 @@ import (
 	"github.com/google/uuid"
 	domainerrors "github.com/kinetria/kinetria-back/internal/kinetria/domain/errors"
 	"github.com/kinetria/kinetria-back/internal/kinetria/domain/ports"
+	"github.com/kinetria/kinetria-back/internal/kinetria/domain/profile"
 )
 
 // GetProgressionInput holds the input parameters for GetProgressionUC.
@@ -21,21 +22,28 @@ type GetProgressionInput struct {
 // GetProgressionUC retrieves workout progression data for a user.
 type GetProgressionUC struct {
 	setRecordRepo ports.SetRecordRepository
+	userRepo      ports.UserRepository
 }
 
 // NewGetProgressionUC creates a new GetProgressionUC.
-func NewGetProgressionUC(setRecordRepo ports.SetRecordRepository) *GetProgressionUC {
-	return &GetProgressionUC{setRecordRepo: setRecordRepo}
+func NewGetProgressionUC(setRecordRepo ports.SetRecordRepository, userRepo ports.UserRepository) *GetProgressionUC {
+	return &GetProgressionUC{setRecordRepo: setRecordRepo, userRepo: userRepo}
 }
 
 // Execute computes progression data for the given user and period.
 // If StartDate/EndDate are nil, defaults to the last 30 days.
+// Points are grouped by calendar day in the user's timezone.
 func (uc *GetProgressionUC) Execute(ctx context.Context, input GetProgressionInput) (*ProgressionData, error) {
-	now := time.Now().UTC()
+	prefs, err := profile.LoadPreferences(ctx, uc.userRepo, input.UserID)
+	if err != nil {
+		return nil, err
+	}
+	loc := prefs.Location()
+	now := time.Now().In(loc)
 
-	// Apply defaults
+	// Apply defaults: from local midnight 30 days ago until now
 	end := now
-	start := now.AddDate(0, 0, -30)
+	start := startOfDay(now, loc).AddDate(0, 0, -30)
 	if input.EndDate != nil {
 		end = input.EndDate.UTC()
 	}
@@ -51,7 +59,7 @@ func (uc *GetProgressionUC) Execute(ctx context.Context, input GetProgressionInp
 		return nil, domainerrors.ErrPeriodTooLong
 	}
 
-	rawPoints, err := uc.setRecordRepo.GetProgressionByUserAndExercise(ctx, input.UserID, input.ExerciseID, start, end)
+	rawPoints, err := uc.setRecordRepo.GetProgressionByUserAndExercise(ctx, input.UserID, input.ExerciseID, start, end, loc)
 	if err != nil {
 		return nil, fmt.Errorf("get progression: %w", err)
 	}
@@ -8,6 +8,7 @@ import (
 	"github.com/google/uuid"
 	"github.com/kinetria/kinetria-back/internal/kinetria/domain/entities"
 	"github.com/kinetria/kinetria-back/internal/kinetria/domain/ports"
+	"github.com/kinetria/kinetria-back/internal/kinetria/domain/vos"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 )
@@ -17,6 +18,7 @@ import (
 type mockSetRecordRepoProgression struct {
 	progressionResult []ports.ProgressionPoint
 	progressionErr    error
+	gotStart          time.Time
 }
 
 func (m *mockSetRecordRepoProgression) Create(_ context.Context, _ *entities.SetRecord) error {
@@ -31,9 +33,21 @@ func (m *mockSetRecordRepoProgression) GetTotalSetsRepsVolume(_ context.Context,
 func (m *mockSetRecordRepoProgression) GetPersonalRecordsByUser(_ context.Context, _ uuid.UUID) ([]ports.PersonalRecord, error) {
 	return nil, nil
 }
-func (m *mockSetRecordRepoProgression) GetProgressionByUserAndExercise(_ context.Context, _ uuid.UUID, _ *uuid.UUID, _, _ time.Time) ([]ports.ProgressionPoint, error) {
+func (m *mockSetRecordRepoProgression) GetProgressionByUserAndExercise(_ context.Context, _ uuid.UUID, _ *uuid.UUID, start, _ time.Time, _ *time.Location) ([]ports.ProgressionPoint, error) {
+	m.gotStart = start
 	return m.progressionResult, m.progressionErr
 }
+func (m *mockSetRecordRepoProgression) GetMuscleVolumeByUserAndPeriod(_ context.Context, _ uuid.UUID, _, _ time.Time, _ *time.Location, _ vos.WeekStart) ([]ports.MuscleVolumeRow, error) {
+	return nil, nil
+}
+
+func (m *mockSetRecordRepoProgression) GetBigLiftSetsByUser(_ context.Context, _ uuid.UUID, _, _ time.Time, _ *time.Location) ([]ports.BigLiftSetRow, error) {
+	return nil, nil
+}
+
+func (m *mockSetRecordRepoProgression) GetExerciseSetSummariesByUser(_ context.Context, _ uuid.UUID, _, _ time.Time, _ *time.Location) ([]ports.ExerciseSetSummaryRow, error) {
+	return nil, nil
+}
 
 // --- Tests ---
 
@@ -58,7 +72,7 @@ func TestGetProgressionUC_Execute(t *testing.T) {
 			},
 		}
 
-		uc := NewGetProgressionUC(setRepo)
+		uc := NewGetProgressionUC(setRepo, utcPrefsRepo())
 		result, err := uc.Execute(context.Background(), GetProgressionInput{
 			UserID:     userID,
 			ExerciseID: &exerciseID,
@@ -79,7 +93,7 @@ func TestGetProgressionUC_Execute(t *testing.T) {
 	t.Run("invalid period: startDate > endDate returns error", func(t *testing.T) {
 		setRepo := &mockSetRecordRepoProgression{}
 
-		uc := NewGetProgressionUC(setRepo)
+		uc := NewGetProgressionUC(setRepo, utcPrefsRepo())
 		badStart := end
 		badEnd := start
 		_, err := uc.Execute(context.Background(), GetProgressionInput{
@@ -97,7 +111,7 @@ func TestGetProgressionUC_Execute(t *testing.T) {
 			progressionResult: []ports.ProgressionPoint{},
 		}
 
-		uc := NewGetProgressionUC(setRepo)
+		uc := NewGetProgressionUC(setRepo, utcPrefsRepo())
 		result, err := uc.Execute(context.Background(), GetProgressionInput{
 			UserID:    userID,
 			StartDate: &start,
@@ -119,7 +133,7 @@ func TestGetProgressionUC_Execute(t *testing.T) {
 			},
 		}
 
-		uc := NewGetProgressionUC(setRepo)
+		uc := NewGetProgressionUC(setRepo, utcPrefsRepo())
 		result, err := uc.Execute(context.Background(), GetProgressionInput{
 			UserID:    userID,
 			StartDate: &start,
@@ -132,4 +146,17 @@ func TestGetProgressionUC_Execute(t *testing.T) {
 		// (100000 - 80000) / 80000 * 100 = 25%
 		assert.InDelta(t, 25.0, result.Points[1].Change, 0.001)
 	})
+	t.Run("default period: starts at local midnight 30 days ago in the user's timezone", func(t *testing.T) {
+		setRepo := &mockSetRecordRepoProgression{}
+		uc := NewGetProgressionUC(setRepo, newPrefsRepo("Asia/Tokyo", vos.WeekStartMonday))
+
+		_, err := uc.Execute(context.Background(), GetProgressionInput{UserID: userID})
+		require.NoError(t, err)
+
+		tokyo, err := time.LoadLocation("Asia/Tokyo")
+		require.NoError(t, err)
+		today := time.Now().In(tokyo)
+		want := time.Date(today.Year(), today.Month(), today.Day()-30, 0, 0, 0, 0, tokyo)
+		assert.True(t, want.Equal(setRepo.gotStart), "got start %v, want %v", setRepo.gotStart, want)
+	})
 }
@@ -0,0 +1,235 @@
+package statistics
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"sort"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/kinetria/kinetria-back/internal/kinetria/domain/ports"
+	"github.com/kinetria/kinetria-back/internal/kinetria/domain/profile"
+	"github.com/kinetria/kinetria-back/internal/kinetria/domain/vos"
+)
+
+// recapTopExercises is the number of exercises listed in a recap.
+const recapTopExercises = 5
+
+// GetRecapInput holds the input parameters for GetRecapUC.
+type GetRecapInput struct {
+	UserID uuid.UUID
+	Period vos.RecapPeriod // vazio: month
+	Date   *time.Time      // um dia do período; nil: hoje
+}
+
+// GetRecapUC summarizes a user's training in a calendar month or year.
+type GetRecapUC struct {
+	effortRepo    ports.SessionEffortRepository
+	setRecordRepo ports.SetRecordRepository
+	userRepo      ports.UserRepository
+}
+
+// NewGetRecapUC creates a new GetRecapUC.
+func NewGetRecapUC(effortRepo ports.SessionEffortRepository, setRecordRepo ports.SetRecordRepository, userRepo ports.UserRepository) *GetRecapUC {
+	return &GetRecapUC{effortRepo: effortRepo, setRecordRepo: setRecordRepo, userRepo: userRepo}
+}
+
+// Execute returns the recap of the month or year containing Date, in the user's timezone:
+// totals, longest streak, favourite workout, top exercises by volume, the heaviest-weight
+// PRs set and when in the day the sessions started.
+func (uc *GetRecapUC) Execute(ctx context.Context, input GetRecapInput) (*RecapData, error) {
+	period := input.Period
+	if period == "" {
+		period = vos.RecapPeriodMonth
+	}
+	if err := period.Validate(); err != nil {
+		return nil, err
+	}
+
+	prefs, err := profile.LoadPreferences(ctx, uc.userRepo, input.UserID)
+	if err != nil {
+		return nil, err
+	}
+	loc := prefs.Location()
+
+	day := calendarDay(time.Now(), loc)
+	if input.Date != nil {
+		day = calendarDay(input.Date.UTC(), time.UTC)
+	}
+	first, last := period.Bounds(day)
+	start := time.Date(first.Year(), first.Month(), first.Day(), 0, 0, 0, 0, loc)
+	end := time.Date(last.Year(), last.Month(), last.Day(), 0, 0, 0, 0, loc).AddDate(0, 0, 1)
+
+	sessions, err := uc.effortRepo.ListSessionLoads(ctx, input.UserID, start, end, loc)
+	if err != nil {
+		return nil, fmt.Errorf("list session loads: %w", err)
+	}
+	// Os recordes comparam com todo o histórico anterior ao período
+	rows, err := uc.setRecordRepo.GetExerciseSetSummariesByUser(ctx, input.UserID, time.Unix(0, 0), end, loc)
+	if err != nil {
+		return nil, fmt.Errorf("get exercise set summaries: %w", err)
+	}
+
+	data := &RecapData{
+		Period:          period,
+		StartDate:       first,
+		EndDate:         last,
+		TopExercises:    []RecapExercise{},
+		PersonalRecords: []RecapRecord{},
+	}
+	summarizeRecapSessions(data, sessions, loc)
+	data.TopExercises, data.PersonalRecords = recapExercises(rows, first, last)
+	return data, nil
+}
+
+// summarizeRecapSessions fills the session totals, streak, favourite workout and time of day.
+func summarizeRecapSessions(data *RecapData, sessions []ports.SessionLoad, loc *time.Location) {
+	days := make(map[time.Time]bool)
+	workouts := make(map[uuid.UUID]*RecapWorkout)
+	var workoutOrder []uuid.UUID
+	byTime := make(map[TimeOfDay]int)
+
+	for _, s := range sessions {
+		data.TotalSessions++
+		data.TotalDurationMinutes += max(s.DurationMinutes, 0)
+		data.TotalTonnage += s.Tonnage
+		days[s.Date] = true
+		byTime[timeOfDay(s.StartedAt.In(loc))]++
+
+		w := workouts[s.WorkoutID]
+		if w == nil {
+			w = &RecapWorkout{WorkoutID: s.WorkoutID, Name: s.WorkoutName}
+			workouts[s.WorkoutID] = w
+			workoutOrder = append(workoutOrder, s.WorkoutID)
+		}
+		w.Sessions++
+	}
+
+	data.ActiveDays = len(days)
+	data.LongestStreak = longestDayStreak(days)
+
+	// Empate: o treino feito primeiro no período
+	for _, id := range workoutOrder {
+		if data.FavoriteWorkout == nil || workouts[id].Sessions > data.FavoriteWorkout.Sessions {
+			data.FavoriteWorkout = workouts[id]
+		}
+	}
+
+	data.TimeOfDay = make([]TimeOfDayShare, 0, 4)
+	for _, t := range []TimeOfDay{TimeOfDayMorning, TimeOfDayAfternoon, TimeOfDayEvening, TimeOfDayNight} {
+		share := TimeOfDayShare{TimeOfDay: t, Sessions: byTime[t]}
+		if data.TotalSessions > 0 {
+			share.Percent = math.Round(float64(byTime[t])/float64(data.TotalSessions)*1000) / 10
+		}
+		data.TimeOfDay = append(data.TimeOfDay, share)
+	}
+}
+
+// recapExercises returns the top exercises by volume in the period from first to last and
+// the PRs set in it. rows cover the whole history up to the end of the period.
+func recapExercises(rows []ports.ExerciseSetSummaryRow, first, last time.Time) ([]RecapExercise, []RecapRecord) {
+	type sessionBest struct {
+		exerciseID uuid.UUID
+		name       string
+		date       time.Time
+		startedAt  time.Time
+		weight     int
+		reps       int
+		volume     int64
+	}
+	type key struct{ session, exercise uuid.UUID }
+	index := make(map[key]int)
+	var performed []sessionBest
+	for _, r := range rows {
+		// A consulta inclui o instante end, a meia-noite seguinte ao período
+		if r.Date.After(last) {
+			continue
+		}
+		k := key{r.SessionID, r.ExerciseID}
+		i, ok := index[k]
+		if !ok {
+			performed = append(performed, sessionBest{exerciseID: r.ExerciseID, name: r.ExerciseName, date: r.Date, startedAt: r.StartedAt})
+			i = len(performed) - 1
+			index[k] = i
+		}
+		p := &performed[i]
+		p.volume += r.Volume
+		if r.MaxWeight > p.weight || (r.MaxWeight == p.weight && r.Reps > p.reps) {
+			p.weight, p.reps = r.MaxWeight, r.Reps
+		}
+	}
+	sort.SliceStable(performed, func(i, j int) bool { return performed[i].startedAt.Before(performed[j].startedAt) })
+
+	top := make(map[uuid.UUID]*RecapExercise)
+	best := make(map[uuid.UUID]int)
+	records := []RecapRecord{}
+	for _, p := range performed {
+		inPeriod := !p.date.Before(first)
+		if inPeriod {
+			e := top[p.exerciseID]
+			if e == nil {
+				e = &RecapExercise{ExerciseID: p.exerciseID, ExerciseName: p.name}
+				top[p.exerciseID] = e
+			}
+			e.Sessions++
+			e.Volume += p.volume
+		}
+		// A primeira vez que o exercício é feito não conta como recorde
+		if previous, seen := best[p.exerciseID]; seen && p.weight > previous && inPeriod {
+			records = append(records, RecapRecord{
+				ExerciseID:     p.exerciseID,
+				ExerciseName:   p.name,
+				Weight:         p.weight,
+				Reps:           p.reps,
+				PreviousWeight: previous,
+				AchievedAt:     p.startedAt,
+			})
+		}
+		best[p.exerciseID] = max(best[p.exerciseID], p.weight)
+	}
+
+	exercises := make([]RecapExercise, 0, len(top))
+	for _, e := range top {
+		exercises = append(exercises, *e)
+	}
+	sort.Slice(exercises, func(i, j int) bool {
+		if exercises[i].Volume != exercises[j].Volume {
+			return exercises[i].Volume > exercises[j].Volume
+		}
+		return exercises[i].ExerciseName < exercises[j].ExerciseName
+	})
+	if len(exercises) > recapTopExercises {
+		exercises = exercises[:recapTopExercises]
+	}
+	return exercises, records
+}
+
+// longestDayStreak returns the longest run of consecutive days in days.
+func longestDayStreak(days map[time.Time]bool) int {
+	longest := 0
+	for d := range days {
+		if days[d.AddDate(0, 0, -1)] {
+			continue // não é o início de uma sequência
+		}
+		n := 1
+		for days[d.AddDate(0, 0, n)] {
+			n++
+		}
+		longest = max(longest, n)
+	}
+	return longest
+}
+
+func timeOfDay(t time.Time) TimeOfDay {
+	switch h := t.Hour(); {
+	case h >= 5 && h < 12:
+		return TimeOfDayMorning
+	case h >= 12 && h < 17:
+		return TimeOfDayAfternoon
+	case h >= 17 && h < 21:
+		return TimeOfDayEvening
+	default:
+		return TimeOfDayNight
+	}
+}
@@ -0,0 +1,116 @@
+package statistics
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	domainerrors "github.com/kinetria/kinetria-back/internal/kinetria/domain/errors"
+	"github.com/kinetria/kinetria-back/internal/kinetria/domain/ports"
+	"github.com/kinetria/kinetria-back/internal/kinetria/domain/vos"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestGetRecapUC_Execute(t *testing.T) {
+	userID := uuid.New()
+	at := func(m time.Month, d, h int) time.Time { return time.Date(2026, m, d, h, 0, 0, 0, time.UTC) }
+	date := func(t time.Time) time.Time { return time.Date(t.Year(), t.Month(), t.Day(), 0, 0, 0, 0, time.UTC) }
+
+	push, pull := uuid.New(), uuid.New()
+	load := func(start time.Time, workout uuid.UUID, name string) ports.SessionLoad {
+		return ports.SessionLoad{SessionID: uuid.New(), StartedAt: start, Date: date(start), WorkoutID: workout, WorkoutName: name, DurationMinutes: 60, Tonnage: 5000000}
+	}
+	loads := []ports.SessionLoad{
+		load(at(3, 2, 7), push, "Push"),
+		load(at(3, 3, 18), pull, "Pull"),
+		load(at(3, 4, 18), push, "Push"),
+		load(at(3, 10, 13), push, "Push"),
+		load(at(3, 11, 22), pull, "Pull"),
+	}
+
+	bench, row, squat := uuid.New(), uuid.New(), uuid.New()
+	summary := func(start time.Time, exercise uuid.UUID, name string, reps, weight int) ports.ExerciseSetSummaryRow {
+		return ports.ExerciseSetSummaryRow{
+			SessionID: uuid.NewSHA1(uuid.Nil, []byte(start.String())), Date: date(start), StartedAt: start,
+			ExerciseID: exercise, ExerciseName: name, Reps: reps, MaxWeight: weight, Volume: int64(weight * reps * 3),
+		}
+	}
+	rows := []ports.ExerciseSetSummaryRow{
+		// Histórico anterior ao período
+		summary(at(2, 20, 7), bench, "Supino", 5, 80000),
+		summary(at(2, 20, 7), squat, "Agachamento", 5, 100000),
+		// Março
+		summary(at(3, 2, 7), bench, "Supino", 5, 80000),
+		summary(at(3, 2, 7), bench, "Supino", 3, 85000),  // recorde
+		summary(at(3, 3, 18), row, "Remada", 8, 60000),   // primeira vez: não é recorde
+		summary(at(3, 4, 18), bench, "Supino", 2, 90000), // recorde
+		summary(at(3, 10, 13), squat, "Agachamento", 5, 95000),
+	}
+
+	t.Run("month_recap", func(t *testing.T) {
+		uc := NewGetRecapUC(&mockEffortRepoLoad{loads: loads}, &mockSetRecordRepoInsights{rows: rows}, utcPrefsRepo())
+		day := at(3, 15, 0)
+
+		data, err := uc.Execute(context.Background(), GetRecapInput{UserID: userID, Date: &day})
+		require.NoError(t, err)
+		assert.Equal(t, vos.RecapPeriodMonth, data.Period)
+		assert.Equal(t, date(at(3, 1, 0)), data.StartDate)
+		assert.Equal(t, date(at(3, 31, 0)), data.EndDate)
+
+		assert.Equal(t, 5, data.TotalSessions)
+		assert.Equal(t, 300, data.TotalDurationMinutes)
+		assert.Equal(t, int64(25000000), data.TotalTonnage)
+		assert.Equal(t, 5, data.ActiveDays)
+		assert.Equal(t, 3, data.LongestStreak)
+
+		require.NotNil(t, data.FavoriteWorkout)
+		assert.Equal(t, push, data.FavoriteWorkout.WorkoutID)
+		assert.Equal(t, 3, data.FavoriteWorkout.Sessions)
+
+		require.Len(t, data.TopExercises, 3)
+		assert.Equal(t, bench, data.TopExercises[0].ExerciseID)
+		assert.Equal(t, 2, data.TopExercises[0].Sessions)
+
+		require.Len(t, data.PersonalRecords, 2)
+		assert.Equal(t, 85000, data.PersonalRecords[0].Weight)
+		assert.Equal(t, 80000, data.PersonalRecords[0].PreviousWeight)
+		assert.Equal(t, 90000, data.PersonalRecords[1].Weight)
+		assert.Equal(t, 2, data.PersonalRecords[1].Reps)
+
+		require.Len(t, data.TimeOfDay, 4)
+		assert.Equal(t, TimeOfDayShare{TimeOfDay: TimeOfDayMorning, Sessions: 1, Percent: 20}, data.TimeOfDay[0])
+		assert.Equal(t, TimeOfDayShare{TimeOfDay: TimeOfDayEvening, Sessions: 2, Percent: 40}, data.TimeOfDay[2])
+		assert.Equal(t, TimeOfDayShare{TimeOfDay: TimeOfDayNight, Sessions: 1, Percent: 20}, data.TimeOfDay[3])
+	})
+
+	t.Run("year_without_sessions", func(t *testing.T) {
+		uc := NewGetRecapUC(&mockEffortRepoLoad{}, &mockSetRecordRepoInsights{}, utcPrefsRepo())
+		day := at(6, 1, 0)
+
+		data, err := uc.Execute(context.Background(), GetRecapInput{UserID: userID, Period: vos.RecapPeriodYear, Date: &day})
+		require.NoError(t, err)
+		assert.Equal(t, date(at(1, 1, 0)), data.StartDate)
+		assert.Equal(t, date(at(12, 31, 0)), data.EndDate)
+		assert.Nil(t, data.FavoriteWorkout)
+		assert.Empty(t, data.TopExercises)
+		assert.Empty(t, data.PersonalRecords)
+		assert.Zero(t, data.LongestStreak)
+	})
+
+	t.Run("invalid_period", func(t *testing.T) {
+		uc := NewGetRecapUC(&mockEffortRepoLoad{}, &mockSetRecordRepoInsights{}, utcPrefsRepo())
+
+		_, err := uc.Execute(context.Background(), GetRecapInput{UserID: userID, Period: "week"})
+		assert.ErrorIs(t, err, domainerrors.ErrMalformedParameters)
+	})
+
+	t.Run("repository_error", func(t *testing.T) {
+		uc := NewGetRecapUC(&mockEffortRepoLoad{}, &mockSetRecordRepoInsights{err: errors.New("db down")}, utcPrefsRepo())
+
+		_, err := uc.Execute(context.Background(), GetRecapInput{UserID: userID})
+		assert.Error(t, err)
+	})
+}
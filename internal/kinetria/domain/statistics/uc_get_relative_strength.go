@@ -0,0 +1,205 @@
+package statistics
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"sort"
+	"time"
+
+	"github.com/google/uuid"
+	domainerrors "github.com/kinetria/kinetria-back/internal/kinetria/domain/errors"
+	"github.com/kinetria/kinetria-back/internal/kinetria/domain/ports"
+	"github.com/kinetria/kinetria-back/internal/kinetria/domain/profile"
+	"github.com/kinetria/kinetria-back/internal/kinetria/domain/vos"
+)
+
+const (
+	// defaultRelativeStrengthDays is the period returned when no start date is given.
+	defaultRelativeStrengthDays = 90
+	// strengthWindowDays is how far back a set still counts towards a lift's best e1RM.
+	strengthWindowDays = 180
+)
+
+// GetRelativeStrengthInput holds the input parameters for GetRelativeStrengthUC.
+type GetRelativeStrengthInput struct {
+	UserID    uuid.UUID
+	StartDate *time.Time
+	EndDate   *time.Time
+}
+
+// GetRelativeStrengthUC computes DOTS, Wilks and IPF GL points and body-weight multiples
+// from the user's squat, bench and deadlift estimated maxes.
+type GetRelativeStrengthUC struct {
+	setRecordRepo  ports.SetRecordRepository
+	bodyWeightRepo ports.BodyWeightRepository
+	userRepo       ports.UserRepository
+}
+
+// NewGetRelativeStrengthUC creates a new GetRelativeStrengthUC.
+func NewGetRelativeStrengthUC(setRecordRepo ports.SetRecordRepository, bodyWeightRepo ports.BodyWeightRepository, userRepo ports.UserRepository) *GetRelativeStrengthUC {
+	return &GetRelativeStrengthUC{setRecordRepo: setRecordRepo, bodyWeightRepo: bodyWeightRepo, userRepo: userRepo}
+}
+
+// Execute computes the relative strength series for the given user and period.
+// If StartDate/EndDate are nil, defaults to the last 90 days.
+//
+// There is a point for every day (in the user's timezone) with a big-lift set or a
+// weigh-in, once some lift has an estimate. Each lift's estimate is the best Epley e1RM
+// over the strengthWindowDays ending on that day; the body weight is the latest weigh-in
+// up to that day. The scores need the three lifts, a body weight and the user's sex.
+func (uc *GetRelativeStrengthUC) Execute(ctx context.Context, input GetRelativeStrengthInput) (*RelativeStrengthData, error) {
+	prefs, err := profile.LoadPreferences(ctx, uc.userRepo, input.UserID)
+	if err != nil {
+		return nil, err
+	}
+	loc := prefs.Location()
+	now := time.Now().In(loc)
+
+	// Apply defaults; end is exclusive: midnight after the last day
+	end := time.Date(now.Year(), now.Month(), now.Day()+1, 0, 0, 0, 0, loc)
+	start := startOfDay(now, loc).AddDate(0, 0, -(defaultRelativeStrengthDays - 1))
+	if input.EndDate != nil {
+		end = dayAfter(*input.EndDate, loc)
+	}
+	if input.StartDate != nil {
+		d := input.StartDate.UTC()
+		start = time.Date(d.Year(), d.Month(), d.Day(), 0, 0, 0, 0, loc)
+	}
+	lastDayStart := end.AddDate(0, 0, -1)
+
+	// Validate period
+	if start.After(lastDayStart) {
+		return nil, domainerrors.ErrInvalidPeriod
+	}
+	if lastDayStart.Sub(start).Hours()/24 > maxPeriodDays {
+		return nil, domainerrors.ErrPeriodTooLong
+	}
+
+	sets, err := uc.setRecordRepo.GetBigLiftSetsByUser(ctx, input.UserID, start.AddDate(0, 0, -(strengthWindowDays-1)), end, loc)
+	if err != nil {
+		return nil, fmt.Errorf("get big lift sets: %w", err)
+	}
+	weighIns, err := uc.bodyWeightRepo.ListBodyWeights(ctx, input.UserID, start, end)
+	if err != nil {
+		return nil, fmt.Errorf("list body weights: %w", err)
+	}
+
+	firstDay, lastDay := calendarDay(start, loc), calendarDay(lastDayStart, loc)
+
+	// Dias com série ou pesagem dentro do período
+	daySet := make(map[time.Time]struct{})
+	for _, s := range sets {
+		if !s.Date.Before(firstDay) && !s.Date.After(lastDay) {
+			daySet[s.Date] = struct{}{}
+		}
+	}
+	weights := make([]ports.BodyWeightEntry, 0, len(weighIns))
+	for _, w := range weighIns {
+		day := calendarDay(w.MeasuredAt, loc)
+		w.MeasuredAt = day
+		weights = append(weights, w)
+		if !day.Before(firstDay) && !day.After(lastDay) {
+			daySet[day] = struct{}{}
+		}
+	}
+	days := make([]time.Time, 0, len(daySet))
+	for d := range daySet {
+		days = append(days, d)
+	}
+	sort.Slice(days, func(i, j int) bool { return days[i].Before(days[j]) })
+
+	points := make([]RelativeStrengthPoint, 0, len(days))
+	for _, day := range days {
+		p := relativeStrengthPoint(day, sets, weights, prefs.Sex)
+		if len(p.Lifts) == 0 {
+			continue
+		}
+		points = append(points, p)
+	}
+
+	return &RelativeStrengthData{
+		StartDate:  firstDay,
+		EndDate:    lastDay,
+		WindowDays: strengthWindowDays,
+		Sex:        prefs.Sex,
+		Points:     points,
+	}, nil
+}
+
+// relativeStrengthPoint builds the point of day from the sets within the window ending on
+// day and the weigh-ins (already converted to calendar days, oldest first).
+func relativeStrengthPoint(day time.Time, sets []ports.BigLiftSetRow, weights []ports.BodyWeightEntry, sex vos.Sex) RelativeStrengthPoint {
+	p := RelativeStrengthPoint{Date: day}
+
+	for _, w := range weights {
+		if w.MeasuredAt.After(day) {
+			break
+		}
+		grams := w.WeightGrams
+		p.BodyWeight = &grams
+	}
+
+	windowStart := day.AddDate(0, 0, -(strengthWindowDays - 1))
+	best := make(map[vos.BigLift]int, 3)
+	for _, s := range sets {
+		if s.Date.Before(windowStart) || s.Date.After(day) {
+			continue
+		}
+		if e1rm := vos.EstimateOneRepMax(s.Weight, s.Reps); e1rm > best[s.Lift] {
+			best[s.Lift] = e1rm
+		}
+	}
+
+	total := 0
+	for _, lift := range vos.AllBigLifts() {
+		e1rm, ok := best[lift]
+		if !ok {
+			continue
+		}
+		total += e1rm
+		p.Lifts = append(p.Lifts, LiftStrength{
+			Lift:            lift,
+			EstimatedMax:    e1rm,
+			BodyWeightRatio: bodyWeightRatio(e1rm, p.BodyWeight),
+		})
+	}
+	if len(p.Lifts) < len(vos.AllBigLifts()) {
+		return p
+	}
+
+	p.Total = &total
+	p.TotalMultiple = bodyWeightRatio(total, p.BodyWeight)
+	if p.BodyWeight != nil && sex != "" {
+		totalKg, bwKg := float64(total)/1000, float64(*p.BodyWeight)/1000
+		p.DOTS = roundedScore(vos.DOTSScore(sex, totalKg, bwKg))
+		p.Wilks = roundedScore(vos.WilksScore(sex, totalKg, bwKg))
+		p.IPFGL = roundedScore(vos.IPFGLScore(sex, totalKg, bwKg))
+	}
+	return p
+}
+
+// bodyWeightRatio returns grams / body weight rounded to 2 decimals, or nil if the weight is unknown.
+func bodyWeightRatio(grams int, bodyWeight *int) *float64 {
+	if bodyWeight == nil || *bodyWeight <= 0 {
+		return nil
+	}
+	r := math.Round(float64(grams)/float64(*bodyWeight)*100) / 100
+	return &r
+}
+
+func roundedScore(score float64) *float64 {
+	s := math.Round(score*100) / 100
+	return &s
+}
+
+// calendarDay returns t's calendar day in loc as midnight UTC, the form the repositories use for dates.
+func calendarDay(t time.Time, loc *time.Location) time.Time {
+	l := t.In(loc)
+	return time.Date(l.Year(), l.Month(), l.Day(), 0, 0, 0, 0, time.UTC)
+}
+
+func startOfDay(t time.Time, loc *time.Location) time.Time {
+	l := t.In(loc)
+	return time.Date(l.Year(), l.Month(), l.Day(), 0, 0, 0, 0, loc)
+}
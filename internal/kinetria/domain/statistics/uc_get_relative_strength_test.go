@@ -0,0 +1,156 @@
+package statistics
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	domainerrors "github.com/kinetria/kinetria-back/internal/kinetria/domain/errors"
+	"github.com/kinetria/kinetria-back/internal/kinetria/domain/ports"
+	"github.com/kinetria/kinetria-back/internal/kinetria/domain/vos"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// --- Mocks for GetRelativeStrengthUC ---
+
+type mockSetRecordRepoStrength struct {
+	mockSetRecordRepoPR
+	sets     []ports.BigLiftSetRow
+	err      error
+	gotStart time.Time
+	gotEnd   time.Time
+}
+
+func (m *mockSetRecordRepoStrength) GetBigLiftSetsByUser(_ context.Context, _ uuid.UUID, start, end time.Time, _ *time.Location) ([]ports.BigLiftSetRow, error) {
+	m.gotStart, m.gotEnd = start, end
+	return m.sets, m.err
+}
+
+type mockBodyWeightRepoStrength struct {
+	weights []ports.BodyWeightEntry
+}
+
+func (m *mockBodyWeightRepoStrength) GetLatestBodyWeight(_ context.Context, _ uuid.UUID) (*int, error) {
+	return nil, nil
+}
+func (m *mockBodyWeightRepoStrength) ListBodyWeights(_ context.Context, _ uuid.UUID, _, _ time.Time) ([]ports.BodyWeightEntry, error) {
+	return m.weights, nil
+}
+
+func newStrengthPrefsRepo(sex vos.Sex) *mockUserRepoPrefs {
+	repo := newPrefsRepo("UTC", vos.WeekStartMonday)
+	repo.user.Preferences.Sex = sex
+	return repo
+}
+
+// --- Tests ---
+
+func TestGetRelativeStrengthUC_Execute(t *testing.T) {
+	userID := uuid.New()
+	day := func(m time.Month, d int) time.Time { return time.Date(2026, m, d, 0, 0, 0, 0, time.UTC) }
+	ptr := func(t time.Time) *time.Time { return &t }
+	input := GetRelativeStrengthInput{UserID: userID, StartDate: ptr(day(3, 1)), EndDate: ptr(day(3, 31))}
+
+	sets := []ports.BigLiftSetRow{
+		// Antes do período, mas dentro da janela de 180 dias
+		{Date: day(1, 10), Lift: vos.BigLiftDeadlift, Reps: 1, Weight: 220000},
+		{Date: day(3, 2), Lift: vos.BigLiftSquat, Reps: 5, Weight: 150000},
+		{Date: day(3, 9), Lift: vos.BigLiftBench, Reps: 1, Weight: 130000},
+		{Date: day(3, 16), Lift: vos.BigLiftSquat, Reps: 3, Weight: 160000},
+	}
+	weights := []ports.BodyWeightEntry{
+		{MeasuredAt: day(2, 20).Add(8 * time.Hour), WeightGrams: 100000},
+		{MeasuredAt: day(3, 20).Add(8 * time.Hour), WeightGrams: 98000},
+	}
+
+	t.Run("scores_over_time", func(t *testing.T) {
+		setRepo := &mockSetRecordRepoStrength{sets: sets}
+		uc := NewGetRelativeStrengthUC(setRepo, &mockBodyWeightRepoStrength{weights: weights}, newStrengthPrefsRepo(vos.SexMale))
+
+		data, err := uc.Execute(context.Background(), input)
+		require.NoError(t, err)
+		assert.Equal(t, day(3, 1).AddDate(0, 0, -179), setRepo.gotStart)
+		// O último dia entra inteiro: o fim é a meia-noite seguinte, exclusiva
+		assert.Equal(t, day(4, 1), setRepo.gotEnd)
+		assert.Equal(t, day(3, 31), data.EndDate)
+		assert.Equal(t, vos.SexMale, data.Sex)
+
+		// Dias 2, 9 e 16 (séries) e 20 (pesagem)
+		require.Len(t, data.Points, 4)
+
+		first := data.Points[0]
+		assert.Equal(t, day(3, 2), first.Date)
+		require.Len(t, first.Lifts, 2)
+		assert.Equal(t, vos.BigLiftSquat, first.Lifts[0].Lift)
+		assert.Equal(t, 175000, first.Lifts[0].EstimatedMax)
+		assert.Equal(t, 1.75, *first.Lifts[0].BodyWeightRatio)
+		assert.Nil(t, first.Total)
+		assert.Nil(t, first.DOTS)
+
+		// A partir do supino os três levantamentos existem
+		bench := data.Points[1]
+		require.NotNil(t, bench.Total)
+		assert.Equal(t, 175000+130000+220000, *bench.Total)
+		assert.Equal(t, 5.25, *bench.TotalMultiple)
+		require.NotNil(t, bench.DOTS)
+		assert.InDelta(t, vos.DOTSScore(vos.SexMale, 525, 100), *bench.DOTS, 0.01)
+		assert.InDelta(t, vos.WilksScore(vos.SexMale, 525, 100), *bench.Wilks, 0.01)
+		assert.InDelta(t, vos.IPFGLScore(vos.SexMale, 525, 100), *bench.IPFGL, 0.01)
+
+		// 160 kg x 3 = 176 kg supera 150 kg x 5
+		assert.Equal(t, 176000, data.Points[2].Lifts[0].EstimatedMax)
+
+		last := data.Points[3]
+		assert.Equal(t, day(3, 20), last.Date)
+		assert.Equal(t, 98000, *last.BodyWeight)
+		assert.Equal(t, 176000+130000+220000, *last.Total)
+	})
+
+	t.Run("lifts_leave_the_window", func(t *testing.T) {
+		old := []ports.BigLiftSetRow{
+			{Date: day(1, 1).AddDate(0, 0, -200), Lift: vos.BigLiftBench, Reps: 1, Weight: 140000},
+			{Date: day(3, 2), Lift: vos.BigLiftSquat, Reps: 1, Weight: 150000},
+		}
+		uc := NewGetRelativeStrengthUC(&mockSetRecordRepoStrength{sets: old}, &mockBodyWeightRepoStrength{}, newStrengthPrefsRepo(vos.SexMale))
+
+		data, err := uc.Execute(context.Background(), input)
+		require.NoError(t, err)
+		require.Len(t, data.Points, 1)
+		require.Len(t, data.Points[0].Lifts, 1)
+		assert.Equal(t, vos.BigLiftSquat, data.Points[0].Lifts[0].Lift)
+		assert.Nil(t, data.Points[0].BodyWeight)
+		assert.Nil(t, data.Points[0].Lifts[0].BodyWeightRatio)
+	})
+
+	t.Run("no_scores_without_sex", func(t *testing.T) {
+		uc := NewGetRelativeStrengthUC(&mockSetRecordRepoStrength{sets: sets}, &mockBodyWeightRepoStrength{weights: weights}, newStrengthPrefsRepo(""))
+
+		data, err := uc.Execute(context.Background(), input)
+		require.NoError(t, err)
+		last := data.Points[len(data.Points)-1]
+		require.NotNil(t, last.TotalMultiple)
+		assert.Nil(t, last.DOTS)
+		assert.Nil(t, last.Wilks)
+		assert.Nil(t, last.IPFGL)
+	})
+
+	t.Run("invalid_period", func(t *testing.T) {
+		uc := NewGetRelativeStrengthUC(&mockSetRecordRepoStrength{}, &mockBodyWeightRepoStrength{}, newStrengthPrefsRepo(vos.SexMale))
+
+		_, err := uc.Execute(context.Background(), GetRelativeStrengthInput{UserID: userID, StartDate: ptr(day(3, 31)), EndDate: ptr(day(3, 1))})
+		assert.ErrorIs(t, err, domainerrors.ErrInvalidPeriod)
+
+		_, err = uc.Execute(context.Background(), GetRelativeStrengthInput{UserID: userID, StartDate: ptr(day(3, 1).AddDate(-3, 0, 0)), EndDate: ptr(day(3, 1))})
+		assert.ErrorIs(t, err, domainerrors.ErrPeriodTooLong)
+	})
+
+	t.Run("repository_error", func(t *testing.T) {
+		uc := NewGetRelativeStrengthUC(&mockSetRecordRepoStrength{err: errors.New("db down")}, &mockBodyWeightRepoStrength{}, newStrengthPrefsRepo(vos.SexMale))
+
+		_, err := uc.Execute(context.Background(), input)
+		assert.Error(t, err)
+	})
+}
@@ -0,0 +1,226 @@
+package statistics
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"time"
+
+	"github.com/google/uuid"
+	domainerrors "github.com/kinetria/kinetria-back/internal/kinetria/domain/errors"
+	"github.com/kinetria/kinetria-back/internal/kinetria/domain/ports"
+	"github.com/kinetria/kinetria-back/internal/kinetria/domain/profile"
+	"github.com/kinetria/kinetria-back/internal/kinetria/domain/vos"
+)
+
+const (
+	acuteLoadDays   = 7
+	chronicLoadDays = 28
+
+	// Faixa segura da razão aguda:crônica e limites dos alertas
+	acwrSafeMin     = 0.8
+	acwrSafeMax     = 1.3
+	acwrSpike       = 1.5
+	monotonyWarning = 2.0
+)
+
+// GetTrainingLoadInput holds the input parameters for GetTrainingLoadUC.
+type GetTrainingLoadInput struct {
+	UserID    uuid.UUID
+	StartDate *time.Time
+	EndDate   *time.Time
+	Method    vos.LoadMethod // vazio: srpe
+}
+
+// GetTrainingLoadUC computes the acute and chronic training loads, the acute:chronic
+// workload ratio (ACWR), monotony and strain for a user.
+type GetTrainingLoadUC struct {
+	effortRepo ports.SessionEffortRepository
+	userRepo   ports.UserRepository
+}
+
+// NewGetTrainingLoadUC creates a new GetTrainingLoadUC.
+func NewGetTrainingLoadUC(effortRepo ports.SessionEffortRepository, userRepo ports.UserRepository) *GetTrainingLoadUC {
+	return &GetTrainingLoadUC{effortRepo: effortRepo, userRepo: userRepo}
+}
+
+// Execute computes the daily load model for the given user and period.
+// If StartDate/EndDate are nil, defaults to the last 28 days.
+//
+// A session's load is duration (minutes) × session RPE, or its tonnage. For each day
+// (in the user's timezone) the acute load is the sum of the last 7 days, the chronic
+// load the weekly average of the last 28 days, and monotony the mean over the standard
+// deviation of the last 7 daily loads; strain is acute load × monotony. Warnings refer
+// to the last day of the period.
+func (uc *GetTrainingLoadUC) Execute(ctx context.Context, input GetTrainingLoadInput) (*TrainingLoadData, error) {
+	method := input.Method
+	if method == "" {
+		method = vos.LoadMethodSRPE
+	}
+	if err := method.Validate(); err != nil {
+		return nil, err
+	}
+
+	prefs, err := profile.LoadPreferences(ctx, uc.userRepo, input.UserID)
+	if err != nil {
+		return nil, err
+	}
+	loc := prefs.Location()
+	now := time.Now().In(loc)
+
+	// Apply defaults; end is exclusive: midnight after the last day
+	end := time.Date(now.Year(), now.Month(), now.Day()+1, 0, 0, 0, 0, loc)
+	start := startOfDay(now, loc).AddDate(0, 0, -(chronicLoadDays - 1))
+	if input.EndDate != nil {
+		end = dayAfter(*input.EndDate, loc)
+	}
+	if input.StartDate != nil {
+		d := input.StartDate.UTC()
+		start = time.Date(d.Year(), d.Month(), d.Day(), 0, 0, 0, 0, loc)
+	}
+	lastDayStart := end.AddDate(0, 0, -1)
+
+	// Validate period
+	if start.After(lastDayStart) {
+		return nil, domainerrors.ErrInvalidPeriod
+	}
+	if lastDayStart.Sub(start).Hours()/24 > maxPeriodDays {
+		return nil, domainerrors.ErrPeriodTooLong
+	}
+
+	// A carga crônica do primeiro dia precisa dos 27 dias anteriores
+	sessions, err := uc.effortRepo.ListSessionLoads(ctx, input.UserID, start.AddDate(0, 0, -(chronicLoadDays-1)), end, loc)
+	if err != nil {
+		return nil, fmt.Errorf("list session loads: %w", err)
+	}
+
+	firstDay, lastDay := calendarDay(start, loc), calendarDay(lastDayStart, loc)
+
+	daily := make(map[time.Time]float64)
+	withoutRPE := 0
+	for _, s := range sessions {
+		daily[s.Date] += sessionLoad(s, method)
+		if s.RPE == nil && !s.Date.Before(firstDay) {
+			withoutRPE++
+		}
+	}
+
+	data := &TrainingLoadData{
+		StartDate: firstDay,
+		EndDate:   lastDay,
+		Method:    method,
+		Days:      []TrainingLoadDay{},
+		Warnings:  []TrainingLoadWarning{},
+	}
+	if method == vos.LoadMethodSRPE {
+		data.SessionsWithoutRPE = withoutRPE
+	}
+	for d := firstDay; !d.After(lastDay); d = d.AddDate(0, 0, 1) {
+		data.Days = append(data.Days, trainingLoadDay(d, daily))
+	}
+	if len(data.Days) > 0 {
+		data.Warnings = trainingLoadWarnings(data.Days[len(data.Days)-1])
+	}
+	return data, nil
+}
+
+// sessionLoad returns the load of a session with the given method.
+// Without a reported RPE the session RPE load is zero.
+func sessionLoad(s ports.SessionLoad, method vos.LoadMethod) float64 {
+	if method == vos.LoadMethodTonnage {
+		return float64(s.Tonnage)
+	}
+	if s.RPE == nil || s.DurationMinutes <= 0 {
+		return 0
+	}
+	return float64(s.DurationMinutes * *s.RPE)
+}
+
+// trainingLoadDay computes the rolling load metrics of day from the daily loads.
+func trainingLoadDay(day time.Time, daily map[time.Time]float64) TrainingLoadDay {
+	out := TrainingLoadDay{Date: day, Load: daily[day], Zone: LoadZoneUnknown}
+
+	week := make([]float64, 0, acuteLoadDays)
+	var chronicSum float64
+	for i := 0; i < chronicLoadDays; i++ {
+		load := daily[day.AddDate(0, 0, -i)]
+		chronicSum += load
+		if i < acuteLoadDays {
+			out.Acute += load
+			week = append(week, load)
+		}
+	}
+	out.Chronic = roundLoad(chronicSum / (chronicLoadDays / acuteLoadDays))
+
+	if out.Chronic > 0 {
+		acwr := math.Round(out.Acute/out.Chronic*100) / 100
+		out.ACWR = &acwr
+		out.Zone = loadZone(acwr)
+	}
+
+	mean := out.Acute / acuteLoadDays
+	var variance float64
+	for _, l := range week {
+		variance += (l - mean) * (l - mean)
+	}
+	if sd := math.Sqrt(variance / acuteLoadDays); sd > 0 {
+		monotony := math.Round(mean/sd*100) / 100
+		strain := roundLoad(out.Acute * monotony)
+		out.Monotony = &monotony
+		out.Strain = &strain
+	}
+	return out
+}
+
+func loadZone(acwr float64) LoadZone {
+	switch {
+	case acwr < acwrSafeMin:
+		return LoadZoneLow
+	case acwr <= acwrSafeMax:
+		return LoadZoneSafe
+	case acwr <= acwrSpike:
+		return LoadZoneHigh
+	default:
+		return LoadZoneSpike
+	}
+}
+
+// trainingLoadWarnings returns the warnings raised by a day's metrics.
+func trainingLoadWarnings(day TrainingLoadDay) []TrainingLoadWarning {
+	warnings := []TrainingLoadWarning{}
+	if day.ACWR != nil {
+		acwr := *day.ACWR
+		switch day.Zone {
+		case LoadZoneSpike:
+			warnings = append(warnings, TrainingLoadWarning{
+				Code:    LoadWarningACWRSpike,
+				Message: fmt.Sprintf("Acute load is %.2fx the chronic load; spikes above %.1f sharply raise injury risk.", acwr, acwrSpike),
+				Value:   acwr,
+			})
+		case LoadZoneHigh:
+			warnings = append(warnings, TrainingLoadWarning{
+				Code:    LoadWarningACWRHigh,
+				Message: fmt.Sprintf("Acute load is %.2fx the chronic load, above the %.1f–%.1f safe band.", acwr, acwrSafeMin, acwrSafeMax),
+				Value:   acwr,
+			})
+		case LoadZoneLow:
+			warnings = append(warnings, TrainingLoadWarning{
+				Code:    LoadWarningACWRLow,
+				Message: fmt.Sprintf("Acute load is %.2fx the chronic load, below the %.1f–%.1f safe band; fitness may be dropping.", acwr, acwrSafeMin, acwrSafeMax),
+				Value:   acwr,
+			})
+		}
+	}
+	if day.Monotony != nil && *day.Monotony > monotonyWarning {
+		warnings = append(warnings, TrainingLoadWarning{
+			Code:    LoadWarningHighMonotony,
+			Message: fmt.Sprintf("Training monotony is %.2f (above %.1f); vary daily load and include easier days.", *day.Monotony, monotonyWarning),
+			Value:   *day.Monotony,
+		})
+	}
+	return warnings
+}
+
+func roundLoad(v float64) float64 {
+	return math.Round(v*100) / 100
+}
@@ -0,0 +1,158 @@
+package statistics
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	domainerrors "github.com/kinetria/kinetria-back/internal/kinetria/domain/errors"
+	"github.com/kinetria/kinetria-back/internal/kinetria/domain/ports"
+	"github.com/kinetria/kinetria-back/internal/kinetria/domain/vos"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// --- Mocks for GetTrainingLoadUC ---
+
+type mockEffortRepoLoad struct {
+	loads    []ports.SessionLoad
+	err      error
+	gotStart time.Time
+	gotEnd   time.Time
+}
+
+func (m *mockEffortRepoLoad) GetSessionEffort(_ context.Context, _ uuid.UUID) (*ports.SessionEffort, error) {
+	return nil, nil
+}
+func (m *mockEffortRepoLoad) SetSessionCalories(_ context.Context, _ uuid.UUID, _ int) error {
+	return nil
+}
+func (m *mockEffortRepoLoad) SetSessionFeedback(_ context.Context, _ uuid.UUID, _ vos.SessionFeedback) error {
+	return nil
+}
+func (m *mockEffortRepoLoad) GetSessionFeedback(_ context.Context, _ uuid.UUID) (*vos.SessionFeedback, error) {
+	return nil, nil
+}
+func (m *mockEffortRepoLoad) ListSessionLoads(_ context.Context, _ uuid.UUID, start, end time.Time, _ *time.Location) ([]ports.SessionLoad, error) {
+	m.gotStart, m.gotEnd = start, end
+	return m.loads, m.err
+}
+
+// --- Tests ---
+
+func TestGetTrainingLoadUC_Execute(t *testing.T) {
+	userID := uuid.New()
+	day := func(d int) time.Time { return time.Date(2026, 3, d, 0, 0, 0, 0, time.UTC) }
+	ptr := func(t time.Time) *time.Time { return &t }
+	rpe := func(v int) *int { return &v }
+
+	// Três semanas de 3 sessões de 60 min a RPE 5 (300 UA), depois uma semana de 6 sessões a RPE 8
+	var loads []ports.SessionLoad
+	for _, d := range []int{2, 4, 6, 9, 11, 13, 16, 18, 20} {
+		loads = append(loads, ports.SessionLoad{Date: day(d), DurationMinutes: 60, RPE: rpe(5), Tonnage: 5000000})
+	}
+	for d := 23; d <= 28; d++ {
+		loads = append(loads, ports.SessionLoad{Date: day(d), DurationMinutes: 60, RPE: rpe(8), Tonnage: 8000000})
+	}
+	loads = append(loads, ports.SessionLoad{Date: day(29), DurationMinutes: 45, Tonnage: 4000000})
+	input := GetTrainingLoadInput{UserID: userID, StartDate: ptr(day(20)), EndDate: ptr(day(29))}
+
+	t.Run("srpe_rolling_loads_and_spike_warning", func(t *testing.T) {
+		repo := &mockEffortRepoLoad{loads: loads}
+		uc := NewGetTrainingLoadUC(repo, utcPrefsRepo())
+
+		data, err := uc.Execute(context.Background(), input)
+		require.NoError(t, err)
+		assert.Equal(t, day(20).AddDate(0, 0, -27), repo.gotStart)
+		// O último dia entra inteiro: o fim é a meia-noite seguinte, exclusiva
+		assert.Equal(t, day(30), repo.gotEnd)
+		assert.Equal(t, day(29), data.EndDate)
+		assert.Equal(t, vos.LoadMethodSRPE, data.Method)
+		require.Len(t, data.Days, 10)
+		assert.Equal(t, 1, data.SessionsWithoutRPE)
+
+		// Dia 20: 900 UA na semana, 2700 UA em 28 dias → 675 por semana
+		d20 := data.Days[0]
+		assert.Equal(t, 300.0, d20.Load)
+		assert.Equal(t, 900.0, d20.Acute)
+		assert.Equal(t, 675.0, d20.Chronic)
+		require.NotNil(t, d20.ACWR)
+		assert.Equal(t, 1.33, *d20.ACWR)
+		assert.Equal(t, LoadZoneHigh, d20.Zone)
+
+		// Dia 29: 6 × 480 UA na semana (a sessão sem RPE não conta)
+		d29 := data.Days[9]
+		assert.Equal(t, 0.0, d29.Load)
+		assert.Equal(t, 2880.0, d29.Acute)
+		assert.Equal(t, LoadZoneSpike, d29.Zone)
+		require.NotNil(t, d29.Monotony)
+		require.NotNil(t, d29.Strain)
+		assert.InDelta(t, 2880*(*d29.Monotony), *d29.Strain, 0.01)
+
+		require.NotEmpty(t, data.Warnings)
+		assert.Equal(t, LoadWarningACWRSpike, data.Warnings[0].Code)
+		assert.Equal(t, *d29.ACWR, data.Warnings[0].Value)
+	})
+
+	t.Run("tonnage_method", func(t *testing.T) {
+		uc := NewGetTrainingLoadUC(&mockEffortRepoLoad{loads: loads}, utcPrefsRepo())
+
+		data, err := uc.Execute(context.Background(), GetTrainingLoadInput{
+			UserID: userID, StartDate: input.StartDate, EndDate: input.EndDate, Method: vos.LoadMethodTonnage,
+		})
+		require.NoError(t, err)
+		assert.Equal(t, 4000000.0, data.Days[9].Load)
+		assert.Equal(t, 0, data.SessionsWithoutRPE)
+	})
+
+	t.Run("no_history_is_unknown_zone", func(t *testing.T) {
+		uc := NewGetTrainingLoadUC(&mockEffortRepoLoad{}, utcPrefsRepo())
+
+		data, err := uc.Execute(context.Background(), input)
+		require.NoError(t, err)
+		for _, d := range data.Days {
+			assert.Equal(t, LoadZoneUnknown, d.Zone)
+			assert.Nil(t, d.ACWR)
+			assert.Nil(t, d.Monotony)
+		}
+		assert.Empty(t, data.Warnings)
+	})
+
+	t.Run("monotony_warning", func(t *testing.T) {
+		// Mesma carga todos os dias com um dia leve: monotonia alta
+		var steady []ports.SessionLoad
+		for d := 1; d <= 28; d++ {
+			r := 6
+			if d == 25 {
+				r = 4
+			}
+			steady = append(steady, ports.SessionLoad{Date: day(d), DurationMinutes: 60, RPE: rpe(r)})
+		}
+		uc := NewGetTrainingLoadUC(&mockEffortRepoLoad{loads: steady}, utcPrefsRepo())
+
+		data, err := uc.Execute(context.Background(), GetTrainingLoadInput{UserID: userID, StartDate: ptr(day(28)), EndDate: ptr(day(28))})
+		require.NoError(t, err)
+		require.Len(t, data.Warnings, 1)
+		assert.Equal(t, LoadWarningHighMonotony, data.Warnings[0].Code)
+		assert.Equal(t, LoadZoneSafe, data.Days[0].Zone)
+	})
+
+	t.Run("invalid_parameters", func(t *testing.T) {
+		uc := NewGetTrainingLoadUC(&mockEffortRepoLoad{}, utcPrefsRepo())
+
+		_, err := uc.Execute(context.Background(), GetTrainingLoadInput{UserID: userID, Method: "volume"})
+		assert.ErrorIs(t, err, domainerrors.ErrMalformedParameters)
+
+		_, err = uc.Execute(context.Background(), GetTrainingLoadInput{UserID: userID, StartDate: ptr(day(29)), EndDate: ptr(day(20))})
+		assert.ErrorIs(t, err, domainerrors.ErrInvalidPeriod)
+	})
+
+	t.Run("repository_error", func(t *testing.T) {
+		uc := NewGetTrainingLoadUC(&mockEffortRepoLoad{err: errors.New("db down")}, utcPrefsRepo())
+
+		_, err := uc.Execute(context.Background(), input)
+		assert.Error(t, err)
+	})
+}
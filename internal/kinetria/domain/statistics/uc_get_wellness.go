@@ -0,0 +1,168 @@
+package statistics
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"time"
+
+	"github.com/google/uuid"
+	domainerrors "github.com/kinetria/kinetria-back/internal/kinetria/domain/errors"
+	"github.com/kinetria/kinetria-back/internal/kinetria/domain/ports"
+	"github.com/kinetria/kinetria-back/internal/kinetria/domain/profile"
+	"github.com/kinetria/kinetria-back/internal/kinetria/domain/vos"
+)
+
+// defaultWellnessDays is the default look-back of the wellness statistics.
+const defaultWellnessDays = 90
+
+// GetWellnessInput holds the input parameters for GetWellnessUC.
+type GetWellnessInput struct {
+	UserID    uuid.UUID
+	StartDate *time.Time
+	EndDate   *time.Time
+}
+
+// GetWellnessUC relates the mood, energy and sleep quality reported after sessions to
+// the session RPE and tonnage.
+type GetWellnessUC struct {
+	effortRepo ports.SessionEffortRepository
+	userRepo   ports.UserRepository
+}
+
+// NewGetWellnessUC creates a new GetWellnessUC.
+func NewGetWellnessUC(effortRepo ports.SessionEffortRepository, userRepo ports.UserRepository) *GetWellnessUC {
+	return &GetWellnessUC{effortRepo: effortRepo, userRepo: userRepo}
+}
+
+// Execute lists the completed sessions of the period with their feedback, the average of
+// each rating and, for each mood, energy and sleep quality rating, the average tonnage and
+// RPE of the sessions given it. If StartDate/EndDate are nil, defaults to the last 90 days.
+func (uc *GetWellnessUC) Execute(ctx context.Context, input GetWellnessInput) (*WellnessData, error) {
+	prefs, err := profile.LoadPreferences(ctx, uc.userRepo, input.UserID)
+	if err != nil {
+		return nil, err
+	}
+	loc := prefs.Location()
+	now := time.Now().In(loc)
+
+	// Apply defaults; end is exclusive: midnight after the last day
+	end := time.Date(now.Year(), now.Month(), now.Day()+1, 0, 0, 0, 0, loc)
+	start := startOfDay(now, loc).AddDate(0, 0, -(defaultWellnessDays - 1))
+	if input.EndDate != nil {
+		end = dayAfter(*input.EndDate, loc)
+	}
+	if input.StartDate != nil {
+		d := input.StartDate.UTC()
+		start = time.Date(d.Year(), d.Month(), d.Day(), 0, 0, 0, 0, loc)
+	}
+	lastDayStart := end.AddDate(0, 0, -1)
+
+	// Validate period
+	if start.After(lastDayStart) {
+		return nil, domainerrors.ErrInvalidPeriod
+	}
+	if lastDayStart.Sub(start).Hours()/24 > maxPeriodDays {
+		return nil, domainerrors.ErrPeriodTooLong
+	}
+
+	loads, err := uc.effortRepo.ListSessionLoads(ctx, input.UserID, start, end, loc)
+	if err != nil {
+		return nil, fmt.Errorf("list session loads: %w", err)
+	}
+
+	data := &WellnessData{
+		StartDate: calendarDay(start, loc),
+		EndDate:   calendarDay(lastDayStart, loc),
+		Sessions:  make([]WellnessSession, 0, len(loads)),
+	}
+	var rpe, mood, energy, sleep ratingMean
+	for _, l := range loads {
+		data.Sessions = append(data.Sessions, WellnessSession{
+			SessionID:       l.SessionID,
+			WorkoutID:       l.WorkoutID,
+			WorkoutName:     l.WorkoutName,
+			Date:            l.Date,
+			DurationMinutes: l.DurationMinutes,
+			Tonnage:         l.Tonnage,
+			RPE:             l.RPE,
+			Mood:            l.Mood,
+			Energy:          l.Energy,
+			SleepQuality:    l.SleepQuality,
+		})
+		if l.RPE != nil || l.Mood != nil || l.Energy != nil || l.SleepQuality != nil {
+			data.SessionsWithFeedback++
+		}
+		rpe.add(l.RPE)
+		mood.add(l.Mood)
+		energy.add(l.Energy)
+		sleep.add(l.SleepQuality)
+	}
+	data.Averages = WellnessAverages{
+		RPE:          rpe.value(),
+		Mood:         mood.value(),
+		Energy:       energy.value(),
+		SleepQuality: sleep.value(),
+	}
+	data.ByMood = wellnessBuckets(loads, func(l ports.SessionLoad) *int { return l.Mood })
+	data.ByEnergy = wellnessBuckets(loads, func(l ports.SessionLoad) *int { return l.Energy })
+	data.BySleepQuality = wellnessBuckets(loads, func(l ports.SessionLoad) *int { return l.SleepQuality })
+	return data, nil
+}
+
+// wellnessBuckets groups the sessions by the rating returned by rating, skipping the
+// sessions without it.
+func wellnessBuckets(loads []ports.SessionLoad, rating func(ports.SessionLoad) *int) []WellnessBucket {
+	type acc struct {
+		sessions int
+		tonnage  int64
+		rpe      ratingMean
+	}
+	var byRating [vos.MaxWellnessRating + 1]acc
+	for _, l := range loads {
+		r := rating(l)
+		if r == nil || *r < vos.MinWellnessRating || *r > vos.MaxWellnessRating {
+			continue
+		}
+		a := &byRating[*r]
+		a.sessions++
+		a.tonnage += l.Tonnage
+		a.rpe.add(l.RPE)
+	}
+
+	buckets := []WellnessBucket{}
+	for r := vos.MinWellnessRating; r <= vos.MaxWellnessRating; r++ {
+		a := byRating[r]
+		if a.sessions == 0 {
+			continue
+		}
+		buckets = append(buckets, WellnessBucket{
+			Rating:     r,
+			Sessions:   a.sessions,
+			AvgTonnage: a.tonnage / int64(a.sessions),
+			AvgRPE:     a.rpe.value(),
+		})
+	}
+	return buckets
+}
+
+// ratingMean accumulates the mean of the ratings given.
+type ratingMean struct {
+	sum, n int
+}
+
+func (m *ratingMean) add(v *int) {
+	if v != nil {
+		m.sum += *v
+		m.n++
+	}
+}
+
+// value returns the mean rounded to two decimals, or nil when no rating was given.
+func (m ratingMean) value() *float64 {
+	if m.n == 0 {
+		return nil
+	}
+	v := math.Round(float64(m.sum)/float64(m.n)*100) / 100
+	return &v
+}
@@ -0,0 +1,77 @@
+package statistics
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	domainerrors "github.com/kinetria/kinetria-back/internal/kinetria/domain/errors"
+	"github.com/kinetria/kinetria-back/internal/kinetria/domain/ports"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestGetWellnessUC_Execute(t *testing.T) {
+	userID := uuid.New()
+	day := func(d int) time.Time { return time.Date(2026, 3, d, 0, 0, 0, 0, time.UTC) }
+	ptr := func(t time.Time) *time.Time { return &t }
+	rating := func(v int) *int { return &v }
+
+	loads := []ports.SessionLoad{
+		{SessionID: uuid.New(), Date: day(2), DurationMinutes: 60, Tonnage: 6000000, RPE: rating(8), Mood: rating(5), SleepQuality: rating(4)},
+		{SessionID: uuid.New(), Date: day(4), DurationMinutes: 50, Tonnage: 4000000, RPE: rating(6), Mood: rating(5), SleepQuality: rating(2)},
+		{SessionID: uuid.New(), Date: day(6), DurationMinutes: 45, Tonnage: 3000000, Mood: rating(2), Energy: rating(1)},
+		{SessionID: uuid.New(), Date: day(8), DurationMinutes: 55, Tonnage: 5000000},
+	}
+	input := GetWellnessInput{UserID: userID, StartDate: ptr(day(1)), EndDate: ptr(day(10))}
+
+	t.Run("averages_and_buckets", func(t *testing.T) {
+		repo := &mockEffortRepoLoad{loads: loads}
+		uc := NewGetWellnessUC(repo, utcPrefsRepo())
+
+		data, err := uc.Execute(context.Background(), input)
+		require.NoError(t, err)
+		assert.Equal(t, day(1), repo.gotStart)
+		require.Len(t, data.Sessions, 4)
+		assert.Equal(t, 3, data.SessionsWithFeedback)
+
+		require.NotNil(t, data.Averages.RPE)
+		assert.Equal(t, 7.0, *data.Averages.RPE)
+		require.NotNil(t, data.Averages.Mood)
+		assert.Equal(t, 4.0, *data.Averages.Mood)
+		assert.Equal(t, 1.0, *data.Averages.Energy)
+		assert.Equal(t, 3.0, *data.Averages.SleepQuality)
+
+		// Humor 2 e 5: a sessão sem feedback não entra em nenhuma nota
+		require.Len(t, data.ByMood, 2)
+		assert.Equal(t, WellnessBucket{Rating: 2, Sessions: 1, AvgTonnage: 3000000}, data.ByMood[0])
+		assert.Equal(t, 5, data.ByMood[1].Rating)
+		assert.Equal(t, 2, data.ByMood[1].Sessions)
+		assert.Equal(t, int64(5000000), data.ByMood[1].AvgTonnage)
+		require.NotNil(t, data.ByMood[1].AvgRPE)
+		assert.Equal(t, 7.0, *data.ByMood[1].AvgRPE)
+
+		require.Len(t, data.BySleepQuality, 2)
+		assert.Equal(t, 2, data.BySleepQuality[0].Rating)
+		assert.Equal(t, 4, data.BySleepQuality[1].Rating)
+	})
+
+	t.Run("no_feedback", func(t *testing.T) {
+		uc := NewGetWellnessUC(&mockEffortRepoLoad{}, utcPrefsRepo())
+
+		data, err := uc.Execute(context.Background(), input)
+		require.NoError(t, err)
+		assert.Empty(t, data.Sessions)
+		assert.Nil(t, data.Averages.Mood)
+		assert.Empty(t, data.ByMood)
+	})
+
+	t.Run("invalid_period", func(t *testing.T) {
+		uc := NewGetWellnessUC(&mockEffortRepoLoad{}, utcPrefsRepo())
+
+		_, err := uc.Execute(context.Background(), GetWellnessInput{UserID: userID, StartDate: ptr(day(10)), EndDate: ptr(day(1))})
+		assert.True(t, errors.Is(err, domainerrors.ErrInvalidPeriod))
+	})
+}
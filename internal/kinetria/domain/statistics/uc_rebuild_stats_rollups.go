@@ -0,0 +1,43 @@
+package statistics
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/google/uuid"
+	"github.com/kinetria/kinetria-back/internal/kinetria/domain/ports"
+)
+
+// RebuildStatsRollupsUC recomputes the daily and weekly statistics rollups from the raw
+// sessions and sets. It backfills history and repairs drift; it is meant to run from the
+// rebuild-rollups command, never on the request path.
+type RebuildStatsRollupsUC struct {
+	rollupRepo ports.StatsRollupRepository
+}
+
+// NewRebuildStatsRollupsUC creates a new RebuildStatsRollupsUC.
+func NewRebuildStatsRollupsUC(rollupRepo ports.StatsRollupRepository) *RebuildStatsRollupsUC {
+	return &RebuildStatsRollupsUC{rollupRepo: rollupRepo}
+}
+
+// Execute rebuilds the rollups of userID, or of every user when userID is nil, and returns
+// the number of users rebuilt. Each user is rebuilt in its own transaction.
+func (uc *RebuildStatsRollupsUC) Execute(ctx context.Context, userID *uuid.UUID) (int, error) {
+	userIDs := []uuid.UUID{}
+	if userID != nil {
+		userIDs = append(userIDs, *userID)
+	} else {
+		ids, err := uc.rollupRepo.ListUserIDs(ctx)
+		if err != nil {
+			return 0, fmt.Errorf("list users: %w", err)
+		}
+		userIDs = ids
+	}
+
+	for i, id := range userIDs {
+		if err := uc.rollupRepo.RebuildUser(ctx, id); err != nil {
+			return i, fmt.Errorf("rebuild stats rollups of user %s: %w", id, err)
+		}
+	}
+	return len(userIDs), nil
+}
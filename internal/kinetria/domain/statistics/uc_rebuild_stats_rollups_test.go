@@ -0,0 +1,72 @@
+package statistics
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// --- Mocks for RebuildStatsRollupsUC ---
+
+type mockStatsRollupRepo struct {
+	userIDs    []uuid.UUID
+	listErr    error
+	rebuildErr error
+	rebuilt    []uuid.UUID
+}
+
+func (m *mockStatsRollupRepo) RefreshDay(_ context.Context, _ uuid.UUID, _ time.Time) error {
+	return nil
+}
+
+func (m *mockStatsRollupRepo) RebuildUser(_ context.Context, userID uuid.UUID) error {
+	if m.rebuildErr != nil {
+		return m.rebuildErr
+	}
+	m.rebuilt = append(m.rebuilt, userID)
+	return nil
+}
+
+func (m *mockStatsRollupRepo) ListUserIDs(_ context.Context) ([]uuid.UUID, error) {
+	return m.userIDs, m.listErr
+}
+
+// --- Tests ---
+
+func TestRebuildStatsRollupsUC_Execute(t *testing.T) {
+	users := []uuid.UUID{uuid.New(), uuid.New(), uuid.New()}
+
+	t.Run("all_users", func(t *testing.T) {
+		repo := &mockStatsRollupRepo{userIDs: users}
+		n, err := NewRebuildStatsRollupsUC(repo).Execute(context.Background(), nil)
+		require.NoError(t, err)
+		assert.Equal(t, 3, n)
+		assert.Equal(t, users, repo.rebuilt)
+	})
+
+	t.Run("single_user", func(t *testing.T) {
+		repo := &mockStatsRollupRepo{userIDs: users}
+		n, err := NewRebuildStatsRollupsUC(repo).Execute(context.Background(), &users[1])
+		require.NoError(t, err)
+		assert.Equal(t, 1, n)
+		assert.Equal(t, []uuid.UUID{users[1]}, repo.rebuilt)
+	})
+
+	t.Run("list_error", func(t *testing.T) {
+		repo := &mockStatsRollupRepo{listErr: errors.New("db down")}
+		_, err := NewRebuildStatsRollupsUC(repo).Execute(context.Background(), nil)
+		assert.Error(t, err)
+	})
+
+	t.Run("rebuild_error", func(t *testing.T) {
+		repo := &mockStatsRollupRepo{userIDs: users, rebuildErr: errors.New("db down")}
+		n, err := NewRebuildStatsRollupsUC(repo).Execute(context.Background(), nil)
+		assert.Error(t, err)
+		assert.Equal(t, 0, n)
+	})
+}
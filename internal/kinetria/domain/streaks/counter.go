@@ -0,0 +1,140 @@
+package streaks
+
+import (
+	"time"
+
+	"github.com/kinetria/kinetria-back/internal/kinetria/domain/ports"
+	"github.com/kinetria/kinetria-back/internal/kinetria/domain/vos"
+)
+
+const (
+	// maxStreakFreezes is how many unused freezes a user can hold.
+	maxStreakFreezes = 2
+	// freezeEveryDays is how many streak days earn a freeze in daily mode.
+	freezeEveryDays = 7
+	// freezeEveryWeeks is how many streak weeks earn a freeze in weekly mode.
+	freezeEveryWeeks = 4
+)
+
+// history holds the sessions and planned rest days of a user, keyed by calendar day.
+type history struct {
+	sessions map[time.Time]int
+	rest     map[time.Time]bool
+}
+
+func newHistory(days []ports.StreakDay, rest []time.Time) history {
+	h := history{
+		sessions: make(map[time.Time]int, len(days)),
+		rest:     make(map[time.Time]bool, len(rest)),
+	}
+	for _, d := range days {
+		h.sessions[calendarDay(d.Day)] += d.Sessions
+	}
+	for _, d := range rest {
+		h.rest[calendarDay(d)] = true
+	}
+	return h
+}
+
+// week returns the sessions of the week starting at start and the sessions it requires:
+// the target, capped by the days not planned as rest.
+func (h history) week(start time.Time, target int) (sessions, required int) {
+	free := 0
+	for i := 0; i < 7; i++ {
+		d := start.AddDate(0, 0, i)
+		sessions += h.sessions[d]
+		if h.sessions[d] > 0 || !h.rest[d] {
+			free++
+		}
+	}
+	return sessions, min(target, free)
+}
+
+// counter walks the periods (days or weeks) of a history in order. Periods that meet the
+// goal extend the streak and earn freezes; a missed period spends a freeze or breaks it.
+// Rest periods leave it unchanged.
+type counter struct {
+	earnEvery int
+	current   int
+	longest   int
+	freezes   int
+	used      int
+	sinceEarn int
+}
+
+func (c *counter) hit() {
+	c.current++
+	c.longest = max(c.longest, c.current)
+	c.sinceEarn++
+	if c.sinceEarn == c.earnEvery {
+		c.sinceEarn = 0
+		c.freezes = min(c.freezes+1, maxStreakFreezes)
+	}
+}
+
+func (c *counter) miss() {
+	if c.freezes > 0 {
+		c.freezes--
+		c.used++
+		return
+	}
+	c.current, c.sinceEarn, c.used = 0, 0, 0
+}
+
+func (c *counter) fill(out *ports.StreakStatus) {
+	out.Current = c.current
+	out.Longest = c.longest
+	out.FreezesAvailable = c.freezes
+	out.FreezesUsed = c.used
+}
+
+// dailyStreak counts consecutive days with a session from first to today. A planned rest
+// day without a session is skipped, and today does not break the streak until it is over.
+func dailyStreak(h history, first, today time.Time, out *ports.StreakStatus) {
+	c := counter{earnEvery: freezeEveryDays}
+	for d := first; !d.After(today); d = d.AddDate(0, 0, 1) {
+		switch {
+		case h.sessions[d] > 0:
+			c.hit()
+		case h.rest[d], d.Equal(today):
+			// descanso planejado, ou o dia de hoje ainda em aberto
+		default:
+			c.miss()
+		}
+	}
+	c.fill(out)
+
+	out.PeriodSessions = h.sessions[today]
+	if !h.rest[today] || out.PeriodSessions > 0 {
+		out.PeriodTarget = 1
+	}
+}
+
+// weeklyStreak counts consecutive weeks reaching target sessions, from the week of first to
+// the week of today. Planned rest days lower the target of their week; a week planned as
+// rest entirely is skipped, and the current week does not break the streak until it is over.
+func weeklyStreak(h history, first, today time.Time, weekStart vos.WeekStart, target int, out *ports.StreakStatus) {
+	c := counter{earnEvery: freezeEveryWeeks}
+	thisWeek := weekStart.StartOf(today)
+	for w := weekStart.StartOf(first); !w.After(thisWeek); w = w.AddDate(0, 0, 7) {
+		sessions, required := h.week(w, target)
+		switch {
+		case required == 0:
+			// semana inteira planejada como descanso
+		case sessions >= required:
+			c.hit()
+		case w.Equal(thisWeek):
+			// semana em andamento
+		default:
+			c.miss()
+		}
+	}
+	c.fill(out)
+
+	out.PeriodSessions, out.PeriodTarget = h.week(thisWeek, target)
+}
+
+// calendarDay returns t's calendar date as midnight UTC, the key used for days.
+func calendarDay(t time.Time) time.Time {
+	return time.Date(t.Year(), t.Month(), t.Day(), 0, 0, 0, 0, time.UTC)
+}
@@ -0,0 +1,108 @@
+package streaks_test
+
+import (
+	"context"
+	"sort"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/kinetria/kinetria-back/internal/kinetria/domain/entities"
+	domainerrors "github.com/kinetria/kinetria-back/internal/kinetria/domain/errors"
+	"github.com/kinetria/kinetria-back/internal/kinetria/domain/ports"
+	"github.com/kinetria/kinetria-back/internal/kinetria/domain/vos"
+)
+
+// mockStreakRepo is an in-memory ports.StreakRepository.
+type mockStreakRepo struct {
+	days []ports.StreakDay
+	rest map[time.Time]bool
+	err  error
+}
+
+func newMockStreakRepo() *mockStreakRepo {
+	return &mockStreakRepo{rest: map[time.Time]bool{}}
+}
+
+// train adds one session on each of the given days.
+func (m *mockStreakRepo) train(days ...time.Time) {
+	for _, d := range days {
+		m.days = append(m.days, ports.StreakDay{Day: d, Sessions: 1})
+	}
+	sort.Slice(m.days, func(i, j int) bool { return m.days[i].Day.Before(m.days[j].Day) })
+}
+
+func (m *mockStreakRepo) ListActivityDays(_ context.Context, _ uuid.UUID) ([]ports.StreakDay, error) {
+	return m.days, m.err
+}
+
+func (m *mockStreakRepo) ListRestDays(_ context.Context, _ uuid.UUID, from, to time.Time) ([]time.Time, error) {
+	if m.err != nil {
+		return nil, m.err
+	}
+	var out []time.Time
+	for d := range m.rest {
+		if !d.Before(from) && !d.After(to) {
+			out = append(out, d)
+		}
+	}
+	sort.Slice(out, func(i, j int) bool { return out[i].Before(out[j]) })
+	return out, nil
+}
+
+func (m *mockStreakRepo) AddRestDay(_ context.Context, _ uuid.UUID, day time.Time) (bool, error) {
+	if m.err != nil {
+		return false, m.err
+	}
+	if m.rest[day] {
+		return false, nil
+	}
+	m.rest[day] = true
+	return true, nil
+}
+
+func (m *mockStreakRepo) DeleteRestDay(_ context.Context, _ uuid.UUID, day time.Time) (bool, error) {
+	if m.err != nil {
+		return false, m.err
+	}
+	if !m.rest[day] {
+		return false, nil
+	}
+	delete(m.rest, day)
+	return true, nil
+}
+
+// mockUserRepo is a ports.UserRepository returning a single user in UTC.
+type mockUserRepo struct {
+	user *entities.User
+}
+
+func newMockUserRepo(mode vos.StreakMode, weeklyTarget int) *mockUserRepo {
+	prefs := vos.DefaultUserPreferences()
+	prefs.Timezone = "UTC"
+	prefs.StreakMode = mode
+	prefs.StreakWeeklyTarget = weeklyTarget
+	return &mockUserRepo{user: &entities.User{ID: uuid.New(), Preferences: prefs}}
+}
+
+func (m *mockUserRepo) Create(_ context.Context, _ *entities.User) error { return nil }
+func (m *mockUserRepo) GetByEmail(_ context.Context, _ string) (*entities.User, error) {
+	return nil, domainerrors.ErrNotFound
+}
+func (m *mockUserRepo) GetByID(_ context.Context, _ uuid.UUID) (*entities.User, error) {
+	return m.user, nil
+}
+func (m *mockUserRepo) Update(_ context.Context, _ *entities.User) error { return nil }
+
+// today returns the current UTC date.
+func today() time.Time {
+	return time.Now().UTC().Truncate(24 * time.Hour)
+}
+
+// daysAgo returns the UTC dates n days before today, for each n.
+func daysAgo(ns ...int) []time.Time {
+	out := make([]time.Time, len(ns))
+	for i, n := range ns {
+		out[i] = today().AddDate(0, 0, -n)
+	}
+	return out
+}
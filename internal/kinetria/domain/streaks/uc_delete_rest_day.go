@@ -0,0 +1,33 @@
+package streaks
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+	domainerrors "github.com/kinetria/kinetria-back/internal/kinetria/domain/errors"
+	"github.com/kinetria/kinetria-back/internal/kinetria/domain/ports"
+)
+
+// DeleteRestDayUC removes a planned rest day.
+type DeleteRestDayUC struct {
+	streakRepo ports.StreakRepository
+}
+
+// NewDeleteRestDayUC creates a new DeleteRestDayUC.
+func NewDeleteRestDayUC(streakRepo ports.StreakRepository) *DeleteRestDayUC {
+	return &DeleteRestDayUC{streakRepo: streakRepo}
+}
+
+// Execute removes the rest day planned on day, or returns ErrNotFound if there is none.
+func (uc *DeleteRestDayUC) Execute(ctx context.Context, userID uuid.UUID, day time.Time) error {
+	ok, err := uc.streakRepo.DeleteRestDay(ctx, userID, calendarDay(day))
+	if err != nil {
+		return fmt.Errorf("failed to delete rest day: %w", err)
+	}
+	if !ok {
+		return domainerrors.ErrNotFound
+	}
+	return nil
+}
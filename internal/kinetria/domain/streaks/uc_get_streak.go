@@ -0,0 +1,63 @@
+package streaks
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/kinetria/kinetria-back/internal/kinetria/domain/ports"
+	"github.com/kinetria/kinetria-back/internal/kinetria/domain/profile"
+	"github.com/kinetria/kinetria-back/internal/kinetria/domain/vos"
+)
+
+// GetStreakUC computes the user's training streak in their chosen mode: consecutive days
+// with a session, or consecutive weeks reaching their weekly target of sessions.
+//
+// Freezes are earned along the streak (one every 7 days or 4 weeks, up to 2 held) and are
+// spent automatically on a missed day or week, which then neither breaks nor extends it.
+// Planned rest days do not break the streak either.
+type GetStreakUC struct {
+	streakRepo ports.StreakRepository
+	userRepo   ports.UserRepository
+}
+
+// NewGetStreakUC creates a new GetStreakUC.
+func NewGetStreakUC(streakRepo ports.StreakRepository, userRepo ports.UserRepository) *GetStreakUC {
+	return &GetStreakUC{streakRepo: streakRepo, userRepo: userRepo}
+}
+
+// Execute returns the user's streak. Days and weeks follow the user's timezone and week start.
+func (uc *GetStreakUC) Execute(ctx context.Context, userID uuid.UUID) (*ports.StreakStatus, error) {
+	prefs, err := profile.LoadPreferences(ctx, uc.userRepo, userID)
+	if err != nil {
+		return nil, err
+	}
+	mode, target := prefs.Streak()
+	today := calendarDay(time.Now().In(prefs.Location()))
+
+	days, err := uc.streakRepo.ListActivityDays(ctx, userID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list activity days: %w", err)
+	}
+	first := today
+	if len(days) > 0 && calendarDay(days[0].Day).Before(today) {
+		first = calendarDay(days[0].Day)
+	}
+
+	// Descansos até o fim da semana atual: os já planejados reduzem a meta desta semana
+	rest, err := uc.streakRepo.ListRestDays(ctx, userID, first, prefs.WeekStart.StartOf(today).AddDate(0, 0, 6))
+	if err != nil {
+		return nil, fmt.Errorf("failed to list rest days: %w", err)
+	}
+	h := newHistory(days, rest)
+
+	out := &ports.StreakStatus{Mode: mode}
+	if mode == vos.StreakModeWeekly {
+		out.WeeklyTarget = target
+		weeklyStreak(h, first, today, prefs.WeekStart, target, out)
+	} else {
+		dailyStreak(h, first, today, out)
+	}
+	return out, nil
+}
@@ -0,0 +1,144 @@
+package streaks_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/kinetria/kinetria-back/internal/kinetria/domain/streaks"
+	"github.com/kinetria/kinetria-back/internal/kinetria/domain/vos"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestGetStreakUC_Daily(t *testing.T) {
+	userID := uuid.New()
+	users := newMockUserRepo(vos.StreakModeDaily, 3)
+
+	t.Run("no_sessions", func(t *testing.T) {
+		out, err := streaks.NewGetStreakUC(newMockStreakRepo(), users).Execute(context.Background(), userID)
+		require.NoError(t, err)
+		assert.Equal(t, vos.StreakModeDaily, out.Mode)
+		assert.Equal(t, 0, out.Current)
+		assert.Equal(t, 1, out.PeriodTarget)
+	})
+
+	t.Run("today_still_open", func(t *testing.T) {
+		repo := newMockStreakRepo()
+		repo.train(daysAgo(3, 2, 1)...)
+
+		out, err := streaks.NewGetStreakUC(repo, users).Execute(context.Background(), userID)
+		require.NoError(t, err)
+		assert.Equal(t, 3, out.Current)
+		assert.Equal(t, 3, out.Longest)
+		assert.Equal(t, 0, out.PeriodSessions)
+	})
+
+	t.Run("missed_day_breaks_without_freeze", func(t *testing.T) {
+		repo := newMockStreakRepo()
+		repo.train(daysAgo(5, 4, 3, 1, 0)...)
+
+		out, err := streaks.NewGetStreakUC(repo, users).Execute(context.Background(), userID)
+		require.NoError(t, err)
+		assert.Equal(t, 2, out.Current)
+		assert.Equal(t, 3, out.Longest)
+	})
+
+	t.Run("planned_rest_day_keeps_streak", func(t *testing.T) {
+		repo := newMockStreakRepo()
+		repo.train(daysAgo(5, 4, 3, 1, 0)...)
+		repo.rest[daysAgo(2)[0]] = true
+
+		out, err := streaks.NewGetStreakUC(repo, users).Execute(context.Background(), userID)
+		require.NoError(t, err)
+		assert.Equal(t, 5, out.Current)
+	})
+
+	t.Run("earned_freeze_covers_missed_day", func(t *testing.T) {
+		repo := newMockStreakRepo()
+		// 7 dias seguidos rendem um congelamento
+		repo.train(daysAgo(10, 9, 8, 7, 6, 5, 4, 1, 0)...)
+
+		out, err := streaks.NewGetStreakUC(repo, users).Execute(context.Background(), userID)
+		require.NoError(t, err)
+		// Dias 3 e 2 sem treino: o primeiro consome o congelamento, o segundo quebra
+		assert.Equal(t, 2, out.Current)
+		assert.Equal(t, 7, out.Longest)
+		assert.Equal(t, 0, out.FreezesAvailable)
+
+		repo = newMockStreakRepo()
+		repo.train(daysAgo(9, 8, 7, 6, 5, 4, 3, 1, 0)...)
+		out, err = streaks.NewGetStreakUC(repo, users).Execute(context.Background(), userID)
+		require.NoError(t, err)
+		assert.Equal(t, 9, out.Current)
+		assert.Equal(t, 1, out.FreezesUsed)
+		assert.Equal(t, 0, out.FreezesAvailable)
+	})
+
+	t.Run("repository_error", func(t *testing.T) {
+		repo := newMockStreakRepo()
+		repo.err = errors.New("db down")
+
+		_, err := streaks.NewGetStreakUC(repo, users).Execute(context.Background(), userID)
+		assert.Error(t, err)
+	})
+}
+
+func TestGetStreakUC_Weekly(t *testing.T) {
+	userID := uuid.New()
+	users := newMockUserRepo(vos.StreakModeWeekly, 3)
+	weekStart := vos.WeekStartMonday.StartOf(today())
+
+	// weekDays returns the first n days of the week starting weeks weeks before the current one.
+	weekDays := func(weeks, n int) []time.Time {
+		start := weekStart.AddDate(0, 0, -7*weeks)
+		out := make([]time.Time, n)
+		for i := range out {
+			out[i] = start.AddDate(0, 0, i)
+		}
+		return out
+	}
+
+	t.Run("three_sessions_a_week", func(t *testing.T) {
+		repo := newMockStreakRepo()
+		repo.train(weekDays(3, 3)...)
+		repo.train(weekDays(2, 3)...)
+		repo.train(weekDays(1, 3)...)
+
+		out, err := streaks.NewGetStreakUC(repo, users).Execute(context.Background(), userID)
+		require.NoError(t, err)
+		assert.Equal(t, vos.StreakModeWeekly, out.Mode)
+		assert.Equal(t, 3, out.WeeklyTarget)
+		// A semana atual ainda está em aberto e não quebra a sequência
+		assert.Equal(t, 3, out.Current)
+		assert.Equal(t, 3, out.PeriodTarget)
+	})
+
+	t.Run("short_week_breaks", func(t *testing.T) {
+		repo := newMockStreakRepo()
+		repo.train(weekDays(3, 3)...)
+		repo.train(weekDays(2, 2)...)
+		repo.train(weekDays(1, 3)...)
+
+		out, err := streaks.NewGetStreakUC(repo, users).Execute(context.Background(), userID)
+		require.NoError(t, err)
+		assert.Equal(t, 1, out.Current)
+		assert.Equal(t, 1, out.Longest)
+	})
+
+	t.Run("rest_days_lower_the_target", func(t *testing.T) {
+		repo := newMockStreakRepo()
+		repo.train(weekDays(2, 3)...)
+		repo.train(weekDays(1, 2)...)
+		// Semana passada: 5 dias de descanso planejados, restam 2 dias livres
+		for _, d := range weekDays(1, 7)[2:] {
+			repo.rest[d] = true
+		}
+
+		out, err := streaks.NewGetStreakUC(repo, users).Execute(context.Background(), userID)
+		require.NoError(t, err)
+		assert.Equal(t, 2, out.Current)
+	})
+}
@@ -0,0 +1,69 @@
+package streaks
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+	domainerrors "github.com/kinetria/kinetria-back/internal/kinetria/domain/errors"
+	"github.com/kinetria/kinetria-back/internal/kinetria/domain/ports"
+	"github.com/kinetria/kinetria-back/internal/kinetria/domain/profile"
+)
+
+const (
+	// defaultRestDayRangeDays is the range listed when no end date is given.
+	defaultRestDayRangeDays = 90
+	// maxRestDayRangeDays is the longest range that can be listed at once.
+	maxRestDayRangeDays = 366
+)
+
+// ListRestDaysInput selects the dates listed. Nil dates default to today and 90 days after From.
+type ListRestDaysInput struct {
+	UserID uuid.UUID
+	From   *time.Time
+	To     *time.Time
+}
+
+// ListRestDaysUC lists the user's planned rest days.
+type ListRestDaysUC struct {
+	streakRepo ports.StreakRepository
+	userRepo   ports.UserRepository
+}
+
+// NewListRestDaysUC creates a new ListRestDaysUC.
+func NewListRestDaysUC(streakRepo ports.StreakRepository, userRepo ports.UserRepository) *ListRestDaysUC {
+	return &ListRestDaysUC{streakRepo: streakRepo, userRepo: userRepo}
+}
+
+// Execute returns the planned rest days between From and To (inclusive), oldest first.
+// The range may not be reversed nor longer than 366 days.
+func (uc *ListRestDaysUC) Execute(ctx context.Context, input ListRestDaysInput) ([]time.Time, error) {
+	var from time.Time
+	if input.From != nil {
+		from = calendarDay(*input.From)
+	} else {
+		prefs, err := profile.LoadPreferences(ctx, uc.userRepo, input.UserID)
+		if err != nil {
+			return nil, err
+		}
+		from = calendarDay(time.Now().In(prefs.Location()))
+	}
+	to := from.AddDate(0, 0, defaultRestDayRangeDays)
+	if input.To != nil {
+		to = calendarDay(*input.To)
+	}
+
+	if to.Before(from) {
+		return nil, domainerrors.ErrInvalidPeriod
+	}
+	if to.Sub(from) > maxRestDayRangeDays*24*time.Hour {
+		return nil, fmt.Errorf("%w: range must not exceed 366 days", domainerrors.ErrMalformedParameters)
+	}
+
+	days, err := uc.streakRepo.ListRestDays(ctx, input.UserID, from, to)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list rest days: %w", err)
+	}
+	return days, nil
+}
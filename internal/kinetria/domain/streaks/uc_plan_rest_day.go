@@ -0,0 +1,49 @@
+package streaks
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+	domainerrors "github.com/kinetria/kinetria-back/internal/kinetria/domain/errors"
+	"github.com/kinetria/kinetria-back/internal/kinetria/domain/ports"
+	"github.com/kinetria/kinetria-back/internal/kinetria/domain/profile"
+)
+
+// maxRestDayHorizonDays is how far ahead a rest day can be planned.
+const maxRestDayHorizonDays = 365
+
+// PlanRestDayUC plans a rest day in the user's calendar.
+type PlanRestDayUC struct {
+	streakRepo ports.StreakRepository
+	userRepo   ports.UserRepository
+}
+
+// NewPlanRestDayUC creates a new PlanRestDayUC.
+func NewPlanRestDayUC(streakRepo ports.StreakRepository, userRepo ports.UserRepository) *PlanRestDayUC {
+	return &PlanRestDayUC{streakRepo: streakRepo, userRepo: userRepo}
+}
+
+// Execute plans day as a rest day. Only today and the next 365 days, in the user's
+// timezone, can be planned: rest days must not repair streaks already broken.
+// Planning a day twice is not an error.
+func (uc *PlanRestDayUC) Execute(ctx context.Context, userID uuid.UUID, day time.Time) error {
+	prefs, err := profile.LoadPreferences(ctx, uc.userRepo, userID)
+	if err != nil {
+		return err
+	}
+	today := calendarDay(time.Now().In(prefs.Location()))
+	day = calendarDay(day)
+	if day.Before(today) {
+		return fmt.Errorf("%w: rest days cannot be planned in the past", domainerrors.ErrMalformedParameters)
+	}
+	if day.After(today.AddDate(0, 0, maxRestDayHorizonDays)) {
+		return fmt.Errorf("%w: rest days must be within the next 365 days", domainerrors.ErrMalformedParameters)
+	}
+
+	if _, err := uc.streakRepo.AddRestDay(ctx, userID, day); err != nil {
+		return fmt.Errorf("failed to plan rest day: %w", err)
+	}
+	return nil
+}
@@ -0,0 +1,72 @@
+package streaks_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/uuid"
+	domainerrors "github.com/kinetria/kinetria-back/internal/kinetria/domain/errors"
+	"github.com/kinetria/kinetria-back/internal/kinetria/domain/streaks"
+	"github.com/kinetria/kinetria-back/internal/kinetria/domain/vos"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestPlanRestDayUC_Execute(t *testing.T) {
+	userID := uuid.New()
+	users := newMockUserRepo(vos.StreakModeDaily, 3)
+
+	t.Run("plans_today_and_is_idempotent", func(t *testing.T) {
+		repo := newMockStreakRepo()
+		uc := streaks.NewPlanRestDayUC(repo, users)
+
+		require.NoError(t, uc.Execute(context.Background(), userID, today()))
+		require.NoError(t, uc.Execute(context.Background(), userID, today()))
+		assert.True(t, repo.rest[today()])
+	})
+
+	t.Run("rejects_past_and_far_future", func(t *testing.T) {
+		uc := streaks.NewPlanRestDayUC(newMockStreakRepo(), users)
+
+		err := uc.Execute(context.Background(), userID, today().AddDate(0, 0, -1))
+		assert.ErrorIs(t, err, domainerrors.ErrMalformedParameters)
+		err = uc.Execute(context.Background(), userID, today().AddDate(0, 0, 366))
+		assert.ErrorIs(t, err, domainerrors.ErrMalformedParameters)
+	})
+}
+
+func TestDeleteRestDayUC_Execute(t *testing.T) {
+	userID := uuid.New()
+	repo := newMockStreakRepo()
+	repo.rest[today()] = true
+	uc := streaks.NewDeleteRestDayUC(repo)
+
+	require.NoError(t, uc.Execute(context.Background(), userID, today()))
+	assert.ErrorIs(t, uc.Execute(context.Background(), userID, today()), domainerrors.ErrNotFound)
+}
+
+func TestListRestDaysUC_Execute(t *testing.T) {
+	userID := uuid.New()
+	repo := newMockStreakRepo()
+	repo.rest[today().AddDate(0, 0, -3)] = true
+	repo.rest[today().AddDate(0, 0, 2)] = true
+	repo.rest[today().AddDate(0, 0, 200)] = true
+	uc := streaks.NewListRestDaysUC(repo, newMockUserRepo(vos.StreakModeDaily, 3))
+
+	t.Run("defaults_to_next_90_days", func(t *testing.T) {
+		days, err := uc.Execute(context.Background(), streaks.ListRestDaysInput{UserID: userID})
+		require.NoError(t, err)
+		require.Len(t, days, 1)
+		assert.Equal(t, today().AddDate(0, 0, 2), days[0])
+	})
+
+	t.Run("invalid_range", func(t *testing.T) {
+		from, to := today(), today().AddDate(0, 0, -1)
+		_, err := uc.Execute(context.Background(), streaks.ListRestDaysInput{UserID: userID, From: &from, To: &to})
+		assert.ErrorIs(t, err, domainerrors.ErrInvalidPeriod)
+
+		to = from.AddDate(0, 0, 400)
+		_, err = uc.Execute(context.Background(), streaks.ListRestDaysInput{UserID: userID, From: &from, To: &to})
+		assert.ErrorIs(t, err, domainerrors.ErrMalformedParameters)
+	})
+}
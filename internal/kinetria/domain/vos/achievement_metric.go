@@ -0,0 +1,42 @@
+package vos
+
+import (
+	"fmt"
+
+	domerrors "github.com/kinetria/kinetria-back/internal/kinetria/domain/errors"
+)
+
+// AchievementMetric is the user counter an achievement rule compares with its threshold.
+// Weights are in grams and volume in grams × reps.
+type AchievementMetric string
+
+const (
+	// AchievementMetricSessions counts completed sessions.
+	AchievementMetricSessions AchievementMetric = "sessions"
+	// AchievementMetricSets counts completed sets, including those of the active session.
+	AchievementMetricSets AchievementMetric = "sets"
+	// AchievementMetricReps sums the reps of completed sets.
+	AchievementMetricReps AchievementMetric = "reps"
+	// AchievementMetricVolume sums weight × reps of completed sets.
+	AchievementMetricVolume AchievementMetric = "volume"
+	// AchievementMetricHeaviestSet is the heaviest weight of a completed set.
+	AchievementMetricHeaviestSet AchievementMetric = "heaviest_set"
+	// AchievementMetricExercises counts the distinct exercises performed.
+	AchievementMetricExercises AchievementMetric = "exercises"
+	// AchievementMetricStreakDays is the user's longest streak in their streak mode, in days;
+	// a weekly streak counts 7 days per week.
+	AchievementMetricStreakDays AchievementMetric = "streak_days"
+)
+
+func (m AchievementMetric) String() string {
+	return string(m)
+}
+
+func (m AchievementMetric) Validate() error {
+	switch m {
+	case AchievementMetricSessions, AchievementMetricSets, AchievementMetricReps, AchievementMetricVolume,
+		AchievementMetricHeaviestSet, AchievementMetricExercises, AchievementMetricStreakDays:
+		return nil
+	}
+	return fmt.Errorf("invalid achievement metric %q: %w", string(m), domerrors.ErrMalformedParameters)
+}
@@ -0,0 +1,27 @@
+package vos_test
+
+import (
+	"errors"
+	"testing"
+
+	domerrors "github.com/kinetria/kinetria-back/internal/kinetria/domain/errors"
+	"github.com/kinetria/kinetria-back/internal/kinetria/domain/vos"
+)
+
+func TestAchievementMetric_Validate(t *testing.T) {
+	valid := []vos.AchievementMetric{
+		vos.AchievementMetricSessions, vos.AchievementMetricSets, vos.AchievementMetricReps,
+		vos.AchievementMetricVolume, vos.AchievementMetricHeaviestSet, vos.AchievementMetricExercises,
+		vos.AchievementMetricStreakDays,
+	}
+	for _, m := range valid {
+		if err := m.Validate(); err != nil {
+			t.Errorf("expected no error for %s, got %v", m, err)
+		}
+	}
+	for _, m := range []vos.AchievementMetric{"", "streak", "SESSIONS"} {
+		if err := m.Validate(); !errors.Is(err, domerrors.ErrMalformedParameters) {
+			t.Errorf("expected ErrMalformedParameters for %q, got %v", m, err)
+		}
+	}
+}
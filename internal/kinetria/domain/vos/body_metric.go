@@ -0,0 +1,77 @@
+package vos
+
+import (
+	"fmt"
+
+	domerrors "github.com/kinetria/kinetria-back/internal/kinetria/domain/errors"
+)
+
+// BodySite is a body location where a circumference is measured.
+type BodySite string
+
+const (
+	BodySiteNeck  BodySite = "neck"
+	BodySiteChest BodySite = "chest"
+	BodySiteWaist BodySite = "waist"
+	BodySiteHips  BodySite = "hips"
+	BodySiteArm   BodySite = "arm"
+	BodySiteThigh BodySite = "thigh"
+	BodySiteCalf  BodySite = "calf"
+)
+
+// AllBodySites returns every measurable site in display order.
+func AllBodySites() []BodySite {
+	return []BodySite{
+		BodySiteNeck,
+		BodySiteChest,
+		BodySiteWaist,
+		BodySiteHips,
+		BodySiteArm,
+		BodySiteThigh,
+		BodySiteCalf,
+	}
+}
+
+func (s BodySite) String() string {
+	return string(s)
+}
+
+func (s BodySite) Validate() error {
+	for _, site := range AllBodySites() {
+		if s == site {
+			return nil
+		}
+	}
+	return fmt.Errorf("invalid body site %q: %w", string(s), domerrors.ErrMalformedParameters)
+}
+
+// BodyMetric is a tracked body measurement: weight, body fat or the circumference of a BodySite.
+type BodyMetric string
+
+const (
+	BodyMetricWeight  BodyMetric = "weight"
+	BodyMetricBodyFat BodyMetric = "bodyFat"
+)
+
+func (m BodyMetric) String() string {
+	return string(m)
+}
+
+// Site returns the body site of a circumference metric.
+func (m BodyMetric) Site() (BodySite, bool) {
+	site := BodySite(m)
+	if site.Validate() != nil {
+		return "", false
+	}
+	return site, true
+}
+
+func (m BodyMetric) Validate() error {
+	if m == BodyMetricWeight || m == BodyMetricBodyFat {
+		return nil
+	}
+	if _, ok := m.Site(); ok {
+		return nil
+	}
+	return fmt.Errorf("invalid body metric %q: %w", string(m), domerrors.ErrMalformedParameters)
+}
@@ -0,0 +1,49 @@
+package vos_test
+
+import (
+	"errors"
+	"testing"
+
+	domerrors "github.com/kinetria/kinetria-back/internal/kinetria/domain/errors"
+	"github.com/kinetria/kinetria-back/internal/kinetria/domain/vos"
+)
+
+func TestBodySite_Validate(t *testing.T) {
+	for _, s := range vos.AllBodySites() {
+		if err := s.Validate(); err != nil {
+			t.Errorf("expected no error for %s, got %v", s, err)
+		}
+	}
+	if err := vos.BodySite("ear").Validate(); !errors.Is(err, domerrors.ErrMalformedParameters) {
+		t.Errorf("expected ErrMalformedParameters, got %v", err)
+	}
+}
+
+func TestBodyMetric_Validate(t *testing.T) {
+	tests := []struct {
+		metric  vos.BodyMetric
+		wantErr bool
+	}{
+		{vos.BodyMetricWeight, false},
+		{vos.BodyMetricBodyFat, false},
+		{vos.BodyMetric(vos.BodySiteWaist), false},
+		{"height", true},
+		{"", true},
+	}
+	for _, tt := range tests {
+		t.Run(string(tt.metric), func(t *testing.T) {
+			if err := tt.metric.Validate(); (err != nil) != tt.wantErr {
+				t.Errorf("Validate() error = %v, wantErr %v", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestBodyMetric_Site(t *testing.T) {
+	if site, ok := vos.BodyMetric("waist").Site(); !ok || site != vos.BodySiteWaist {
+		t.Errorf("Site() = %q, %v; want waist, true", site, ok)
+	}
+	if _, ok := vos.BodyMetricWeight.Site(); ok {
+		t.Error("weight must not be a circumference site")
+	}
+}
@@ -0,0 +1,24 @@
+package vos
+
+import (
+	"math"
+	"time"
+)
+
+// DefaultBodyWeightGrams is the reference body weight used when the user's weight is unknown.
+const DefaultBodyWeightGrams = 70000
+
+// EstimateCalories returns the energy expenditure in kcal of an activity with the given MET,
+// performed by someone weighing bodyWeightGrams for the given active time:
+// kcal = MET × kg × hours. Non-positive inputs yield 0; an unknown weight (<= 0) uses
+// DefaultBodyWeightGrams.
+func EstimateCalories(met float64, bodyWeightGrams int, active time.Duration) int {
+	if met <= 0 || active <= 0 {
+		return 0
+	}
+	if bodyWeightGrams <= 0 {
+		bodyWeightGrams = DefaultBodyWeightGrams
+	}
+	kg := float64(bodyWeightGrams) / 1000
+	return int(math.Round(met * kg * active.Hours()))
+}
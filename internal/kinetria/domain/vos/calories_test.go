@@ -0,0 +1,31 @@
+package vos_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/kinetria/kinetria-back/internal/kinetria/domain/vos"
+)
+
+func TestEstimateCalories(t *testing.T) {
+	tests := []struct {
+		name   string
+		met    float64
+		weight int
+		active time.Duration
+		want   int
+	}{
+		{"one_hour_80kg", 6, 80000, time.Hour, 480},
+		{"half_hour_60kg", 5, 60000, 30 * time.Minute, 150},
+		{"unknown_weight_uses_default", 5, 0, time.Hour, 350},
+		{"zero_duration", 6, 80000, 0, 0},
+		{"zero_met", 0, 80000, time.Hour, 0},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := vos.EstimateCalories(tt.met, tt.weight, tt.active); got != tt.want {
+				t.Errorf("EstimateCalories() = %d, want %d", got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,47 @@
+package vos
+
+import (
+	"fmt"
+
+	domerrors "github.com/kinetria/kinetria-back/internal/kinetria/domain/errors"
+)
+
+// ExerciseSort selects the ordering of the exercise library listing.
+type ExerciseSort string
+
+const (
+	ExerciseSortName       ExerciseSort = "name"
+	ExerciseSortPopularity ExerciseSort = "popularity"
+)
+
+func (s ExerciseSort) String() string {
+	return string(s)
+}
+
+func (s ExerciseSort) Validate() error {
+	switch s {
+	case ExerciseSortName, ExerciseSortPopularity:
+		return nil
+	}
+	return fmt.Errorf("invalid exercise sort %q: %w", string(s), domerrors.ErrMalformedParameters)
+}
+
+// RecentExerciseSort selects the ordering of a user's recently performed exercises.
+type RecentExerciseSort string
+
+const (
+	RecentExerciseSortRecent   RecentExerciseSort = "recent"
+	RecentExerciseSortFrequent RecentExerciseSort = "frequent"
+)
+
+func (s RecentExerciseSort) String() string {
+	return string(s)
+}
+
+func (s RecentExerciseSort) Validate() error {
+	switch s {
+	case RecentExerciseSortRecent, RecentExerciseSortFrequent:
+		return nil
+	}
+	return fmt.Errorf("invalid recent exercise sort %q: %w", string(s), domerrors.ErrMalformedParameters)
+}
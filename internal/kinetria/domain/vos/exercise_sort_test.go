@@ -0,0 +1,35 @@
+package vos_test
+
+import (
+	"errors"
+	"testing"
+
+	domerrors "github.com/kinetria/kinetria-back/internal/kinetria/domain/errors"
+	"github.com/kinetria/kinetria-back/internal/kinetria/domain/vos"
+)
+
+func TestExerciseSort_Validate(t *testing.T) {
+	for _, s := range []vos.ExerciseSort{vos.ExerciseSortName, vos.ExerciseSortPopularity} {
+		if err := s.Validate(); err != nil {
+			t.Errorf("expected no error for %s, got %v", s, err)
+		}
+	}
+	for _, s := range []vos.ExerciseSort{"", "Name", "recent"} {
+		if err := s.Validate(); !errors.Is(err, domerrors.ErrMalformedParameters) {
+			t.Errorf("expected ErrMalformedParameters for %q, got %v", s, err)
+		}
+	}
+}
+
+func TestRecentExerciseSort_Validate(t *testing.T) {
+	for _, s := range []vos.RecentExerciseSort{vos.RecentExerciseSortRecent, vos.RecentExerciseSortFrequent} {
+		if err := s.Validate(); err != nil {
+			t.Errorf("expected no error for %s, got %v", s, err)
+		}
+	}
+	for _, s := range []vos.RecentExerciseSort{"", "popularity"} {
+		if err := s.Validate(); !errors.Is(err, domerrors.ErrMalformedParameters) {
+			t.Errorf("expected ErrMalformedParameters for %q, got %v", s, err)
+		}
+	}
+}
@@ -0,0 +1,27 @@
+package vos
+
+import (
+	"fmt"
+
+	domerrors "github.com/kinetria/kinetria-back/internal/kinetria/domain/errors"
+)
+
+// GoalStatus is the state of a training goal.
+type GoalStatus string
+
+const (
+	GoalStatusActive   GoalStatus = "active"
+	GoalStatusAchieved GoalStatus = "achieved"
+)
+
+func (s GoalStatus) String() string {
+	return string(s)
+}
+
+func (s GoalStatus) Validate() error {
+	switch s {
+	case GoalStatusActive, GoalStatusAchieved:
+		return nil
+	}
+	return fmt.Errorf("invalid goal status %q: %w", string(s), domerrors.ErrMalformedParameters)
+}
@@ -0,0 +1,47 @@
+package vos
+
+import (
+	"fmt"
+
+	domerrors "github.com/kinetria/kinetria-back/internal/kinetria/domain/errors"
+)
+
+// GoalType is what a training goal measures. The target of each type is stored in its
+// canonical unit: grams for e1RM, weight and body weight, grams × reps for volume and
+// a session count for weekly sessions.
+type GoalType string
+
+const (
+	// GoalTypeExerciseE1RM targets the estimated one-rep max of an exercise.
+	GoalTypeExerciseE1RM GoalType = "exercise_e1rm"
+	// GoalTypeExerciseWeight targets the heaviest weight lifted in an exercise.
+	GoalTypeExerciseWeight GoalType = "exercise_weight"
+	// GoalTypeWeeklySessions targets the completed sessions in a week.
+	GoalTypeWeeklySessions GoalType = "weekly_sessions"
+	// GoalTypeMonthlyVolume targets the weight × reps lifted in a calendar month.
+	GoalTypeMonthlyVolume GoalType = "monthly_volume"
+	// GoalTypeBodyWeight targets the body weight, up or down from where it was set.
+	GoalTypeBodyWeight GoalType = "body_weight"
+)
+
+func (t GoalType) String() string {
+	return string(t)
+}
+
+func (t GoalType) Validate() error {
+	switch t {
+	case GoalTypeExerciseE1RM, GoalTypeExerciseWeight, GoalTypeWeeklySessions, GoalTypeMonthlyVolume, GoalTypeBodyWeight:
+		return nil
+	}
+	return fmt.Errorf("invalid goal type %q: %w", string(t), domerrors.ErrMalformedParameters)
+}
+
+// IsExercise reports whether goals of this type track a single exercise.
+func (t GoalType) IsExercise() bool {
+	return t == GoalTypeExerciseE1RM || t == GoalTypeExerciseWeight
+}
+
+// IsPeriodic reports whether progress restarts every week or month.
+func (t GoalType) IsPeriodic() bool {
+	return t == GoalTypeWeeklySessions || t == GoalTypeMonthlyVolume
+}
@@ -0,0 +1,59 @@
+package vos_test
+
+import (
+	"errors"
+	"testing"
+
+	domerrors "github.com/kinetria/kinetria-back/internal/kinetria/domain/errors"
+	"github.com/kinetria/kinetria-back/internal/kinetria/domain/vos"
+)
+
+func TestGoalType_Validate(t *testing.T) {
+	valid := []vos.GoalType{
+		vos.GoalTypeExerciseE1RM, vos.GoalTypeExerciseWeight, vos.GoalTypeWeeklySessions,
+		vos.GoalTypeMonthlyVolume, vos.GoalTypeBodyWeight,
+	}
+	for _, g := range valid {
+		if err := g.Validate(); err != nil {
+			t.Errorf("expected no error for %s, got %v", g, err)
+		}
+	}
+	for _, g := range []vos.GoalType{"", "e1rm", "BODY_WEIGHT"} {
+		if err := g.Validate(); !errors.Is(err, domerrors.ErrMalformedParameters) {
+			t.Errorf("expected ErrMalformedParameters for %q, got %v", g, err)
+		}
+	}
+}
+
+func TestGoalType_Kinds(t *testing.T) {
+	tests := []struct {
+		goal     vos.GoalType
+		exercise bool
+		periodic bool
+	}{
+		{vos.GoalTypeExerciseE1RM, true, false},
+		{vos.GoalTypeExerciseWeight, true, false},
+		{vos.GoalTypeWeeklySessions, false, true},
+		{vos.GoalTypeMonthlyVolume, false, true},
+		{vos.GoalTypeBodyWeight, false, false},
+	}
+	for _, tt := range tests {
+		if got := tt.goal.IsExercise(); got != tt.exercise {
+			t.Errorf("%s IsExercise() = %v, want %v", tt.goal, got, tt.exercise)
+		}
+		if got := tt.goal.IsPeriodic(); got != tt.periodic {
+			t.Errorf("%s IsPeriodic() = %v, want %v", tt.goal, got, tt.periodic)
+		}
+	}
+}
+
+func TestGoalStatus_Validate(t *testing.T) {
+	for _, s := range []vos.GoalStatus{vos.GoalStatusActive, vos.GoalStatusAchieved} {
+		if err := s.Validate(); err != nil {
+			t.Errorf("expected no error for %s, got %v", s, err)
+		}
+	}
+	if err := vos.GoalStatus("done").Validate(); !errors.Is(err, domerrors.ErrMalformedParameters) {
+		t.Errorf("expected ErrMalformedParameters, got %v", err)
+	}
+}
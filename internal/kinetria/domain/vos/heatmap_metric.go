@@ -0,0 +1,29 @@
+package vos
+
+import (
+	"fmt"
+
+	domerrors "github.com/kinetria/kinetria-back/internal/kinetria/domain/errors"
+)
+
+// HeatmapMetric selects what the intensity of a calendar heatmap day measures.
+type HeatmapMetric string
+
+const (
+	// HeatmapMetricVolume is the weight × reps of the day's completed sets.
+	HeatmapMetricVolume HeatmapMetric = "volume"
+	// HeatmapMetricDuration is the day's training time in minutes.
+	HeatmapMetricDuration HeatmapMetric = "duration"
+)
+
+func (m HeatmapMetric) String() string {
+	return string(m)
+}
+
+func (m HeatmapMetric) Validate() error {
+	switch m {
+	case HeatmapMetricVolume, HeatmapMetricDuration:
+		return nil
+	}
+	return fmt.Errorf("invalid heatmap metric %q: %w", string(m), domerrors.ErrMalformedParameters)
+}
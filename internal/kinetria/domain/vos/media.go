@@ -0,0 +1,48 @@
+package vos
+
+import (
+	"fmt"
+
+	domerrors "github.com/kinetria/kinetria-back/internal/kinetria/domain/errors"
+)
+
+// MediaOwnerType identifies the kind of entity an uploaded media file belongs to.
+type MediaOwnerType string
+
+const (
+	MediaOwnerExercise MediaOwnerType = "exercise"
+	MediaOwnerWorkout  MediaOwnerType = "workout"
+	MediaOwnerUser     MediaOwnerType = "user"
+)
+
+func (o MediaOwnerType) String() string {
+	return string(o)
+}
+
+func (o MediaOwnerType) Validate() error {
+	switch o {
+	case MediaOwnerExercise, MediaOwnerWorkout, MediaOwnerUser:
+		return nil
+	}
+	return fmt.Errorf("invalid media owner type %q: %w", string(o), domerrors.ErrMalformedParameters)
+}
+
+// MediaKind distinguishes still images from video files.
+type MediaKind string
+
+const (
+	MediaKindImage MediaKind = "image"
+	MediaKindVideo MediaKind = "video"
+)
+
+func (k MediaKind) String() string {
+	return string(k)
+}
+
+func (k MediaKind) Validate() error {
+	switch k {
+	case MediaKindImage, MediaKindVideo:
+		return nil
+	}
+	return fmt.Errorf("invalid media kind %q: %w", string(k), domerrors.ErrMalformedParameters)
+}
@@ -0,0 +1,33 @@
+package vos_test
+
+import (
+	"errors"
+	"testing"
+
+	domerrors "github.com/kinetria/kinetria-back/internal/kinetria/domain/errors"
+	"github.com/kinetria/kinetria-back/internal/kinetria/domain/vos"
+)
+
+func TestMediaOwnerType_Validate(t *testing.T) {
+	for _, o := range []vos.MediaOwnerType{vos.MediaOwnerExercise, vos.MediaOwnerWorkout, vos.MediaOwnerUser} {
+		if err := o.Validate(); err != nil {
+			t.Errorf("expected no error for %s, got %v", o, err)
+		}
+	}
+	err := vos.MediaOwnerType("session").Validate()
+	if !errors.Is(err, domerrors.ErrMalformedParameters) {
+		t.Errorf("expected ErrMalformedParameters, got %v", err)
+	}
+}
+
+func TestMediaKind_Validate(t *testing.T) {
+	for _, k := range []vos.MediaKind{vos.MediaKindImage, vos.MediaKindVideo} {
+		if err := k.Validate(); err != nil {
+			t.Errorf("expected no error for %s, got %v", k, err)
+		}
+	}
+	err := vos.MediaKind("audio").Validate()
+	if !errors.Is(err, domerrors.ErrMalformedParameters) {
+		t.Errorf("expected ErrMalformedParameters, got %v", err)
+	}
+}
@@ -0,0 +1,118 @@
+package vos
+
+import (
+	"fmt"
+
+	domerrors "github.com/kinetria/kinetria-back/internal/kinetria/domain/errors"
+)
+
+// MuscleGroup is a canonical muscle group from the exercise taxonomy.
+type MuscleGroup string
+
+const (
+	MuscleGroupChest      MuscleGroup = "chest"
+	MuscleGroupBack       MuscleGroup = "back"
+	MuscleGroupLowerBack  MuscleGroup = "lower_back"
+	MuscleGroupTraps      MuscleGroup = "traps"
+	MuscleGroupShoulders  MuscleGroup = "shoulders"
+	MuscleGroupBiceps     MuscleGroup = "biceps"
+	MuscleGroupTriceps    MuscleGroup = "triceps"
+	MuscleGroupForearms   MuscleGroup = "forearms"
+	MuscleGroupQuadriceps MuscleGroup = "quadriceps"
+	MuscleGroupHamstrings MuscleGroup = "hamstrings"
+	MuscleGroupGlutes     MuscleGroup = "glutes"
+	MuscleGroupCalves     MuscleGroup = "calves"
+	MuscleGroupCore       MuscleGroup = "core"
+	MuscleGroupObliques   MuscleGroup = "obliques"
+)
+
+// AllMuscleGroups returns the full canonical taxonomy in display order.
+func AllMuscleGroups() []MuscleGroup {
+	return []MuscleGroup{
+		MuscleGroupChest,
+		MuscleGroupBack,
+		MuscleGroupLowerBack,
+		MuscleGroupTraps,
+		MuscleGroupShoulders,
+		MuscleGroupBiceps,
+		MuscleGroupTriceps,
+		MuscleGroupForearms,
+		MuscleGroupQuadriceps,
+		MuscleGroupHamstrings,
+		MuscleGroupGlutes,
+		MuscleGroupCalves,
+		MuscleGroupCore,
+		MuscleGroupObliques,
+	}
+}
+
+// legacyMuscleLabels maps the free-text labels used in Exercise.Muscles to the taxonomy.
+var legacyMuscleLabels = map[string]MuscleGroup{
+	"Peito":         MuscleGroupChest,
+	"Costas":        MuscleGroupBack,
+	"Lombar":        MuscleGroupLowerBack,
+	"Trapézio":      MuscleGroupTraps,
+	"Ombros":        MuscleGroupShoulders,
+	"Bíceps":        MuscleGroupBiceps,
+	"Tríceps":       MuscleGroupTriceps,
+	"Antebraço":     MuscleGroupForearms,
+	"Quadríceps":    MuscleGroupQuadriceps,
+	"Pernas":        MuscleGroupQuadriceps,
+	"Isquiotibiais": MuscleGroupHamstrings,
+	"Glúteos":       MuscleGroupGlutes,
+	"Panturrilha":   MuscleGroupCalves,
+	"Core":          MuscleGroupCore,
+	"Oblíquos":      MuscleGroupObliques,
+}
+
+// MuscleGroupFromLabel resolves a legacy muscle label (e.g. "Peito") or a canonical
+// slug (e.g. "chest") to a MuscleGroup.
+func MuscleGroupFromLabel(label string) (MuscleGroup, bool) {
+	if mg, ok := legacyMuscleLabels[label]; ok {
+		return mg, true
+	}
+	mg := MuscleGroup(label)
+	return mg, mg.Validate() == nil
+}
+
+func (m MuscleGroup) String() string {
+	return string(m)
+}
+
+func (m MuscleGroup) Validate() error {
+	for _, g := range AllMuscleGroups() {
+		if m == g {
+			return nil
+		}
+	}
+	return fmt.Errorf("invalid muscle group %q: %w", string(m), domerrors.ErrMalformedParameters)
+}
+
+// MuscleRole describes how a muscle group participates in an exercise.
+type MuscleRole string
+
+const (
+	MuscleRolePrimary   MuscleRole = "primary"
+	MuscleRoleSecondary MuscleRole = "secondary"
+)
+
+func (r MuscleRole) String() string {
+	return string(r)
+}
+
+func (r MuscleRole) Validate() error {
+	switch r {
+	case MuscleRolePrimary, MuscleRoleSecondary:
+		return nil
+	}
+	return fmt.Errorf("invalid muscle role %q: %w", string(r), domerrors.ErrMalformedParameters)
+}
+
+// DefaultInvolvement returns the set fraction credited to a muscle with this role
+// when no explicit involvement is provided: a full set for primary movers, half for secondary.
+func (r MuscleRole) DefaultInvolvement() float64 {
+	if r == MuscleRolePrimary {
+		return 1.0
+	}
+	return 0.5
+}
@@ -0,0 +1,85 @@
+package vos_test
+
+import (
+	"errors"
+	"testing"
+
+	domerrors "github.com/kinetria/kinetria-back/internal/kinetria/domain/errors"
+	"github.com/kinetria/kinetria-back/internal/kinetria/domain/vos"
+)
+
+func TestMuscleGroup_Validate_ValidValues(t *testing.T) {
+	for _, mg := range vos.AllMuscleGroups() {
+		t.Run(mg.String(), func(t *testing.T) {
+			if err := mg.Validate(); err != nil {
+				t.Errorf("expected no error for %s, got %v", mg, err)
+			}
+		})
+	}
+}
+
+func TestMuscleGroup_Validate_InvalidValues(t *testing.T) {
+	tests := []struct {
+		name string
+		mg   vos.MuscleGroup
+	}{
+		{"legacy_label", vos.MuscleGroup("Peito")},
+		{"unknown", vos.MuscleGroup("neck")},
+		{"empty", vos.MuscleGroup("")},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.mg.Validate()
+			if err == nil {
+				t.Errorf("expected error for %q, got nil", tt.name)
+			}
+			if !errors.Is(err, domerrors.ErrMalformedParameters) {
+				t.Errorf("expected ErrMalformedParameters, got %v", err)
+			}
+		})
+	}
+}
+
+func TestMuscleRole_Validate(t *testing.T) {
+	if err := vos.MuscleRolePrimary.Validate(); err != nil {
+		t.Errorf("expected no error for primary, got %v", err)
+	}
+	if err := vos.MuscleRoleSecondary.Validate(); err != nil {
+		t.Errorf("expected no error for secondary, got %v", err)
+	}
+	err := vos.MuscleRole("stabilizer").Validate()
+	if !errors.Is(err, domerrors.ErrMalformedParameters) {
+		t.Errorf("expected ErrMalformedParameters, got %v", err)
+	}
+}
+
+func TestMuscleRole_DefaultInvolvement(t *testing.T) {
+	if got := vos.MuscleRolePrimary.DefaultInvolvement(); got != 1.0 {
+		t.Errorf("expected 1.0 for primary, got %v", got)
+	}
+	if got := vos.MuscleRoleSecondary.DefaultInvolvement(); got != 0.5 {
+		t.Errorf("expected 0.5 for secondary, got %v", got)
+	}
+}
+
+func TestMuscleGroupFromLabel(t *testing.T) {
+	tests := []struct {
+		label string
+		want  vos.MuscleGroup
+		ok    bool
+	}{
+		{"Peito", vos.MuscleGroupChest, true},
+		{"Pernas", vos.MuscleGroupQuadriceps, true},
+		{"glutes", vos.MuscleGroupGlutes, true},
+		{"Pescoço", "", false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.label, func(t *testing.T) {
+			got, ok := vos.MuscleGroupFromLabel(tt.label)
+			if ok != tt.ok || (ok && got != tt.want) {
+				t.Errorf("MuscleGroupFromLabel(%q) = %q, %v; want %q, %v", tt.label, got, ok, tt.want, tt.ok)
+			}
+		})
+	}
+}
@@ -0,0 +1,142 @@
+package vos
+
+import (
+	"fmt"
+	"math"
+
+	domerrors "github.com/kinetria/kinetria-back/internal/kinetria/domain/errors"
+)
+
+// Bounds of the readiness check-in markers.
+const (
+	MaxSleepHours = 24
+	// MinReadinessRating and MaxReadinessRating bound stress and soreness.
+	MinReadinessRating = 1
+	MaxReadinessRating = 5
+	// MinHRV and MaxHRV bound the heart rate variability (RMSSD, ms).
+	MinHRV = 1
+	MaxHRV = 300
+)
+
+const (
+	// targetSleepHours is the sleep that scores full marks.
+	targetSleepHours = 8
+	// hrvFloorRatio is the share of the HRV baseline at and below which HRV scores zero.
+	hrvFloorRatio = 0.8
+	// lowReadinessReduction is the cut applied to the prescription when readiness is low.
+	lowReadinessReduction = 0.10
+	// loadRoundingGrams is the step adjusted loads are rounded to.
+	loadRoundingGrams = 500
+)
+
+// ReadinessMarkers are the answers of a pre-workout readiness check-in.
+type ReadinessMarkers struct {
+	SleepHours float64
+	Stress     int  // 1 (tranquilo) a 5 (muito estressado)
+	Soreness   int  // 1 (sem dor) a 5 (muito dolorido)
+	HRV        *int // RMSSD em ms; nil quando o usuário não mede
+}
+
+// Validate checks every marker against its scale.
+func (m ReadinessMarkers) Validate() error {
+	if m.SleepHours < 0 || m.SleepHours > MaxSleepHours || math.IsNaN(m.SleepHours) {
+		return fmt.Errorf("invalid sleepHours %v: must be between 0 and %d: %w", m.SleepHours, MaxSleepHours, domerrors.ErrMalformedParameters)
+	}
+	if m.Stress < MinReadinessRating || m.Stress > MaxReadinessRating {
+		return fmt.Errorf("invalid stress %d: must be between %d and %d: %w", m.Stress, MinReadinessRating, MaxReadinessRating, domerrors.ErrMalformedParameters)
+	}
+	if m.Soreness < MinReadinessRating || m.Soreness > MaxReadinessRating {
+		return fmt.Errorf("invalid soreness %d: must be between %d and %d: %w", m.Soreness, MinReadinessRating, MaxReadinessRating, domerrors.ErrMalformedParameters)
+	}
+	if m.HRV != nil && (*m.HRV < MinHRV || *m.HRV > MaxHRV) {
+		return fmt.Errorf("invalid hrv %d: must be between %d and %d: %w", *m.HRV, MinHRV, MaxHRV, domerrors.ErrMalformedParameters)
+	}
+	return nil
+}
+
+// Score returns the readiness score, 0–100. Sleep (full marks at 8 hours), stress and
+// soreness weigh 40/30/30. When both the HRV and the user's HRV baseline are known, HRV
+// takes 30 points and the others 30/20/20; HRV scores full marks at or above the baseline
+// and zero at 80% of it or below.
+func (m ReadinessMarkers) Score(hrvBaseline *float64) int {
+	sleep := math.Min(m.SleepHours/targetSleepHours, 1)
+	stress := float64(MaxReadinessRating-m.Stress) / (MaxReadinessRating - MinReadinessRating)
+	soreness := float64(MaxReadinessRating-m.Soreness) / (MaxReadinessRating - MinReadinessRating)
+
+	score := 0.4*sleep + 0.3*stress + 0.3*soreness
+	if m.HRV != nil && hrvBaseline != nil && *hrvBaseline > 0 {
+		ratio := float64(*m.HRV) / *hrvBaseline
+		hrv := math.Max(0, math.Min(1, (ratio-hrvFloorRatio)/(1-hrvFloorRatio)))
+		score = 0.3*sleep + 0.2*stress + 0.2*soreness + 0.3*hrv
+	}
+	return int(math.Round(score * 100))
+}
+
+// ReadinessLevel classifies a readiness score.
+type ReadinessLevel string
+
+const (
+	ReadinessLevelLow      ReadinessLevel = "low"      // abaixo de 50
+	ReadinessLevelModerate ReadinessLevel = "moderate" // 50 a 69
+	ReadinessLevelHigh     ReadinessLevel = "high"     // 70 ou mais
+)
+
+// ReadinessLevelFor returns the level of a readiness score.
+func ReadinessLevelFor(score int) ReadinessLevel {
+	switch {
+	case score < 50:
+		return ReadinessLevelLow
+	case score < 70:
+		return ReadinessLevelModerate
+	default:
+		return ReadinessLevelHigh
+	}
+}
+
+func (l ReadinessLevel) String() string {
+	return string(l)
+}
+
+// Reduction is the share the day's prescription is cut by at this level.
+func (l ReadinessLevel) Reduction() float64 {
+	if l == ReadinessLevelLow {
+		return lowReadinessReduction
+	}
+	return 0
+}
+
+// ReadinessAdjustment is what a low readiness scales down in the day's prescription.
+type ReadinessAdjustment string
+
+const (
+	ReadinessAdjustmentLoad   ReadinessAdjustment = "load"   // reduz a carga de cada série
+	ReadinessAdjustmentVolume ReadinessAdjustment = "volume" // reduz o número de séries
+)
+
+func (a ReadinessAdjustment) String() string {
+	return string(a)
+}
+
+func (a ReadinessAdjustment) Validate() error {
+	switch a {
+	case ReadinessAdjustmentLoad, ReadinessAdjustmentVolume:
+		return nil
+	}
+	return fmt.Errorf("invalid readiness adjustment %q: %w", string(a), domerrors.ErrMalformedParameters)
+}
+
+// Apply scales a prescription of sets × weight (grams) down by reduction. Loads are
+// rounded down to 0.5 kg; volume drops at least one set while keeping one.
+func (a ReadinessAdjustment) Apply(sets, weight int, reduction float64) (int, int) {
+	if reduction <= 0 {
+		return sets, weight
+	}
+	switch a {
+	case ReadinessAdjustmentLoad:
+		scaled := int(float64(weight) * (1 - reduction))
+		weight = scaled - scaled%loadRoundingGrams
+	case ReadinessAdjustmentVolume:
+		sets = max(1, sets-int(math.Ceil(float64(sets)*reduction)))
+	}
+	return sets, weight
+}
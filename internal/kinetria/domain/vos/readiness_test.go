@@ -0,0 +1,120 @@
+package vos_test
+
+import (
+	"errors"
+	"testing"
+
+	domerrors "github.com/kinetria/kinetria-back/internal/kinetria/domain/errors"
+	"github.com/kinetria/kinetria-back/internal/kinetria/domain/vos"
+)
+
+func TestReadinessMarkers_Validate(t *testing.T) {
+	v := func(n int) *int { return &n }
+	tests := []struct {
+		name    string
+		markers vos.ReadinessMarkers
+		wantErr bool
+	}{
+		{"valid", vos.ReadinessMarkers{SleepHours: 7.5, Stress: 2, Soreness: 3}, false},
+		{"with_hrv", vos.ReadinessMarkers{SleepHours: 6, Stress: 5, Soreness: 1, HRV: v(65)}, false},
+		{"negative_sleep", vos.ReadinessMarkers{SleepHours: -1, Stress: 2, Soreness: 2}, true},
+		{"too_much_sleep", vos.ReadinessMarkers{SleepHours: 25, Stress: 2, Soreness: 2}, true},
+		{"stress_missing", vos.ReadinessMarkers{SleepHours: 8, Soreness: 2}, true},
+		{"soreness_above_five", vos.ReadinessMarkers{SleepHours: 8, Stress: 2, Soreness: 6}, true},
+		{"hrv_zero", vos.ReadinessMarkers{SleepHours: 8, Stress: 2, Soreness: 2, HRV: v(0)}, true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.markers.Validate()
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("Validate() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if err != nil && !errors.Is(err, domerrors.ErrMalformedParameters) {
+				t.Errorf("expected ErrMalformedParameters, got %v", err)
+			}
+		})
+	}
+}
+
+func TestReadinessMarkers_Score(t *testing.T) {
+	v := func(n int) *int { return &n }
+	baseline := 60.0
+	tests := []struct {
+		name     string
+		markers  vos.ReadinessMarkers
+		baseline *float64
+		want     int
+	}{
+		{"best", vos.ReadinessMarkers{SleepHours: 9, Stress: 1, Soreness: 1}, nil, 100},
+		{"worst", vos.ReadinessMarkers{SleepHours: 0, Stress: 5, Soreness: 5}, nil, 0},
+		// 0.4×0.75 + 0.3×0.5 + 0.3×0.25
+		{"mixed", vos.ReadinessMarkers{SleepHours: 6, Stress: 3, Soreness: 4}, nil, 53},
+		{"hrv_without_baseline_is_ignored", vos.ReadinessMarkers{SleepHours: 8, Stress: 1, Soreness: 1, HRV: v(30)}, nil, 100},
+		// 0.3 + 0.2 + 0.2 + 0.3×0.5 (HRV a 90% da linha de base)
+		{"hrv_below_baseline", vos.ReadinessMarkers{SleepHours: 8, Stress: 1, Soreness: 1, HRV: v(54)}, &baseline, 85},
+		{"hrv_above_baseline", vos.ReadinessMarkers{SleepHours: 8, Stress: 1, Soreness: 1, HRV: v(70)}, &baseline, 100},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.markers.Score(tt.baseline); got != tt.want {
+				t.Errorf("Score() = %d, want %d", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestReadinessLevelFor(t *testing.T) {
+	tests := []struct {
+		score int
+		want  vos.ReadinessLevel
+	}{
+		{0, vos.ReadinessLevelLow},
+		{49, vos.ReadinessLevelLow},
+		{50, vos.ReadinessLevelModerate},
+		{69, vos.ReadinessLevelModerate},
+		{70, vos.ReadinessLevelHigh},
+		{100, vos.ReadinessLevelHigh},
+	}
+	for _, tt := range tests {
+		if got := vos.ReadinessLevelFor(tt.score); got != tt.want {
+			t.Errorf("ReadinessLevelFor(%d) = %s, want %s", tt.score, got, tt.want)
+		}
+	}
+}
+
+func TestReadinessAdjustment_Apply(t *testing.T) {
+	tests := []struct {
+		name       string
+		adjustment vos.ReadinessAdjustment
+		sets       int
+		weight     int
+		reduction  float64
+		wantSets   int
+		wantWeight int
+	}{
+		{"load", vos.ReadinessAdjustmentLoad, 4, 82500, 0.1, 4, 74000},
+		{"load_bodyweight", vos.ReadinessAdjustmentLoad, 3, 0, 0.1, 3, 0},
+		{"volume_drops_a_set", vos.ReadinessAdjustmentVolume, 3, 60000, 0.1, 2, 60000},
+		{"volume_keeps_one_set", vos.ReadinessAdjustmentVolume, 1, 60000, 0.1, 1, 60000},
+		{"no_reduction", vos.ReadinessAdjustmentLoad, 3, 60000, 0, 3, 60000},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			sets, weight := tt.adjustment.Apply(tt.sets, tt.weight, tt.reduction)
+			if sets != tt.wantSets || weight != tt.wantWeight {
+				t.Errorf("Apply() = (%d, %d), want (%d, %d)", sets, weight, tt.wantSets, tt.wantWeight)
+			}
+		})
+	}
+}
+
+func TestReadinessAdjustment_Validate(t *testing.T) {
+	for _, a := range []vos.ReadinessAdjustment{vos.ReadinessAdjustmentLoad, vos.ReadinessAdjustmentVolume} {
+		if err := a.Validate(); err != nil {
+			t.Errorf("Validate(%q) unexpected error: %v", a, err)
+		}
+	}
+	if err := vos.ReadinessAdjustment("intensity").Validate(); !errors.Is(err, domerrors.ErrMalformedParameters) {
+		t.Errorf("expected ErrMalformedParameters, got %v", err)
+	}
+}
@@ -0,0 +1,38 @@
+package vos
+
+import (
+	"fmt"
+	"time"
+
+	domerrors "github.com/kinetria/kinetria-back/internal/kinetria/domain/errors"
+)
+
+// RecapPeriod is the calendar period covered by a training recap.
+type RecapPeriod string
+
+const (
+	RecapPeriodMonth RecapPeriod = "month"
+	RecapPeriodYear  RecapPeriod = "year"
+)
+
+func (p RecapPeriod) String() string {
+	return string(p)
+}
+
+func (p RecapPeriod) Validate() error {
+	switch p {
+	case RecapPeriodMonth, RecapPeriodYear:
+		return nil
+	}
+	return fmt.Errorf("invalid recap period %q: %w", string(p), domerrors.ErrMalformedParameters)
+}
+
+// Bounds returns the first and last calendar day (as UTC midnight) of the period containing day.
+func (p RecapPeriod) Bounds(day time.Time) (time.Time, time.Time) {
+	if p == RecapPeriodYear {
+		first := time.Date(day.Year(), time.January, 1, 0, 0, 0, 0, time.UTC)
+		return first, first.AddDate(1, 0, -1)
+	}
+	first := time.Date(day.Year(), day.Month(), 1, 0, 0, 0, 0, time.UTC)
+	return first, first.AddDate(0, 1, -1)
+}
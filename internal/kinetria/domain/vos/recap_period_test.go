@@ -0,0 +1,41 @@
+package vos_test
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	domerrors "github.com/kinetria/kinetria-back/internal/kinetria/domain/errors"
+	"github.com/kinetria/kinetria-back/internal/kinetria/domain/vos"
+)
+
+func TestRecapPeriod_Validate(t *testing.T) {
+	for _, p := range []vos.RecapPeriod{vos.RecapPeriodMonth, vos.RecapPeriodYear} {
+		if err := p.Validate(); err != nil {
+			t.Errorf("expected no error for %s, got %v", p, err)
+		}
+	}
+	for _, p := range []vos.RecapPeriod{"", "week", "YEAR"} {
+		if err := p.Validate(); !errors.Is(err, domerrors.ErrMalformedParameters) {
+			t.Errorf("expected ErrMalformedParameters for %q, got %v", p, err)
+		}
+	}
+}
+
+func TestRecapPeriod_Bounds(t *testing.T) {
+	day := time.Date(2024, time.February, 17, 0, 0, 0, 0, time.UTC)
+	tests := []struct {
+		period    vos.RecapPeriod
+		wantFirst time.Time
+		wantLast  time.Time
+	}{
+		{vos.RecapPeriodMonth, time.Date(2024, 2, 1, 0, 0, 0, 0, time.UTC), time.Date(2024, 2, 29, 0, 0, 0, 0, time.UTC)},
+		{vos.RecapPeriodYear, time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC), time.Date(2024, 12, 31, 0, 0, 0, 0, time.UTC)},
+	}
+	for _, tt := range tests {
+		first, last := tt.period.Bounds(day)
+		if !first.Equal(tt.wantFirst) || !last.Equal(tt.wantLast) {
+			t.Errorf("%s bounds = %s..%s, want %s..%s", tt.period, first, last, tt.wantFirst, tt.wantLast)
+		}
+	}
+}
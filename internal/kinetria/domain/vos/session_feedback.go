@@ -0,0 +1,65 @@
+package vos
+
+import (
+	"fmt"
+
+	domerrors "github.com/kinetria/kinetria-back/internal/kinetria/domain/errors"
+)
+
+// Bounds of the post-session feedback scales.
+const (
+	// MinSessionRPE and MaxSessionRPE bound the session RPE (CR-10 scale).
+	MinSessionRPE = 1
+	MaxSessionRPE = 10
+	// MinWellnessRating and MaxWellnessRating bound mood, energy, sleep quality and soreness.
+	MinWellnessRating = 1
+	MaxWellnessRating = 5
+)
+
+// SessionFeedback is the lifter's structured feedback on a session. Every field is
+// optional: nil ratings were not given and muscle groups missing from Soreness are not sore.
+type SessionFeedback struct {
+	RPE          *int // esforço percebido da sessão, 1–10
+	Mood         *int // 1 (péssimo) a 5 (ótimo)
+	Energy       *int // 1 (exausto) a 5 (muito disposto)
+	SleepQuality *int // qualidade do sono da noite anterior, 1 a 5
+	Soreness     map[MuscleGroup]int
+}
+
+// IsZero reports whether no feedback was given.
+func (f SessionFeedback) IsZero() bool {
+	return f.RPE == nil && f.Mood == nil && f.Energy == nil && f.SleepQuality == nil && len(f.Soreness) == 0
+}
+
+// Validate checks every given rating against its scale and the soreness muscle groups
+// against the taxonomy.
+func (f SessionFeedback) Validate() error {
+	if err := validateRating("rpe", f.RPE, MinSessionRPE, MaxSessionRPE); err != nil {
+		return err
+	}
+	if err := validateRating("mood", f.Mood, MinWellnessRating, MaxWellnessRating); err != nil {
+		return err
+	}
+	if err := validateRating("energy", f.Energy, MinWellnessRating, MaxWellnessRating); err != nil {
+		return err
+	}
+	if err := validateRating("sleepQuality", f.SleepQuality, MinWellnessRating, MaxWellnessRating); err != nil {
+		return err
+	}
+	for group, v := range f.Soreness {
+		if err := group.Validate(); err != nil {
+			return err
+		}
+		if v < MinWellnessRating || v > MaxWellnessRating {
+			return fmt.Errorf("invalid soreness %d for %s: must be between %d and %d: %w", v, group, MinWellnessRating, MaxWellnessRating, domerrors.ErrMalformedParameters)
+		}
+	}
+	return nil
+}
+
+func validateRating(name string, v *int, lo, hi int) error {
+	if v != nil && (*v < lo || *v > hi) {
+		return fmt.Errorf("invalid %s %d: must be between %d and %d: %w", name, *v, lo, hi, domerrors.ErrMalformedParameters)
+	}
+	return nil
+}
@@ -0,0 +1,48 @@
+package vos_test
+
+import (
+	"errors"
+	"testing"
+
+	domerrors "github.com/kinetria/kinetria-back/internal/kinetria/domain/errors"
+	"github.com/kinetria/kinetria-back/internal/kinetria/domain/vos"
+)
+
+func TestSessionFeedback_Validate(t *testing.T) {
+	v := func(n int) *int { return &n }
+	tests := []struct {
+		name     string
+		feedback vos.SessionFeedback
+		wantErr  bool
+	}{
+		{"empty", vos.SessionFeedback{}, false},
+		{"full", vos.SessionFeedback{RPE: v(10), Mood: v(1), Energy: v(5), SleepQuality: v(3), Soreness: map[vos.MuscleGroup]int{vos.MuscleGroupChest: 4}}, false},
+		{"rpe_out_of_range", vos.SessionFeedback{RPE: v(11)}, true},
+		{"mood_zero", vos.SessionFeedback{Mood: v(0)}, true},
+		{"energy_above_five", vos.SessionFeedback{Energy: v(6)}, true},
+		{"sleep_negative", vos.SessionFeedback{SleepQuality: v(-1)}, true},
+		{"unknown_muscle", vos.SessionFeedback{Soreness: map[vos.MuscleGroup]int{"neck": 2}}, true},
+		{"soreness_out_of_range", vos.SessionFeedback{Soreness: map[vos.MuscleGroup]int{vos.MuscleGroupGlutes: 0}}, true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.feedback.Validate()
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("Validate() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if err != nil && !errors.Is(err, domerrors.ErrMalformedParameters) {
+				t.Errorf("expected ErrMalformedParameters, got %v", err)
+			}
+		})
+	}
+}
+
+func TestSessionFeedback_IsZero(t *testing.T) {
+	if !(vos.SessionFeedback{Soreness: map[vos.MuscleGroup]int{}}).IsZero() {
+		t.Error("expected empty feedback to be zero")
+	}
+	mood := 3
+	if (vos.SessionFeedback{Mood: &mood}).IsZero() {
+		t.Error("expected feedback with mood not to be zero")
+	}
+}
@@ -0,0 +1,125 @@
+package vos
+
+import (
+	"fmt"
+	"math"
+
+	domerrors "github.com/kinetria/kinetria-back/internal/kinetria/domain/errors"
+)
+
+// BigLift is one of the three powerlifting lifts. Library exercises are mapped to a
+// big lift through a tag with the same name.
+type BigLift string
+
+const (
+	BigLiftSquat    BigLift = "squat"
+	BigLiftBench    BigLift = "bench"
+	BigLiftDeadlift BigLift = "deadlift"
+)
+
+// AllBigLifts returns the big lifts in competition order.
+func AllBigLifts() []BigLift {
+	return []BigLift{BigLiftSquat, BigLiftBench, BigLiftDeadlift}
+}
+
+func (l BigLift) String() string {
+	return string(l)
+}
+
+func (l BigLift) Validate() error {
+	for _, lift := range AllBigLifts() {
+		if l == lift {
+			return nil
+		}
+	}
+	return fmt.Errorf("invalid big lift %q: %w", string(l), domerrors.ErrMalformedParameters)
+}
+
+// MaxEstimateReps is the highest rep count a one-rep max is estimated from;
+// past it the Epley formula overestimates too much.
+const MaxEstimateReps = 10
+
+// EstimateOneRepMax returns the Epley estimated one-rep max, in grams, of a set of reps
+// with weightGrams: weight × (1 + reps/30). A single is its own max. Sets outside
+// 1..MaxEstimateReps reps or without weight yield 0.
+func EstimateOneRepMax(weightGrams, reps int) int {
+	if weightGrams <= 0 || reps < 1 || reps > MaxEstimateReps {
+		return 0
+	}
+	if reps == 1 {
+		return weightGrams
+	}
+	return int(math.Round(float64(weightGrams) * (1 + float64(reps)/30)))
+}
+
+// DOTSScore returns the DOTS points of lifting totalKg at bodyWeightKg.
+// Returns 0 when sex is not set or an input is not positive.
+func DOTSScore(sex Sex, totalKg, bodyWeightKg float64) float64 {
+	if totalKg <= 0 || bodyWeightKg <= 0 {
+		return 0
+	}
+	var coef []float64
+	switch sex {
+	case SexMale:
+		bodyWeightKg = clamp(bodyWeightKg, 40, 210)
+		coef = []float64{-307.75076, 24.0900756, -0.1918759221, 0.0007391293, -0.000001093}
+	case SexFemale:
+		bodyWeightKg = clamp(bodyWeightKg, 40, 150)
+		coef = []float64{-57.96288, 13.6175032, -0.1126655495, 0.0005158568, -0.0000010706}
+	default:
+		return 0
+	}
+	return totalKg * 500 / polynomial(coef, bodyWeightKg)
+}
+
+// WilksScore returns the (original) Wilks points of lifting totalKg at bodyWeightKg.
+// Returns 0 when sex is not set or an input is not positive.
+func WilksScore(sex Sex, totalKg, bodyWeightKg float64) float64 {
+	if totalKg <= 0 || bodyWeightKg <= 0 {
+		return 0
+	}
+	var coef []float64
+	switch sex {
+	case SexMale:
+		bodyWeightKg = clamp(bodyWeightKg, 40, 201.9)
+		coef = []float64{-216.0475144, 16.2606339, -0.002388645, -0.00113732, 7.01863e-06, -1.291e-08}
+	case SexFemale:
+		bodyWeightKg = clamp(bodyWeightKg, 26.51, 154.53)
+		coef = []float64{594.31747775582, -27.23842536447, 0.82112226871, -0.00930733913, 4.731582e-05, -9.054e-08}
+	default:
+		return 0
+	}
+	return totalKg * 500 / polynomial(coef, bodyWeightKg)
+}
+
+// IPFGLScore returns the IPF GL points (classic/raw powerlifting) of lifting totalKg
+// at bodyWeightKg. Returns 0 when sex is not set or an input is not positive.
+func IPFGLScore(sex Sex, totalKg, bodyWeightKg float64) float64 {
+	if totalKg <= 0 || bodyWeightKg <= 0 {
+		return 0
+	}
+	var a, b, c float64
+	switch sex {
+	case SexMale:
+		a, b, c = 1199.72839, 1025.18162, 0.00921
+	case SexFemale:
+		a, b, c = 610.32796, 1045.59282, 0.03048
+	default:
+		return 0
+	}
+	return totalKg * 100 / (a - b*math.Exp(-c*bodyWeightKg))
+}
+
+// polynomial evaluates coef[0] + coef[1]·x + coef[2]·x² + ...
+func polynomial(coef []float64, x float64) float64 {
+	var sum, pow float64 = 0, 1
+	for _, c := range coef {
+		sum += c * pow
+		pow *= x
+	}
+	return sum
+}
+
+func clamp(v, lo, hi float64) float64 {
+	return math.Max(lo, math.Min(hi, v))
+}
@@ -0,0 +1,71 @@
+package vos_test
+
+import (
+	"math"
+	"testing"
+
+	"github.com/kinetria/kinetria-back/internal/kinetria/domain/vos"
+)
+
+func TestBigLift_Validate(t *testing.T) {
+	for _, l := range vos.AllBigLifts() {
+		if err := l.Validate(); err != nil {
+			t.Errorf("expected %q to be valid, got %v", l, err)
+		}
+	}
+	if err := vos.BigLift("curl").Validate(); err == nil {
+		t.Error("expected error for unknown lift")
+	}
+}
+
+func TestEstimateOneRepMax(t *testing.T) {
+	tests := []struct {
+		name   string
+		weight int
+		reps   int
+		want   int
+	}{
+		{"single_is_its_own_max", 100000, 1, 100000},
+		{"five_reps", 100000, 5, 116667},
+		{"ten_reps", 60000, 10, 80000},
+		{"too_many_reps", 60000, 11, 0},
+		{"no_reps", 60000, 0, 0},
+		{"no_weight", 0, 5, 0},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := vos.EstimateOneRepMax(tt.weight, tt.reps); got != tt.want {
+				t.Errorf("EstimateOneRepMax() = %d, want %d", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestStrengthScores(t *testing.T) {
+	tests := []struct {
+		name  string
+		score func(vos.Sex, float64, float64) float64
+		sex   vos.Sex
+		total float64
+		bw    float64
+		want  float64
+	}{
+		{"dots_male", vos.DOTSScore, vos.SexMale, 600, 100, 369.31},
+		{"dots_female", vos.DOTSScore, vos.SexFemale, 400, 60, 443.42},
+		{"dots_male_bodyweight_capped", vos.DOTSScore, vos.SexMale, 600, 250, 297.37},
+		{"wilks_male", vos.WilksScore, vos.SexMale, 600, 100, 365.15},
+		{"wilks_female", vos.WilksScore, vos.SexFemale, 400, 60, 445.95},
+		{"ipf_gl_male", vos.IPFGLScore, vos.SexMale, 600, 100, 75.80},
+		{"ipf_gl_female", vos.IPFGLScore, vos.SexFemale, 400, 60, 90.42},
+		{"unset_sex", vos.DOTSScore, "", 600, 100, 0},
+		{"unknown_bodyweight", vos.WilksScore, vos.SexMale, 600, 0, 0},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := tt.score(tt.sex, tt.total, tt.bw)
+			if math.Abs(got-tt.want) > 0.01 {
+				t.Errorf("score = %.4f, want %.2f", got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,45 @@
+package vos
+
+import (
+	"fmt"
+
+	domerrors "github.com/kinetria/kinetria-back/internal/kinetria/domain/errors"
+)
+
+// SyncOperationType is the kind of an offline operation replayed by the sync endpoint.
+type SyncOperationType string
+
+const (
+	SyncOperationStartSession   SyncOperationType = "session.start"
+	SyncOperationRecordSet      SyncOperationType = "set.record"
+	SyncOperationFinishSession  SyncOperationType = "session.finish"
+	SyncOperationAbandonSession SyncOperationType = "session.abandon"
+)
+
+func (t SyncOperationType) String() string {
+	return string(t)
+}
+
+func (t SyncOperationType) Validate() error {
+	switch t {
+	case SyncOperationStartSession, SyncOperationRecordSet, SyncOperationFinishSession, SyncOperationAbandonSession:
+		return nil
+	}
+	return fmt.Errorf("invalid sync operation type %q: %w", string(t), domerrors.ErrMalformedParameters)
+}
+
+// SyncOperationStatus is the outcome of an offline operation.
+type SyncOperationStatus string
+
+const (
+	// SyncOperationApplied: a operação foi aplicada agora.
+	SyncOperationApplied SyncOperationStatus = "applied"
+	// SyncOperationDuplicate: a operação já tinha sido aplicada em um sync anterior.
+	SyncOperationDuplicate SyncOperationStatus = "duplicate"
+	// SyncOperationRejected: a operação conflita com o estado do servidor ou é inválida.
+	SyncOperationRejected SyncOperationStatus = "rejected"
+)
+
+func (s SyncOperationStatus) String() string {
+	return string(s)
+}
@@ -0,0 +1,27 @@
+package vos_test
+
+import (
+	"errors"
+	"testing"
+
+	domerrors "github.com/kinetria/kinetria-back/internal/kinetria/domain/errors"
+	"github.com/kinetria/kinetria-back/internal/kinetria/domain/vos"
+)
+
+func TestSyncOperationType_Validate(t *testing.T) {
+	for _, valid := range []vos.SyncOperationType{
+		vos.SyncOperationStartSession,
+		vos.SyncOperationRecordSet,
+		vos.SyncOperationFinishSession,
+		vos.SyncOperationAbandonSession,
+	} {
+		if err := valid.Validate(); err != nil {
+			t.Errorf("expected no error for %s, got %v", valid, err)
+		}
+	}
+
+	err := vos.SyncOperationType("session.delete").Validate()
+	if !errors.Is(err, domerrors.ErrMalformedParameters) {
+		t.Errorf("expected ErrMalformedParameters, got %v", err)
+	}
+}
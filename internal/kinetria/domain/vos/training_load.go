@@ -0,0 +1,29 @@
+package vos
+
+import (
+	"fmt"
+
+	domerrors "github.com/kinetria/kinetria-back/internal/kinetria/domain/errors"
+)
+
+// LoadMethod selects how the training load of a session is measured.
+type LoadMethod string
+
+const (
+	// LoadMethodSRPE is Foster's session RPE: duration in minutes × session RPE (arbitrary units).
+	LoadMethodSRPE LoadMethod = "srpe"
+	// LoadMethodTonnage is the weight × reps of the completed sets.
+	LoadMethodTonnage LoadMethod = "tonnage"
+)
+
+func (m LoadMethod) String() string {
+	return string(m)
+}
+
+func (m LoadMethod) Validate() error {
+	switch m {
+	case LoadMethodSRPE, LoadMethodTonnage:
+		return nil
+	}
+	return fmt.Errorf("invalid load method %q: %w", string(m), domerrors.ErrMalformedParameters)
+}
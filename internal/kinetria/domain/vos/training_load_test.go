@@ -0,0 +1,22 @@
+package vos_test
+
+import (
+	"errors"
+	"testing"
+
+	domerrors "github.com/kinetria/kinetria-back/internal/kinetria/domain/errors"
+	"github.com/kinetria/kinetria-back/internal/kinetria/domain/vos"
+)
+
+func TestLoadMethod_Validate(t *testing.T) {
+	for _, m := range []vos.LoadMethod{vos.LoadMethodSRPE, vos.LoadMethodTonnage} {
+		if err := m.Validate(); err != nil {
+			t.Errorf("expected no error for %s, got %v", m, err)
+		}
+	}
+	for _, m := range []vos.LoadMethod{"", "SRPE", "volume"} {
+		if err := m.Validate(); !errors.Is(err, domerrors.ErrMalformedParameters) {
+			t.Errorf("expected ErrMalformedParameters for %q, got %v", m, err)
+		}
+	}
+}
@@ -0,0 +1,104 @@
+package vos
+
+import (
+	"fmt"
+	"math"
+
+	domerrors "github.com/kinetria/kinetria-back/internal/kinetria/domain/errors"
+)
+
+// UnitSystem selects the units weights are exchanged in at the API boundary.
+// Weights are always stored as integer grams.
+type UnitSystem string
+
+const (
+	UnitSystemMetric   UnitSystem = "metric"
+	UnitSystemImperial UnitSystem = "imperial"
+)
+
+// WeightUnit is the unit a weight value is expressed in.
+type WeightUnit string
+
+const (
+	WeightUnitKilogram WeightUnit = "kg"
+	WeightUnitPound    WeightUnit = "lb"
+)
+
+// LengthUnit is the unit a body length (circumference) is expressed in.
+type LengthUnit string
+
+const (
+	LengthUnitCentimeter LengthUnit = "cm"
+	LengthUnitInch       LengthUnit = "in"
+)
+
+// gramsPerPound is the exact international avoirdupois pound.
+const gramsPerPound = 453.59237
+
+// millimetersPerInch is the exact international inch.
+const millimetersPerInch = 25.4
+
+func (u UnitSystem) String() string {
+	return string(u)
+}
+
+func (u UnitSystem) Validate() error {
+	switch u {
+	case UnitSystemMetric, UnitSystemImperial:
+		return nil
+	}
+	return fmt.Errorf("invalid unit system %q: %w", string(u), domerrors.ErrMalformedParameters)
+}
+
+// WeightUnit returns the weight unit used by the system (kg unless imperial).
+func (u UnitSystem) WeightUnit() WeightUnit {
+	if u == UnitSystemImperial {
+		return WeightUnitPound
+	}
+	return WeightUnitKilogram
+}
+
+// FromGrams converts a canonical gram value to the system's weight unit.
+// Kilograms keep gram precision; pounds are rounded to 0.01 lb, so any weight
+// entered with up to two decimals (e.g. a 2.5 lb plate) reads back exactly.
+func (u UnitSystem) FromGrams(grams int64) float64 {
+	if u.WeightUnit() == WeightUnitPound {
+		return math.Round(float64(grams)/gramsPerPound*100) / 100
+	}
+	return float64(grams) / 1000
+}
+
+// ToGrams converts a weight in the system's unit to canonical grams, rounded
+// to the nearest gram.
+func (u UnitSystem) ToGrams(weight float64) int64 {
+	if u.WeightUnit() == WeightUnitPound {
+		return int64(math.Round(weight * gramsPerPound))
+	}
+	return int64(math.Round(weight * 1000))
+}
+
+// LengthUnit returns the length unit used by the system (cm unless imperial).
+func (u UnitSystem) LengthUnit() LengthUnit {
+	if u == UnitSystemImperial {
+		return LengthUnitInch
+	}
+	return LengthUnitCentimeter
+}
+
+// FromMillimeters converts a canonical millimeter value to the system's length unit,
+// rounded to one decimal (0.1 cm or 0.1 in, both coarser than a millimeter).
+func (u UnitSystem) FromMillimeters(mm int64) float64 {
+	if u.LengthUnit() == LengthUnitInch {
+		return math.Round(float64(mm)/millimetersPerInch*10) / 10
+	}
+	return float64(mm) / 10
+}
+
+// ToMillimeters converts a length in the system's unit to canonical millimeters,
+// rounded to the nearest millimeter.
+func (u UnitSystem) ToMillimeters(length float64) int64 {
+	if u.LengthUnit() == LengthUnitInch {
+		return int64(math.Round(length * millimetersPerInch))
+	}
+	return int64(math.Round(length * 10))
+}
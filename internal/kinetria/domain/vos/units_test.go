@@ -0,0 +1,89 @@
+package vos_test
+
+import (
+	"errors"
+	"testing"
+
+	domerrors "github.com/kinetria/kinetria-back/internal/kinetria/domain/errors"
+	"github.com/kinetria/kinetria-back/internal/kinetria/domain/vos"
+)
+
+func TestUnitSystem_Validate(t *testing.T) {
+	for _, u := range []vos.UnitSystem{vos.UnitSystemMetric, vos.UnitSystemImperial} {
+		if err := u.Validate(); err != nil {
+			t.Errorf("expected no error for %s, got %v", u, err)
+		}
+	}
+	for _, u := range []vos.UnitSystem{"", "stones"} {
+		if err := u.Validate(); !errors.Is(err, domerrors.ErrMalformedParameters) {
+			t.Errorf("expected ErrMalformedParameters for %q, got %v", u, err)
+		}
+	}
+}
+
+func TestUnitSystem_WeightUnit(t *testing.T) {
+	if got := vos.UnitSystemMetric.WeightUnit(); got != vos.WeightUnitKilogram {
+		t.Errorf("metric weight unit = %q, want kg", got)
+	}
+	if got := vos.UnitSystemImperial.WeightUnit(); got != vos.WeightUnitPound {
+		t.Errorf("imperial weight unit = %q, want lb", got)
+	}
+}
+
+func TestUnitSystem_ToGrams(t *testing.T) {
+	tests := []struct {
+		name   string
+		system vos.UnitSystem
+		weight float64
+		want   int64
+	}{
+		{"kg", vos.UnitSystemMetric, 80, 80000},
+		{"kg_fraction", vos.UnitSystemMetric, 1.25, 1250},
+		{"lb_plate", vos.UnitSystemImperial, 2.5, 1134},
+		{"lb_bar", vos.UnitSystemImperial, 45, 20412},
+		{"zero", vos.UnitSystemImperial, 0, 0},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.system.ToGrams(tt.weight); got != tt.want {
+				t.Errorf("ToGrams(%v) = %d, want %d", tt.weight, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestUnitSystem_RoundTrip(t *testing.T) {
+	// Every 0.01 lb step up to 1000 lb must read back exactly.
+	for cents := 0; cents <= 100000; cents++ {
+		lb := float64(cents) / 100
+		got := vos.UnitSystemImperial.FromGrams(vos.UnitSystemImperial.ToGrams(lb))
+		if got != lb {
+			t.Fatalf("round trip of %v lb = %v", lb, got)
+		}
+	}
+
+	for _, kg := range []float64{0.5, 1.25, 2.5, 20, 102.5} {
+		if got := vos.UnitSystemMetric.FromGrams(vos.UnitSystemMetric.ToGrams(kg)); got != kg {
+			t.Errorf("round trip of %v kg = %v", kg, got)
+		}
+	}
+}
+
+func TestUnitSystem_LengthRoundTrip(t *testing.T) {
+	if got := vos.UnitSystemImperial.LengthUnit(); got != vos.LengthUnitInch {
+		t.Errorf("imperial length unit = %q, want in", got)
+	}
+	if got := vos.UnitSystemMetric.ToMillimeters(82.5); got != 825 {
+		t.Errorf("ToMillimeters(82.5 cm) = %d, want 825", got)
+	}
+
+	// Every 0.1 step up to 100 cm / 100 in must read back exactly.
+	for tenths := 0; tenths <= 1000; tenths++ {
+		v := float64(tenths) / 10
+		for _, u := range []vos.UnitSystem{vos.UnitSystemMetric, vos.UnitSystemImperial} {
+			if got := u.FromMillimeters(u.ToMillimeters(v)); got != v {
+				t.Fatalf("%s round trip of %v = %v", u, v, got)
+			}
+		}
+	}
+}
@@ -3,6 +3,7 @@ package vos
 import (
 	"encoding/json"
 	"fmt"
+	"time"
 )
 
 // Theme constants
@@ -21,18 +22,132 @@ const (
 	LanguageEnUS Language = "en-US"
 )
 
-// UserPreferences holds user UI preferences.
+// WeekStart is the first day of the week used for weekly buckets.
+type WeekStart string
+
+const (
+	WeekStartMonday WeekStart = "monday"
+	WeekStartSunday WeekStart = "sunday"
+)
+
+// Sex selects the coefficients of the relative strength formulas (DOTS, Wilks, IPF GL).
+// It is optional: an empty Sex means the user has not set it.
+type Sex string
+
+const (
+	SexMale   Sex = "male"
+	SexFemale Sex = "female"
+)
+
+// StreakMode selects how training streaks are counted: consecutive days with a session,
+// or consecutive weeks reaching a target number of sessions.
+type StreakMode string
+
+const (
+	StreakModeDaily  StreakMode = "daily"
+	StreakModeWeekly StreakMode = "weekly"
+)
+
+// Bounds and default of the sessions per week required by the weekly streak mode.
+const (
+	MinStreakWeeklyTarget     = 1
+	MaxStreakWeeklyTarget     = 7
+	DefaultStreakWeeklyTarget = 3
+)
+
+// DefaultTimezone is the IANA timezone assumed for users who never set one.
+const DefaultTimezone = "America/Sao_Paulo"
+
+// UserPreferences holds user UI preferences, the calendar settings used to
+// bucket statistics by day and week, the units weights are exchanged in,
+// the sex used by the relative strength scores and how streaks are counted.
 type UserPreferences struct {
-	Theme    Theme    `json:"theme"`
-	Language Language `json:"language"`
+	Theme     Theme      `json:"theme"`
+	Language  Language   `json:"language"`
+	Timezone  string     `json:"timezone"`  // IANA name, e.g. "America/Sao_Paulo"
+	WeekStart WeekStart  `json:"weekStart"` // "monday" or "sunday"
+	Units     UnitSystem `json:"units"`     // "metric" or "imperial"
+	Sex       Sex        `json:"sex,omitempty"`
+	// StreakMode and StreakWeeklyTarget are empty for users who never set them (daily, 3).
+	StreakMode         StreakMode `json:"streakMode,omitempty"`
+	StreakWeeklyTarget int        `json:"streakWeeklyTarget,omitempty"` // sessões por semana no modo weekly
 }
 
 // DefaultUserPreferences returns the default preferences.
 func DefaultUserPreferences() UserPreferences {
 	return UserPreferences{
-		Theme:    ThemeLight,
-		Language: LanguagePtBR,
+		Theme:     ThemeLight,
+		Language:  LanguagePtBR,
+		Timezone:  DefaultTimezone,
+		WeekStart: WeekStartMonday,
+		Units:     UnitSystemMetric,
+
+		StreakMode:         StreakModeDaily,
+		StreakWeeklyTarget: DefaultStreakWeeklyTarget,
+	}
+}
+
+// WithUnsetFrom returns p with an empty Timezone, WeekStart, Units, Sex or streak setting taken from current,
+// so clients that only know about theme/language do not reset the newer settings.
+func (p UserPreferences) WithUnsetFrom(current UserPreferences) UserPreferences {
+	if p.Timezone == "" {
+		p.Timezone = current.Timezone
+	}
+	if p.WeekStart == "" {
+		p.WeekStart = current.WeekStart
+	}
+	if p.Units == "" {
+		p.Units = current.Units
+	}
+	if p.Sex == "" {
+		p.Sex = current.Sex
+	}
+	if p.StreakMode == "" {
+		p.StreakMode = current.StreakMode
+	}
+	if p.StreakWeeklyTarget == 0 {
+		p.StreakWeeklyTarget = current.StreakWeeklyTarget
 	}
+	return p
+}
+
+// Streak returns the streak mode and weekly target, with the defaults for users who never set them.
+func (p UserPreferences) Streak() (StreakMode, int) {
+	mode, target := p.StreakMode, p.StreakWeeklyTarget
+	if mode == "" {
+		mode = StreakModeDaily
+	}
+	if target == 0 {
+		target = DefaultStreakWeeklyTarget
+	}
+	return mode, target
+}
+
+// Location returns the user's timezone, falling back to UTC if it cannot be loaded.
+func (p UserPreferences) Location() *time.Location {
+	if p.Timezone == "" {
+		return time.UTC
+	}
+	loc, err := time.LoadLocation(p.Timezone)
+	if err != nil {
+		return time.UTC
+	}
+	return loc
+}
+
+// Weekday returns the weekday the week starts on (Monday unless set to Sunday).
+func (w WeekStart) Weekday() time.Weekday {
+	if w == WeekStartSunday {
+		return time.Sunday
+	}
+	return time.Monday
+}
+
+// StartOf returns midnight of the first day of t's week, in t's location.
+func (w WeekStart) StartOf(t time.Time) time.Time {
+	day := time.Date(t.Year(), t.Month(), t.Day(), 0, 0, 0, 0, t.Location())
+	offset := (int(day.Weekday()) - int(w.Weekday()) + 7) % 7
+	return day.AddDate(0, 0, -offset)
 }
 
 // Validate returns an error if any field has an invalid value.
@@ -47,6 +162,35 @@ func (p UserPreferences) Validate() error {
 	default:
 		return fmt.Errorf("invalid language %q: must be \"pt-BR\" or \"en-US\"", p.Language)
 	}
+	if p.Timezone == "" || p.Timezone == "Local" {
+		return fmt.Errorf("invalid timezone %q: must be an IANA timezone name", p.Timezone)
+	}
+	if _, err := time.LoadLocation(p.Timezone); err != nil {
+		return fmt.Errorf("invalid timezone %q: must be an IANA timezone name", p.Timezone)
+	}
+	switch p.WeekStart {
+	case WeekStartMonday, WeekStartSunday:
+	default:
+		return fmt.Errorf("invalid weekStart %q: must be \"monday\" or \"sunday\"", p.WeekStart)
+	}
+	switch p.Units {
+	case UnitSystemMetric, UnitSystemImperial:
+	default:
+		return fmt.Errorf("invalid units %q: must be \"metric\" or \"imperial\"", p.Units)
+	}
+	switch p.Sex {
+	case "", SexMale, SexFemale:
+	default:
+		return fmt.Errorf("invalid sex %q: must be \"male\" or \"female\"", p.Sex)
+	}
+	switch p.StreakMode {
+	case StreakModeDaily, StreakModeWeekly:
+	default:
+		return fmt.Errorf("invalid streakMode %q: must be \"daily\" or \"weekly\"", p.StreakMode)
+	}
+	if p.StreakWeeklyTarget < MinStreakWeeklyTarget || p.StreakWeeklyTarget > MaxStreakWeeklyTarget {
+		return fmt.Errorf("invalid streakWeeklyTarget %d: must be between %d and %d", p.StreakWeeklyTarget, MinStreakWeeklyTarget, MaxStreakWeeklyTarget)
+	}
 	return nil
 }
 
@@ -0,0 +1,167 @@
+package vos_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/kinetria/kinetria-back/internal/kinetria/domain/vos"
+)
+
+func TestUserPreferences_Validate_Defaults(t *testing.T) {
+	if err := vos.DefaultUserPreferences().Validate(); err != nil {
+		t.Errorf("expected defaults to be valid, got %v", err)
+	}
+}
+
+func TestUserPreferences_Validate_Calendar(t *testing.T) {
+	tests := []struct {
+		name      string
+		timezone  string
+		weekStart vos.WeekStart
+		wantErr   bool
+	}{
+		{"sao_paulo_monday", "America/Sao_Paulo", vos.WeekStartMonday, false},
+		{"utc_sunday", "UTC", vos.WeekStartSunday, false},
+		{"empty_timezone", "", vos.WeekStartMonday, true},
+		{"local_timezone", "Local", vos.WeekStartMonday, true},
+		{"unknown_timezone", "Mars/Olympus", vos.WeekStartMonday, true},
+		{"invalid_week_start", "UTC", vos.WeekStart("friday"), true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			p := vos.DefaultUserPreferences()
+			p.Timezone = tt.timezone
+			p.WeekStart = tt.weekStart
+			err := p.Validate()
+			if (err != nil) != tt.wantErr {
+				t.Errorf("Validate() error = %v, wantErr %v", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestUserPreferences_Validate_Units(t *testing.T) {
+	p := vos.DefaultUserPreferences()
+	p.Units = vos.UnitSystemImperial
+	if err := p.Validate(); err != nil {
+		t.Errorf("expected imperial to be valid, got %v", err)
+	}
+	p.Units = "stones"
+	if err := p.Validate(); err == nil {
+		t.Error("expected error for unknown units")
+	}
+}
+
+func TestUserPreferences_Validate_Sex(t *testing.T) {
+	for _, sex := range []vos.Sex{"", vos.SexMale, vos.SexFemale} {
+		p := vos.DefaultUserPreferences()
+		p.Sex = sex
+		if err := p.Validate(); err != nil {
+			t.Errorf("expected sex %q to be valid, got %v", sex, err)
+		}
+	}
+	p := vos.DefaultUserPreferences()
+	p.Sex = "other"
+	if err := p.Validate(); err == nil {
+		t.Error("expected error for unknown sex")
+	}
+}
+
+func TestUserPreferences_Validate_Streak(t *testing.T) {
+	tests := []struct {
+		name    string
+		mode    vos.StreakMode
+		target  int
+		wantErr bool
+	}{
+		{"daily", vos.StreakModeDaily, 3, false},
+		{"weekly_min", vos.StreakModeWeekly, 1, false},
+		{"weekly_max", vos.StreakModeWeekly, 7, false},
+		{"empty_mode", "", 3, true},
+		{"unknown_mode", vos.StreakMode("monthly"), 3, true},
+		{"zero_target", vos.StreakModeWeekly, 0, true},
+		{"target_above_week", vos.StreakModeWeekly, 8, true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			p := vos.DefaultUserPreferences()
+			p.StreakMode = tt.mode
+			p.StreakWeeklyTarget = tt.target
+			err := p.Validate()
+			if (err != nil) != tt.wantErr {
+				t.Errorf("Validate() error = %v, wantErr %v", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestUserPreferences_Streak(t *testing.T) {
+	mode, target := vos.UserPreferences{}.Streak()
+	if mode != vos.StreakModeDaily || target != vos.DefaultStreakWeeklyTarget {
+		t.Errorf("expected defaults for unset streak settings, got %q/%d", mode, target)
+	}
+	mode, target = vos.UserPreferences{StreakMode: vos.StreakModeWeekly, StreakWeeklyTarget: 4}.Streak()
+	if mode != vos.StreakModeWeekly || target != 4 {
+		t.Errorf("expected weekly/4, got %q/%d", mode, target)
+	}
+}
+
+func TestUserPreferences_WithUnsetFrom(t *testing.T) {
+	current := vos.DefaultUserPreferences()
+	current.Timezone = "Europe/Lisbon"
+	current.WeekStart = vos.WeekStartSunday
+	current.Units = vos.UnitSystemImperial
+	current.Sex = vos.SexFemale
+	current.StreakMode = vos.StreakModeWeekly
+	current.StreakWeeklyTarget = 4
+
+	got := vos.UserPreferences{Theme: vos.ThemeDark, Language: vos.LanguageEnUS}.WithUnsetFrom(current)
+	if got.Timezone != "Europe/Lisbon" || got.WeekStart != vos.WeekStartSunday || got.Units != vos.UnitSystemImperial || got.Sex != vos.SexFemale {
+		t.Errorf("expected unset settings to be kept, got %q/%q/%q/%q", got.Timezone, got.WeekStart, got.Units, got.Sex)
+	}
+	if got.StreakMode != vos.StreakModeWeekly || got.StreakWeeklyTarget != 4 {
+		t.Errorf("expected unset streak settings to be kept, got %q/%d", got.StreakMode, got.StreakWeeklyTarget)
+	}
+
+	got = vos.UserPreferences{Timezone: "UTC", WeekStart: vos.WeekStartMonday, Units: vos.UnitSystemMetric}.WithUnsetFrom(current)
+	if got.Timezone != "UTC" || got.WeekStart != vos.WeekStartMonday || got.Units != vos.UnitSystemMetric {
+		t.Errorf("expected explicit settings to win, got %q/%q/%q", got.Timezone, got.WeekStart, got.Units)
+	}
+}
+
+func TestUserPreferences_Location(t *testing.T) {
+	p := vos.DefaultUserPreferences()
+	if got := p.Location().String(); got != vos.DefaultTimezone {
+		t.Errorf("Location() = %q, want %q", got, vos.DefaultTimezone)
+	}
+	p.Timezone = "Mars/Olympus"
+	if got := p.Location(); got != time.UTC {
+		t.Errorf("Location() = %v, want UTC fallback", got)
+	}
+}
+
+func TestWeekStart_StartOf(t *testing.T) {
+	// Wednesday 2024-03-06 15:00
+	wednesday := time.Date(2024, 3, 6, 15, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		weekStart vos.WeekStart
+		want      time.Time
+	}{
+		{vos.WeekStartMonday, time.Date(2024, 3, 4, 0, 0, 0, 0, time.UTC)},
+		{vos.WeekStartSunday, time.Date(2024, 3, 3, 0, 0, 0, 0, time.UTC)},
+	}
+	for _, tt := range tests {
+		t.Run(string(tt.weekStart), func(t *testing.T) {
+			if got := tt.weekStart.StartOf(wednesday); !got.Equal(tt.want) {
+				t.Errorf("StartOf() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+
+	sunday := time.Date(2024, 3, 10, 9, 0, 0, 0, time.UTC)
+	if got := vos.WeekStartSunday.StartOf(sunday); !got.Equal(time.Date(2024, 3, 10, 0, 0, 0, 0, time.UTC)) {
+		t.Errorf("StartOf(sunday) = %v, want same day", got)
+	}
+}
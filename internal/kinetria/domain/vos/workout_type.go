@@ -26,3 +26,22 @@ func (w WorkoutType) Validate() error {
 	}
 	return fmt.Errorf("invalid workout type %q: %w", string(w), domerrors.ErrMalformedParameters)
 }
+
+// DefaultMET is the metabolic equivalent assumed for unknown workout types.
+const DefaultMET = 5.0
+
+// MET returns the metabolic equivalent of a typical session of this workout type
+// (Compendium of Physical Activities ranges for resistance, mobility and circuit training).
+func (w WorkoutType) MET() float64 {
+	switch w {
+	case WorkoutTypeForca:
+		return 6.0
+	case WorkoutTypeHipertrofia:
+		return 5.0
+	case WorkoutTypeMobilidade:
+		return 2.5
+	case WorkoutTypeCondicionamento:
+		return 8.0
+	}
+	return DefaultMET
+}
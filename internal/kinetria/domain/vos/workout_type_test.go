@@ -58,3 +58,12 @@ func TestWorkoutType_String(t *testing.T) {
 		})
 	}
 }
+
+func TestWorkoutType_MET(t *testing.T) {
+	if got := vos.WorkoutTypeCondicionamento.MET(); got <= vos.WorkoutTypeMobilidade.MET() {
+		t.Errorf("expected conditioning MET above mobility, got %v", got)
+	}
+	if got := vos.WorkoutType("YOGA").MET(); got != vos.DefaultMET {
+		t.Errorf("unknown type MET = %v, want %v", got, vos.DefaultMET)
+	}
+}
@@ -22,7 +22,7 @@ func (m *mockCreateWorkoutRepo) ExistsByIDAndUserID(_ context.Context, _, _ uuid
 	return false, nil
 }
 
-func (m *mockCreateWorkoutRepo) ListByUserID(_ context.Context, _ uuid.UUID, _, _ int) ([]entities.Workout, int, error) {
+func (m *mockCreateWorkoutRepo) ListByUserID(_ context.Context, _ uuid.UUID, _ bool, _, _ int) ([]entities.Workout, int, error) {
 	return nil, 0, nil
 }
 
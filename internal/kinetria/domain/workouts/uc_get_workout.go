@@ -23,12 +23,13 @@ type GetWorkoutOutput struct {
 
 // GetWorkoutUC is the use case for retrieving a specific workout with its exercises
 type GetWorkoutUC struct {
-	repo ports.WorkoutRepository
+	repo         ports.WorkoutRepository
+	favoriteRepo ports.FavoriteRepository
 }
 
 // NewGetWorkoutUC creates a new instance of GetWorkoutUC
-func NewGetWorkoutUC(repo ports.WorkoutRepository) *GetWorkoutUC {
-	return &GetWorkoutUC{repo: repo}
+func NewGetWorkoutUC(repo ports.WorkoutRepository, favoriteRepo ports.FavoriteRepository) *GetWorkoutUC {
+	return &GetWorkoutUC{repo: repo, favoriteRepo: favoriteRepo}
 }
 
 // Execute retrieves a workout by ID, validating ownership and input parameters
@@ -52,8 +53,13 @@ func (uc *GetWorkoutUC) Execute(ctx context.Context, input GetWorkoutInput) (Get
 		return GetWorkoutOutput{}, fmt.Errorf("workout with id '%s' not found", input.WorkoutID.String())
 	}
 
+	marked := []entities.Workout{*workout}
+	if err := markFavoriteWorkouts(ctx, uc.favoriteRepo, input.UserID, marked); err != nil {
+		return GetWorkoutOutput{}, err
+	}
+
 	return GetWorkoutOutput{
-		Workout:   *workout,
+		Workout:   marked[0],
 		Exercises: exercises,
 	}, nil
 }
@@ -24,7 +24,7 @@ func (m *mockGetWorkoutRepo) GetByID(ctx context.Context, workoutID, userID uuid
 	return nil, nil, nil
 }
 
-func (m *mockGetWorkoutRepo) ListByUserID(_ context.Context, _ uuid.UUID, _, _ int) ([]entities.Workout, int, error) {
+func (m *mockGetWorkoutRepo) ListByUserID(_ context.Context, _ uuid.UUID, _ bool, _, _ int) ([]entities.Workout, int, error) {
 	return nil, 0, nil
 }
 
@@ -215,7 +215,7 @@ func TestGetWorkoutUC_Execute(t *testing.T) {
 			}
 
 			// Create use case
-			uc := workouts.NewGetWorkoutUC(mockRepo)
+			uc := workouts.NewGetWorkoutUC(mockRepo, &mockFavoriteRepo{})
 
 			// Execute
 			output, err := uc.Execute(context.Background(), tt.input)
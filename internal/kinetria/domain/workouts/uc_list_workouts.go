@@ -11,9 +11,10 @@ import (
 )
 
 type ListWorkoutsInput struct {
-	UserID   uuid.UUID
-	Page     int
-	PageSize int
+	UserID        uuid.UUID
+	FavoritesOnly bool
+	Page          int
+	PageSize      int
 }
 
 type ListWorkoutsOutput struct {
@@ -25,11 +26,12 @@ type ListWorkoutsOutput struct {
 }
 
 type ListWorkoutsUC struct {
-	repo ports.WorkoutRepository
+	repo         ports.WorkoutRepository
+	favoriteRepo ports.FavoriteRepository
 }
 
-func NewListWorkoutsUC(repo ports.WorkoutRepository) *ListWorkoutsUC {
-	return &ListWorkoutsUC{repo: repo}
+func NewListWorkoutsUC(repo ports.WorkoutRepository, favoriteRepo ports.FavoriteRepository) *ListWorkoutsUC {
+	return &ListWorkoutsUC{repo: repo, favoriteRepo: favoriteRepo}
 }
 
 func (uc *ListWorkoutsUC) Execute(ctx context.Context, input ListWorkoutsInput) (ListWorkoutsOutput, error) {
@@ -56,11 +58,15 @@ func (uc *ListWorkoutsUC) Execute(ctx context.Context, input ListWorkoutsInput)
 
 	offset := (page - 1) * pageSize
 
-	workouts, total, err := uc.repo.ListByUserID(ctx, input.UserID, offset, pageSize)
+	workouts, total, err := uc.repo.ListByUserID(ctx, input.UserID, input.FavoritesOnly, offset, pageSize)
 	if err != nil {
 		return ListWorkoutsOutput{}, fmt.Errorf("failed to list workouts: %w", err)
 	}
 
+	if err := markFavoriteWorkouts(ctx, uc.favoriteRepo, input.UserID, workouts); err != nil {
+		return ListWorkoutsOutput{}, err
+	}
+
 	totalPages := 0
 	if total > 0 {
 		totalPages = int(math.Ceil(float64(total) / float64(pageSize)))
@@ -74,3 +80,22 @@ func (uc *ListWorkoutsUC) Execute(ctx context.Context, input ListWorkoutsInput)
 		TotalPages: totalPages,
 	}, nil
 }
+
+// markFavoriteWorkouts sets IsFavorite on each workout for the given user.
+func markFavoriteWorkouts(ctx context.Context, favoriteRepo ports.FavoriteRepository, userID uuid.UUID, workouts []entities.Workout) error {
+	if len(workouts) == 0 {
+		return nil
+	}
+	ids := make([]uuid.UUID, len(workouts))
+	for i, w := range workouts {
+		ids[i] = w.ID
+	}
+	favorites, err := favoriteRepo.FavoriteWorkoutIDs(ctx, userID, ids)
+	if err != nil {
+		return fmt.Errorf("failed to load favorite workouts: %w", err)
+	}
+	for i := range workouts {
+		workouts[i].IsFavorite = favorites[workouts[i].ID]
+	}
+	return nil
+}
@@ -14,12 +14,12 @@ import (
 
 // Mock inline do WorkoutRepository
 type mockWorkoutRepo struct {
-	listByUserIDFunc func(ctx context.Context, userID uuid.UUID, offset, limit int) ([]entities.Workout, int, error)
+	listByUserIDFunc func(ctx context.Context, userID uuid.UUID, favoritesOnly bool, offset, limit int) ([]entities.Workout, int, error)
 }
 
-func (m *mockWorkoutRepo) ListByUserID(ctx context.Context, userID uuid.UUID, offset, limit int) ([]entities.Workout, int, error) {
+func (m *mockWorkoutRepo) ListByUserID(ctx context.Context, userID uuid.UUID, favoritesOnly bool, offset, limit int) ([]entities.Workout, int, error) {
 	if m.listByUserIDFunc != nil {
-		return m.listByUserIDFunc(ctx, userID, offset, limit)
+		return m.listByUserIDFunc(ctx, userID, favoritesOnly, offset, limit)
 	}
 	return nil, 0, nil
 }
@@ -219,13 +219,13 @@ func TestListWorkoutsUC_Execute(t *testing.T) {
 		t.Run(tt.name, func(t *testing.T) {
 			// Setup mock
 			mockRepo := &mockWorkoutRepo{
-				listByUserIDFunc: func(ctx context.Context, userID uuid.UUID, offset, limit int) ([]entities.Workout, int, error) {
+				listByUserIDFunc: func(ctx context.Context, userID uuid.UUID, favoritesOnly bool, offset, limit int) ([]entities.Workout, int, error) {
 					return tt.mockReturn, tt.mockTotal, tt.mockError
 				},
 			}
 
 			// Create use case
-			uc := workouts.NewListWorkoutsUC(mockRepo)
+			uc := workouts.NewListWorkoutsUC(mockRepo, &mockFavoriteRepo{})
 
 			// Execute
 			output, err := uc.Execute(context.Background(), tt.input)
@@ -269,3 +269,31 @@ func TestListWorkoutsUC_Execute(t *testing.T) {
 		})
 	}
 }
+
+func TestListWorkoutsUC_Execute_Favorites(t *testing.T) {
+	userID := uuid.New()
+	favoriteID := uuid.New()
+	otherID := uuid.New()
+
+	var gotFavoritesOnly bool
+	mockRepo := &mockWorkoutRepo{
+		listByUserIDFunc: func(_ context.Context, _ uuid.UUID, favoritesOnly bool, _, _ int) ([]entities.Workout, int, error) {
+			gotFavoritesOnly = favoritesOnly
+			return []entities.Workout{{ID: favoriteID}, {ID: otherID}}, 2, nil
+		},
+	}
+	favoriteRepo := &mockFavoriteRepo{workouts: map[uuid.UUID]bool{favoriteID: true}}
+
+	uc := workouts.NewListWorkoutsUC(mockRepo, favoriteRepo)
+	output, err := uc.Execute(context.Background(), workouts.ListWorkoutsInput{UserID: userID, FavoritesOnly: true, Page: 1, PageSize: 20})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if !gotFavoritesOnly {
+		t.Error("expected favoritesOnly to be passed to the repository")
+	}
+	if !output.Workouts[0].IsFavorite || output.Workouts[1].IsFavorite {
+		t.Errorf("unexpected IsFavorite flags: %v, %v", output.Workouts[0].IsFavorite, output.Workouts[1].IsFavorite)
+	}
+}
@@ -0,0 +1,46 @@
+package workouts
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/google/uuid"
+	domerrors "github.com/kinetria/kinetria-back/internal/kinetria/domain/errors"
+	"github.com/kinetria/kinetria-back/internal/kinetria/domain/ports"
+)
+
+// SetWorkoutFavoriteUC marks or unmarks a workout as one of the user's favorites.
+type SetWorkoutFavoriteUC struct {
+	workoutRepo  ports.WorkoutRepository
+	favoriteRepo ports.FavoriteRepository
+}
+
+// NewSetWorkoutFavoriteUC creates a new SetWorkoutFavoriteUC.
+func NewSetWorkoutFavoriteUC(workoutRepo ports.WorkoutRepository, favoriteRepo ports.FavoriteRepository) *SetWorkoutFavoriteUC {
+	return &SetWorkoutFavoriteUC{workoutRepo: workoutRepo, favoriteRepo: favoriteRepo}
+}
+
+// Execute sets the favorite state; it is idempotent.
+// Only workouts visible to the user (their own and templates) can be favorited;
+// any other workout is reported as ErrWorkoutNotFound.
+func (uc *SetWorkoutFavoriteUC) Execute(ctx context.Context, userID, workoutID uuid.UUID, favorite bool) error {
+	if !favorite {
+		if err := uc.favoriteRepo.RemoveWorkout(ctx, userID, workoutID); err != nil {
+			return fmt.Errorf("failed to remove favorite workout: %w", err)
+		}
+		return nil
+	}
+
+	workout, err := uc.workoutRepo.GetByIDOnly(ctx, workoutID)
+	if err != nil {
+		return fmt.Errorf("failed to get workout: %w", err)
+	}
+	if workout == nil || (workout.CreatedBy != nil && *workout.CreatedBy != userID) {
+		return domerrors.ErrWorkoutNotFound
+	}
+
+	if err := uc.favoriteRepo.AddWorkout(ctx, userID, workoutID); err != nil {
+		return fmt.Errorf("failed to add favorite workout: %w", err)
+	}
+	return nil
+}
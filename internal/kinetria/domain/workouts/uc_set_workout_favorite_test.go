@@ -0,0 +1,133 @@
+package workouts_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/kinetria/kinetria-back/internal/kinetria/domain/entities"
+	domerrors "github.com/kinetria/kinetria-back/internal/kinetria/domain/errors"
+	"github.com/kinetria/kinetria-back/internal/kinetria/domain/workouts"
+)
+
+// mockFavoriteRepo implements ports.FavoriteRepository for workouts tests.
+type mockFavoriteRepo struct {
+	workouts map[uuid.UUID]bool
+	err      error
+}
+
+func (m *mockFavoriteRepo) AddExercise(_ context.Context, _, _ uuid.UUID) error    { return nil }
+func (m *mockFavoriteRepo) RemoveExercise(_ context.Context, _, _ uuid.UUID) error { return nil }
+
+func (m *mockFavoriteRepo) AddWorkout(_ context.Context, _, workoutID uuid.UUID) error {
+	if m.err != nil {
+		return m.err
+	}
+	if m.workouts == nil {
+		m.workouts = map[uuid.UUID]bool{}
+	}
+	m.workouts[workoutID] = true
+	return nil
+}
+
+func (m *mockFavoriteRepo) RemoveWorkout(_ context.Context, _, workoutID uuid.UUID) error {
+	if m.err != nil {
+		return m.err
+	}
+	delete(m.workouts, workoutID)
+	return nil
+}
+
+func (m *mockFavoriteRepo) FavoriteExerciseIDs(_ context.Context, _ uuid.UUID, _ []uuid.UUID) (map[uuid.UUID]bool, error) {
+	return map[uuid.UUID]bool{}, nil
+}
+
+func (m *mockFavoriteRepo) FavoriteWorkoutIDs(_ context.Context, _ uuid.UUID, _ []uuid.UUID) (map[uuid.UUID]bool, error) {
+	if m.err != nil {
+		return nil, m.err
+	}
+	return m.workouts, nil
+}
+
+func TestSetWorkoutFavoriteUC_Execute(t *testing.T) {
+	userID := uuid.New()
+	otherUserID := uuid.New()
+	workoutID := uuid.New()
+
+	tests := []struct {
+		name         string
+		workout      *entities.Workout
+		favorite     bool
+		repoErr      error
+		wantErrIs    error
+		wantErr      bool
+		wantFavorite bool
+	}{
+		{
+			name:         "favorite_own_workout",
+			workout:      &entities.Workout{ID: workoutID, CreatedBy: &userID},
+			favorite:     true,
+			wantFavorite: true,
+		},
+		{
+			name:         "favorite_template",
+			workout:      &entities.Workout{ID: workoutID},
+			favorite:     true,
+			wantFavorite: true,
+		},
+		{
+			name:      "other_users_workout_is_not_found",
+			workout:   &entities.Workout{ID: workoutID, CreatedBy: &otherUserID},
+			favorite:  true,
+			wantErrIs: domerrors.ErrWorkoutNotFound,
+		},
+		{
+			name:      "missing_workout",
+			favorite:  true,
+			wantErrIs: domerrors.ErrWorkoutNotFound,
+		},
+		{
+			name:         "unfavorite_is_idempotent",
+			favorite:     false,
+			wantFavorite: false,
+		},
+		{
+			name:     "repository_error",
+			workout:  &entities.Workout{ID: workoutID, CreatedBy: &userID},
+			favorite: true,
+			repoErr:  errors.New("db down"),
+			wantErr:  true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			workoutRepo := &mockDeleteWorkoutRepo{
+				getByIDOnlyFn: func(_ context.Context, _ uuid.UUID) (*entities.Workout, error) {
+					return tt.workout, nil
+				},
+			}
+			favoriteRepo := &mockFavoriteRepo{err: tt.repoErr}
+			uc := workouts.NewSetWorkoutFavoriteUC(workoutRepo, favoriteRepo)
+
+			err := uc.Execute(context.Background(), userID, workoutID, tt.favorite)
+
+			if tt.wantErrIs != nil || tt.wantErr {
+				if err == nil {
+					t.Fatal("expected error, got nil")
+				}
+				if tt.wantErrIs != nil && !errors.Is(err, tt.wantErrIs) {
+					t.Errorf("expected %v, got %v", tt.wantErrIs, err)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if favoriteRepo.workouts[workoutID] != tt.wantFavorite {
+				t.Errorf("favorite = %v, want %v", favoriteRepo.workouts[workoutID], tt.wantFavorite)
+			}
+		})
+	}
+}
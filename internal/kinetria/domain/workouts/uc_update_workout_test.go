@@ -23,7 +23,7 @@ func (m *mockUpdateWorkoutRepo) ExistsByIDAndUserID(_ context.Context, _, _ uuid
 	return false, nil
 }
 
-func (m *mockUpdateWorkoutRepo) ListByUserID(_ context.Context, _ uuid.UUID, _, _ int) ([]entities.Workout, int, error) {
+func (m *mockUpdateWorkoutRepo) ListByUserID(_ context.Context, _ uuid.UUID, _ bool, _, _ int) ([]entities.Workout, int, error) {
 	return nil, 0, nil
 }
 
@@ -26,6 +26,37 @@ type Config struct {
 	JWTSecret          string        `envconfig:"JWT_SECRET" required:"true"`
 	JWTExpiry          time.Duration `envconfig:"JWT_EXPIRY" default:"1h"`
 	RefreshTokenExpiry time.Duration `envconfig:"REFRESH_TOKEN_EXPIRY" default:"720h"`
+
+	// Admin endpoints (exercise library import/export). Empty disables them.
+	AdminAPIKey string `envconfig:"ADMIN_API_KEY"`
+
+	// Responses of requests sent with an Idempotency-Key are replayed for this long.
+	IdempotencyKeyTTL time.Duration `envconfig:"IDEMPOTENCY_KEY_TTL" default:"24h"`
+
+	// JSON file with the achievements catalog. Empty uses the built-in catalog.
+	AchievementRulesPath string `envconfig:"ACHIEVEMENT_RULES_PATH"`
+
+	// Statistics
+	MuscleVolumeMinSets float64 `envconfig:"MUSCLE_VOLUME_MIN_SETS" default:"10"`
+	MuscleVolumeMaxSets float64 `envconfig:"MUSCLE_VOLUME_MAX_SETS" default:"20"`
+
+	// Batch recompute of the global exercise popularity score. 0 disables it.
+	ExercisePopularityRefreshInterval time.Duration `envconfig:"EXERCISE_POPULARITY_REFRESH_INTERVAL" default:"1h"`
+
+	// Media storage
+	MediaStorageDriver string `envconfig:"MEDIA_STORAGE_DRIVER" default:"local"`
+	MediaLocalDir      string `envconfig:"MEDIA_LOCAL_DIR" default:"./data/media"`
+	MediaPublicBaseURL string `envconfig:"MEDIA_PUBLIC_BASE_URL" default:"http://localhost:8080/api/v1/media"`
+	MediaMaxImageBytes int64  `envconfig:"MEDIA_MAX_IMAGE_BYTES" default:"5242880"`
+	MediaMaxVideoBytes int64  `envconfig:"MEDIA_MAX_VIDEO_BYTES" default:"52428800"`
+
+	// S3-compatible storage (MEDIA_STORAGE_DRIVER=s3)
+	S3Endpoint        string `envconfig:"S3_ENDPOINT"`
+	S3Region          string `envconfig:"S3_REGION" default:"us-east-1"`
+	S3Bucket          string `envconfig:"S3_BUCKET"`
+	S3AccessKeyID     string `envconfig:"S3_ACCESS_KEY_ID"`
+	S3SecretAccessKey string `envconfig:"S3_SECRET_ACCESS_KEY"`
+	S3ForcePathStyle  bool   `envconfig:"S3_FORCE_PATH_STYLE" default:"true"`
 }
 
 func ParseConfigFromEnv() (Config, error) {
@@ -0,0 +1,127 @@
+package service
+
+import (
+	"net/http"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/kinetria/kinetria-back/internal/kinetria/domain/achievements"
+	"github.com/kinetria/kinetria-back/internal/kinetria/domain/entities"
+	"github.com/kinetria/kinetria-back/internal/kinetria/domain/profile"
+	"github.com/kinetria/kinetria-back/internal/kinetria/domain/vos"
+)
+
+// achievementCountUnits is the unit of the metrics that are counts, not weights.
+var achievementCountUnits = map[vos.AchievementMetric]string{
+	vos.AchievementMetricSessions:   "sessions",
+	vos.AchievementMetricSets:       "sets",
+	vos.AchievementMetricReps:       "reps",
+	vos.AchievementMetricExercises:  "exercises",
+	vos.AchievementMetricStreakDays: "days",
+}
+
+// AchievementsHandler handles HTTP requests for achievements. Weight and volume
+// thresholds are returned in kg or lb per the user's unit preference.
+type AchievementsHandler struct {
+	listAchievementsUC *achievements.ListAchievementsUC
+	getProfileUC       *profile.GetProfileUC
+}
+
+// NewAchievementsHandler creates a new AchievementsHandler.
+func NewAchievementsHandler(
+	listAchievementsUC *achievements.ListAchievementsUC,
+	getProfileUC *profile.GetProfileUC,
+) *AchievementsHandler {
+	return &AchievementsHandler{
+		listAchievementsUC: listAchievementsUC,
+		getProfileUC:       getProfileUC,
+	}
+}
+
+// AchievementDTO is an achievement of the catalog with the user's progress toward it.
+type AchievementDTO struct {
+	Code            string     `json:"code"`
+	Name            string     `json:"name"`
+	Description     string     `json:"description"`
+	Metric          string     `json:"metric"`
+	Unit            string     `json:"unit"`
+	Threshold       float64    `json:"threshold"`
+	Value           float64    `json:"value"`
+	ProgressPercent float64    `json:"progressPercent"`
+	Unlocked        bool       `json:"unlocked"`
+	AwardedAt       *time.Time `json:"awardedAt"`
+}
+
+// HandleListAchievements godoc
+// @Summary List achievements
+// @Description Every achievement of the catalog in catalog order, unlocked or not, with the user's current value and progress toward its threshold. Achievements are awarded when sessions and sets are written, not when listed.
+// @Tags achievements
+// @Produce json
+// @Security BearerAuth
+// @Success 200 {object} SuccessResponse{data=[]AchievementDTO}
+// @Failure 401 {object} ErrorResponse "Unauthorized"
+// @Failure 500 {object} ErrorResponse "Internal server error"
+// @Router /api/v1/achievements [get]
+func (h *AchievementsHandler) HandleListAchievements(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	userID, ok := ctx.Value(userIDKey).(uuid.UUID)
+	if !ok {
+		writeError(w, http.StatusUnauthorized, "UNAUTHORIZED", "Missing user authentication")
+		return
+	}
+
+	out, err := h.listAchievementsUC.Execute(ctx, userID)
+	if err != nil {
+		writeError(w, http.StatusInternalServerError, "INTERNAL_ERROR", "An unexpected error occurred.")
+		return
+	}
+
+	units, err := unitSystemFor(ctx, h.getProfileUC, userID)
+	if err != nil {
+		writeError(w, http.StatusInternalServerError, "INTERNAL_ERROR", "An unexpected error occurred.")
+		return
+	}
+
+	dtos := make([]AchievementDTO, len(out))
+	for i, p := range out {
+		dtos[i] = AchievementDTO{
+			Code:            p.Rule.Code,
+			Name:            p.Rule.Name,
+			Description:     p.Rule.Description,
+			Metric:          p.Rule.Metric.String(),
+			Unit:            achievementUnit(p.Rule.Metric, units),
+			Threshold:       achievementValue(p.Rule.Metric, p.Rule.Threshold, units),
+			Value:           achievementValue(p.Rule.Metric, p.Value, units),
+			ProgressPercent: p.ProgressPercent,
+			Unlocked:        p.Unlocked,
+			AwardedAt:       p.AwardedAt,
+		}
+	}
+	writeSuccess(w, http.StatusOK, dtos)
+}
+
+func achievementUnit(m vos.AchievementMetric, units vos.UnitSystem) string {
+	if u, ok := achievementCountUnits[m]; ok {
+		return u
+	}
+	return string(units.WeightUnit())
+}
+
+// achievementValue converts a canonical value of m to the user's unit; counts are unchanged.
+func achievementValue(m vos.AchievementMetric, v int64, units vos.UnitSystem) float64 {
+	if _, ok := achievementCountUnits[m]; ok {
+		return float64(v)
+	}
+	return units.FromGrams(v)
+}
+
+// achievementCodes returns the codes of newly awarded achievements, never nil, for
+// the responses of the endpoints that evaluate them.
+func achievementCodes(list []entities.UserAchievement) []string {
+	codes := make([]string, len(list))
+	for i, a := range list {
+		codes[i] = a.Code
+	}
+	return codes
+}
@@ -7,13 +7,21 @@ import (
 
 	"github.com/google/uuid"
 	"github.com/kinetria/kinetria-back/internal/kinetria/domain/dashboard"
+	"github.com/kinetria/kinetria-back/internal/kinetria/domain/ports"
+	"github.com/kinetria/kinetria-back/internal/kinetria/domain/statistics"
+	"github.com/kinetria/kinetria-back/internal/kinetria/domain/streaks"
 )
 
+// dashboardInsightsLimit caps the progress insights shown on the dashboard.
+const dashboardInsightsLimit = 3
+
 type DashboardHandler struct {
 	getUserProfileUC  *dashboard.GetUserProfileUC
 	getTodayWorkoutUC *dashboard.GetTodayWorkoutUC
 	getWeekProgressUC *dashboard.GetWeekProgressUC
 	getWeekStatsUC    *dashboard.GetWeekStatsUC
+	getInsightsUC     *statistics.GetProgressInsightsUC
+	getStreakUC       *streaks.GetStreakUC
 }
 
 func NewDashboardHandler(
@@ -21,18 +29,22 @@ func NewDashboardHandler(
 	getTodayWorkoutUC *dashboard.GetTodayWorkoutUC,
 	getWeekProgressUC *dashboard.GetWeekProgressUC,
 	getWeekStatsUC *dashboard.GetWeekStatsUC,
+	getInsightsUC *statistics.GetProgressInsightsUC,
+	getStreakUC *streaks.GetStreakUC,
 ) *DashboardHandler {
 	return &DashboardHandler{
 		getUserProfileUC:  getUserProfileUC,
 		getTodayWorkoutUC: getTodayWorkoutUC,
 		getWeekProgressUC: getWeekProgressUC,
 		getWeekStatsUC:    getWeekStatsUC,
+		getInsightsUC:     getInsightsUC,
+		getStreakUC:       getStreakUC,
 	}
 }
 
 // GetDashboard godoc
 // @Summary Get user dashboard
-// @Description Get aggregated dashboard data including user profile, today's workout, week progress, and stats
+// @Description Get aggregated dashboard data including user profile, today's workout, week progress, stats, progress insights and the streak in the user's streak mode
 // @Tags dashboard
 // @Produce json
 // @Security BearerAuth
@@ -60,11 +72,13 @@ func (h *DashboardHandler) GetDashboard(w http.ResponseWriter, r *http.Request)
 		todayWorkout *dashboard.GetTodayWorkoutOutput
 		weekProgress *dashboard.GetWeekProgressOutput
 		weekStats    *dashboard.GetWeekStatsOutput
+		insights     []statistics.ProgressInsight
+		streak       *ports.StreakStatus
 		err          error
 		source       string // para debug
 	}
 
-	ch := make(chan result, 4)
+	ch := make(chan result, 6)
 
 	// Executar use cases em paralelo
 	go func() {
@@ -87,9 +101,19 @@ func (h *DashboardHandler) GetDashboard(w http.ResponseWriter, r *http.Request)
 		ch <- result{weekStats: out, err: err, source: "weekStats"}
 	}()
 
+	go func() {
+		out, err := h.getInsightsUC.Execute(ctx, statistics.GetProgressInsightsInput{UserID: userID, Limit: dashboardInsightsLimit})
+		ch <- result{insights: out, err: err, source: "insights"}
+	}()
+
+	go func() {
+		out, err := h.getStreakUC.Execute(ctx, userID)
+		ch <- result{streak: out, err: err, source: "streak"}
+	}()
+
 	// Coletar resultados
 	var res result
-	for i := 0; i < 4; i++ {
+	for i := 0; i < 6; i++ {
 		r := <-ch
 		if r.err != nil {
 			// Fail-fast: se qualquer use case falhar, retornar erro
@@ -115,6 +139,12 @@ func (h *DashboardHandler) GetDashboard(w http.ResponseWriter, r *http.Request)
 		if r.weekStats != nil {
 			res.weekStats = r.weekStats
 		}
+		if r.insights != nil {
+			res.insights = r.insights
+		}
+		if r.streak != nil {
+			res.streak = r.streak
+		}
 	}
 
 	// Montar DTO de resposta
@@ -131,6 +161,8 @@ func (h *DashboardHandler) GetDashboard(w http.ResponseWriter, r *http.Request)
 			"calories":         res.weekStats.Calories,
 			"totalTimeMinutes": res.weekStats.TotalTimeMinutes,
 		},
+		"insights": mapInsightsToDTO(res.insights),
+		"streak":   mapStreakToDTO(res.streak),
 	}
 
 	// TodayWorkout pode ser null
@@ -162,3 +194,25 @@ func mapWeekProgressToDTO(days []dashboard.DayProgress) []map[string]interface{}
 	}
 	return result
 }
+
+// Helper: mapear insights para DTO (sem cargas, que dependem da unidade do usuário)
+func mapInsightsToDTO(insights []statistics.ProgressInsight) []map[string]interface{} {
+	result := make([]map[string]interface{}, len(insights))
+	for i, in := range insights {
+		change := in.E1RMChange
+		if in.BaselineE1RM == 0 {
+			change = in.VolumeChange
+		}
+		result[i] = map[string]interface{}{
+			"exerciseId":   in.ExerciseID.String(),
+			"exerciseName": in.ExerciseName,
+			"kind":         in.Kind,
+			"change":       change,
+			"suggestion": map[string]interface{}{
+				"kind":    in.Suggestion.Kind,
+				"message": in.Suggestion.Message,
+			},
+		}
+	}
+	return result
+}
@@ -0,0 +1,188 @@
+package service
+
+import (
+	"errors"
+	"io"
+	"mime"
+	"net/http"
+	"strconv"
+
+	domainerrors "github.com/kinetria/kinetria-back/internal/kinetria/domain/errors"
+	domainexercises "github.com/kinetria/kinetria-back/internal/kinetria/domain/exercises"
+)
+
+// maxLibraryImportBytes caps the size of an uploaded library file.
+const maxLibraryImportBytes = 10 << 20
+
+// ExerciseLibraryHandler handles administrative bulk import/export of the exercise library.
+type ExerciseLibraryHandler struct {
+	importExercisesUC *domainexercises.ImportExercisesUC
+	exportExercisesUC *domainexercises.ExportExercisesUC
+	adminAPIKey       string
+}
+
+// NewExerciseLibraryHandler creates a new ExerciseLibraryHandler.
+// adminAPIKey protects the routes (see AdminKeyMiddleware).
+func NewExerciseLibraryHandler(
+	importExercisesUC *domainexercises.ImportExercisesUC,
+	exportExercisesUC *domainexercises.ExportExercisesUC,
+	adminAPIKey string,
+) *ExerciseLibraryHandler {
+	return &ExerciseLibraryHandler{
+		importExercisesUC: importExercisesUC,
+		exportExercisesUC: exportExercisesUC,
+		adminAPIKey:       adminAPIKey,
+	}
+}
+
+// ImportChangeDTO is the JSON representation of one row's effect on the library.
+type ImportChangeDTO struct {
+	Row    int      `json:"row"`
+	Slug   string   `json:"slug"`
+	Action string   `json:"action"`
+	Fields []string `json:"fields,omitempty"`
+}
+
+// ImportRowErrorDTO is the JSON representation of a validation error on one row.
+type ImportRowErrorDTO struct {
+	Row     int    `json:"row"`
+	Slug    string `json:"slug,omitempty"`
+	Field   string `json:"field,omitempty"`
+	Message string `json:"message"`
+}
+
+// ImportResultDTO is the response for POST /admin/exercises/import.
+type ImportResultDTO struct {
+	DryRun    bool                `json:"dryRun"`
+	Applied   bool                `json:"applied"`
+	Created   int                 `json:"created"`
+	Updated   int                 `json:"updated"`
+	Unchanged int                 `json:"unchanged"`
+	Changes   []ImportChangeDTO   `json:"changes"`
+	Errors    []ImportRowErrorDTO `json:"errors"`
+}
+
+// HandleImportExercises godoc
+// @Summary Import exercise library
+// @Description Upserts library exercises from a CSV or JSON file, matched by slug. All-or-nothing: any row error aborts the import and every error is reported. Use dryRun=true to preview the diff.
+// @Tags admin
+// @Accept json
+// @Accept text/csv
+// @Produce json
+// @Param X-Admin-Key header string true "Admin API key"
+// @Param format query string false "csv or json (defaults to the request Content-Type)"
+// @Param dryRun query bool false "Only compute the diff"
+// @Success 200 {object} SuccessResponse "Import result (check applied and errors)"
+// @Failure 400 {object} ErrorResponse "Unreadable file or invalid format"
+// @Failure 401 {object} ErrorResponse "Invalid admin key"
+// @Failure 403 {object} ErrorResponse "Admin endpoints disabled"
+// @Failure 413 {object} ErrorResponse "File too large"
+// @Failure 500 {object} ErrorResponse "Internal server error"
+// @Router /api/v1/admin/exercises/import [post]
+func (h *ExerciseLibraryHandler) HandleImportExercises(w http.ResponseWriter, r *http.Request) {
+	format := libraryFormatFromRequest(r)
+
+	dryRun := false
+	if v := r.URL.Query().Get("dryRun"); v != "" {
+		parsed, err := strconv.ParseBool(v)
+		if err != nil {
+			writeError(w, http.StatusBadRequest, "VALIDATION_ERROR", "dryRun must be true or false.")
+			return
+		}
+		dryRun = parsed
+	}
+
+	data, err := io.ReadAll(http.MaxBytesReader(w, r.Body, maxLibraryImportBytes))
+	if err != nil {
+		var tooLarge *http.MaxBytesError
+		if errors.As(err, &tooLarge) {
+			writeError(w, http.StatusRequestEntityTooLarge, "PAYLOAD_TOO_LARGE", "File exceeds the maximum import size.")
+			return
+		}
+		writeError(w, http.StatusBadRequest, "VALIDATION_ERROR", "Failed to read request body.")
+		return
+	}
+
+	result, err := h.importExercisesUC.Execute(r.Context(), domainexercises.ImportExercisesInput{
+		Format: format,
+		Data:   data,
+		DryRun: dryRun,
+	})
+	if err != nil {
+		if errors.Is(err, domainerrors.ErrMalformedParameters) {
+			writeError(w, http.StatusBadRequest, "VALIDATION_ERROR", err.Error())
+			return
+		}
+		writeError(w, http.StatusInternalServerError, "INTERNAL_ERROR", "Failed to import exercises.")
+		return
+	}
+
+	resp := ImportResultDTO{
+		DryRun:    result.DryRun,
+		Applied:   result.Applied,
+		Created:   result.Created,
+		Updated:   result.Updated,
+		Unchanged: result.Unchanged,
+		Changes:   make([]ImportChangeDTO, 0, len(result.Changes)),
+		Errors:    make([]ImportRowErrorDTO, 0, len(result.Errors)),
+	}
+	for _, c := range result.Changes {
+		resp.Changes = append(resp.Changes, ImportChangeDTO{Row: c.Row, Slug: c.Slug, Action: string(c.Action), Fields: c.Fields})
+	}
+	for _, e := range result.Errors {
+		resp.Errors = append(resp.Errors, ImportRowErrorDTO{Row: e.Row, Slug: e.Slug, Field: e.Field, Message: e.Message})
+	}
+	writeSuccess(w, http.StatusOK, resp)
+}
+
+// HandleExportExercises godoc
+// @Summary Export exercise library
+// @Description Downloads the whole exercise library as CSV or JSON, in the same format accepted by the import.
+// @Tags admin
+// @Produce json
+// @Produce text/csv
+// @Param X-Admin-Key header string true "Admin API key"
+// @Param format query string false "csv or json (default json)"
+// @Success 200 {file} file "Exercise library"
+// @Failure 400 {object} ErrorResponse "Invalid format"
+// @Failure 401 {object} ErrorResponse "Invalid admin key"
+// @Failure 403 {object} ErrorResponse "Admin endpoints disabled"
+// @Failure 500 {object} ErrorResponse "Internal server error"
+// @Router /api/v1/admin/exercises/export [get]
+func (h *ExerciseLibraryHandler) HandleExportExercises(w http.ResponseWriter, r *http.Request) {
+	format := domainexercises.LibraryFormat(r.URL.Query().Get("format"))
+	if format == "" {
+		format = domainexercises.LibraryFormatJSON
+	}
+
+	data, err := h.exportExercisesUC.Execute(r.Context(), format)
+	if err != nil {
+		if errors.Is(err, domainerrors.ErrMalformedParameters) {
+			writeError(w, http.StatusBadRequest, "VALIDATION_ERROR", err.Error())
+			return
+		}
+		writeError(w, http.StatusInternalServerError, "INTERNAL_ERROR", "Failed to export exercises.")
+		return
+	}
+
+	contentType := "application/json"
+	if format == domainexercises.LibraryFormatCSV {
+		contentType = "text/csv; charset=utf-8"
+	}
+	w.Header().Set("Content-Type", contentType)
+	w.Header().Set("Content-Disposition", `attachment; filename="exercises.`+string(format)+`"`)
+	w.WriteHeader(http.StatusOK)
+	_, _ = w.Write(data)
+}
+
+// libraryFormatFromRequest reads the format query param, falling back to the Content-Type.
+func libraryFormatFromRequest(r *http.Request) domainexercises.LibraryFormat {
+	if f := r.URL.Query().Get("format"); f != "" {
+		return domainexercises.LibraryFormat(f)
+	}
+	mediaType, _, _ := mime.ParseMediaType(r.Header.Get("Content-Type"))
+	if mediaType == "text/csv" {
+		return domainexercises.LibraryFormatCSV
+	}
+	return domainexercises.LibraryFormatJSON
+}
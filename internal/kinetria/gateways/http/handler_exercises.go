@@ -3,6 +3,7 @@ package service
 import (
 "encoding/json"
 "errors"
+"math"
 "net/http"
 "strconv"
 
@@ -12,6 +13,8 @@ import (
 domainerrors "github.com/kinetria/kinetria-back/internal/kinetria/domain/errors"
 domainexercises "github.com/kinetria/kinetria-back/internal/kinetria/domain/exercises"
 "github.com/kinetria/kinetria-back/internal/kinetria/domain/ports"
+"github.com/kinetria/kinetria-back/internal/kinetria/domain/profile"
+"github.com/kinetria/kinetria-back/internal/kinetria/domain/vos"
 gatewayauth "github.com/kinetria/kinetria-back/internal/kinetria/gateways/auth"
 )
 
@@ -20,6 +23,7 @@ gatewayauth "github.com/kinetria/kinetria-back/internal/kinetria/gateways/auth"
 // LibraryExerciseDTO is the JSON representation of an exercise from the library.
 type LibraryExerciseDTO struct {
 ID           string   `json:"id"`
+Slug         string   `json:"slug"`
 Name         string   `json:"name"`
 Description  *string  `json:"description"`
 Instructions *string  `json:"instructions"`
@@ -29,14 +33,25 @@ Equipment    *string  `json:"equipment"`
 ThumbnailURL *string  `json:"thumbnailUrl"`
 VideoURL     *string  `json:"videoUrl"`
 Muscles      []string `json:"muscles"`
+MuscleGroups []MuscleGroupDTO `json:"muscleGroups"`
+IsFavorite   bool     `json:"isFavorite"`
+}
+
+// MuscleGroupDTO is the JSON representation of a canonical muscle group worked by an exercise.
+type MuscleGroupDTO struct {
+MuscleGroup string  `json:"muscleGroup"`
+Role        string  `json:"role"`
+Involvement float64 `json:"involvement"`
 }
 
 // UserStatsDTO is the JSON representation of a user's performance stats for an exercise.
+// Weights are in the user's unit preference, named by WeightUnit.
 type UserStatsDTO struct {
 LastPerformed  *string  `json:"lastPerformed"`
-BestWeight     *int     `json:"bestWeight"`
+BestWeight     *float64 `json:"bestWeight"`
 TimesPerformed int      `json:"timesPerformed"`
 AverageWeight  *float64 `json:"averageWeight"`
+WeightUnit     string   `json:"weightUnit"`
 }
 
 // LibraryExerciseWithStatsDTO extends LibraryExerciseDTO with optional user stats.
@@ -47,10 +62,10 @@ UserStats *UserStatsDTO `json:"userStats,omitempty"`
 
 // SetDetailDTO is the JSON representation of a single recorded set.
 type SetDetailDTO struct {
-SetNumber int    `json:"setNumber"`
-Reps      int    `json:"reps"`
-Weight    *int   `json:"weight"`
-Status    string `json:"status"`
+SetNumber int      `json:"setNumber"`
+Reps      int      `json:"reps"`
+Weight    *float64 `json:"weight"`
+Status    string   `json:"status"`
 }
 
 // HistoryEntryDTO is the JSON representation of one session's exercise history.
@@ -72,20 +87,37 @@ type ExerciseDetailResponse struct {
 Data LibraryExerciseWithStatsDTO `json:"data"`
 }
 
+// RecentExerciseDTO extends LibraryExerciseDTO with the user's usage of the exercise.
+type RecentExerciseDTO struct {
+LibraryExerciseDTO
+LastPerformedAt string `json:"lastPerformedAt"`
+TimesPerformed  int    `json:"timesPerformed"`
+}
+
+// RecentExercisesResponse is the response for GET /exercises/recent.
+type RecentExercisesResponse struct {
+Data []RecentExerciseDTO `json:"data"`
+}
+
 // ExerciseHistoryResponse is the paginated response for GET /exercises/:id/history.
+// Set weights are in the user's unit preference, named by WeightUnit.
 type ExerciseHistoryResponse struct {
-Data []HistoryEntryDTO `json:"data"`
-Meta PaginationMetaDTO `json:"meta"`
+Data       []HistoryEntryDTO `json:"data"`
+WeightUnit string            `json:"weightUnit"`
+Meta       PaginationMetaDTO `json:"meta"`
 }
 
 // --- Handler ---
 
 // ExercisesHandler handles HTTP requests for the exercise library endpoints.
 type ExercisesHandler struct {
-listExercisesUC      *domainexercises.ListExercisesUC
-getExerciseUC        *domainexercises.GetExerciseUC
-getExerciseHistoryUC *domainexercises.GetExerciseHistoryUC
-jwtManager           *gatewayauth.JWTManager
+listExercisesUC       *domainexercises.ListExercisesUC
+getExerciseUC         *domainexercises.GetExerciseUC
+getExerciseHistoryUC  *domainexercises.GetExerciseHistoryUC
+getRecentExercisesUC  *domainexercises.GetRecentExercisesUC
+setExerciseFavoriteUC *domainexercises.SetExerciseFavoriteUC
+getProfileUC          *profile.GetProfileUC
+jwtManager            *gatewayauth.JWTManager
 }
 
 // NewExercisesHandler creates a new ExercisesHandler.
@@ -93,18 +125,26 @@ func NewExercisesHandler(
 listExercisesUC *domainexercises.ListExercisesUC,
 getExerciseUC *domainexercises.GetExerciseUC,
 getExerciseHistoryUC *domainexercises.GetExerciseHistoryUC,
+getRecentExercisesUC *domainexercises.GetRecentExercisesUC,
+setExerciseFavoriteUC *domainexercises.SetExerciseFavoriteUC,
+getProfileUC *profile.GetProfileUC,
 jwtManager *gatewayauth.JWTManager,
 ) *ExercisesHandler {
 return &ExercisesHandler{
-listExercisesUC:      listExercisesUC,
-getExerciseUC:        getExerciseUC,
-getExerciseHistoryUC: getExerciseHistoryUC,
-jwtManager:           jwtManager,
+listExercisesUC:       listExercisesUC,
+getExerciseUC:         getExerciseUC,
+getExerciseHistoryUC:  getExerciseHistoryUC,
+getRecentExercisesUC:  getRecentExercisesUC,
+setExerciseFavoriteUC: setExerciseFavoriteUC,
+getProfileUC:          getProfileUC,
+jwtManager:            jwtManager,
 }
 }
 
 // HandleListExercises handles GET /api/v1/exercises
 // Returns a paginated list of exercises with optional filters.
+// favorites=true restricts the list to the authenticated user's favorites.
+// sort=name (default) or sort=popularity (global usage score, recomputed in batch).
 func (h *ExercisesHandler) HandleListExercises(w http.ResponseWriter, r *http.Request) {
 q := r.URL.Query()
 
@@ -138,12 +178,35 @@ MuscleGroup: nullableQueryParam(q.Get("muscleGroup")),
 Equipment:   nullableQueryParam(q.Get("equipment")),
 Difficulty:  nullableQueryParam(q.Get("difficulty")),
 Search:      nullableQueryParam(q.Get("search")),
+Sort:        vos.ExerciseSort(q.Get("sort")),
+}
+if filters.Sort != "" && filters.Sort.Validate() != nil {
+writeError(w, http.StatusBadRequest, "VALIDATION_ERROR", "sort must be one of: name, popularity")
+return
+}
+
+favoritesOnly := false
+if v := q.Get("favorites"); v != "" {
+favoritesOnly, err = strconv.ParseBool(v)
+if err != nil {
+writeError(w, http.StatusBadRequest, "VALIDATION_ERROR", "favorites must be true or false")
+return
+}
+}
+
+// Optional auth: marks favorites; required for the favorites filter
+userID := tryExtractUserIDFromJWT(r, h.jwtManager)
+if favoritesOnly && userID == nil {
+writeError(w, http.StatusUnauthorized, "UNAUTHORIZED", "authentication required")
+return
 }
 
 output, err := h.listExercisesUC.Execute(r.Context(), domainexercises.ListExercisesInput{
-Filters:  filters,
-Page:     page,
-PageSize: pageSize,
+Filters:       filters,
+Page:          page,
+PageSize:      pageSize,
+UserID:        userID,
+FavoritesOnly: favoritesOnly,
 })
 if err != nil {
 writeError(w, http.StatusInternalServerError, "INTERNAL_ERROR", "An unexpected error occurred")
@@ -196,8 +259,13 @@ return
 dto := LibraryExerciseWithStatsDTO{
 LibraryExerciseDTO: mapExerciseToLibraryDTO(result.Exercise),
 }
-if result.UserStats != nil {
-dto.UserStats = mapStatsToDTO(result.UserStats)
+if result.UserStats != nil && userID != nil {
+units, err := unitSystemFor(r.Context(), h.getProfileUC, *userID)
+if err != nil {
+writeError(w, http.StatusInternalServerError, "INTERNAL_ERROR", "An unexpected error occurred")
+return
+}
+dto.UserStats = mapStatsToDTO(result.UserStats, units)
 }
 
 w.Header().Set("Content-Type", "application/json")
@@ -264,6 +332,12 @@ writeError(w, http.StatusInternalServerError, "INTERNAL_ERROR", "An unexpected e
 return
 }
 
+units, err := unitSystemFor(ctx, h.getProfileUC, userID)
+if err != nil {
+writeError(w, http.StatusInternalServerError, "INTERNAL_ERROR", "An unexpected error occurred")
+return
+}
+
 dtos := make([]HistoryEntryDTO, 0, len(output.Entries))
 for _, entry := range output.Entries {
 sets := make([]SetDetailDTO, 0, len(entry.Sets))
@@ -271,7 +345,7 @@ for _, s := range entry.Sets {
 sets = append(sets, SetDetailDTO{
 SetNumber: s.SetNumber,
 Reps:      s.Reps,
-Weight:    s.Weight,
+Weight:    weightPtrFromGrams(units, s.Weight),
 Status:    s.Status,
 })
 }
@@ -284,7 +358,8 @@ Sets:        sets,
 }
 
 resp := ExerciseHistoryResponse{
-Data: dtos,
+Data:       dtos,
+WeightUnit: string(units.WeightUnit()),
 Meta: PaginationMetaDTO{
 Page:       output.Page,
 PageSize:   output.PageSize,
@@ -298,12 +373,102 @@ w.WriteHeader(http.StatusOK)
 _ = json.NewEncoder(w).Encode(resp)
 }
 
+// HandleListRecentExercises handles GET /api/v1/exercises/recent
+// Requires authentication. Returns the exercises the user performed most recently
+// (sort=recent, default) or most often (sort=frequent), up to limit (default 10, max 50).
+func (h *ExercisesHandler) HandleListRecentExercises(w http.ResponseWriter, r *http.Request) {
+userID, ok := r.Context().Value(userIDKey).(uuid.UUID)
+if !ok {
+writeError(w, http.StatusUnauthorized, "UNAUTHORIZED", "authentication required")
+return
+}
+
+q := r.URL.Query()
+
+limit, err := parseLibraryIntParam(q.Get("limit"), 10)
+if err != nil {
+writeError(w, http.StatusBadRequest, "VALIDATION_ERROR", "limit must be a valid integer")
+return
+}
+if limit < 1 || limit > domainexercises.MaxRecentExercises {
+writeError(w, http.StatusBadRequest, "VALIDATION_ERROR", "limit must be between 1 and 50")
+return
+}
+
+sort := vos.RecentExerciseSort(q.Get("sort"))
+if sort != "" && sort.Validate() != nil {
+writeError(w, http.StatusBadRequest, "VALIDATION_ERROR", "sort must be one of: recent, frequent")
+return
+}
+
+output, err := h.getRecentExercisesUC.Execute(r.Context(), domainexercises.GetRecentExercisesInput{
+UserID: userID,
+Sort:   sort,
+Limit:  limit,
+})
+if err != nil {
+writeError(w, http.StatusInternalServerError, "INTERNAL_ERROR", "An unexpected error occurred")
+return
+}
+
+dtos := make([]RecentExerciseDTO, 0, len(output.Exercises))
+for _, re := range output.Exercises {
+dtos = append(dtos, RecentExerciseDTO{
+LibraryExerciseDTO: mapExerciseToLibraryDTO(re.Exercise),
+LastPerformedAt:    re.LastPerformedAt.UTC().Format("2006-01-02T15:04:05Z"),
+TimesPerformed:     re.TimesPerformed,
+})
+}
+
+w.Header().Set("Content-Type", "application/json")
+w.WriteHeader(http.StatusOK)
+_ = json.NewEncoder(w).Encode(RecentExercisesResponse{Data: dtos})
+}
+
+// HandleFavoriteExercise handles PUT /api/v1/exercises/{id}/favorite
+// Marks the exercise as a favorite of the authenticated user. Idempotent.
+func (h *ExercisesHandler) HandleFavoriteExercise(w http.ResponseWriter, r *http.Request) {
+h.setFavorite(w, r, true)
+}
+
+// HandleUnfavoriteExercise handles DELETE /api/v1/exercises/{id}/favorite
+// Removes the exercise from the authenticated user's favorites. Idempotent.
+func (h *ExercisesHandler) HandleUnfavoriteExercise(w http.ResponseWriter, r *http.Request) {
+h.setFavorite(w, r, false)
+}
+
+func (h *ExercisesHandler) setFavorite(w http.ResponseWriter, r *http.Request, favorite bool) {
+userID, ok := r.Context().Value(userIDKey).(uuid.UUID)
+if !ok {
+writeError(w, http.StatusUnauthorized, "UNAUTHORIZED", "authentication required")
+return
+}
+
+exerciseID, err := uuid.Parse(chi.URLParam(r, "id"))
+if err != nil {
+writeError(w, http.StatusBadRequest, "VALIDATION_ERROR", "invalid exercise ID")
+return
+}
+
+if err := h.setExerciseFavoriteUC.Execute(r.Context(), userID, exerciseID, favorite); err != nil {
+if errors.Is(err, domainerrors.ErrExerciseNotFound) {
+writeError(w, http.StatusNotFound, "NOT_FOUND", "exercise not found")
+return
+}
+writeError(w, http.StatusInternalServerError, "INTERNAL_ERROR", "An unexpected error occurred")
+return
+}
+
+w.WriteHeader(http.StatusNoContent)
+}
+
 // --- Helpers ---
 
 // mapExerciseToLibraryDTO converts a domain Exercise entity to LibraryExerciseDTO.
 func mapExerciseToLibraryDTO(e *entities.Exercise) LibraryExerciseDTO {
 dto := LibraryExerciseDTO{
 ID:      e.ID.String(),
+Slug:    e.Slug,
 Name:    e.Name,
 Muscles: e.Muscles,
 }
@@ -316,6 +481,15 @@ dto.Tips = e.Tips
 dto.Difficulty = e.Difficulty
 dto.Equipment = e.Equipment
 dto.VideoURL = e.VideoURL
+dto.IsFavorite = e.IsFavorite
+dto.MuscleGroups = make([]MuscleGroupDTO, 0, len(e.MuscleGroups))
+for _, m := range e.MuscleGroups {
+dto.MuscleGroups = append(dto.MuscleGroups, MuscleGroupDTO{
+MuscleGroup: m.MuscleGroup.String(),
+Role:        m.Role.String(),
+Involvement: m.Involvement,
+})
+}
 return dto
 }
 
@@ -335,12 +509,16 @@ return nil
 return &s
 }
 
-// mapStatsToDTO converts ports.ExerciseUserStats to UserStatsDTO.
-func mapStatsToDTO(stats *ports.ExerciseUserStats) *UserStatsDTO {
+// mapStatsToDTO converts ports.ExerciseUserStats to UserStatsDTO, with weights in the user's unit.
+func mapStatsToDTO(stats *ports.ExerciseUserStats, units vos.UnitSystem) *UserStatsDTO {
 dto := &UserStatsDTO{
 TimesPerformed: stats.TimesPerformed,
-BestWeight:     stats.BestWeight,
-AverageWeight:  stats.AverageWeight,
+BestWeight:     weightPtrFromGrams(units, stats.BestWeight),
+WeightUnit:     string(units.WeightUnit()),
+}
+if stats.AverageWeight != nil {
+avg := units.FromGrams(int64(math.Round(*stats.AverageWeight)))
+dto.AverageWeight = &avg
 }
 if stats.LastPerformed != nil {
 s := stats.LastPerformed.UTC().Format("2006-01-02T15:04:05Z")
@@ -0,0 +1,426 @@
+package service
+
+import (
+	"encoding/json"
+	"errors"
+	"math"
+	"net/http"
+	"time"
+
+	"github.com/go-chi/chi/v5"
+	"github.com/google/uuid"
+	domainerrors "github.com/kinetria/kinetria-back/internal/kinetria/domain/errors"
+	"github.com/kinetria/kinetria-back/internal/kinetria/domain/goals"
+	"github.com/kinetria/kinetria-back/internal/kinetria/domain/profile"
+	"github.com/kinetria/kinetria-back/internal/kinetria/domain/vos"
+)
+
+// goalUnitSessions is the unit of weekly session goals.
+const goalUnitSessions = "sessions"
+
+// GoalsHandler handles HTTP requests for training goals. Weight and volume targets are
+// exchanged in kg or lb per the user's unit preference; weekly session targets are counts.
+type GoalsHandler struct {
+	createGoalUC *goals.CreateGoalUC
+	getGoalUC    *goals.GetGoalUC
+	listGoalsUC  *goals.ListGoalsUC
+	updateGoalUC *goals.UpdateGoalUC
+	deleteGoalUC *goals.DeleteGoalUC
+	getProfileUC *profile.GetProfileUC
+}
+
+// NewGoalsHandler creates a new GoalsHandler.
+func NewGoalsHandler(
+	createGoalUC *goals.CreateGoalUC,
+	getGoalUC *goals.GetGoalUC,
+	listGoalsUC *goals.ListGoalsUC,
+	updateGoalUC *goals.UpdateGoalUC,
+	deleteGoalUC *goals.DeleteGoalUC,
+	getProfileUC *profile.GetProfileUC,
+) *GoalsHandler {
+	return &GoalsHandler{
+		createGoalUC: createGoalUC,
+		getGoalUC:    getGoalUC,
+		listGoalsUC:  listGoalsUC,
+		updateGoalUC: updateGoalUC,
+		deleteGoalUC: deleteGoalUC,
+		getProfileUC: getProfileUC,
+	}
+}
+
+// CreateGoalRequest is the body of POST /goals.
+type CreateGoalRequest struct {
+	Type       string  `json:"type"`                 // exercise_e1rm, exercise_weight, weekly_sessions, monthly_volume, body_weight
+	ExerciseID *string `json:"exerciseId,omitempty"` // required for exercise goals
+	Target     float64 `json:"target"`               // kg or lb; sessions for weekly_sessions
+	TargetDate *string `json:"targetDate,omitempty"` // YYYY-MM-DD; not for periodic goals
+}
+
+// UpdateGoalRequest is the body of PATCH /goals/{id}. Omitted fields are left unchanged.
+type UpdateGoalRequest struct {
+	Target     *float64 `json:"target,omitempty"`
+	TargetDate *string  `json:"targetDate,omitempty"` // YYYY-MM-DD
+}
+
+// GoalDTO is a goal with progress, in the user's units.
+type GoalDTO struct {
+	ID              string     `json:"id"`
+	Type            string     `json:"type"`
+	ExerciseID      *string    `json:"exerciseId"`
+	Status          string     `json:"status"`
+	Unit            string     `json:"unit"`
+	Target          float64    `json:"target"`
+	Start           *float64   `json:"start"`
+	Current         *float64   `json:"current"`
+	Remaining       *float64   `json:"remaining"`
+	ProgressPercent *float64   `json:"progressPercent"`
+	TargetDate      *string    `json:"targetDate"`
+	PeriodStart     *string    `json:"periodStart"`
+	PeriodEnd       *string    `json:"periodEnd"` // último dia do período
+	ProjectedDate   *string    `json:"projectedDate"`
+	OnTrack         *bool      `json:"onTrack"`
+	AchievedAt      *time.Time `json:"achievedAt"`
+	CreatedAt       time.Time  `json:"createdAt"`
+	UpdatedAt       time.Time  `json:"updatedAt"`
+}
+
+// HandleCreateGoal godoc
+// @Summary Set a training goal
+// @Description Creates a goal: exercise e1RM or weight, weekly sessions, monthly volume or body weight. Exercise and body weight goals measure progress from the current value.
+// @Tags goals
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param request body CreateGoalRequest true "Goal type, target and optional target date"
+// @Success 201 {object} SuccessResponse{data=GoalDTO}
+// @Failure 400 {object} ErrorResponse "Validation error"
+// @Failure 401 {object} ErrorResponse "Unauthorized"
+// @Failure 404 {object} ErrorResponse "Exercise not found"
+// @Failure 500 {object} ErrorResponse "Internal server error"
+// @Router /api/v1/goals [post]
+func (h *GoalsHandler) HandleCreateGoal(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	userID, ok := ctx.Value(userIDKey).(uuid.UUID)
+	if !ok {
+		writeError(w, http.StatusUnauthorized, "UNAUTHORIZED", "Missing user authentication")
+		return
+	}
+
+	var req CreateGoalRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		writeError(w, http.StatusBadRequest, "INVALID_BODY", "Invalid request body.")
+		return
+	}
+
+	units, err := unitSystemFor(ctx, h.getProfileUC, userID)
+	if err != nil {
+		writeError(w, http.StatusInternalServerError, "INTERNAL_ERROR", "An unexpected error occurred.")
+		return
+	}
+
+	goalType := vos.GoalType(req.Type)
+	input := goals.CreateGoalInput{
+		UserID:      userID,
+		Type:        goalType,
+		TargetValue: goalValueToCanonical(goalType, req.Target, units),
+	}
+	if req.ExerciseID != nil {
+		id, err := uuid.Parse(*req.ExerciseID)
+		if err != nil {
+			writeError(w, http.StatusBadRequest, "VALIDATION_ERROR", "exerciseId must be a valid UUID")
+			return
+		}
+		input.ExerciseID = &id
+	}
+	if req.TargetDate != nil {
+		d, err := time.Parse("2006-01-02", *req.TargetDate)
+		if err != nil {
+			writeError(w, http.StatusBadRequest, "VALIDATION_ERROR", "Invalid targetDate format. Use YYYY-MM-DD.")
+			return
+		}
+		input.TargetDate = &d
+	}
+
+	out, err := h.createGoalUC.Execute(ctx, input)
+	if err != nil {
+		writeGoalError(w, err)
+		return
+	}
+
+	writeSuccess(w, http.StatusCreated, mapGoalProgressToDTO(*out, units))
+}
+
+// HandleListGoals godoc
+// @Summary List training goals
+// @Description Every goal with its progress, active first. Goals are recorded as achieved when a session or weigh-in reaches their target.
+// @Tags goals
+// @Produce json
+// @Security BearerAuth
+// @Success 200 {object} SuccessResponse{data=[]GoalDTO}
+// @Failure 401 {object} ErrorResponse "Unauthorized"
+// @Failure 500 {object} ErrorResponse "Internal server error"
+// @Router /api/v1/goals [get]
+func (h *GoalsHandler) HandleListGoals(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	userID, ok := ctx.Value(userIDKey).(uuid.UUID)
+	if !ok {
+		writeError(w, http.StatusUnauthorized, "UNAUTHORIZED", "Missing user authentication")
+		return
+	}
+
+	out, err := h.listGoalsUC.Execute(ctx, userID)
+	if err != nil {
+		writeGoalError(w, err)
+		return
+	}
+
+	units, err := unitSystemFor(ctx, h.getProfileUC, userID)
+	if err != nil {
+		writeError(w, http.StatusInternalServerError, "INTERNAL_ERROR", "An unexpected error occurred.")
+		return
+	}
+
+	dtos := make([]GoalDTO, len(out))
+	for i, p := range out {
+		dtos[i] = mapGoalProgressToDTO(p, units)
+	}
+	writeSuccess(w, http.StatusOK, dtos)
+}
+
+// HandleGetGoal godoc
+// @Summary Get a training goal
+// @Description Goal with current value, progress, projected completion date and whether it is on track.
+// @Tags goals
+// @Produce json
+// @Security BearerAuth
+// @Param id path string true "Goal ID (UUID)"
+// @Success 200 {object} SuccessResponse{data=GoalDTO}
+// @Failure 400 {object} ErrorResponse "Invalid goal ID"
+// @Failure 401 {object} ErrorResponse "Unauthorized"
+// @Failure 404 {object} ErrorResponse "Goal not found"
+// @Failure 500 {object} ErrorResponse "Internal server error"
+// @Router /api/v1/goals/{id} [get]
+func (h *GoalsHandler) HandleGetGoal(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	userID, ok := ctx.Value(userIDKey).(uuid.UUID)
+	if !ok {
+		writeError(w, http.StatusUnauthorized, "UNAUTHORIZED", "Missing user authentication")
+		return
+	}
+	id, err := uuid.Parse(chi.URLParam(r, "id"))
+	if err != nil {
+		writeError(w, http.StatusBadRequest, "VALIDATION_ERROR", "id must be a valid UUID")
+		return
+	}
+
+	out, err := h.getGoalUC.Execute(ctx, userID, id)
+	if err != nil {
+		writeGoalError(w, err)
+		return
+	}
+
+	units, err := unitSystemFor(ctx, h.getProfileUC, userID)
+	if err != nil {
+		writeError(w, http.StatusInternalServerError, "INTERNAL_ERROR", "An unexpected error occurred.")
+		return
+	}
+
+	writeSuccess(w, http.StatusOK, mapGoalProgressToDTO(*out, units))
+}
+
+// HandleUpdateGoal godoc
+// @Summary Update a training goal
+// @Description Changes the target or target date of an active goal. Achieved goals cannot be changed.
+// @Tags goals
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param id path string true "Goal ID (UUID)"
+// @Param request body UpdateGoalRequest true "Fields to change"
+// @Success 200 {object} SuccessResponse{data=GoalDTO}
+// @Failure 400 {object} ErrorResponse "Validation error"
+// @Failure 401 {object} ErrorResponse "Unauthorized"
+// @Failure 404 {object} ErrorResponse "Goal not found"
+// @Failure 409 {object} ErrorResponse "Goal already achieved"
+// @Failure 500 {object} ErrorResponse "Internal server error"
+// @Router /api/v1/goals/{id} [patch]
+func (h *GoalsHandler) HandleUpdateGoal(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	userID, ok := ctx.Value(userIDKey).(uuid.UUID)
+	if !ok {
+		writeError(w, http.StatusUnauthorized, "UNAUTHORIZED", "Missing user authentication")
+		return
+	}
+	id, err := uuid.Parse(chi.URLParam(r, "id"))
+	if err != nil {
+		writeError(w, http.StatusBadRequest, "VALIDATION_ERROR", "id must be a valid UUID")
+		return
+	}
+
+	var req UpdateGoalRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		writeError(w, http.StatusBadRequest, "INVALID_BODY", "Invalid request body.")
+		return
+	}
+
+	units, err := unitSystemFor(ctx, h.getProfileUC, userID)
+	if err != nil {
+		writeError(w, http.StatusInternalServerError, "INTERNAL_ERROR", "An unexpected error occurred.")
+		return
+	}
+
+	input := goals.UpdateGoalInput{UserID: userID, ID: id}
+	if req.Target != nil {
+		// A conversão depende do tipo da meta, que só o registro conhece
+		current, err := h.getGoalUC.Execute(ctx, userID, id)
+		if err != nil {
+			writeGoalError(w, err)
+			return
+		}
+		target := goalValueToCanonical(current.Goal.Type, *req.Target, units)
+		input.TargetValue = &target
+	}
+	if req.TargetDate != nil {
+		d, err := time.Parse("2006-01-02", *req.TargetDate)
+		if err != nil {
+			writeError(w, http.StatusBadRequest, "VALIDATION_ERROR", "Invalid targetDate format. Use YYYY-MM-DD.")
+			return
+		}
+		input.TargetDate = &d
+	}
+
+	out, err := h.updateGoalUC.Execute(ctx, input)
+	if err != nil {
+		writeGoalError(w, err)
+		return
+	}
+
+	writeSuccess(w, http.StatusOK, mapGoalProgressToDTO(*out, units))
+}
+
+// HandleDeleteGoal godoc
+// @Summary Delete a training goal
+// @Tags goals
+// @Security BearerAuth
+// @Param id path string true "Goal ID (UUID)"
+// @Success 204 "No Content"
+// @Failure 400 {object} ErrorResponse "Invalid goal ID"
+// @Failure 401 {object} ErrorResponse "Unauthorized"
+// @Failure 404 {object} ErrorResponse "Goal not found"
+// @Failure 500 {object} ErrorResponse "Internal server error"
+// @Router /api/v1/goals/{id} [delete]
+func (h *GoalsHandler) HandleDeleteGoal(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	userID, ok := ctx.Value(userIDKey).(uuid.UUID)
+	if !ok {
+		writeError(w, http.StatusUnauthorized, "UNAUTHORIZED", "Missing user authentication")
+		return
+	}
+	id, err := uuid.Parse(chi.URLParam(r, "id"))
+	if err != nil {
+		writeError(w, http.StatusBadRequest, "VALIDATION_ERROR", "id must be a valid UUID")
+		return
+	}
+
+	if err := h.deleteGoalUC.Execute(ctx, userID, id); err != nil {
+		writeGoalError(w, err)
+		return
+	}
+	w.WriteHeader(http.StatusNoContent)
+}
+
+// --- Helpers ---
+
+func writeGoalError(w http.ResponseWriter, err error) {
+	switch {
+	case errors.Is(err, domainerrors.ErrExerciseNotFound):
+		writeError(w, http.StatusNotFound, "NOT_FOUND", "exercise not found")
+	case errors.Is(err, domainerrors.ErrNotFound):
+		writeError(w, http.StatusNotFound, "GOAL_NOT_FOUND", "Goal not found.")
+	case errors.Is(err, domainerrors.ErrConflict):
+		writeError(w, http.StatusConflict, "GOAL_ALREADY_ACHIEVED", "Goal already achieved.")
+	case errors.Is(err, domainerrors.ErrMalformedParameters):
+		writeError(w, http.StatusBadRequest, "VALIDATION_ERROR", err.Error())
+	default:
+		writeError(w, http.StatusInternalServerError, "INTERNAL_ERROR", "An unexpected error occurred.")
+	}
+}
+
+// goalUnit is the unit the values of a goal of type t are exchanged in.
+func goalUnit(t vos.GoalType, units vos.UnitSystem) string {
+	if t == vos.GoalTypeWeeklySessions {
+		return goalUnitSessions
+	}
+	return string(units.WeightUnit())
+}
+
+// goalValueToCanonical converts a value in the user's unit to the canonical unit of t
+// (grams, grams × reps or sessions).
+func goalValueToCanonical(t vos.GoalType, v float64, units vos.UnitSystem) int64 {
+	if t == vos.GoalTypeWeeklySessions {
+		return int64(math.Round(v))
+	}
+	return units.ToGrams(v)
+}
+
+// goalValueFromCanonical converts a canonical value of t to the user's unit. The
+// conversion is linear, so signed differences (remaining) convert as well.
+func goalValueFromCanonical(t vos.GoalType, v int64, units vos.UnitSystem) float64 {
+	if t == vos.GoalTypeWeeklySessions {
+		return float64(v)
+	}
+	return units.FromGrams(v)
+}
+
+func goalValuePtrFromCanonical(t vos.GoalType, v *int64, units vos.UnitSystem) *float64 {
+	if v == nil {
+		return nil
+	}
+	f := goalValueFromCanonical(t, *v, units)
+	return &f
+}
+
+func formatDatePtr(t *time.Time) *string {
+	if t == nil {
+		return nil
+	}
+	d := t.Format("2006-01-02")
+	return &d
+}
+
+func mapGoalProgressToDTO(p goals.GoalProgress, units vos.UnitSystem) GoalDTO {
+	g := p.Goal
+	dto := GoalDTO{
+		ID:              g.ID.String(),
+		Type:            g.Type.String(),
+		Status:          g.Status.String(),
+		Unit:            goalUnit(g.Type, units),
+		Target:          goalValueFromCanonical(g.Type, g.TargetValue, units),
+		Start:           goalValuePtrFromCanonical(g.Type, g.StartValue, units),
+		Current:         goalValuePtrFromCanonical(g.Type, p.CurrentValue, units),
+		Remaining:       goalValuePtrFromCanonical(g.Type, p.Remaining, units),
+		ProgressPercent: p.ProgressPercent,
+		TargetDate:      formatDatePtr(g.TargetDate),
+		PeriodStart:     formatDatePtr(p.PeriodStart),
+		ProjectedDate:   formatDatePtr(p.ProjectedDate),
+		OnTrack:         p.OnTrack,
+		AchievedAt:      g.AchievedAt,
+		CreatedAt:       g.CreatedAt,
+		UpdatedAt:       g.UpdatedAt,
+	}
+	if g.ExerciseID != nil {
+		id := g.ExerciseID.String()
+		dto.ExerciseID = &id
+	}
+	if p.PeriodEnd != nil {
+		// O fim do período é exclusivo; a API expõe o último dia
+		last := p.PeriodEnd.AddDate(0, 0, -1)
+		dto.PeriodEnd = formatDatePtr(&last)
+	}
+	return dto
+}
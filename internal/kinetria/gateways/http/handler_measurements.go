@@ -0,0 +1,684 @@
+package service
+
+import (
+	"encoding/json"
+	"errors"
+	"math"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/go-chi/chi/v5"
+	"github.com/google/uuid"
+	"github.com/kinetria/kinetria-back/internal/kinetria/domain/entities"
+	domainerrors "github.com/kinetria/kinetria-back/internal/kinetria/domain/errors"
+	"github.com/kinetria/kinetria-back/internal/kinetria/domain/measurements"
+	"github.com/kinetria/kinetria-back/internal/kinetria/domain/profile"
+	"github.com/kinetria/kinetria-back/internal/kinetria/domain/vos"
+)
+
+// MeasurementsHandler handles HTTP requests for the body measurement log and goal weight.
+// Weights are exchanged in kg or lb and circumferences in cm or in, per the user's unit preference.
+type MeasurementsHandler struct {
+	createMeasurementUC   *measurements.CreateMeasurementUC
+	getMeasurementUC      *measurements.GetMeasurementUC
+	listMeasurementsUC    *measurements.ListMeasurementsUC
+	updateMeasurementUC   *measurements.UpdateMeasurementUC
+	deleteMeasurementUC   *measurements.DeleteMeasurementUC
+	getMeasurementTrendUC *measurements.GetMeasurementTrendUC
+	getGoalWeightUC       *measurements.GetGoalWeightUC
+	setGoalWeightUC       *measurements.SetGoalWeightUC
+	deleteGoalWeightUC    *measurements.DeleteGoalWeightUC
+	getProfileUC          *profile.GetProfileUC
+}
+
+// NewMeasurementsHandler creates a new MeasurementsHandler.
+func NewMeasurementsHandler(
+	createMeasurementUC *measurements.CreateMeasurementUC,
+	getMeasurementUC *measurements.GetMeasurementUC,
+	listMeasurementsUC *measurements.ListMeasurementsUC,
+	updateMeasurementUC *measurements.UpdateMeasurementUC,
+	deleteMeasurementUC *measurements.DeleteMeasurementUC,
+	getMeasurementTrendUC *measurements.GetMeasurementTrendUC,
+	getGoalWeightUC *measurements.GetGoalWeightUC,
+	setGoalWeightUC *measurements.SetGoalWeightUC,
+	deleteGoalWeightUC *measurements.DeleteGoalWeightUC,
+	getProfileUC *profile.GetProfileUC,
+) *MeasurementsHandler {
+	return &MeasurementsHandler{
+		createMeasurementUC:   createMeasurementUC,
+		getMeasurementUC:      getMeasurementUC,
+		listMeasurementsUC:    listMeasurementsUC,
+		updateMeasurementUC:   updateMeasurementUC,
+		deleteMeasurementUC:   deleteMeasurementUC,
+		getMeasurementTrendUC: getMeasurementTrendUC,
+		getGoalWeightUC:       getGoalWeightUC,
+		setGoalWeightUC:       setGoalWeightUC,
+		deleteGoalWeightUC:    deleteGoalWeightUC,
+		getProfileUC:          getProfileUC,
+	}
+}
+
+// MeasurementRequest is the body of POST /measurements and PATCH /measurements/{id}.
+// Omitted fields are not recorded (POST) or left unchanged (PATCH); on PATCH a
+// circumference of 0 removes that site.
+type MeasurementRequest struct {
+	MeasuredAt     *time.Time         `json:"measuredAt"`
+	Weight         *float64           `json:"weight"`         // kg or lb
+	BodyFat        *float64           `json:"bodyFat"`        // percent
+	Circumferences map[string]float64 `json:"circumferences"` // cm or in, by site (neck, chest, waist, hips, arm, thigh, calf)
+	Notes          *string            `json:"notes"`
+}
+
+// MeasurementDTO is a measurement in the user's units.
+type MeasurementDTO struct {
+	ID             string             `json:"id"`
+	MeasuredAt     time.Time          `json:"measuredAt"`
+	Weight         *float64           `json:"weight"`
+	BodyFat        *float64           `json:"bodyFat"`
+	Circumferences map[string]float64 `json:"circumferences"`
+	Notes          string             `json:"notes"`
+	WeightUnit     string             `json:"weightUnit"`
+	LengthUnit     string             `json:"lengthUnit"`
+	CreatedAt      time.Time          `json:"createdAt"`
+	UpdatedAt      time.Time          `json:"updatedAt"`
+}
+
+// MeasurementTrendPointDTO is one day of a measurement trend.
+type MeasurementTrendPointDTO struct {
+	Date          string  `json:"date"`
+	Value         float64 `json:"value"`
+	MovingAverage float64 `json:"movingAverage"`
+}
+
+// MeasurementTrendDTO is the daily series of a metric with its moving average.
+type MeasurementTrendDTO struct {
+	Metric     string                     `json:"metric"`
+	Unit       string                     `json:"unit"`
+	StartDate  string                     `json:"startDate"`
+	EndDate    string                     `json:"endDate"`
+	WindowDays int                        `json:"windowDays"`
+	Points     []MeasurementTrendPointDTO `json:"points"`
+	Change     *float64                   `json:"change"`
+}
+
+// GoalWeightRequest is the body of PUT /measurements/goal.
+type GoalWeightRequest struct {
+	TargetWeight float64 `json:"targetWeight"`         // kg or lb
+	TargetDate   *string `json:"targetDate,omitempty"` // YYYY-MM-DD
+}
+
+// GoalWeightDTO is the goal weight with progress, in the user's units.
+type GoalWeightDTO struct {
+	TargetWeight    float64  `json:"targetWeight"`
+	TargetDate      *string  `json:"targetDate"`
+	StartWeight     *float64 `json:"startWeight"`
+	CurrentWeight   *float64 `json:"currentWeight"`
+	Remaining       *float64 `json:"remaining"`
+	ProgressPercent *float64 `json:"progressPercent"`
+	Reached         bool     `json:"reached"`
+	WeeklyRate      *float64 `json:"weeklyRate"`
+	ProjectedDate   *string  `json:"projectedDate"`
+	WeightUnit      string   `json:"weightUnit"`
+}
+
+// HandleCreateMeasurement godoc
+// @Summary Log a body measurement
+// @Description Records body weight, body fat and/or circumferences. At least one value is required.
+// @Tags measurements
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param request body MeasurementRequest true "Measurement values"
+// @Success 201 {object} SuccessResponse{data=MeasurementDTO}
+// @Failure 400 {object} ErrorResponse "Validation error"
+// @Failure 401 {object} ErrorResponse "Unauthorized"
+// @Failure 500 {object} ErrorResponse "Internal server error"
+// @Router /api/v1/measurements [post]
+func (h *MeasurementsHandler) HandleCreateMeasurement(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	userID, ok := ctx.Value(userIDKey).(uuid.UUID)
+	if !ok {
+		writeError(w, http.StatusUnauthorized, "UNAUTHORIZED", "Missing user authentication")
+		return
+	}
+
+	var req MeasurementRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		writeError(w, http.StatusBadRequest, "INVALID_BODY", "Invalid request body.")
+		return
+	}
+
+	units, err := unitSystemFor(ctx, h.getProfileUC, userID)
+	if err != nil {
+		writeError(w, http.StatusInternalServerError, "INTERNAL_ERROR", "An unexpected error occurred.")
+		return
+	}
+
+	input := measurements.CreateMeasurementInput{
+		UserID:     userID,
+		MeasuredAt: req.MeasuredAt,
+		Values:     mapMeasurementRequestToValues(req, units),
+	}
+	if req.Notes != nil {
+		input.Notes = *req.Notes
+	}
+
+	m, err := h.createMeasurementUC.Execute(ctx, input)
+	if err != nil {
+		writeMeasurementError(w, err)
+		return
+	}
+
+	writeSuccess(w, http.StatusCreated, mapMeasurementToDTO(*m, units))
+}
+
+// HandleListMeasurements godoc
+// @Summary List body measurements
+// @Description Paginated measurement log, most recent first.
+// @Tags measurements
+// @Produce json
+// @Security BearerAuth
+// @Param startDate query string false "Start date (RFC3339 or YYYY-MM-DD)"
+// @Param endDate query string false "End date, inclusive (RFC3339 or YYYY-MM-DD)"
+// @Param page query int false "Page number" default(1)
+// @Param pageSize query int false "Items per page (max 100)" default(20)
+// @Success 200 {object} ApiResponseDTO{data=[]MeasurementDTO}
+// @Failure 400 {object} ErrorResponse "Invalid parameters"
+// @Failure 401 {object} ErrorResponse "Unauthorized"
+// @Failure 500 {object} ErrorResponse "Internal server error"
+// @Router /api/v1/measurements [get]
+func (h *MeasurementsHandler) HandleListMeasurements(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	userID, ok := ctx.Value(userIDKey).(uuid.UUID)
+	if !ok {
+		writeError(w, http.StatusUnauthorized, "UNAUTHORIZED", "Missing user authentication")
+		return
+	}
+
+	input := measurements.ListMeasurementsInput{UserID: userID}
+	if !parseMeasurementPeriod(w, r, &input.StartDate, &input.EndDate) {
+		return
+	}
+	var err error
+	if input.Page, err = parseIntQueryParam(r, "page", 1); err != nil {
+		writeError(w, http.StatusBadRequest, "VALIDATION_ERROR", "page must be a valid integer")
+		return
+	}
+	if input.PageSize, err = parseIntQueryParam(r, "pageSize", 20); err != nil {
+		writeError(w, http.StatusBadRequest, "VALIDATION_ERROR", "pageSize must be a valid integer")
+		return
+	}
+
+	out, err := h.listMeasurementsUC.Execute(ctx, input)
+	if err != nil {
+		writeMeasurementError(w, err)
+		return
+	}
+
+	units, err := unitSystemFor(ctx, h.getProfileUC, userID)
+	if err != nil {
+		writeError(w, http.StatusInternalServerError, "INTERNAL_ERROR", "An unexpected error occurred.")
+		return
+	}
+
+	dtos := make([]MeasurementDTO, len(out.Measurements))
+	for i, m := range out.Measurements {
+		dtos[i] = mapMeasurementToDTO(m, units)
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusOK)
+	_ = json.NewEncoder(w).Encode(ApiResponseDTO{
+		Data: dtos,
+		Meta: &PaginationMetaDTO{
+			Page:       out.Page,
+			PageSize:   out.PageSize,
+			Total:      out.Total,
+			TotalPages: out.TotalPages,
+		},
+	})
+}
+
+// HandleGetMeasurement godoc
+// @Summary Get a body measurement
+// @Tags measurements
+// @Produce json
+// @Security BearerAuth
+// @Param id path string true "Measurement ID (UUID)"
+// @Success 200 {object} SuccessResponse{data=MeasurementDTO}
+// @Failure 400 {object} ErrorResponse "Invalid measurement ID"
+// @Failure 401 {object} ErrorResponse "Unauthorized"
+// @Failure 404 {object} ErrorResponse "Measurement not found"
+// @Failure 500 {object} ErrorResponse "Internal server error"
+// @Router /api/v1/measurements/{id} [get]
+func (h *MeasurementsHandler) HandleGetMeasurement(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	userID, ok := ctx.Value(userIDKey).(uuid.UUID)
+	if !ok {
+		writeError(w, http.StatusUnauthorized, "UNAUTHORIZED", "Missing user authentication")
+		return
+	}
+	id, err := uuid.Parse(chi.URLParam(r, "id"))
+	if err != nil {
+		writeError(w, http.StatusBadRequest, "VALIDATION_ERROR", "id must be a valid UUID")
+		return
+	}
+
+	m, err := h.getMeasurementUC.Execute(ctx, userID, id)
+	if err != nil {
+		writeMeasurementError(w, err)
+		return
+	}
+
+	units, err := unitSystemFor(ctx, h.getProfileUC, userID)
+	if err != nil {
+		writeError(w, http.StatusInternalServerError, "INTERNAL_ERROR", "An unexpected error occurred.")
+		return
+	}
+
+	writeSuccess(w, http.StatusOK, mapMeasurementToDTO(*m, units))
+}
+
+// HandleUpdateMeasurement godoc
+// @Summary Update a body measurement
+// @Description Partially updates a measurement. A circumference of 0 removes that site.
+// @Tags measurements
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param id path string true "Measurement ID (UUID)"
+// @Param request body MeasurementRequest true "Fields to change"
+// @Success 200 {object} SuccessResponse{data=MeasurementDTO}
+// @Failure 400 {object} ErrorResponse "Validation error"
+// @Failure 401 {object} ErrorResponse "Unauthorized"
+// @Failure 404 {object} ErrorResponse "Measurement not found"
+// @Failure 500 {object} ErrorResponse "Internal server error"
+// @Router /api/v1/measurements/{id} [patch]
+func (h *MeasurementsHandler) HandleUpdateMeasurement(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	userID, ok := ctx.Value(userIDKey).(uuid.UUID)
+	if !ok {
+		writeError(w, http.StatusUnauthorized, "UNAUTHORIZED", "Missing user authentication")
+		return
+	}
+	id, err := uuid.Parse(chi.URLParam(r, "id"))
+	if err != nil {
+		writeError(w, http.StatusBadRequest, "VALIDATION_ERROR", "id must be a valid UUID")
+		return
+	}
+
+	var req MeasurementRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		writeError(w, http.StatusBadRequest, "INVALID_BODY", "Invalid request body.")
+		return
+	}
+
+	units, err := unitSystemFor(ctx, h.getProfileUC, userID)
+	if err != nil {
+		writeError(w, http.StatusInternalServerError, "INTERNAL_ERROR", "An unexpected error occurred.")
+		return
+	}
+
+	m, err := h.updateMeasurementUC.Execute(ctx, measurements.UpdateMeasurementInput{
+		UserID:     userID,
+		ID:         id,
+		MeasuredAt: req.MeasuredAt,
+		Values:     mapMeasurementRequestToValues(req, units),
+		Notes:      req.Notes,
+	})
+	if err != nil {
+		writeMeasurementError(w, err)
+		return
+	}
+
+	writeSuccess(w, http.StatusOK, mapMeasurementToDTO(*m, units))
+}
+
+// HandleDeleteMeasurement godoc
+// @Summary Delete a body measurement
+// @Tags measurements
+// @Security BearerAuth
+// @Param id path string true "Measurement ID (UUID)"
+// @Success 204 "No Content"
+// @Failure 400 {object} ErrorResponse "Invalid measurement ID"
+// @Failure 401 {object} ErrorResponse "Unauthorized"
+// @Failure 404 {object} ErrorResponse "Measurement not found"
+// @Failure 500 {object} ErrorResponse "Internal server error"
+// @Router /api/v1/measurements/{id} [delete]
+func (h *MeasurementsHandler) HandleDeleteMeasurement(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	userID, ok := ctx.Value(userIDKey).(uuid.UUID)
+	if !ok {
+		writeError(w, http.StatusUnauthorized, "UNAUTHORIZED", "Missing user authentication")
+		return
+	}
+	id, err := uuid.Parse(chi.URLParam(r, "id"))
+	if err != nil {
+		writeError(w, http.StatusBadRequest, "VALIDATION_ERROR", "id must be a valid UUID")
+		return
+	}
+
+	if err := h.deleteMeasurementUC.Execute(ctx, userID, id); err != nil {
+		writeMeasurementError(w, err)
+		return
+	}
+	w.WriteHeader(http.StatusNoContent)
+}
+
+// HandleGetMeasurementTrend godoc
+// @Summary Get a body measurement trend
+// @Description Daily values of a metric (mean of the day's entries, days in the user's timezone) with a trailing moving average.
+// @Description Values are in the user's units: unit is "kg"/"lb" for weight, "%" for bodyFat and "cm"/"in" for circumferences.
+// @Tags measurements
+// @Produce json
+// @Security BearerAuth
+// @Param metric query string false "weight (default), bodyFat, neck, chest, waist, hips, arm, thigh or calf"
+// @Param startDate query string false "Start date (YYYY-MM-DD), default 90 days before endDate"
+// @Param endDate query string false "End date (YYYY-MM-DD), default today"
+// @Param window query int false "Moving average window in days (1-90)" default(7)
+// @Success 200 {object} SuccessResponse{data=MeasurementTrendDTO}
+// @Failure 400 {object} ErrorResponse "Invalid parameters"
+// @Failure 401 {object} ErrorResponse "Unauthorized"
+// @Failure 500 {object} ErrorResponse "Internal server error"
+// @Router /api/v1/measurements/trend [get]
+func (h *MeasurementsHandler) HandleGetMeasurementTrend(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	userID, ok := ctx.Value(userIDKey).(uuid.UUID)
+	if !ok {
+		writeError(w, http.StatusUnauthorized, "UNAUTHORIZED", "Missing user authentication")
+		return
+	}
+
+	input := measurements.GetMeasurementTrendInput{UserID: userID, Metric: vos.BodyMetricWeight}
+	if s := r.URL.Query().Get("metric"); s != "" {
+		input.Metric = vos.BodyMetric(s)
+	}
+	if !parseMeasurementPeriod(w, r, &input.StartDate, &input.EndDate) {
+		return
+	}
+	if s := r.URL.Query().Get("window"); s != "" {
+		window, err := strconv.Atoi(s)
+		if err != nil {
+			writeError(w, http.StatusBadRequest, "VALIDATION_ERROR", "window must be a valid integer")
+			return
+		}
+		input.WindowDays = window
+	}
+
+	trend, err := h.getMeasurementTrendUC.Execute(ctx, input)
+	if err != nil {
+		writeMeasurementError(w, err)
+		return
+	}
+
+	units, err := unitSystemFor(ctx, h.getProfileUC, userID)
+	if err != nil {
+		writeError(w, http.StatusInternalServerError, "INTERNAL_ERROR", "An unexpected error occurred.")
+		return
+	}
+
+	writeSuccess(w, http.StatusOK, mapMeasurementTrendToDTO(trend, units))
+}
+
+// HandleGetGoalWeight godoc
+// @Summary Get the goal weight
+// @Description Goal weight with the latest weight, progress since the goal was set, weekly rate (last 4 weeks) and projected date.
+// @Tags measurements
+// @Produce json
+// @Security BearerAuth
+// @Success 200 {object} SuccessResponse{data=GoalWeightDTO}
+// @Failure 401 {object} ErrorResponse "Unauthorized"
+// @Failure 404 {object} ErrorResponse "No goal weight set"
+// @Failure 500 {object} ErrorResponse "Internal server error"
+// @Router /api/v1/measurements/goal [get]
+func (h *MeasurementsHandler) HandleGetGoalWeight(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	userID, ok := ctx.Value(userIDKey).(uuid.UUID)
+	if !ok {
+		writeError(w, http.StatusUnauthorized, "UNAUTHORIZED", "Missing user authentication")
+		return
+	}
+
+	out, err := h.getGoalWeightUC.Execute(ctx, userID)
+	if err != nil {
+		if errors.Is(err, domainerrors.ErrNotFound) {
+			writeError(w, http.StatusNotFound, "GOAL_NOT_FOUND", "No goal weight set.")
+			return
+		}
+		writeMeasurementError(w, err)
+		return
+	}
+
+	units, err := unitSystemFor(ctx, h.getProfileUC, userID)
+	if err != nil {
+		writeError(w, http.StatusInternalServerError, "INTERNAL_ERROR", "An unexpected error occurred.")
+		return
+	}
+
+	writeSuccess(w, http.StatusOK, mapGoalWeightToDTO(out, units))
+}
+
+// HandleSetGoalWeight godoc
+// @Summary Set the goal weight
+// @Description Sets or replaces the goal weight. Progress is measured from the latest logged weight.
+// @Tags measurements
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param request body GoalWeightRequest true "Target weight (kg or lb) and optional target date"
+// @Success 200 {object} SuccessResponse{data=GoalWeightDTO}
+// @Failure 400 {object} ErrorResponse "Validation error"
+// @Failure 401 {object} ErrorResponse "Unauthorized"
+// @Failure 500 {object} ErrorResponse "Internal server error"
+// @Router /api/v1/measurements/goal [put]
+func (h *MeasurementsHandler) HandleSetGoalWeight(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	userID, ok := ctx.Value(userIDKey).(uuid.UUID)
+	if !ok {
+		writeError(w, http.StatusUnauthorized, "UNAUTHORIZED", "Missing user authentication")
+		return
+	}
+
+	var req GoalWeightRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		writeError(w, http.StatusBadRequest, "INVALID_BODY", "Invalid request body.")
+		return
+	}
+
+	units, err := unitSystemFor(ctx, h.getProfileUC, userID)
+	if err != nil {
+		writeError(w, http.StatusInternalServerError, "INTERNAL_ERROR", "An unexpected error occurred.")
+		return
+	}
+
+	input := measurements.SetGoalWeightInput{
+		UserID:      userID,
+		TargetGrams: int(units.ToGrams(req.TargetWeight)),
+	}
+	if req.TargetDate != nil {
+		d, err := time.Parse("2006-01-02", *req.TargetDate)
+		if err != nil {
+			writeError(w, http.StatusBadRequest, "VALIDATION_ERROR", "Invalid targetDate format. Use YYYY-MM-DD.")
+			return
+		}
+		input.TargetDate = &d
+	}
+
+	if _, err := h.setGoalWeightUC.Execute(ctx, input); err != nil {
+		writeMeasurementError(w, err)
+		return
+	}
+
+	out, err := h.getGoalWeightUC.Execute(ctx, userID)
+	if err != nil {
+		writeMeasurementError(w, err)
+		return
+	}
+	writeSuccess(w, http.StatusOK, mapGoalWeightToDTO(out, units))
+}
+
+// HandleDeleteGoalWeight godoc
+// @Summary Delete the goal weight
+// @Tags measurements
+// @Security BearerAuth
+// @Success 204 "No Content"
+// @Failure 401 {object} ErrorResponse "Unauthorized"
+// @Failure 500 {object} ErrorResponse "Internal server error"
+// @Router /api/v1/measurements/goal [delete]
+func (h *MeasurementsHandler) HandleDeleteGoalWeight(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	userID, ok := ctx.Value(userIDKey).(uuid.UUID)
+	if !ok {
+		writeError(w, http.StatusUnauthorized, "UNAUTHORIZED", "Missing user authentication")
+		return
+	}
+
+	if err := h.deleteGoalWeightUC.Execute(ctx, userID); err != nil {
+		writeMeasurementError(w, err)
+		return
+	}
+	w.WriteHeader(http.StatusNoContent)
+}
+
+// --- Helpers ---
+
+// parseMeasurementPeriod reads the optional startDate/endDate query params.
+// It writes a 400 and returns false on an invalid date.
+func parseMeasurementPeriod(w http.ResponseWriter, r *http.Request, start, end **time.Time) bool {
+	if s := r.URL.Query().Get("startDate"); s != "" {
+		t, err := parseDate(s)
+		if err != nil {
+			writeError(w, http.StatusBadRequest, "VALIDATION_ERROR", "Invalid startDate format. Use YYYY-MM-DD or RFC3339.")
+			return false
+		}
+		*start = &t
+	}
+	if s := r.URL.Query().Get("endDate"); s != "" {
+		t, err := parseDate(s)
+		if err != nil {
+			writeError(w, http.StatusBadRequest, "VALIDATION_ERROR", "Invalid endDate format. Use YYYY-MM-DD or RFC3339.")
+			return false
+		}
+		*end = &t
+	}
+	return true
+}
+
+func writeMeasurementError(w http.ResponseWriter, err error) {
+	switch {
+	case errors.Is(err, domainerrors.ErrNotFound):
+		writeError(w, http.StatusNotFound, "MEASUREMENT_NOT_FOUND", "Measurement not found.")
+	case errors.Is(err, domainerrors.ErrMalformedParameters), isStatValidationError(err):
+		writeError(w, http.StatusBadRequest, "VALIDATION_ERROR", err.Error())
+	default:
+		writeError(w, http.StatusInternalServerError, "INTERNAL_ERROR", "An unexpected error occurred.")
+	}
+}
+
+func mapMeasurementRequestToValues(req MeasurementRequest, units vos.UnitSystem) measurements.MeasurementValues {
+	values := measurements.MeasurementValues{
+		WeightGrams:    weightPtrToGrams(units, req.Weight),
+		BodyFatPercent: req.BodyFat,
+	}
+	if len(req.Circumferences) > 0 {
+		values.Circumferences = make(map[vos.BodySite]int, len(req.Circumferences))
+		for site, length := range req.Circumferences {
+			values.Circumferences[vos.BodySite(site)] = int(units.ToMillimeters(length))
+		}
+	}
+	return values
+}
+
+func mapMeasurementToDTO(m entities.BodyMeasurement, units vos.UnitSystem) MeasurementDTO {
+	dto := MeasurementDTO{
+		ID:             m.ID.String(),
+		MeasuredAt:     m.MeasuredAt,
+		Weight:         weightPtrFromGrams(units, m.WeightGrams),
+		BodyFat:        m.BodyFatPercent,
+		Circumferences: make(map[string]float64, len(m.Circumferences)),
+		Notes:          m.Notes,
+		WeightUnit:     string(units.WeightUnit()),
+		LengthUnit:     string(units.LengthUnit()),
+		CreatedAt:      m.CreatedAt,
+		UpdatedAt:      m.UpdatedAt,
+	}
+	for site, mm := range m.Circumferences {
+		dto.Circumferences[string(site)] = units.FromMillimeters(int64(mm))
+	}
+	return dto
+}
+
+// metricToUserUnit converts a canonical metric value (grams, percent or mm) to the user's unit.
+func metricToUserUnit(metric vos.BodyMetric, v float64, units vos.UnitSystem) float64 {
+	switch metric {
+	case vos.BodyMetricWeight:
+		return units.FromGrams(int64(math.Round(v)))
+	case vos.BodyMetricBodyFat:
+		return math.Round(v*10) / 10
+	}
+	return units.FromMillimeters(int64(math.Round(v)))
+}
+
+func mapMeasurementTrendToDTO(t *measurements.MeasurementTrend, units vos.UnitSystem) MeasurementTrendDTO {
+	unit := string(units.LengthUnit())
+	switch t.Metric {
+	case vos.BodyMetricWeight:
+		unit = string(units.WeightUnit())
+	case vos.BodyMetricBodyFat:
+		unit = "%"
+	}
+
+	dto := MeasurementTrendDTO{
+		Metric:     string(t.Metric),
+		Unit:       unit,
+		StartDate:  t.StartDate.Format("2006-01-02"),
+		EndDate:    t.EndDate.Format("2006-01-02"),
+		WindowDays: t.WindowDays,
+		Points:     make([]MeasurementTrendPointDTO, len(t.Points)),
+	}
+	for i, p := range t.Points {
+		dto.Points[i] = MeasurementTrendPointDTO{
+			Date:          p.Date.Format("2006-01-02"),
+			Value:         metricToUserUnit(t.Metric, p.Value, units),
+			MovingAverage: metricToUserUnit(t.Metric, p.MovingAverage, units),
+		}
+	}
+	if t.Change != nil {
+		// A diferença é convertida com sinal: FromGrams/FromMillimeters são lineares
+		change := metricToUserUnit(t.Metric, *t.Change, units)
+		dto.Change = &change
+	}
+	return dto
+}
+
+func mapGoalWeightToDTO(g *measurements.GoalWeightProgress, units vos.UnitSystem) GoalWeightDTO {
+	dto := GoalWeightDTO{
+		TargetWeight:    units.FromGrams(int64(g.Goal.TargetGrams)),
+		StartWeight:     weightPtrFromGrams(units, g.Goal.StartGrams),
+		CurrentWeight:   weightPtrFromGrams(units, g.CurrentGrams),
+		Remaining:       weightPtrFromGrams(units, g.RemainingGrams),
+		ProgressPercent: g.ProgressPercent,
+		Reached:         g.Reached,
+		WeeklyRate:      weightPtrFromGrams(units, g.WeeklyRateGrams),
+		WeightUnit:      string(units.WeightUnit()),
+	}
+	if g.Goal.TargetDate != nil {
+		d := g.Goal.TargetDate.Format("2006-01-02")
+		dto.TargetDate = &d
+	}
+	if g.ProjectedDate != nil {
+		d := g.ProjectedDate.Format("2006-01-02")
+		dto.ProjectedDate = &d
+	}
+	return dto
+}
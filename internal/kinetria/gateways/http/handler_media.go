@@ -0,0 +1,235 @@
+package service
+
+import (
+	"errors"
+	"io"
+	"net/http"
+
+	"github.com/go-chi/chi/v5"
+	"github.com/google/uuid"
+	domainerrors "github.com/kinetria/kinetria-back/internal/kinetria/domain/errors"
+	"github.com/kinetria/kinetria-back/internal/kinetria/domain/media"
+	"github.com/kinetria/kinetria-back/internal/kinetria/domain/ports"
+	"github.com/kinetria/kinetria-back/internal/kinetria/domain/vos"
+)
+
+// multipartOverheadBytes is the slack allowed on top of the file size for multipart headers.
+const multipartOverheadBytes = 1 << 20
+
+// MediaHandler handles HTTP requests for media uploads.
+type MediaHandler struct {
+	uploadMediaUC *media.UploadMediaUC
+	storage       ports.MediaStorage
+}
+
+// NewMediaHandler creates a new MediaHandler.
+func NewMediaHandler(uploadMediaUC *media.UploadMediaUC, storage ports.MediaStorage) *MediaHandler {
+	return &MediaHandler{uploadMediaUC: uploadMediaUC, storage: storage}
+}
+
+// mediaAssetResponse is the response DTO for upload endpoints.
+type mediaAssetResponse struct {
+	ID           string  `json:"id"`
+	URL          string  `json:"url"`
+	ThumbnailURL *string `json:"thumbnailUrl"`
+	ContentType  string  `json:"contentType"`
+	SizeBytes    int64   `json:"sizeBytes"`
+}
+
+// HandleUploadProfileImage godoc
+// @Summary Upload profile image
+// @Description Upload a JPEG, PNG or GIF as the authenticated user's profile image. A thumbnail is generated.
+// @Tags media
+// @Accept multipart/form-data
+// @Produce json
+// @Security BearerAuth
+// @Param file formData file true "Image file"
+// @Success 201 {object} SuccessResponse "Uploaded media"
+// @Failure 400 {object} ErrorResponse "Invalid request"
+// @Failure 401 {object} ErrorResponse "Unauthorized"
+// @Failure 413 {object} ErrorResponse "File or image dimensions too large"
+// @Failure 415 {object} ErrorResponse "Unsupported media type"
+// @Failure 500 {object} ErrorResponse "Internal server error"
+// @Router /api/v1/profile/image [post]
+func (h *MediaHandler) HandleUploadProfileImage(w http.ResponseWriter, r *http.Request) {
+	userID, ok := r.Context().Value(userIDKey).(uuid.UUID)
+	if !ok {
+		writeError(w, http.StatusUnauthorized, "UNAUTHORIZED", "Missing user authentication")
+		return
+	}
+	h.upload(w, r, vos.MediaOwnerUser, userID, vos.MediaKindImage)
+}
+
+// HandleUploadWorkoutImage godoc
+// @Summary Upload workout image
+// @Description Upload a JPEG, PNG or GIF as the image of a workout owned by the authenticated user.
+// @Tags media
+// @Accept multipart/form-data
+// @Produce json
+// @Security BearerAuth
+// @Param id path string true "Workout ID (UUID)"
+// @Param file formData file true "Image file"
+// @Success 201 {object} SuccessResponse "Uploaded media"
+// @Failure 400 {object} ErrorResponse "Invalid request"
+// @Failure 401 {object} ErrorResponse "Unauthorized"
+// @Failure 403 {object} ErrorResponse "Forbidden"
+// @Failure 404 {object} ErrorResponse "Workout not found"
+// @Failure 413 {object} ErrorResponse "File or image dimensions too large"
+// @Failure 415 {object} ErrorResponse "Unsupported media type"
+// @Failure 500 {object} ErrorResponse "Internal server error"
+// @Router /api/v1/workouts/{id}/image [post]
+func (h *MediaHandler) HandleUploadWorkoutImage(w http.ResponseWriter, r *http.Request) {
+	h.uploadForPathOwner(w, r, vos.MediaOwnerWorkout, vos.MediaKindImage)
+}
+
+// HandleUploadExerciseThumbnail godoc
+// @Summary Upload exercise thumbnail
+// @Description Upload a JPEG, PNG or GIF for a library exercise. The generated thumbnail becomes its thumbnailUrl.
+// @Description Library exercises are shared, so the X-Admin-Key header is required as well.
+// @Tags media
+// @Accept multipart/form-data
+// @Produce json
+// @Security BearerAuth
+// @Param id path string true "Exercise ID (UUID)"
+// @Param X-Admin-Key header string true "Admin API key"
+// @Param file formData file true "Image file"
+// @Success 201 {object} SuccessResponse "Uploaded media"
+// @Failure 400 {object} ErrorResponse "Invalid request"
+// @Failure 401 {object} ErrorResponse "Unauthorized"
+// @Failure 403 {object} ErrorResponse "Forbidden"
+// @Failure 404 {object} ErrorResponse "Exercise not found"
+// @Failure 413 {object} ErrorResponse "File or image dimensions too large"
+// @Failure 415 {object} ErrorResponse "Unsupported media type"
+// @Failure 500 {object} ErrorResponse "Internal server error"
+// @Router /api/v1/exercises/{id}/thumbnail [post]
+func (h *MediaHandler) HandleUploadExerciseThumbnail(w http.ResponseWriter, r *http.Request) {
+	h.uploadForPathOwner(w, r, vos.MediaOwnerExercise, vos.MediaKindImage)
+}
+
+// HandleUploadExerciseVideo godoc
+// @Summary Upload exercise video
+// @Description Upload an MP4 or WebM demonstration video for a library exercise.
+// @Description Library exercises are shared, so the X-Admin-Key header is required as well.
+// @Tags media
+// @Accept multipart/form-data
+// @Produce json
+// @Security BearerAuth
+// @Param id path string true "Exercise ID (UUID)"
+// @Param X-Admin-Key header string true "Admin API key"
+// @Param file formData file true "Video file"
+// @Success 201 {object} SuccessResponse "Uploaded media"
+// @Failure 400 {object} ErrorResponse "Invalid request"
+// @Failure 401 {object} ErrorResponse "Unauthorized"
+// @Failure 403 {object} ErrorResponse "Forbidden"
+// @Failure 404 {object} ErrorResponse "Exercise not found"
+// @Failure 413 {object} ErrorResponse "File or image dimensions too large"
+// @Failure 415 {object} ErrorResponse "Unsupported media type"
+// @Failure 500 {object} ErrorResponse "Internal server error"
+// @Router /api/v1/exercises/{id}/video [post]
+func (h *MediaHandler) HandleUploadExerciseVideo(w http.ResponseWriter, r *http.Request) {
+	h.uploadForPathOwner(w, r, vos.MediaOwnerExercise, vos.MediaKindVideo)
+}
+
+// HandleServeMedia serves stored files through the storage driver (local files or
+// signed reads from the private bucket). Returns 404 when the driver cannot serve them.
+func (h *MediaHandler) HandleServeMedia(w http.ResponseWriter, r *http.Request) {
+	server, ok := h.storage.(http.Handler)
+	if !ok {
+		http.NotFound(w, r)
+		return
+	}
+	r2 := r.Clone(r.Context())
+	r2.URL.Path = "/" + chi.URLParam(r, "*")
+	r2.URL.RawPath = ""
+	server.ServeHTTP(w, r2)
+}
+
+func (h *MediaHandler) uploadForPathOwner(w http.ResponseWriter, r *http.Request, ownerType vos.MediaOwnerType, kind vos.MediaKind) {
+	ownerID, err := uuid.Parse(chi.URLParam(r, "id"))
+	if err != nil {
+		writeError(w, http.StatusBadRequest, "VALIDATION_ERROR", "Invalid ID format.")
+		return
+	}
+	h.upload(w, r, ownerType, ownerID, kind)
+}
+
+// upload reads the "file" multipart field, sniffs its content type and runs the upload use case.
+func (h *MediaHandler) upload(w http.ResponseWriter, r *http.Request, ownerType vos.MediaOwnerType, ownerID uuid.UUID, kind vos.MediaKind) {
+	ctx := r.Context()
+
+	userID, ok := ctx.Value(userIDKey).(uuid.UUID)
+	if !ok {
+		writeError(w, http.StatusUnauthorized, "UNAUTHORIZED", "Missing user authentication")
+		return
+	}
+
+	maxBytes := h.uploadMediaUC.MaxUploadBytes()
+	r.Body = http.MaxBytesReader(w, r.Body, maxBytes+multipartOverheadBytes)
+
+	file, _, err := r.FormFile("file")
+	if err != nil {
+		var tooLarge *http.MaxBytesError
+		if errors.As(err, &tooLarge) {
+			writeError(w, http.StatusRequestEntityTooLarge, "PAYLOAD_TOO_LARGE", "File exceeds the maximum upload size.")
+			return
+		}
+		writeError(w, http.StatusBadRequest, "VALIDATION_ERROR", "Expected a multipart form with a 'file' field.")
+		return
+	}
+	defer file.Close()
+
+	data, err := io.ReadAll(io.LimitReader(file, maxBytes+1))
+	if err != nil {
+		writeError(w, http.StatusBadRequest, "VALIDATION_ERROR", "Failed to read uploaded file.")
+		return
+	}
+
+	out, err := h.uploadMediaUC.Execute(ctx, media.UploadMediaInput{
+		UserID:    userID,
+		OwnerType: ownerType,
+		OwnerID:   ownerID,
+		Kind:      kind,
+		// The declared Content-Type is not trusted; detect it from the file itself.
+		ContentType: http.DetectContentType(data),
+		Data:        data,
+		Admin:       isAdminRequest(ctx),
+	})
+	if err != nil {
+		status, code, message := mapMediaError(err)
+		writeError(w, status, code, message)
+		return
+	}
+
+	resp := mediaAssetResponse{
+		ID:          out.Asset.ID.String(),
+		URL:         out.Asset.URL,
+		ContentType: out.Asset.ContentType,
+		SizeBytes:   out.Asset.SizeBytes,
+	}
+	if out.Asset.ThumbnailURL != "" {
+		resp.ThumbnailURL = &out.Asset.ThumbnailURL
+	}
+	writeSuccess(w, http.StatusCreated, resp)
+}
+
+// mapMediaError maps domain errors from media use cases to HTTP status, code and message.
+func mapMediaError(err error) (int, string, string) {
+	switch {
+	case errors.Is(err, domainerrors.ErrUnsupportedMediaType):
+		return http.StatusUnsupportedMediaType, "UNSUPPORTED_MEDIA_TYPE", err.Error()
+	case errors.Is(err, domainerrors.ErrMediaTooLarge):
+		return http.StatusRequestEntityTooLarge, "PAYLOAD_TOO_LARGE", err.Error()
+	case errors.Is(err, domainerrors.ErrMalformedParameters):
+		return http.StatusBadRequest, "VALIDATION_ERROR", err.Error()
+	case errors.Is(err, domainerrors.ErrWorkoutNotFound):
+		return http.StatusNotFound, "WORKOUT_NOT_FOUND", "Workout not found."
+	case errors.Is(err, domainerrors.ErrExerciseNotFound):
+		return http.StatusNotFound, "NOT_FOUND", "exercise not found"
+	case errors.Is(err, domainerrors.ErrCannotModifyTemplate):
+		return http.StatusForbidden, "CANNOT_MODIFY_TEMPLATE", "Cannot modify template workouts."
+	case errors.Is(err, domainerrors.ErrForbidden):
+		return http.StatusForbidden, "FORBIDDEN", "You do not have permission to perform this action."
+	default:
+		return http.StatusInternalServerError, "INTERNAL_ERROR", "Failed to upload media."
+	}
+}
@@ -30,8 +30,15 @@ func NewProfileHandler(
 
 // userPreferencesDTO is the DTO for user preferences in request/response.
 type userPreferencesDTO struct {
-	Theme    string `json:"theme"`
-	Language string `json:"language"`
+	Theme     string `json:"theme"`
+	Language  string `json:"language"`
+	Timezone  string `json:"timezone"`
+	WeekStart string `json:"weekStart"`
+	Units     string `json:"units"`
+	Sex       string `json:"sex,omitempty"` // "male" or "female"; used by relative strength scores
+
+	StreakMode         string `json:"streakMode,omitempty"`         // "daily" or "weekly"
+	StreakWeeklyTarget int    `json:"streakWeeklyTarget,omitempty"` // sessions per week in weekly mode, 1–7
 }
 
 // profileResponse is the response DTO for profile endpoints.
@@ -92,10 +99,7 @@ func (h *ProfileHandler) HandleGetProfile(w http.ResponseWriter, r *http.Request
 		Name:            u.Name,
 		Email:           u.Email,
 		ProfileImageURL: profileImageURL,
-		Preferences: userPreferencesDTO{
-			Theme:    string(u.Preferences.Theme),
-			Language: string(u.Preferences.Language),
-		},
+		Preferences:     mapPreferencesToDTO(u.Preferences),
 	})
 }
 
@@ -103,7 +107,10 @@ func (h *ProfileHandler) HandleGetProfile(w http.ResponseWriter, r *http.Request
 // @Summary Update user profile
 // @Description Partially update the authenticated user's profile. Only the fields provided in the request body are changed.
 // @Description Validation rules: name must be 2–100 characters; preferences.theme must be "dark" or "light";
-// @Description preferences.language must be "pt-BR" or "en-US". At least one field must be provided.
+// @Description preferences.language must be "pt-BR" or "en-US"; preferences.timezone must be an IANA name;
+// @Description preferences.weekStart must be "monday" or "sunday"; preferences.units must be "metric" or "imperial"
+// @Description (omitted timezone/weekStart/units keep their current values).
+// @Description At least one field must be provided.
 // @Tags profile
 // @Accept json
 // @Produce json
@@ -138,8 +145,15 @@ func (h *ProfileHandler) HandleUpdateProfile(w http.ResponseWriter, r *http.Requ
 	}
 	if req.Preferences != nil {
 		prefs := vos.UserPreferences{
-			Theme:    vos.Theme(req.Preferences.Theme),
-			Language: vos.Language(req.Preferences.Language),
+			Theme:     vos.Theme(req.Preferences.Theme),
+			Language:  vos.Language(req.Preferences.Language),
+			Timezone:  req.Preferences.Timezone,
+			WeekStart: vos.WeekStart(req.Preferences.WeekStart),
+			Units:     vos.UnitSystem(req.Preferences.Units),
+			Sex:       vos.Sex(req.Preferences.Sex),
+
+			StreakMode:         vos.StreakMode(req.Preferences.StreakMode),
+			StreakWeeklyTarget: req.Preferences.StreakWeeklyTarget,
 		}
 		input.Preferences = &prefs
 	}
@@ -169,9 +183,22 @@ func (h *ProfileHandler) HandleUpdateProfile(w http.ResponseWriter, r *http.Requ
 		Name:            u.Name,
 		Email:           u.Email,
 		ProfileImageURL: profileImageURL,
-		Preferences: userPreferencesDTO{
-			Theme:    string(u.Preferences.Theme),
-			Language: string(u.Preferences.Language),
-		},
+		Preferences:     mapPreferencesToDTO(u.Preferences),
 	})
 }
+
+// mapPreferencesToDTO maps the preferences to the response DTO. Streak settings never
+// set by the user are returned with their defaults.
+func mapPreferencesToDTO(p vos.UserPreferences) userPreferencesDTO {
+	streakMode, streakWeeklyTarget := p.Streak()
+	return userPreferencesDTO{
+		Theme:              string(p.Theme),
+		Language:           string(p.Language),
+		Timezone:           p.Timezone,
+		WeekStart:          string(p.WeekStart),
+		Units:              string(p.Units),
+		Sex:                string(p.Sex),
+		StreakMode:         string(streakMode),
+		StreakWeeklyTarget: streakWeeklyTarget,
+	}
+}
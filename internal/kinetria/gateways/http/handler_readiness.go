@@ -0,0 +1,213 @@
+package service
+
+import (
+	"encoding/json"
+	"errors"
+	"net/http"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/kinetria/kinetria-back/internal/kinetria/domain/entities"
+	domainerrors "github.com/kinetria/kinetria-back/internal/kinetria/domain/errors"
+	"github.com/kinetria/kinetria-back/internal/kinetria/domain/ports"
+	"github.com/kinetria/kinetria-back/internal/kinetria/domain/readiness"
+	"github.com/kinetria/kinetria-back/internal/kinetria/domain/vos"
+)
+
+// ReadinessHandler handles HTTP requests for pre-workout readiness check-ins.
+type ReadinessHandler struct {
+	checkInUC      *readiness.CheckInUC
+	listCheckInsUC *readiness.ListCheckInsUC
+}
+
+// NewReadinessHandler creates a new ReadinessHandler.
+func NewReadinessHandler(checkInUC *readiness.CheckInUC, listCheckInsUC *readiness.ListCheckInsUC) *ReadinessHandler {
+	return &ReadinessHandler{checkInUC: checkInUC, listCheckInsUC: listCheckInsUC}
+}
+
+// ReadinessCheckInRequest holds the answers of a readiness check-in.
+type ReadinessCheckInRequest struct {
+	SleepHours *float64 `json:"sleepHours" example:"7.5"`   // 0-24, obrigatório
+	Stress     int      `json:"stress" example:"2"`         // 1 (tranquilo) a 5 (muito estressado)
+	Soreness   int      `json:"soreness" example:"3"`       // 1 (sem dor) a 5 (muito dolorido)
+	HRV        *int     `json:"hrv,omitempty" example:"62"` // RMSSD em ms, opcional
+}
+
+// toMarkers returns the markers of the request; sleepHours is required.
+func (req ReadinessCheckInRequest) toMarkers() (vos.ReadinessMarkers, error) {
+	if req.SleepHours == nil {
+		return vos.ReadinessMarkers{}, errors.New("sleepHours is required")
+	}
+	return vos.ReadinessMarkers{
+		SleepHours: *req.SleepHours,
+		Stress:     req.Stress,
+		Soreness:   req.Soreness,
+		HRV:        req.HRV,
+	}, nil
+}
+
+// ReadinessCheckInDTO is a stored readiness check-in.
+type ReadinessCheckInDTO struct {
+	ID         string    `json:"id"`
+	SessionID  *string   `json:"sessionId"` // sessão iniciada com o check-in
+	SleepHours float64   `json:"sleepHours"`
+	Stress     int       `json:"stress"`
+	Soreness   int       `json:"soreness"`
+	HRV        *int      `json:"hrv"`
+	Score      int       `json:"score"`
+	Level      string    `json:"level"`
+	Adjustment *string   `json:"adjustment"` // ajuste aplicado à prescrição da sessão
+	CheckedAt  time.Time `json:"checkedAt"`
+}
+
+// ReadinessDayDTO is the mean score of one day's check-ins.
+type ReadinessDayDTO struct {
+	Date     string  `json:"date"`
+	Score    float64 `json:"score"`
+	CheckIns int     `json:"checkIns"`
+}
+
+// ReadinessTrendDTO lists the check-ins of a range of days with their daily trend.
+type ReadinessTrendDTO struct {
+	From         string                `json:"from"`
+	To           string                `json:"to"`
+	AverageScore *float64              `json:"averageScore"`
+	Days         []ReadinessDayDTO     `json:"days"`
+	CheckIns     []ReadinessCheckInDTO `json:"checkIns"`
+}
+
+// HandleCreateCheckIn godoc
+// @Summary Record a readiness check-in
+// @Description Scores the user's readiness (0-100) from sleep hours, stress and soreness (1-5) and, when known, the HRV
+// @Description measured against the mean of the user's check-ins of the last 28 days. level is low (below 50),
+// @Description moderate (50-69) or high. A session started within 12 hours uses the check-in (see POST /sessions).
+// @Tags readiness
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param request body ReadinessCheckInRequest true "Check-in answers"
+// @Success 201 {object} SuccessResponse{data=ReadinessCheckInDTO}
+// @Failure 401 {object} ErrorResponse "Unauthorized"
+// @Failure 422 {object} ErrorResponse "Validation error"
+// @Failure 500 {object} ErrorResponse "Internal server error"
+// @Router /api/v1/readiness/check-ins [post]
+func (h *ReadinessHandler) HandleCreateCheckIn(w http.ResponseWriter, r *http.Request) {
+	r.Body = http.MaxBytesReader(w, r.Body, 1<<20) // 1 MB limit
+	ctx := r.Context()
+
+	userID, ok := ctx.Value(userIDKey).(uuid.UUID)
+	if !ok {
+		writeError(w, http.StatusUnauthorized, "UNAUTHORIZED", "Missing user authentication")
+		return
+	}
+
+	var req ReadinessCheckInRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		writeError(w, http.StatusUnprocessableEntity, "VALIDATION_ERROR", "Request body is invalid.")
+		return
+	}
+	markers, err := req.toMarkers()
+	if err != nil {
+		writeError(w, http.StatusUnprocessableEntity, "VALIDATION_ERROR", err.Error())
+		return
+	}
+
+	checkIn, err := h.checkInUC.Execute(ctx, ports.ReadinessCheckInInput{UserID: userID, Markers: markers})
+	if err != nil {
+		if errors.Is(err, domainerrors.ErrMalformedParameters) {
+			writeError(w, http.StatusUnprocessableEntity, "VALIDATION_ERROR", err.Error())
+			return
+		}
+		writeError(w, http.StatusInternalServerError, "INTERNAL_ERROR", "An unexpected error occurred.")
+		return
+	}
+	writeSuccess(w, http.StatusCreated, mapReadinessCheckInToDTO(*checkIn))
+}
+
+// HandleListCheckIns godoc
+// @Summary List readiness check-ins
+// @Description The check-ins made in a range of days (user's timezone), oldest first, with the mean score of each day and of the range.
+// @Tags readiness
+// @Produce json
+// @Security BearerAuth
+// @Param from query string false "First date (YYYY-MM-DD), default 29 days before to"
+// @Param to query string false "Last date (YYYY-MM-DD), default today; at most 366 days after from"
+// @Success 200 {object} SuccessResponse{data=ReadinessTrendDTO}
+// @Failure 400 {object} ErrorResponse "Invalid parameters"
+// @Failure 401 {object} ErrorResponse "Unauthorized"
+// @Failure 500 {object} ErrorResponse "Internal server error"
+// @Router /api/v1/readiness/check-ins [get]
+func (h *ReadinessHandler) HandleListCheckIns(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	userID, ok := ctx.Value(userIDKey).(uuid.UUID)
+	if !ok {
+		writeError(w, http.StatusUnauthorized, "UNAUTHORIZED", "Missing user authentication")
+		return
+	}
+
+	input := readiness.ListCheckInsInput{UserID: userID}
+	if s := r.URL.Query().Get("from"); s != "" {
+		t, err := time.Parse("2006-01-02", s)
+		if err != nil {
+			writeError(w, http.StatusBadRequest, "VALIDATION_ERROR", "Invalid from format. Use YYYY-MM-DD.")
+			return
+		}
+		input.From = &t
+	}
+	if s := r.URL.Query().Get("to"); s != "" {
+		t, err := time.Parse("2006-01-02", s)
+		if err != nil {
+			writeError(w, http.StatusBadRequest, "VALIDATION_ERROR", "Invalid to format. Use YYYY-MM-DD.")
+			return
+		}
+		input.To = &t
+	}
+
+	trend, err := h.listCheckInsUC.Execute(ctx, input)
+	if err != nil {
+		if errors.Is(err, domainerrors.ErrMalformedParameters) || isStatValidationError(err) {
+			writeError(w, http.StatusBadRequest, "VALIDATION_ERROR", err.Error())
+			return
+		}
+		writeError(w, http.StatusInternalServerError, "INTERNAL_ERROR", "An unexpected error occurred.")
+		return
+	}
+
+	out := ReadinessTrendDTO{
+		From:         trend.From.Format("2006-01-02"),
+		To:           trend.To.Format("2006-01-02"),
+		AverageScore: trend.AverageScore,
+		Days:         make([]ReadinessDayDTO, 0, len(trend.Days)),
+		CheckIns:     make([]ReadinessCheckInDTO, 0, len(trend.CheckIns)),
+	}
+	for _, d := range trend.Days {
+		out.Days = append(out.Days, ReadinessDayDTO{Date: d.Date.Format("2006-01-02"), Score: d.Score, CheckIns: d.CheckIns})
+	}
+	for _, c := range trend.CheckIns {
+		out.CheckIns = append(out.CheckIns, mapReadinessCheckInToDTO(c))
+	}
+	writeSuccess(w, http.StatusOK, out)
+}
+
+func mapReadinessCheckInToDTO(c entities.ReadinessCheckIn) ReadinessCheckInDTO {
+	dto := ReadinessCheckInDTO{
+		ID:         c.ID.String(),
+		SleepHours: c.SleepHours,
+		Stress:     c.Stress,
+		Soreness:   c.Soreness,
+		HRV:        c.HRV,
+		Score:      c.Score,
+		Level:      c.Level.String(),
+		CheckedAt:  c.CheckedAt,
+	}
+	if c.SessionID != nil {
+		id := c.SessionID.String()
+		dto.SessionID = &id
+	}
+	if c.Adjustment != nil {
+		a := c.Adjustment.String()
+		dto.Adjustment = &a
+	}
+	return dto
+}
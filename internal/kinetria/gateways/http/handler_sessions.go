@@ -4,10 +4,12 @@ import (
 	"encoding/json"
 	"errors"
 	"net/http"
+	"time"
 
 	"github.com/go-chi/chi/v5"
 	"github.com/google/uuid"
 	domainerrors "github.com/kinetria/kinetria-back/internal/kinetria/domain/errors"
+	"github.com/kinetria/kinetria-back/internal/kinetria/domain/profile"
 	domainsessions "github.com/kinetria/kinetria-back/internal/kinetria/domain/sessions"
 	"github.com/kinetria/kinetria-back/internal/kinetria/domain/vos"
 )
@@ -24,6 +26,12 @@ type SessionsHandler struct {
 	recordSetUC      *domainsessions.RecordSetUseCase
 	finishSessionUC  *domainsessions.FinishSessionUseCase
 	abandonSessionUC *domainsessions.AbandonSessionUseCase
+	getSummaryUC     *domainsessions.GetSessionSummaryUC
+	getFeedbackUC    *domainsessions.GetSessionFeedbackUC
+	updateFeedbackUC *domainsessions.UpdateSessionFeedbackUC
+	logSessionUC     *domainsessions.LogSessionUC
+	updateSessionUC  *domainsessions.UpdateSessionUC
+	getProfileUC     *profile.GetProfileUC
 }
 
 // NewSessionsHandler creates a new SessionsHandler with the required use cases.
@@ -32,18 +40,35 @@ func NewSessionsHandler(
 	recordSetUC *domainsessions.RecordSetUseCase,
 	finishSessionUC *domainsessions.FinishSessionUseCase,
 	abandonSessionUC *domainsessions.AbandonSessionUseCase,
+	getSummaryUC *domainsessions.GetSessionSummaryUC,
+	getFeedbackUC *domainsessions.GetSessionFeedbackUC,
+	updateFeedbackUC *domainsessions.UpdateSessionFeedbackUC,
+	logSessionUC *domainsessions.LogSessionUC,
+	updateSessionUC *domainsessions.UpdateSessionUC,
+	getProfileUC *profile.GetProfileUC,
 ) *SessionsHandler {
 	return &SessionsHandler{
 		startSessionUC:   startSessionUC,
 		recordSetUC:      recordSetUC,
 		finishSessionUC:  finishSessionUC,
 		abandonSessionUC: abandonSessionUC,
+		getSummaryUC:     getSummaryUC,
+		getFeedbackUC:    getFeedbackUC,
+		updateFeedbackUC: updateFeedbackUC,
+		logSessionUC:     logSessionUC,
+		updateSessionUC:  updateSessionUC,
+		getProfileUC:     getProfileUC,
 	}
 }
 
 // StartSession godoc
 // @Summary Start a workout session
-// @Description Start a new workout session for a specific workout
+// @Description Start a new workout session for a specific workout.
+// @Description An optional readiness check-in (see POST /readiness/check-ins) is recorded with the session; without it
+// @Description the latest check-in of the last 12 hours not used by another session is taken. With adjust "load" or
+// @Description "volume" a low readiness (score below 50) cuts the day's prescription by 10%: loads are rounded down
+// @Description to 0.5 kg and volume drops at least one set per exercise. The response has the check-in and the day's
+// @Description targets under "readiness" (null without a check-in); the workout's prescription is not changed.
 // @Tags sessions
 // @Accept json
 // @Produce json
@@ -66,7 +91,9 @@ func (h *SessionsHandler) StartSession(w http.ResponseWriter, r *http.Request) {
 	}
 
 	var req struct {
-		WorkoutID string `json:"workoutId"`
+		WorkoutID string                   `json:"workoutId"`
+		Readiness *ReadinessCheckInRequest `json:"readiness"`
+		Adjust    *string                  `json:"adjust"`
 	}
 	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
 		writeError(w, http.StatusUnprocessableEntity, "VALIDATION_ERROR", "Request body is invalid.")
@@ -79,14 +106,28 @@ func (h *SessionsHandler) StartSession(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
-	output, err := h.startSessionUC.Execute(r.Context(), domainsessions.StartSessionInput{
+	input := domainsessions.StartSessionInput{
 		UserID:    userID,
 		WorkoutID: workoutID,
-	})
+	}
+	if req.Readiness != nil {
+		markers, err := req.Readiness.toMarkers()
+		if err != nil {
+			writeError(w, http.StatusUnprocessableEntity, "VALIDATION_ERROR", err.Error())
+			return
+		}
+		input.Readiness = &markers
+	}
+	if req.Adjust != nil {
+		adjustment := vos.ReadinessAdjustment(*req.Adjust)
+		input.Adjustment = &adjustment
+	}
+
+	output, err := h.startSessionUC.Execute(r.Context(), input)
 	if err != nil {
 		switch {
 		case errors.Is(err, domainerrors.ErrMalformedParameters):
-			writeError(w, http.StatusUnprocessableEntity, "VALIDATION_ERROR", "Invalid parameters provided.")
+			writeError(w, http.StatusUnprocessableEntity, "VALIDATION_ERROR", err.Error())
 		case errors.Is(err, domainerrors.ErrWorkoutNotFound):
 			writeError(w, http.StatusNotFound, "WORKOUT_NOT_FOUND", "Workout not found.")
 		case errors.Is(err, domainerrors.ErrActiveSessionExists):
@@ -97,17 +138,29 @@ func (h *SessionsHandler) StartSession(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
+	var readiness *SessionReadinessDTO
+	if output.Readiness != nil {
+		units, err := unitSystemFor(r.Context(), h.getProfileUC, userID)
+		if err != nil {
+			writeError(w, http.StatusInternalServerError, "INTERNAL_ERROR", "An unexpected error occurred.")
+			return
+		}
+		readiness = mapSessionReadinessToDTO(output.Readiness, units)
+	}
+
 	writeSuccess(w, http.StatusCreated, map[string]interface{}{
 		"id":        output.Session.ID.String(),
 		"workoutId": output.Session.WorkoutID.String(),
 		"startedAt": output.Session.StartedAt,
 		"status":    string(output.Session.Status),
+		"readiness": readiness,
 	})
 }
 
 // RecordSet godoc
 // @Summary Record a set
-// @Description Record a completed or skipped set for an exercise
+// @Description Record a completed or skipped set for an exercise.
+// @Description weight is in the user's unit preference (kg for metric, lb for imperial); the response echoes it with weightUnit.
 // @Tags sessions
 // @Accept json
 // @Produce json
@@ -136,17 +189,23 @@ func (h *SessionsHandler) RecordSet(w http.ResponseWriter, r *http.Request) {
 	}
 
 	var req struct {
-		ExerciseID string `json:"exerciseId"`
-		SetNumber  int    `json:"setNumber"`
-		Weight     int    `json:"weight"` // grams
-		Reps       int    `json:"reps"`
-		Status     string `json:"status"`
+		ExerciseID string  `json:"exerciseId"`
+		SetNumber  int     `json:"setNumber"`
+		Weight     float64 `json:"weight"` // kg or lb, per the user's unit preference
+		Reps       int     `json:"reps"`
+		Status     string  `json:"status"`
 	}
 	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
 		writeError(w, http.StatusUnprocessableEntity, "VALIDATION_ERROR", "Request body is invalid.")
 		return
 	}
 
+	units, err := unitSystemFor(r.Context(), h.getProfileUC, userID)
+	if err != nil {
+		writeError(w, http.StatusInternalServerError, "INTERNAL_ERROR", "An unexpected error occurred.")
+		return
+	}
+
 	exerciseID, err := uuid.Parse(req.ExerciseID)
 	if err != nil {
 		writeError(w, http.StatusUnprocessableEntity, "VALIDATION_ERROR", "Invalid exerciseId format.")
@@ -158,7 +217,7 @@ func (h *SessionsHandler) RecordSet(w http.ResponseWriter, r *http.Request) {
 		SessionID:  sessionID,
 		ExerciseID: exerciseID,
 		SetNumber:  req.SetNumber,
-		Weight:     req.Weight,
+		Weight:     int(units.ToGrams(req.Weight)),
 		Reps:       req.Reps,
 		Status:     vos.SetRecordStatus(req.Status),
 	})
@@ -185,25 +244,32 @@ func (h *SessionsHandler) RecordSet(w http.ResponseWriter, r *http.Request) {
 		"sessionId":  output.SetRecord.SessionID.String(),
 		"exerciseId": output.SetRecord.WorkoutExerciseID.String(),
 		"setNumber":  output.SetRecord.SetNumber,
-		"weight":     output.SetRecord.Weight,
+		"weight":     units.FromGrams(int64(output.SetRecord.Weight)),
+		"weightUnit": units.WeightUnit(),
 		"reps":       output.SetRecord.Reps,
 		"status":     output.SetRecord.Status,
 		"recordedAt": output.SetRecord.RecordedAt,
+		// Conquistas concedidas por esta série
+		"newAchievements": achievementCodes(output.Achievements),
 	})
 }
 
 // FinishSession godoc
 // @Summary Finish a workout session
-// @Description Mark a workout session as completed
+// @Description Mark a workout session as completed. The response includes the session summary
+// @Description (see GET /sessions/{sessionId}/summary); it is null if the summary could not be built.
+// @Description The optional feedback (rpe 1-10; mood, energy, sleepQuality and soreness per muscle group 1-5)
+// @Description is stored with the session and can be edited later with PUT /sessions/{sessionId}/feedback.
 // @Tags sessions
 // @Accept json
 // @Produce json
 // @Security BearerAuth
 // @Param sessionId path string true "Session ID"
-// @Param request body FinishSessionRequest true "Finish notes"
+// @Param request body FinishSessionRequest true "Finish notes and optional session feedback"
 // @Success 200 {object} SuccessResponse{data=SessionStatusResponse}
 // @Failure 401 {object} ErrorResponse "Unauthorized"
 // @Failure 404 {object} ErrorResponse "Session not found"
+// @Failure 422 {object} ErrorResponse "Validation error"
 // @Failure 500 {object} ErrorResponse "Internal server error"
 // @Router /api/v1/sessions/{sessionId}/finish [patch]
 func (h *SessionsHandler) FinishSession(w http.ResponseWriter, r *http.Request) {
@@ -223,6 +289,7 @@ func (h *SessionsHandler) FinishSession(w http.ResponseWriter, r *http.Request)
 
 	var req struct {
 		Notes string `json:"notes"`
+		SessionFeedbackDTO
 	}
 	if r.ContentLength != 0 {
 		if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
@@ -231,13 +298,26 @@ func (h *SessionsHandler) FinishSession(w http.ResponseWriter, r *http.Request)
 		}
 	}
 
+	units, err := unitSystemFor(r.Context(), h.getProfileUC, userID)
+	if err != nil {
+		writeError(w, http.StatusInternalServerError, "INTERNAL_ERROR", "An unexpected error occurred.")
+		return
+	}
+
 	output, err := h.finishSessionUC.Execute(r.Context(), domainsessions.FinishSessionInput{
-		UserID:    userID,
-		SessionID: sessionID,
-		Notes:     req.Notes,
+		UserID:       userID,
+		SessionID:    sessionID,
+		Notes:        req.Notes,
+		RPE:          req.RPE,
+		Mood:         req.Mood,
+		Energy:       req.Energy,
+		SleepQuality: req.SleepQuality,
+		Soreness:     soreMuscleGroups(req.Soreness),
 	})
 	if err != nil {
 		switch {
+		case errors.Is(err, domainerrors.ErrMalformedParameters):
+			writeError(w, http.StatusUnprocessableEntity, "VALIDATION_ERROR", err.Error())
 		case errors.Is(err, domainerrors.ErrNotFound):
 			writeError(w, http.StatusNotFound, "SESSION_NOT_FOUND", "Session not found.")
 		case errors.Is(err, domainerrors.ErrSessionAlreadyClosed):
@@ -255,7 +335,376 @@ func (h *SessionsHandler) FinishSession(w http.ResponseWriter, r *http.Request)
 		"finishedAt": output.Session.FinishedAt,
 		"status":     string(output.Session.Status),
 		"notes":      output.Session.Notes,
+		"calories":   output.Session.Calories,
+		"rpe":        output.Session.RPE,
+		// Conquistas concedidas por esta sessão
+		"newAchievements": achievementCodes(output.Achievements),
+		"summary":         mapSessionSummaryPtrToDTO(output.Summary, units),
+	})
+}
+
+// LogSession godoc
+// @Summary Log a past session
+// @Description Log a session that already happened, e.g. when the app was not used at the gym. The session is created
+// @Description completed with the given startedAt and finishedAt (at most 12 hours apart, not in the future) and all of
+// @Description its sets in one transaction; nothing is stored if any part is invalid. The times must not overlap another
+// @Description session of the user that was not abandoned. Sets follow POST /sessions/{sessionId}/sets (weight in the
+// @Description user's unit preference) and the optional feedback follows PATCH /sessions/{sessionId}/finish.
+// @Description The session counts in statistics, streaks and achievements like a finished one, and the response matches finish.
+// @Tags sessions
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param request body LogSessionRequest true "Past session with its sets"
+// @Success 201 {object} SuccessResponse{data=SessionStatusResponse}
+// @Failure 401 {object} ErrorResponse "Unauthorized"
+// @Failure 404 {object} ErrorResponse "Workout or exercise not found"
+// @Failure 409 {object} ErrorResponse "Session overlaps another session"
+// @Failure 422 {object} ErrorResponse "Validation error"
+// @Failure 500 {object} ErrorResponse "Internal server error"
+// @Router /api/v1/sessions/log [post]
+func (h *SessionsHandler) LogSession(w http.ResponseWriter, r *http.Request) {
+	r.Body = http.MaxBytesReader(w, r.Body, 1<<20) // 1 MB limit
+
+	userID, ok := r.Context().Value(userIDKey).(uuid.UUID)
+	if !ok {
+		writeError(w, http.StatusUnauthorized, "UNAUTHORIZED", "Invalid or expired access token.")
+		return
+	}
+
+	var req struct {
+		WorkoutID  string    `json:"workoutId"`
+		StartedAt  time.Time `json:"startedAt"`
+		FinishedAt time.Time `json:"finishedAt"`
+		Notes      string    `json:"notes"`
+		Sets       []struct {
+			ExerciseID string  `json:"exerciseId"`
+			SetNumber  int     `json:"setNumber"`
+			Weight     float64 `json:"weight"` // kg or lb, per the user's unit preference
+			Reps       int     `json:"reps"`
+			Status     string  `json:"status"`
+		} `json:"sets"`
+		SessionFeedbackDTO
+	}
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		writeError(w, http.StatusUnprocessableEntity, "VALIDATION_ERROR", "Request body is invalid.")
+		return
+	}
+
+	workoutID, err := uuid.Parse(req.WorkoutID)
+	if err != nil {
+		writeError(w, http.StatusUnprocessableEntity, "VALIDATION_ERROR", "Invalid workoutId format.")
+		return
+	}
+
+	units, err := unitSystemFor(r.Context(), h.getProfileUC, userID)
+	if err != nil {
+		writeError(w, http.StatusInternalServerError, "INTERNAL_ERROR", "An unexpected error occurred.")
+		return
+	}
+
+	input := domainsessions.LogSessionInput{
+		UserID:     userID,
+		WorkoutID:  workoutID,
+		StartedAt:  req.StartedAt,
+		FinishedAt: req.FinishedAt,
+		Notes:      req.Notes,
+		Sets:       make([]domainsessions.LoggedSet, 0, len(req.Sets)),
+		Feedback: vos.SessionFeedback{
+			RPE:          req.RPE,
+			Mood:         req.Mood,
+			Energy:       req.Energy,
+			SleepQuality: req.SleepQuality,
+			Soreness:     soreMuscleGroups(req.Soreness),
+		},
+	}
+	for _, set := range req.Sets {
+		exerciseID, err := uuid.Parse(set.ExerciseID)
+		if err != nil {
+			writeError(w, http.StatusUnprocessableEntity, "VALIDATION_ERROR", "Invalid exerciseId format.")
+			return
+		}
+		input.Sets = append(input.Sets, domainsessions.LoggedSet{
+			ExerciseID: exerciseID,
+			SetNumber:  set.SetNumber,
+			Weight:     int(units.ToGrams(set.Weight)),
+			Reps:       set.Reps,
+			Status:     vos.SetRecordStatus(set.Status),
+		})
+	}
+
+	output, err := h.logSessionUC.Execute(r.Context(), input)
+	if err != nil {
+		switch {
+		case errors.Is(err, domainerrors.ErrMalformedParameters):
+			writeError(w, http.StatusUnprocessableEntity, "VALIDATION_ERROR", err.Error())
+		case errors.Is(err, domainerrors.ErrWorkoutNotFound):
+			writeError(w, http.StatusNotFound, "WORKOUT_NOT_FOUND", "Workout not found.")
+		case errors.Is(err, domainerrors.ErrExerciseNotFound):
+			writeError(w, http.StatusNotFound, "EXERCISE_NOT_FOUND", "Exercise not found in the workout.")
+		case errors.Is(err, domainerrors.ErrSessionOverlap):
+			writeError(w, http.StatusConflict, "SESSION_OVERLAP", "Session overlaps another session.")
+		default:
+			writeError(w, http.StatusInternalServerError, "INTERNAL_ERROR", "An unexpected error occurred.")
+		}
+		return
+	}
+
+	writeSuccess(w, http.StatusCreated, map[string]interface{}{
+		"id":         output.Session.ID.String(),
+		"workoutId":  output.Session.WorkoutID.String(),
+		"startedAt":  output.Session.StartedAt,
+		"finishedAt": output.Session.FinishedAt,
+		"status":     string(output.Session.Status),
+		"notes":      output.Session.Notes,
+		"calories":   output.Session.Calories,
+		"rpe":        output.Session.RPE,
+		// Conquistas concedidas pela sessão registrada
+		"newAchievements": achievementCodes(output.Achievements),
+		"summary":         mapSessionSummaryPtrToDTO(output.Summary, units),
+	})
+}
+
+// UpdateSession godoc
+// @Summary Correct a completed session
+// @Description Correct the startedAt, finishedAt and notes of a completed session, e.g. when it was finished in the app
+// @Description long after the workout ended. Omitted fields are kept. The session must stay at most 12 hours long, end in
+// @Description the past and not overlap another session of the user that was not abandoned. When the times change, the
+// @Description calorie estimate and the statistics of the old and new days are recomputed.
+// @Tags sessions
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param sessionId path string true "Session ID"
+// @Param request body UpdateSessionRequest true "Fields to correct"
+// @Success 200 {object} SuccessResponse{data=SessionStatusResponse}
+// @Failure 401 {object} ErrorResponse "Unauthorized"
+// @Failure 404 {object} ErrorResponse "Session not found"
+// @Failure 409 {object} ErrorResponse "Session not completed or overlapping another session"
+// @Failure 422 {object} ErrorResponse "Validation error"
+// @Failure 500 {object} ErrorResponse "Internal server error"
+// @Router /api/v1/sessions/{sessionId} [patch]
+func (h *SessionsHandler) UpdateSession(w http.ResponseWriter, r *http.Request) {
+	r.Body = http.MaxBytesReader(w, r.Body, 1<<20) // 1 MB limit
+
+	userID, ok := r.Context().Value(userIDKey).(uuid.UUID)
+	if !ok {
+		writeError(w, http.StatusUnauthorized, "UNAUTHORIZED", "Invalid or expired access token.")
+		return
+	}
+
+	sessionID, err := uuid.Parse(chi.URLParam(r, "sessionId"))
+	if err != nil {
+		writeError(w, http.StatusUnprocessableEntity, "VALIDATION_ERROR", "Invalid sessionId format.")
+		return
+	}
+
+	var req struct {
+		StartedAt  *time.Time `json:"startedAt"`
+		FinishedAt *time.Time `json:"finishedAt"`
+		Notes      *string    `json:"notes"`
+	}
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		writeError(w, http.StatusUnprocessableEntity, "VALIDATION_ERROR", "Request body is invalid.")
+		return
+	}
+	if req.StartedAt == nil && req.FinishedAt == nil && req.Notes == nil {
+		writeError(w, http.StatusUnprocessableEntity, "VALIDATION_ERROR", "At least one of startedAt, finishedAt or notes is required.")
+		return
+	}
+
+	session, err := h.updateSessionUC.Execute(r.Context(), domainsessions.UpdateSessionInput{
+		UserID:     userID,
+		SessionID:  sessionID,
+		StartedAt:  req.StartedAt,
+		FinishedAt: req.FinishedAt,
+		Notes:      req.Notes,
+	})
+	if err != nil {
+		switch {
+		case errors.Is(err, domainerrors.ErrMalformedParameters):
+			writeError(w, http.StatusUnprocessableEntity, "VALIDATION_ERROR", err.Error())
+		case errors.Is(err, domainerrors.ErrNotFound):
+			writeError(w, http.StatusNotFound, "SESSION_NOT_FOUND", "Session not found.")
+		case errors.Is(err, domainerrors.ErrConflict):
+			writeError(w, http.StatusConflict, "SESSION_NOT_COMPLETED", "Only completed sessions can be corrected.")
+		case errors.Is(err, domainerrors.ErrSessionOverlap):
+			writeError(w, http.StatusConflict, "SESSION_OVERLAP", "Session overlaps another session.")
+		default:
+			writeError(w, http.StatusInternalServerError, "INTERNAL_ERROR", "An unexpected error occurred.")
+		}
+		return
+	}
+
+	writeSuccess(w, http.StatusOK, map[string]interface{}{
+		"id":         session.ID.String(),
+		"workoutId":  session.WorkoutID.String(),
+		"startedAt":  session.StartedAt,
+		"finishedAt": session.FinishedAt,
+		"status":     string(session.Status),
+		"notes":      session.Notes,
+		"calories":   session.Calories,
+		"rpe":        session.RPE,
+	})
+}
+
+// GetSessionSummary godoc
+// @Summary Get a session summary
+// @Description Duration, total sets, reps and volume (completed sets only), the per-exercise breakdown against
+// @Description the workout's prescription, the personal records set (heaviest set or Epley e1RM above the
+// @Description exercise's best in earlier sessions), a comparison with the previous completed session of the
+// @Description same workout and the completion rate (share of the prescribed sets completed, 0-100).
+// @Description Weights and volumes are in the user's unit preference, named by weightUnit.
+// @Tags sessions
+// @Produce json
+// @Security BearerAuth
+// @Param sessionId path string true "Session ID"
+// @Success 200 {object} SuccessResponse{data=SessionSummaryDTO}
+// @Failure 401 {object} ErrorResponse "Unauthorized"
+// @Failure 404 {object} ErrorResponse "Session not found"
+// @Failure 422 {object} ErrorResponse "Validation error"
+// @Failure 500 {object} ErrorResponse "Internal server error"
+// @Router /api/v1/sessions/{sessionId}/summary [get]
+func (h *SessionsHandler) GetSessionSummary(w http.ResponseWriter, r *http.Request) {
+	userID, ok := r.Context().Value(userIDKey).(uuid.UUID)
+	if !ok {
+		writeError(w, http.StatusUnauthorized, "UNAUTHORIZED", "Invalid or expired access token.")
+		return
+	}
+
+	sessionID, err := uuid.Parse(chi.URLParam(r, "sessionId"))
+	if err != nil {
+		writeError(w, http.StatusUnprocessableEntity, "VALIDATION_ERROR", "Invalid sessionId format.")
+		return
+	}
+
+	out, err := h.getSummaryUC.Execute(r.Context(), domainsessions.GetSessionSummaryInput{
+		UserID:    userID,
+		SessionID: sessionID,
+	})
+	if err != nil {
+		switch {
+		case errors.Is(err, domainerrors.ErrNotFound):
+			writeError(w, http.StatusNotFound, "SESSION_NOT_FOUND", "Session not found.")
+		default:
+			writeError(w, http.StatusInternalServerError, "INTERNAL_ERROR", "An unexpected error occurred.")
+		}
+		return
+	}
+
+	units, err := unitSystemFor(r.Context(), h.getProfileUC, userID)
+	if err != nil {
+		writeError(w, http.StatusInternalServerError, "INTERNAL_ERROR", "An unexpected error occurred.")
+		return
+	}
+
+	writeSuccess(w, http.StatusOK, mapSessionSummaryToDTO(out, units))
+}
+
+// GetSessionFeedback godoc
+// @Summary Get the feedback of a session
+// @Description The post-session feedback: rpe (1-10), mood, energy, sleepQuality and soreness per muscle group (1-5).
+// @Description Ratings not given are null; soreness lists only the sore muscle groups.
+// @Tags sessions
+// @Produce json
+// @Security BearerAuth
+// @Param sessionId path string true "Session ID"
+// @Success 200 {object} SuccessResponse{data=SessionFeedbackDTO}
+// @Failure 401 {object} ErrorResponse "Unauthorized"
+// @Failure 404 {object} ErrorResponse "Session not found"
+// @Failure 422 {object} ErrorResponse "Validation error"
+// @Failure 500 {object} ErrorResponse "Internal server error"
+// @Router /api/v1/sessions/{sessionId}/feedback [get]
+func (h *SessionsHandler) GetSessionFeedback(w http.ResponseWriter, r *http.Request) {
+	userID, ok := r.Context().Value(userIDKey).(uuid.UUID)
+	if !ok {
+		writeError(w, http.StatusUnauthorized, "UNAUTHORIZED", "Invalid or expired access token.")
+		return
+	}
+
+	sessionID, err := uuid.Parse(chi.URLParam(r, "sessionId"))
+	if err != nil {
+		writeError(w, http.StatusUnprocessableEntity, "VALIDATION_ERROR", "Invalid sessionId format.")
+		return
+	}
+
+	feedback, err := h.getFeedbackUC.Execute(r.Context(), userID, sessionID)
+	if err != nil {
+		switch {
+		case errors.Is(err, domainerrors.ErrNotFound):
+			writeError(w, http.StatusNotFound, "SESSION_NOT_FOUND", "Session not found.")
+		default:
+			writeError(w, http.StatusInternalServerError, "INTERNAL_ERROR", "An unexpected error occurred.")
+		}
+		return
+	}
+
+	writeSuccess(w, http.StatusOK, mapSessionFeedbackToDTO(feedback))
+}
+
+// UpdateSessionFeedback godoc
+// @Summary Update the feedback of a session
+// @Description Replaces the whole feedback of a completed session: ratings omitted or null are cleared.
+// @Tags sessions
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param sessionId path string true "Session ID"
+// @Param request body SessionFeedbackDTO true "Session feedback"
+// @Success 200 {object} SuccessResponse{data=SessionFeedbackDTO}
+// @Failure 401 {object} ErrorResponse "Unauthorized"
+// @Failure 404 {object} ErrorResponse "Session not found"
+// @Failure 409 {object} ErrorResponse "Session is not completed"
+// @Failure 422 {object} ErrorResponse "Validation error"
+// @Failure 500 {object} ErrorResponse "Internal server error"
+// @Router /api/v1/sessions/{sessionId}/feedback [put]
+func (h *SessionsHandler) UpdateSessionFeedback(w http.ResponseWriter, r *http.Request) {
+	r.Body = http.MaxBytesReader(w, r.Body, 1<<20) // 1 MB limit
+
+	userID, ok := r.Context().Value(userIDKey).(uuid.UUID)
+	if !ok {
+		writeError(w, http.StatusUnauthorized, "UNAUTHORIZED", "Invalid or expired access token.")
+		return
+	}
+
+	sessionID, err := uuid.Parse(chi.URLParam(r, "sessionId"))
+	if err != nil {
+		writeError(w, http.StatusUnprocessableEntity, "VALIDATION_ERROR", "Invalid sessionId format.")
+		return
+	}
+
+	var req SessionFeedbackDTO
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		writeError(w, http.StatusUnprocessableEntity, "VALIDATION_ERROR", "Request body is invalid.")
+		return
+	}
+
+	feedback, err := h.updateFeedbackUC.Execute(r.Context(), domainsessions.UpdateSessionFeedbackInput{
+		UserID:    userID,
+		SessionID: sessionID,
+		Feedback: vos.SessionFeedback{
+			RPE:          req.RPE,
+			Mood:         req.Mood,
+			Energy:       req.Energy,
+			SleepQuality: req.SleepQuality,
+			Soreness:     soreMuscleGroups(req.Soreness),
+		},
 	})
+	if err != nil {
+		switch {
+		case errors.Is(err, domainerrors.ErrMalformedParameters):
+			writeError(w, http.StatusUnprocessableEntity, "VALIDATION_ERROR", err.Error())
+		case errors.Is(err, domainerrors.ErrNotFound):
+			writeError(w, http.StatusNotFound, "SESSION_NOT_FOUND", "Session not found.")
+		case errors.Is(err, domainerrors.ErrConflict):
+			writeError(w, http.StatusConflict, "SESSION_NOT_COMPLETED", "Only completed sessions take feedback.")
+		default:
+			writeError(w, http.StatusInternalServerError, "INTERNAL_ERROR", "An unexpected error occurred.")
+		}
+		return
+	}
+
+	writeSuccess(w, http.StatusOK, mapSessionFeedbackToDTO(feedback))
 }
 
 // AbandonSession godoc
@@ -311,3 +760,214 @@ func (h *SessionsHandler) AbandonSession(w http.ResponseWriter, r *http.Request)
 		"status":     string(output.Session.Status),
 	})
 }
+
+// SessionSummaryDTO is the recap of a session. Weights and volumes are in weightUnit.
+type SessionSummaryDTO struct {
+	SessionID       string                      `json:"sessionId"`
+	WorkoutID       string                      `json:"workoutId"`
+	Status          string                      `json:"status"`
+	StartedAt       time.Time                   `json:"startedAt"`
+	FinishedAt      *time.Time                  `json:"finishedAt"`
+	DurationMinutes int                         `json:"durationMinutes"`
+	TotalSets       int                         `json:"totalSets"`
+	TotalReps       int                         `json:"totalReps"`
+	TotalVolume     float64                     `json:"totalVolume"`
+	WeightUnit      string                      `json:"weightUnit"`
+	CompletionRate  float64                     `json:"completionRate"`
+	Calories        *int                        `json:"calories"`
+	RPE             *int                        `json:"rpe"`
+	Exercises       []SessionExerciseSummaryDTO `json:"exercises"`
+	PersonalRecords []SessionPersonalRecordDTO  `json:"personalRecords"`
+	Comparison      *SessionComparisonDTO       `json:"comparison"` // null sem sessão anterior do mesmo treino
+}
+
+// SessionExerciseSummaryDTO is the work done on one exercise against its prescription.
+type SessionExerciseSummaryDTO struct {
+	ExerciseID     string  `json:"exerciseId"`
+	ExerciseName   string  `json:"exerciseName"`
+	PrescribedSets int     `json:"prescribedSets"`
+	PrescribedReps string  `json:"prescribedReps"`
+	CompletedSets  int     `json:"completedSets"`
+	SkippedSets    int     `json:"skippedSets"`
+	TotalReps      int     `json:"totalReps"`
+	Volume         float64 `json:"volume"`
+	MaxWeight      float64 `json:"maxWeight"`
+	CompletionRate float64 `json:"completionRate"`
+}
+
+// SessionPersonalRecordDTO is a personal record set in the session.
+type SessionPersonalRecordDTO struct {
+	ExerciseID   string  `json:"exerciseId"`
+	ExerciseName string  `json:"exerciseName"`
+	Kind         string  `json:"kind" enums:"weight,e1rm"`
+	Value        float64 `json:"value"`
+	Previous     float64 `json:"previous"`
+}
+
+// SessionTotalsDTO holds the aggregate numbers of a session.
+type SessionTotalsDTO struct {
+	DurationMinutes int     `json:"durationMinutes"`
+	TotalSets       int     `json:"totalSets"`
+	TotalReps       int     `json:"totalReps"`
+	TotalVolume     float64 `json:"totalVolume"`
+}
+
+// SessionComparisonDTO compares the session with the previous completed session of the
+// same workout; deltas are this session minus the previous one.
+type SessionComparisonDTO struct {
+	PreviousSessionID string           `json:"previousSessionId"`
+	PreviousStartedAt time.Time        `json:"previousStartedAt"`
+	Previous          SessionTotalsDTO `json:"previous"`
+	Deltas            SessionTotalsDTO `json:"deltas"`
+}
+
+func mapSessionSummaryPtrToDTO(s *domainsessions.SessionSummary, units vos.UnitSystem) *SessionSummaryDTO {
+	if s == nil {
+		return nil
+	}
+	dto := mapSessionSummaryToDTO(s, units)
+	return &dto
+}
+
+func mapSessionSummaryToDTO(s *domainsessions.SessionSummary, units vos.UnitSystem) SessionSummaryDTO {
+	dto := SessionSummaryDTO{
+		SessionID:       s.Session.ID.String(),
+		WorkoutID:       s.Session.WorkoutID.String(),
+		Status:          string(s.Session.Status),
+		StartedAt:       s.Session.StartedAt,
+		FinishedAt:      s.Session.FinishedAt,
+		DurationMinutes: s.DurationMinutes,
+		TotalSets:       s.TotalSets,
+		TotalReps:       s.TotalReps,
+		TotalVolume:     units.FromGrams(s.TotalVolume),
+		WeightUnit:      string(units.WeightUnit()),
+		CompletionRate:  s.CompletionRate,
+		Calories:        s.Session.Calories,
+		RPE:             s.Session.RPE,
+		Exercises:       make([]SessionExerciseSummaryDTO, len(s.Exercises)),
+		PersonalRecords: make([]SessionPersonalRecordDTO, len(s.PersonalRecords)),
+	}
+	for i, ex := range s.Exercises {
+		dto.Exercises[i] = SessionExerciseSummaryDTO{
+			ExerciseID:     ex.ExerciseID.String(),
+			ExerciseName:   ex.ExerciseName,
+			PrescribedSets: ex.PrescribedSets,
+			PrescribedReps: ex.PrescribedReps,
+			CompletedSets:  ex.CompletedSets,
+			SkippedSets:    ex.SkippedSets,
+			TotalReps:      ex.TotalReps,
+			Volume:         units.FromGrams(ex.Volume),
+			MaxWeight:      units.FromGrams(int64(ex.MaxWeight)),
+			CompletionRate: ex.CompletionRate,
+		}
+	}
+	for i, pr := range s.PersonalRecords {
+		dto.PersonalRecords[i] = SessionPersonalRecordDTO{
+			ExerciseID:   pr.ExerciseID.String(),
+			ExerciseName: pr.ExerciseName,
+			Kind:         pr.Kind,
+			Value:        units.FromGrams(int64(pr.Value)),
+			Previous:     units.FromGrams(int64(pr.Previous)),
+		}
+	}
+	if c := s.Comparison; c != nil {
+		dto.Comparison = &SessionComparisonDTO{
+			PreviousSessionID: c.PreviousSessionID.String(),
+			PreviousStartedAt: c.PreviousStartedAt,
+			Previous: SessionTotalsDTO{
+				DurationMinutes: c.Previous.DurationMinutes,
+				TotalSets:       c.Previous.TotalSets,
+				TotalReps:       c.Previous.TotalReps,
+				TotalVolume:     units.FromGrams(c.Previous.TotalVolume),
+			},
+			Deltas: SessionTotalsDTO{
+				DurationMinutes: s.DurationMinutes - c.Previous.DurationMinutes,
+				TotalSets:       s.TotalSets - c.Previous.TotalSets,
+				TotalReps:       s.TotalReps - c.Previous.TotalReps,
+				TotalVolume:     units.FromGrams(s.TotalVolume - c.Previous.TotalVolume),
+			},
+		}
+	}
+	return dto
+}
+
+// SessionFeedbackDTO is the post-session feedback. Ratings are null when not given.
+type SessionFeedbackDTO struct {
+	RPE          *int `json:"rpe"`          // 1-10
+	Mood         *int `json:"mood"`         // 1-5
+	Energy       *int `json:"energy"`       // 1-5
+	SleepQuality *int `json:"sleepQuality"` // 1-5
+	// Soreness rates the sore muscle groups 1-5, keyed by muscle group.
+	Soreness map[string]int `json:"soreness"`
+}
+
+func soreMuscleGroups(soreness map[string]int) map[vos.MuscleGroup]int {
+	if len(soreness) == 0 {
+		return nil
+	}
+	out := make(map[vos.MuscleGroup]int, len(soreness))
+	for group, rating := range soreness {
+		out[vos.MuscleGroup(group)] = rating
+	}
+	return out
+}
+
+func mapSessionFeedbackToDTO(f *vos.SessionFeedback) SessionFeedbackDTO {
+	soreness := make(map[string]int, len(f.Soreness))
+	for group, rating := range f.Soreness {
+		soreness[string(group)] = rating
+	}
+	return SessionFeedbackDTO{
+		RPE:          f.RPE,
+		Mood:         f.Mood,
+		Energy:       f.Energy,
+		SleepQuality: f.SleepQuality,
+		Soreness:     soreness,
+	}
+}
+
+// SessionReadinessDTO is the readiness check-in a session started with and the day's
+// targets. Weights are in weightUnit.
+type SessionReadinessDTO struct {
+	CheckIn    ReadinessCheckInDTO `json:"checkIn"`
+	Adjustment *string             `json:"adjustment"` // null quando a prescrição não foi ajustada
+	Reduction  float64             `json:"reduction"`  // fração cortada da prescrição, 0-1
+	WeightUnit string              `json:"weightUnit"`
+	Targets    []ExerciseTargetDTO `json:"targets"`
+}
+
+// ExerciseTargetDTO is the day's prescription of one exercise next to the workout's.
+type ExerciseTargetDTO struct {
+	ExerciseID       string  `json:"exerciseId"`
+	ExerciseName     string  `json:"exerciseName"`
+	Reps             string  `json:"reps"`
+	PrescribedSets   int     `json:"prescribedSets"`
+	Sets             int     `json:"sets"`
+	PrescribedWeight float64 `json:"prescribedWeight"`
+	Weight           float64 `json:"weight"`
+}
+
+func mapSessionReadinessToDTO(r *domainsessions.SessionReadiness, units vos.UnitSystem) *SessionReadinessDTO {
+	dto := &SessionReadinessDTO{
+		CheckIn:    mapReadinessCheckInToDTO(r.CheckIn),
+		Reduction:  r.Reduction,
+		WeightUnit: string(units.WeightUnit()),
+		Targets:    make([]ExerciseTargetDTO, 0, len(r.Targets)),
+	}
+	if r.Adjustment != nil {
+		a := r.Adjustment.String()
+		dto.Adjustment = &a
+	}
+	for _, t := range r.Targets {
+		dto.Targets = append(dto.Targets, ExerciseTargetDTO{
+			ExerciseID:       t.ExerciseID.String(),
+			ExerciseName:     t.ExerciseName,
+			Reps:             t.Reps,
+			PrescribedSets:   t.PrescribedSets,
+			Sets:             t.Sets,
+			PrescribedWeight: units.FromGrams(int64(t.PrescribedWeight)),
+			Weight:           units.FromGrams(int64(t.Weight)),
+		})
+	}
+	return dto
+}
@@ -2,20 +2,32 @@ package service
 
 import (
 	"errors"
+	"math"
 	"net/http"
+	"strconv"
 	"time"
 
 	"github.com/google/uuid"
 	domainerrors "github.com/kinetria/kinetria-back/internal/kinetria/domain/errors"
+	"github.com/kinetria/kinetria-back/internal/kinetria/domain/profile"
 	"github.com/kinetria/kinetria-back/internal/kinetria/domain/statistics"
+	"github.com/kinetria/kinetria-back/internal/kinetria/domain/vos"
 )
 
 // StatisticsHandler handles HTTP requests for statistics endpoints.
 type StatisticsHandler struct {
-	getOverviewUC        *statistics.GetOverviewUC
-	getProgressionUC     *statistics.GetProgressionUC
-	getPersonalRecordsUC *statistics.GetPersonalRecordsUC
-	getFrequencyUC       *statistics.GetFrequencyUC
+	getOverviewUC         *statistics.GetOverviewUC
+	getProgressionUC      *statistics.GetProgressionUC
+	getPersonalRecordsUC  *statistics.GetPersonalRecordsUC
+	getFrequencyUC        *statistics.GetFrequencyUC
+	getMuscleVolumeUC     *statistics.GetMuscleVolumeUC
+	getRelativeStrengthUC *statistics.GetRelativeStrengthUC
+	getTrainingLoadUC     *statistics.GetTrainingLoadUC
+	getProgressInsightsUC *statistics.GetProgressInsightsUC
+	getHeatmapUC          *statistics.GetHeatmapUC
+	getRecapUC            *statistics.GetRecapUC
+	getWellnessUC         *statistics.GetWellnessUC
+	getProfileUC          *profile.GetProfileUC
 }
 
 // NewStatisticsHandler creates a new StatisticsHandler.
@@ -24,23 +36,47 @@ func NewStatisticsHandler(
 	getProgressionUC *statistics.GetProgressionUC,
 	getPersonalRecordsUC *statistics.GetPersonalRecordsUC,
 	getFrequencyUC *statistics.GetFrequencyUC,
+	getMuscleVolumeUC *statistics.GetMuscleVolumeUC,
+	getRelativeStrengthUC *statistics.GetRelativeStrengthUC,
+	getTrainingLoadUC *statistics.GetTrainingLoadUC,
+	getProgressInsightsUC *statistics.GetProgressInsightsUC,
+	getHeatmapUC *statistics.GetHeatmapUC,
+	getRecapUC *statistics.GetRecapUC,
+	getWellnessUC *statistics.GetWellnessUC,
+	getProfileUC *profile.GetProfileUC,
 ) *StatisticsHandler {
 	return &StatisticsHandler{
-		getOverviewUC:        getOverviewUC,
-		getProgressionUC:     getProgressionUC,
-		getPersonalRecordsUC: getPersonalRecordsUC,
-		getFrequencyUC:       getFrequencyUC,
+		getOverviewUC:         getOverviewUC,
+		getProgressionUC:      getProgressionUC,
+		getPersonalRecordsUC:  getPersonalRecordsUC,
+		getFrequencyUC:        getFrequencyUC,
+		getMuscleVolumeUC:     getMuscleVolumeUC,
+		getRelativeStrengthUC: getRelativeStrengthUC,
+		getTrainingLoadUC:     getTrainingLoadUC,
+		getProgressInsightsUC: getProgressInsightsUC,
+		getHeatmapUC:          getHeatmapUC,
+		getRecapUC:            getRecapUC,
+		getWellnessUC:         getWellnessUC,
+		getProfileUC:          getProfileUC,
 	}
 }
 
 // HandleGetOverview godoc
 // @Summary Get statistics overview
 // @Description Get aggregated workout statistics for the authenticated user
+// @Description Weights and volumes are in the user's unit preference, named by weightUnit ("kg" or "lb").
+// @Description With compare=true, or a compareStartDate/compareEndDate, the response also has a "comparison" with
+// @Description the totals of the previous equal-length period (or the given one), the absolute and percentage
+// @Description delta of every metric and the per-exercise volume of both periods.
+// @Description currentStreak/longestStreak count days or weeks per streakMode (see GET /streak) and do not depend on the period.
 // @Tags statistics
 // @Produce json
 // @Security BearerAuth
 // @Param startDate query string false "Start date (RFC3339 or YYYY-MM-DD)"
 // @Param endDate query string false "End date (RFC3339 or YYYY-MM-DD)"
+// @Param compare query bool false "Compare with the previous equal-length period"
+// @Param compareStartDate query string false "Start of the comparison period (RFC3339 or YYYY-MM-DD)"
+// @Param compareEndDate query string false "End of the comparison period (RFC3339 or YYYY-MM-DD)"
 // @Success 200 {object} SuccessResponse "Overview statistics"
 // @Failure 400 {object} ErrorResponse "Invalid parameters"
 // @Failure 401 {object} ErrorResponse "Unauthorized"
@@ -74,6 +110,13 @@ func (h *StatisticsHandler) HandleGetOverview(w http.ResponseWriter, r *http.Req
 		input.EndDate = &t
 	}
 
+	compare, err := parseComparePeriod(r)
+	if err != nil {
+		writeError(w, http.StatusBadRequest, "VALIDATION_ERROR", err.Error())
+		return
+	}
+	input.Compare = compare
+
 	out, err := h.getOverviewUC.Execute(ctx, input)
 	if err != nil {
 		if isStatValidationError(err) {
@@ -84,12 +127,19 @@ func (h *StatisticsHandler) HandleGetOverview(w http.ResponseWriter, r *http.Req
 		return
 	}
 
-	writeSuccess(w, http.StatusOK, mapOverviewToResponse(out))
+	units, err := unitSystemFor(ctx, h.getProfileUC, userID)
+	if err != nil {
+		writeError(w, http.StatusInternalServerError, "INTERNAL_ERROR", "Failed to retrieve overview statistics.")
+		return
+	}
+
+	writeSuccess(w, http.StatusOK, mapOverviewToResponse(out, units))
 }
 
 // HandleGetProgression godoc
 // @Summary Get workout progression
 // @Description Get daily progression data (max weight, total volume) for the authenticated user
+// @Description Weights and volumes are in the user's unit preference, named by weightUnit ("kg" or "lb").
 // @Tags statistics
 // @Produce json
 // @Security BearerAuth
@@ -148,12 +198,19 @@ func (h *StatisticsHandler) HandleGetProgression(w http.ResponseWriter, r *http.
 		return
 	}
 
-	writeSuccess(w, http.StatusOK, mapProgressionToResponse(out))
+	units, err := unitSystemFor(ctx, h.getProfileUC, userID)
+	if err != nil {
+		writeError(w, http.StatusInternalServerError, "INTERNAL_ERROR", "Failed to retrieve progression data.")
+		return
+	}
+
+	writeSuccess(w, http.StatusOK, mapProgressionToResponse(out, units))
 }
 
 // HandleGetPersonalRecords godoc
 // @Summary Get personal records
 // @Description Get personal records (best performance per muscle group) for the authenticated user
+// @Description Weights and volumes are in the user's unit preference, named by weightUnit ("kg" or "lb").
 // @Tags statistics
 // @Produce json
 // @Security BearerAuth
@@ -176,17 +233,28 @@ func (h *StatisticsHandler) HandleGetPersonalRecords(w http.ResponseWriter, r *h
 		return
 	}
 
-	writeSuccess(w, http.StatusOK, mapPersonalRecordsToResponse(records))
+	units, err := unitSystemFor(ctx, h.getProfileUC, userID)
+	if err != nil {
+		writeError(w, http.StatusInternalServerError, "INTERNAL_ERROR", "Failed to retrieve personal records.")
+		return
+	}
+
+	writeSuccess(w, http.StatusOK, mapPersonalRecordsToResponse(records, units))
 }
 
 // HandleGetFrequency godoc
 // @Summary Get workout frequency
 // @Description Get daily workout frequency (heatmap data) for the authenticated user
+// @Description With compare=true, or a compareStartDate/compareEndDate, the response also has a "comparison" with the
+// @Description daily counts of the previous equal-length period (or the given one) and the workout and active-day deltas.
 // @Tags statistics
 // @Produce json
 // @Security BearerAuth
 // @Param startDate query string false "Start date (RFC3339 or YYYY-MM-DD)"
 // @Param endDate query string false "End date (RFC3339 or YYYY-MM-DD)"
+// @Param compare query bool false "Compare with the previous equal-length period"
+// @Param compareStartDate query string false "Start of the comparison period (RFC3339 or YYYY-MM-DD)"
+// @Param compareEndDate query string false "End of the comparison period (RFC3339 or YYYY-MM-DD)"
 // @Success 200 {object} SuccessResponse "Frequency data"
 // @Failure 400 {object} ErrorResponse "Invalid parameters"
 // @Failure 401 {object} ErrorResponse "Unauthorized"
@@ -220,6 +288,25 @@ func (h *StatisticsHandler) HandleGetFrequency(w http.ResponseWriter, r *http.Re
 		input.EndDate = &t
 	}
 
+	compare, err := parseComparePeriod(r)
+	if err != nil {
+		writeError(w, http.StatusBadRequest, "VALIDATION_ERROR", err.Error())
+		return
+	}
+	if compare != nil {
+		out, err := h.getFrequencyUC.Compare(ctx, input, *compare)
+		if err != nil {
+			if isStatValidationError(err) {
+				writeError(w, http.StatusBadRequest, "VALIDATION_ERROR", err.Error())
+				return
+			}
+			writeError(w, http.StatusInternalServerError, "INTERNAL_ERROR", "Failed to retrieve frequency data.")
+			return
+		}
+		writeSuccess(w, http.StatusOK, mapFrequencyComparisonToResponse(out))
+		return
+	}
+
 	out, err := h.getFrequencyUC.Execute(ctx, input)
 	if err != nil {
 		if isStatValidationError(err) {
@@ -233,6 +320,378 @@ func (h *StatisticsHandler) HandleGetFrequency(w http.ResponseWriter, r *http.Re
 	writeSuccess(w, http.StatusOK, mapFrequencyToResponse(out))
 }
 
+// HandleGetMuscleVolume godoc
+// @Summary Get weekly volume per muscle group
+// @Description Get weighted hard sets and volume per muscle group and week, flagged against the volume landmarks
+// @Description Weights and volumes are in the user's unit preference, named by weightUnit ("kg" or "lb").
+// @Tags statistics
+// @Produce json
+// @Security BearerAuth
+// @Param startDate query string false "Start date (RFC3339 or YYYY-MM-DD), aligned to Monday"
+// @Param endDate query string false "End date (RFC3339 or YYYY-MM-DD)"
+// @Success 200 {object} SuccessResponse "Muscle volume data"
+// @Failure 400 {object} ErrorResponse "Invalid parameters"
+// @Failure 401 {object} ErrorResponse "Unauthorized"
+// @Failure 500 {object} ErrorResponse "Internal server error"
+// @Router /api/v1/stats/muscle-volume [get]
+func (h *StatisticsHandler) HandleGetMuscleVolume(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	userID, ok := ctx.Value(userIDKey).(uuid.UUID)
+	if !ok {
+		writeError(w, http.StatusUnauthorized, "UNAUTHORIZED", "Missing user authentication")
+		return
+	}
+
+	input := statistics.GetMuscleVolumeInput{UserID: userID}
+
+	if s := r.URL.Query().Get("startDate"); s != "" {
+		t, err := parseDate(s)
+		if err != nil {
+			writeError(w, http.StatusBadRequest, "VALIDATION_ERROR", "Invalid startDate format. Use YYYY-MM-DD or RFC3339.")
+			return
+		}
+		input.StartDate = &t
+	}
+	if s := r.URL.Query().Get("endDate"); s != "" {
+		t, err := parseDate(s)
+		if err != nil {
+			writeError(w, http.StatusBadRequest, "VALIDATION_ERROR", "Invalid endDate format. Use YYYY-MM-DD or RFC3339.")
+			return
+		}
+		input.EndDate = &t
+	}
+
+	out, err := h.getMuscleVolumeUC.Execute(ctx, input)
+	if err != nil {
+		if isStatValidationError(err) {
+			writeError(w, http.StatusBadRequest, "VALIDATION_ERROR", err.Error())
+			return
+		}
+		writeError(w, http.StatusInternalServerError, "INTERNAL_ERROR", "Failed to retrieve muscle volume data.")
+		return
+	}
+
+	units, err := unitSystemFor(ctx, h.getProfileUC, userID)
+	if err != nil {
+		writeError(w, http.StatusInternalServerError, "INTERNAL_ERROR", "Failed to retrieve muscle volume data.")
+		return
+	}
+
+	writeSuccess(w, http.StatusOK, mapMuscleVolumeToResponse(out, units))
+}
+
+// HandleGetRelativeStrength godoc
+// @Summary Get relative strength
+// @Description Get DOTS, Wilks and IPF GL points and body-weight multiples over time, from the best
+// @Description estimated 1RM of the exercises tagged "squat", "bench" and "deadlift" in the last 180 days.
+// @Description Scores need all three lifts, a logged body weight and the sex preference; otherwise they are omitted.
+// @Description Weights are in the user's unit preference, named by weightUnit ("kg" or "lb").
+// @Tags statistics
+// @Produce json
+// @Security BearerAuth
+// @Param startDate query string false "Start date (RFC3339 or YYYY-MM-DD), defaults to 90 days ago"
+// @Param endDate query string false "End date (RFC3339 or YYYY-MM-DD)"
+// @Success 200 {object} SuccessResponse "Relative strength data"
+// @Failure 400 {object} ErrorResponse "Invalid parameters"
+// @Failure 401 {object} ErrorResponse "Unauthorized"
+// @Failure 500 {object} ErrorResponse "Internal server error"
+// @Router /api/v1/stats/relative-strength [get]
+func (h *StatisticsHandler) HandleGetRelativeStrength(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	userID, ok := ctx.Value(userIDKey).(uuid.UUID)
+	if !ok {
+		writeError(w, http.StatusUnauthorized, "UNAUTHORIZED", "Missing user authentication")
+		return
+	}
+
+	input := statistics.GetRelativeStrengthInput{UserID: userID}
+
+	if s := r.URL.Query().Get("startDate"); s != "" {
+		t, err := parseDate(s)
+		if err != nil {
+			writeError(w, http.StatusBadRequest, "VALIDATION_ERROR", "Invalid startDate format. Use YYYY-MM-DD or RFC3339.")
+			return
+		}
+		input.StartDate = &t
+	}
+	if s := r.URL.Query().Get("endDate"); s != "" {
+		t, err := parseDate(s)
+		if err != nil {
+			writeError(w, http.StatusBadRequest, "VALIDATION_ERROR", "Invalid endDate format. Use YYYY-MM-DD or RFC3339.")
+			return
+		}
+		input.EndDate = &t
+	}
+
+	out, err := h.getRelativeStrengthUC.Execute(ctx, input)
+	if err != nil {
+		if isStatValidationError(err) {
+			writeError(w, http.StatusBadRequest, "VALIDATION_ERROR", err.Error())
+			return
+		}
+		writeError(w, http.StatusInternalServerError, "INTERNAL_ERROR", "Failed to retrieve relative strength data.")
+		return
+	}
+
+	units, err := unitSystemFor(ctx, h.getProfileUC, userID)
+	if err != nil {
+		writeError(w, http.StatusInternalServerError, "INTERNAL_ERROR", "Failed to retrieve relative strength data.")
+		return
+	}
+
+	writeSuccess(w, http.StatusOK, mapRelativeStrengthToResponse(out, units))
+}
+
+// HandleGetTrainingLoad godoc
+// @Summary Get training load
+// @Description Get the daily training load model: acute (7-day) and chronic (28-day average weekly) loads,
+// @Description the acute:chronic workload ratio (ACWR), monotony and strain, with warnings for the last day
+// @Description when the ACWR leaves the 0.8–1.3 safe band or monotony exceeds 2.
+// @Description method "srpe" (default) uses duration (min) × session RPE in arbitrary units; sessions finished
+// @Description without an RPE count as zero. method "tonnage" uses weight × reps in the user's weight unit.
+// @Tags statistics
+// @Produce json
+// @Security BearerAuth
+// @Param startDate query string false "Start date (RFC3339 or YYYY-MM-DD), defaults to 27 days ago"
+// @Param endDate query string false "End date (RFC3339 or YYYY-MM-DD)"
+// @Param method query string false "Load method: srpe or tonnage"
+// @Success 200 {object} SuccessResponse "Training load data"
+// @Failure 400 {object} ErrorResponse "Invalid parameters"
+// @Failure 401 {object} ErrorResponse "Unauthorized"
+// @Failure 500 {object} ErrorResponse "Internal server error"
+// @Router /api/v1/stats/load [get]
+func (h *StatisticsHandler) HandleGetTrainingLoad(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	userID, ok := ctx.Value(userIDKey).(uuid.UUID)
+	if !ok {
+		writeError(w, http.StatusUnauthorized, "UNAUTHORIZED", "Missing user authentication")
+		return
+	}
+
+	input := statistics.GetTrainingLoadInput{
+		UserID: userID,
+		Method: vos.LoadMethod(r.URL.Query().Get("method")),
+	}
+
+	if s := r.URL.Query().Get("startDate"); s != "" {
+		t, err := parseDate(s)
+		if err != nil {
+			writeError(w, http.StatusBadRequest, "VALIDATION_ERROR", "Invalid startDate format. Use YYYY-MM-DD or RFC3339.")
+			return
+		}
+		input.StartDate = &t
+	}
+	if s := r.URL.Query().Get("endDate"); s != "" {
+		t, err := parseDate(s)
+		if err != nil {
+			writeError(w, http.StatusBadRequest, "VALIDATION_ERROR", "Invalid endDate format. Use YYYY-MM-DD or RFC3339.")
+			return
+		}
+		input.EndDate = &t
+	}
+
+	out, err := h.getTrainingLoadUC.Execute(ctx, input)
+	if err != nil {
+		if errors.Is(err, domainerrors.ErrMalformedParameters) {
+			writeError(w, http.StatusBadRequest, "VALIDATION_ERROR", "method must be one of: srpe, tonnage.")
+			return
+		}
+		if isStatValidationError(err) {
+			writeError(w, http.StatusBadRequest, "VALIDATION_ERROR", err.Error())
+			return
+		}
+		writeError(w, http.StatusInternalServerError, "INTERNAL_ERROR", "Failed to retrieve training load data.")
+		return
+	}
+
+	units, err := unitSystemFor(ctx, h.getProfileUC, userID)
+	if err != nil {
+		writeError(w, http.StatusInternalServerError, "INTERNAL_ERROR", "Failed to retrieve training load data.")
+		return
+	}
+
+	writeSuccess(w, http.StatusOK, mapTrainingLoadToResponse(out, units))
+}
+
+// HandleGetProgressInsights godoc
+// @Summary Get progress insights
+// @Description Get the exercises that plateaued or regressed, regressions first, each with a suggestion
+// @Description (deload, rep_range_change or exercise_swap). The best estimated 1RM and session volume in the
+// @Description analysis window are compared with the best of the previous 26 weeks; exercises not performed
+// @Description in the last 28 days are skipped. sessions and weeks are mutually exclusive (2–12, default 4 sessions).
+// @Description Weights and volumes are in the user's unit preference, named by weightUnit ("kg" or "lb").
+// @Tags statistics
+// @Produce json
+// @Security BearerAuth
+// @Param sessions query int false "Window: last N sessions of each exercise"
+// @Param weeks query int false "Window: sessions in the last N weeks"
+// @Param limit query int false "Maximum number of insights"
+// @Success 200 {object} SuccessResponse "Progress insights"
+// @Failure 400 {object} ErrorResponse "Invalid parameters"
+// @Failure 401 {object} ErrorResponse "Unauthorized"
+// @Failure 500 {object} ErrorResponse "Internal server error"
+// @Router /api/v1/stats/insights [get]
+func (h *StatisticsHandler) HandleGetProgressInsights(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	userID, ok := ctx.Value(userIDKey).(uuid.UUID)
+	if !ok {
+		writeError(w, http.StatusUnauthorized, "UNAUTHORIZED", "Missing user authentication")
+		return
+	}
+
+	input := statistics.GetProgressInsightsInput{UserID: userID}
+	for _, p := range []struct {
+		name string
+		dst  *int
+	}{{"sessions", &input.Sessions}, {"weeks", &input.Weeks}, {"limit", &input.Limit}} {
+		s := r.URL.Query().Get(p.name)
+		if s == "" {
+			continue
+		}
+		n, err := strconv.Atoi(s)
+		if err != nil {
+			writeError(w, http.StatusBadRequest, "VALIDATION_ERROR", "Invalid "+p.name+": must be an integer.")
+			return
+		}
+		*p.dst = n
+	}
+
+	out, err := h.getProgressInsightsUC.Execute(ctx, input)
+	if err != nil {
+		if errors.Is(err, domainerrors.ErrMalformedParameters) {
+			writeError(w, http.StatusBadRequest, "VALIDATION_ERROR", err.Error())
+			return
+		}
+		writeError(w, http.StatusInternalServerError, "INTERNAL_ERROR", "Failed to retrieve progress insights.")
+		return
+	}
+
+	units, err := unitSystemFor(ctx, h.getProfileUC, userID)
+	if err != nil {
+		writeError(w, http.StatusInternalServerError, "INTERNAL_ERROR", "Failed to retrieve progress insights.")
+		return
+	}
+
+	writeSuccess(w, http.StatusOK, mapProgressInsightsToResponse(out, units))
+}
+
+// HandleGetHeatmap godoc
+// @Summary Get yearly training heatmap
+// @Description Get every day of a year with its sessions, value and intensity level (0 without training, 1–4 by
+// @Description the quartiles of the year's training days, returned as thresholds) for a GitHub-style calendar.
+// @Description metric "volume" (default) is weight × reps in the user's weight unit; "duration" is minutes.
+// @Tags statistics
+// @Produce json
+// @Security BearerAuth
+// @Param year query int false "Year, defaults to the current year"
+// @Param metric query string false "Intensity metric: volume or duration"
+// @Success 200 {object} SuccessResponse "Heatmap data"
+// @Failure 400 {object} ErrorResponse "Invalid parameters"
+// @Failure 401 {object} ErrorResponse "Unauthorized"
+// @Failure 500 {object} ErrorResponse "Internal server error"
+// @Router /api/v1/stats/heatmap [get]
+func (h *StatisticsHandler) HandleGetHeatmap(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	userID, ok := ctx.Value(userIDKey).(uuid.UUID)
+	if !ok {
+		writeError(w, http.StatusUnauthorized, "UNAUTHORIZED", "Missing user authentication")
+		return
+	}
+
+	input := statistics.GetHeatmapInput{
+		UserID: userID,
+		Metric: vos.HeatmapMetric(r.URL.Query().Get("metric")),
+	}
+	if s := r.URL.Query().Get("year"); s != "" {
+		year, err := strconv.Atoi(s)
+		if err != nil {
+			writeError(w, http.StatusBadRequest, "VALIDATION_ERROR", "Invalid year: must be an integer.")
+			return
+		}
+		input.Year = year
+	}
+
+	out, err := h.getHeatmapUC.Execute(ctx, input)
+	if err != nil {
+		if errors.Is(err, domainerrors.ErrMalformedParameters) {
+			writeError(w, http.StatusBadRequest, "VALIDATION_ERROR", err.Error())
+			return
+		}
+		writeError(w, http.StatusInternalServerError, "INTERNAL_ERROR", "Failed to retrieve heatmap data.")
+		return
+	}
+
+	units, err := unitSystemFor(ctx, h.getProfileUC, userID)
+	if err != nil {
+		writeError(w, http.StatusInternalServerError, "INTERNAL_ERROR", "Failed to retrieve heatmap data.")
+		return
+	}
+
+	writeSuccess(w, http.StatusOK, mapHeatmapToResponse(out, units))
+}
+
+// HandleGetRecap godoc
+// @Summary Get training recap
+// @Description Get a summary of the calendar month or year containing date: totals, longest streak, favourite
+// @Description workout, top 5 exercises by volume, heaviest-weight PRs set and the time-of-day distribution
+// @Description (morning 05–12, afternoon 12–17, evening 17–21, night 21–05), all in the user's timezone.
+// @Description Weights and volumes are in the user's unit preference, named by weightUnit ("kg" or "lb").
+// @Tags statistics
+// @Produce json
+// @Security BearerAuth
+// @Param period query string false "Recap period: month (default) or year"
+// @Param date query string false "A day in the period (RFC3339 or YYYY-MM-DD), defaults to today"
+// @Success 200 {object} SuccessResponse "Recap data"
+// @Failure 400 {object} ErrorResponse "Invalid parameters"
+// @Failure 401 {object} ErrorResponse "Unauthorized"
+// @Failure 500 {object} ErrorResponse "Internal server error"
+// @Router /api/v1/stats/recap [get]
+func (h *StatisticsHandler) HandleGetRecap(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	userID, ok := ctx.Value(userIDKey).(uuid.UUID)
+	if !ok {
+		writeError(w, http.StatusUnauthorized, "UNAUTHORIZED", "Missing user authentication")
+		return
+	}
+
+	input := statistics.GetRecapInput{
+		UserID: userID,
+		Period: vos.RecapPeriod(r.URL.Query().Get("period")),
+	}
+	if s := r.URL.Query().Get("date"); s != "" {
+		t, err := parseDate(s)
+		if err != nil {
+			writeError(w, http.StatusBadRequest, "VALIDATION_ERROR", "Invalid date format. Use YYYY-MM-DD or RFC3339.")
+			return
+		}
+		input.Date = &t
+	}
+
+	out, err := h.getRecapUC.Execute(ctx, input)
+	if err != nil {
+		if errors.Is(err, domainerrors.ErrMalformedParameters) {
+			writeError(w, http.StatusBadRequest, "VALIDATION_ERROR", "period must be one of: month, year.")
+			return
+		}
+		writeError(w, http.StatusInternalServerError, "INTERNAL_ERROR", "Failed to retrieve recap.")
+		return
+	}
+
+	units, err := unitSystemFor(ctx, h.getProfileUC, userID)
+	if err != nil {
+		writeError(w, http.StatusInternalServerError, "INTERNAL_ERROR", "Failed to retrieve recap.")
+		return
+	}
+
+	writeSuccess(w, http.StatusOK, mapRecapToResponse(out, units))
+}
+
 // --- Helpers ---
 
 // parseDate parses a date string in YYYY-MM-DD or RFC3339 format.
@@ -246,6 +705,44 @@ func parseDate(s string) (time.Time, error) {
 	return time.Time{}, domainerrors.ErrInvalidPeriod
 }
 
+// parseComparePeriod reads the comparison query parameters. It returns nil when comparison
+// was not requested: compare=true selects the previous equal-length period, and
+// compareStartDate/compareEndDate select a custom one.
+func parseComparePeriod(r *http.Request) (*statistics.ComparePeriod, error) {
+	q := r.URL.Query()
+	var period statistics.ComparePeriod
+	requested := false
+
+	if s := q.Get("compare"); s != "" {
+		b, err := strconv.ParseBool(s)
+		if err != nil {
+			return nil, errors.New("Invalid compare: must be true or false.")
+		}
+		requested = b
+	}
+	if s := q.Get("compareStartDate"); s != "" {
+		t, err := parseDate(s)
+		if err != nil {
+			return nil, errors.New("Invalid compareStartDate format. Use YYYY-MM-DD or RFC3339.")
+		}
+		period.StartDate = &t
+		requested = true
+	}
+	if s := q.Get("compareEndDate"); s != "" {
+		t, err := parseDate(s)
+		if err != nil {
+			return nil, errors.New("Invalid compareEndDate format. Use YYYY-MM-DD or RFC3339.")
+		}
+		period.EndDate = &t
+		requested = true
+	}
+
+	if !requested {
+		return nil, nil
+	}
+	return &period, nil
+}
+
 // isStatValidationError reports whether the error is a period/input validation error.
 func isStatValidationError(err error) bool {
 	return errors.Is(err, domainerrors.ErrInvalidPeriod) ||
@@ -261,51 +758,153 @@ type overviewResponse struct {
 	TotalWorkouts    int     `json:"totalWorkouts"`
 	AveragePerWeek   float64 `json:"averagePerWeek"`
 	TotalTimeMinutes int     `json:"totalTimeMinutes"`
+	TotalCalories    int     `json:"totalCalories"`
 	TotalSets        int     `json:"totalSets"`
 	TotalReps        int     `json:"totalReps"`
-	TotalVolume      int64   `json:"totalVolume"`
+	TotalVolume      float64 `json:"totalVolume"`
+	WeightUnit       string  `json:"weightUnit"`
 	CurrentStreak    int     `json:"currentStreak"`
 	LongestStreak    int     `json:"longestStreak"`
+	StreakMode       string  `json:"streakMode"` // streaks em dias ("daily") ou semanas ("weekly")
+	StreakFreezes    int     `json:"streakFreezes"`
+
+	Comparison *overviewComparisonResponse `json:"comparison,omitempty"`
+}
+
+type metricDeltaResponse struct {
+	Absolute float64  `json:"absolute"`
+	Percent  *float64 `json:"percent"` // null quando o valor anterior é zero
+}
+
+type overviewPeriodResponse struct {
+	StartDate        string  `json:"startDate"`
+	EndDate          string  `json:"endDate"`
+	TotalWorkouts    int     `json:"totalWorkouts"`
+	AveragePerWeek   float64 `json:"averagePerWeek"`
+	TotalTimeMinutes int     `json:"totalTimeMinutes"`
+	TotalCalories    int     `json:"totalCalories"`
+	TotalSets        int     `json:"totalSets"`
+	TotalReps        int     `json:"totalReps"`
+	TotalVolume      float64 `json:"totalVolume"`
+}
+
+type overviewDeltasResponse struct {
+	TotalWorkouts    metricDeltaResponse `json:"totalWorkouts"`
+	AveragePerWeek   metricDeltaResponse `json:"averagePerWeek"`
+	TotalTimeMinutes metricDeltaResponse `json:"totalTimeMinutes"`
+	TotalCalories    metricDeltaResponse `json:"totalCalories"`
+	TotalSets        metricDeltaResponse `json:"totalSets"`
+	TotalReps        metricDeltaResponse `json:"totalReps"`
+	TotalVolume      metricDeltaResponse `json:"totalVolume"`
+}
+
+type exerciseVolumeComparisonResponse struct {
+	ExerciseID     string              `json:"exerciseId"`
+	ExerciseName   string              `json:"exerciseName"`
+	Volume         float64             `json:"volume"`
+	PreviousVolume float64             `json:"previousVolume"`
+	Delta          metricDeltaResponse `json:"delta"`
+}
+
+type overviewComparisonResponse struct {
+	Previous  overviewPeriodResponse             `json:"previous"`
+	Deltas    overviewDeltasResponse             `json:"deltas"`
+	Exercises []exerciseVolumeComparisonResponse `json:"exercises"`
+}
+
+func mapMetricDelta(d statistics.MetricDelta) metricDeltaResponse {
+	return metricDeltaResponse{Absolute: d.Absolute, Percent: d.Percent}
+}
+
+// mapVolumeDelta converts the absolute change of a volume in grams to the user's unit.
+func mapVolumeDelta(d statistics.MetricDelta, units vos.UnitSystem) metricDeltaResponse {
+	return metricDeltaResponse{Absolute: units.FromGrams(int64(math.Round(d.Absolute))), Percent: d.Percent}
+}
+
+func mapOverviewComparisonToResponse(c *statistics.OverviewComparison, units vos.UnitSystem) *overviewComparisonResponse {
+	exercises := make([]exerciseVolumeComparisonResponse, 0, len(c.Exercises))
+	for _, e := range c.Exercises {
+		exercises = append(exercises, exerciseVolumeComparisonResponse{
+			ExerciseID:     e.ExerciseID.String(),
+			ExerciseName:   e.ExerciseName,
+			Volume:         units.FromGrams(e.Volume),
+			PreviousVolume: units.FromGrams(e.PreviousVolume),
+			Delta:          mapVolumeDelta(e.Delta, units),
+		})
+	}
+	p := c.Previous
+	return &overviewComparisonResponse{
+		Previous: overviewPeriodResponse{
+			StartDate:        p.StartDate.Format("2006-01-02"),
+			EndDate:          p.EndDate.Format("2006-01-02"),
+			TotalWorkouts:    p.TotalWorkouts,
+			AveragePerWeek:   p.AveragePerWeek,
+			TotalTimeMinutes: p.TotalTimeMinutes,
+			TotalCalories:    p.TotalCalories,
+			TotalSets:        p.TotalSets,
+			TotalReps:        p.TotalReps,
+			TotalVolume:      units.FromGrams(p.TotalVolume),
+		},
+		Deltas: overviewDeltasResponse{
+			TotalWorkouts:    mapMetricDelta(c.Deltas.TotalWorkouts),
+			AveragePerWeek:   mapMetricDelta(c.Deltas.AveragePerWeek),
+			TotalTimeMinutes: mapMetricDelta(c.Deltas.TotalTimeMinutes),
+			TotalCalories:    mapMetricDelta(c.Deltas.TotalCalories),
+			TotalSets:        mapMetricDelta(c.Deltas.TotalSets),
+			TotalReps:        mapMetricDelta(c.Deltas.TotalReps),
+			TotalVolume:      mapVolumeDelta(c.Deltas.TotalVolume, units),
+		},
+		Exercises: exercises,
+	}
 }
 
-func mapOverviewToResponse(out *statistics.OverviewStats) overviewResponse {
-	return overviewResponse{
+func mapOverviewToResponse(out *statistics.OverviewStats, units vos.UnitSystem) overviewResponse {
+	resp := overviewResponse{
 		StartDate:        out.StartDate.Format("2006-01-02"),
 		EndDate:          out.EndDate.Format("2006-01-02"),
 		TotalWorkouts:    out.TotalWorkouts,
 		AveragePerWeek:   out.AveragePerWeek,
 		TotalTimeMinutes: out.TotalTimeMinutes,
+		TotalCalories:    out.TotalCalories,
 		TotalSets:        out.TotalSets,
 		TotalReps:        out.TotalReps,
-		TotalVolume:      out.TotalVolume,
+		TotalVolume:      units.FromGrams(out.TotalVolume),
+		WeightUnit:       string(units.WeightUnit()),
 		CurrentStreak:    out.CurrentStreak,
 		LongestStreak:    out.LongestStreak,
+		StreakMode:       string(out.StreakMode),
+		StreakFreezes:    out.StreakFreezes,
+	}
+	if out.Comparison != nil {
+		resp.Comparison = mapOverviewComparisonToResponse(out.Comparison, units)
 	}
+	return resp
 }
 
 type progressionPointResponse struct {
 	Date        string  `json:"date"`
-	MaxWeight   int64   `json:"maxWeight"`
-	TotalVolume int64   `json:"totalVolume"`
+	MaxWeight   float64 `json:"maxWeight"`
+	TotalVolume float64 `json:"totalVolume"`
 	Change      float64 `json:"change"`
 }
 
 type progressionResponse struct {
 	ExerciseID string                     `json:"exerciseId,omitempty"`
+	WeightUnit string                     `json:"weightUnit"`
 	Points     []progressionPointResponse `json:"points"`
 }
 
-func mapProgressionToResponse(out *statistics.ProgressionData) progressionResponse {
+func mapProgressionToResponse(out *statistics.ProgressionData, units vos.UnitSystem) progressionResponse {
 	points := make([]progressionPointResponse, 0, len(out.Points))
 	for _, p := range out.Points {
 		points = append(points, progressionPointResponse{
 			Date:        p.Date.Format("2006-01-02"),
-			MaxWeight:   p.MaxWeight,
-			TotalVolume: p.TotalVolume,
+			MaxWeight:   units.FromGrams(p.MaxWeight),
+			TotalVolume: units.FromGrams(p.TotalVolume),
 			Change:      p.Change,
 		})
 	}
-	resp := progressionResponse{Points: points}
+	resp := progressionResponse{WeightUnit: string(units.WeightUnit()), Points: points}
 	if out.ExerciseID != nil {
 		resp.ExerciseID = out.ExerciseID.String()
 	}
@@ -313,31 +912,32 @@ func mapProgressionToResponse(out *statistics.ProgressionData) progressionRespon
 }
 
 type personalRecordResponse struct {
-	ExerciseID   string `json:"exerciseId"`
-	ExerciseName string `json:"exerciseName"`
-	Weight       int    `json:"weight"`
-	Reps         int    `json:"reps"`
-	Volume       int64  `json:"volume"`
-	AchievedAt   string `json:"achievedAt"`
+	ExerciseID   string  `json:"exerciseId"`
+	ExerciseName string  `json:"exerciseName"`
+	Weight       float64 `json:"weight"`
+	Reps         int     `json:"reps"`
+	Volume       float64 `json:"volume"`
+	AchievedAt   string  `json:"achievedAt"`
 }
 
 type personalRecordsResponse struct {
-	Records []personalRecordResponse `json:"records"`
+	WeightUnit string                   `json:"weightUnit"`
+	Records    []personalRecordResponse `json:"records"`
 }
 
-func mapPersonalRecordsToResponse(records []statistics.PersonalRecord) personalRecordsResponse {
+func mapPersonalRecordsToResponse(records []statistics.PersonalRecord, units vos.UnitSystem) personalRecordsResponse {
 	dtos := make([]personalRecordResponse, 0, len(records))
 	for _, pr := range records {
 		dtos = append(dtos, personalRecordResponse{
 			ExerciseID:   pr.ExerciseID.String(),
 			ExerciseName: pr.ExerciseName,
-			Weight:       pr.Weight,
+			Weight:       units.FromGrams(int64(pr.Weight)),
 			Reps:         pr.Reps,
-			Volume:       pr.Volume,
+			Volume:       units.FromGrams(pr.Volume),
 			AchievedAt:   pr.AchievedAt.Format("2006-01-02"),
 		})
 	}
-	return personalRecordsResponse{Records: dtos}
+	return personalRecordsResponse{WeightUnit: string(units.WeightUnit()), Records: dtos}
 }
 
 type frequencyDataResponse struct {
@@ -346,10 +946,30 @@ type frequencyDataResponse struct {
 }
 
 type frequencyResponse struct {
-	Data []frequencyDataResponse `json:"data"`
+	Data       []frequencyDataResponse      `json:"data"`
+	Comparison *frequencyComparisonResponse `json:"comparison,omitempty"`
+}
+
+type frequencyPeriodResponse struct {
+	StartDate     string                  `json:"startDate"`
+	EndDate       string                  `json:"endDate"`
+	TotalWorkouts int                     `json:"totalWorkouts"`
+	ActiveDays    int                     `json:"activeDays"`
+	Data          []frequencyDataResponse `json:"data,omitempty"`
+}
+
+type frequencyComparisonResponse struct {
+	Current       frequencyPeriodResponse `json:"current"`
+	Previous      frequencyPeriodResponse `json:"previous"`
+	TotalWorkouts metricDeltaResponse     `json:"totalWorkouts"`
+	ActiveDays    metricDeltaResponse     `json:"activeDays"`
 }
 
 func mapFrequencyToResponse(data []statistics.FrequencyData) frequencyResponse {
+	return frequencyResponse{Data: mapFrequencyDays(data)}
+}
+
+func mapFrequencyDays(data []statistics.FrequencyData) []frequencyDataResponse {
 	dtos := make([]frequencyDataResponse, 0, len(data))
 	for _, d := range data {
 		dtos = append(dtos, frequencyDataResponse{
@@ -357,5 +977,536 @@ func mapFrequencyToResponse(data []statistics.FrequencyData) frequencyResponse {
 			Count: d.Count,
 		})
 	}
-	return frequencyResponse{Data: dtos}
+	return dtos
+}
+
+// mapFrequencyComparisonToResponse keeps the current days in "data", as without comparison.
+func mapFrequencyComparisonToResponse(out *statistics.FrequencyComparison) frequencyResponse {
+	period := func(p statistics.FrequencyPeriod) frequencyPeriodResponse {
+		return frequencyPeriodResponse{
+			StartDate:     p.StartDate.Format("2006-01-02"),
+			EndDate:       p.EndDate.Format("2006-01-02"),
+			TotalWorkouts: p.TotalWorkouts,
+			ActiveDays:    p.ActiveDays,
+		}
+	}
+	previous := period(out.Previous)
+	previous.Data = mapFrequencyDays(out.Previous.Days)
+	return frequencyResponse{
+		Data: mapFrequencyDays(out.Current.Days),
+		Comparison: &frequencyComparisonResponse{
+			Current:       period(out.Current),
+			Previous:      previous,
+			TotalWorkouts: mapMetricDelta(out.TotalWorkouts),
+			ActiveDays:    mapMetricDelta(out.ActiveDays),
+		},
+	}
+}
+
+type muscleVolumeResponse struct {
+	MuscleGroup string  `json:"muscleGroup"`
+	HardSets    float64 `json:"hardSets"`
+	Volume      float64 `json:"volume"`
+	Status      string  `json:"status"`
+}
+
+type muscleVolumeWeekResponse struct {
+	WeekStart string                 `json:"weekStart"`
+	Muscles   []muscleVolumeResponse `json:"muscles"`
+}
+
+type volumeLandmarksResponse struct {
+	MinSets float64 `json:"minSets"`
+	MaxSets float64 `json:"maxSets"`
+}
+
+type muscleVolumeDataResponse struct {
+	StartDate  string                     `json:"startDate"`
+	EndDate    string                     `json:"endDate"`
+	WeightUnit string                     `json:"weightUnit"`
+	Landmarks  volumeLandmarksResponse    `json:"landmarks"`
+	Weeks      []muscleVolumeWeekResponse `json:"weeks"`
+}
+
+func mapMuscleVolumeToResponse(out *statistics.MuscleVolumeData, units vos.UnitSystem) muscleVolumeDataResponse {
+	weeks := make([]muscleVolumeWeekResponse, 0, len(out.Weeks))
+	for _, w := range out.Weeks {
+		muscles := make([]muscleVolumeResponse, 0, len(w.Muscles))
+		for _, m := range w.Muscles {
+			muscles = append(muscles, muscleVolumeResponse{
+				MuscleGroup: m.MuscleGroup.String(),
+				HardSets:    m.HardSets,
+				Volume:      units.FromGrams(m.Volume),
+				Status:      string(m.Status),
+			})
+		}
+		weeks = append(weeks, muscleVolumeWeekResponse{
+			WeekStart: w.WeekStart.Format("2006-01-02"),
+			Muscles:   muscles,
+		})
+	}
+	return muscleVolumeDataResponse{
+		StartDate:  out.StartDate.Format("2006-01-02"),
+		EndDate:    out.EndDate.Format("2006-01-02"),
+		WeightUnit: string(units.WeightUnit()),
+		Landmarks:  volumeLandmarksResponse{MinSets: out.Landmarks.MinSets, MaxSets: out.Landmarks.MaxSets},
+		Weeks:      weeks,
+	}
+}
+
+type liftStrengthResponse struct {
+	Lift            string   `json:"lift"`
+	EstimatedMax    float64  `json:"estimatedMax"`
+	BodyWeightRatio *float64 `json:"bodyWeightRatio"`
+}
+
+type relativeStrengthPointResponse struct {
+	Date          string                 `json:"date"`
+	BodyWeight    *float64               `json:"bodyWeight"`
+	Lifts         []liftStrengthResponse `json:"lifts"`
+	Total         *float64               `json:"total"`
+	TotalMultiple *float64               `json:"totalMultiple"`
+	DOTS          *float64               `json:"dots"`
+	Wilks         *float64               `json:"wilks"`
+	IPFGL         *float64               `json:"ipfGl"`
+}
+
+type relativeStrengthResponse struct {
+	StartDate  string                          `json:"startDate"`
+	EndDate    string                          `json:"endDate"`
+	WeightUnit string                          `json:"weightUnit"`
+	WindowDays int                             `json:"windowDays"`
+	Sex        string                          `json:"sex,omitempty"`
+	Points     []relativeStrengthPointResponse `json:"points"`
+}
+
+func mapRelativeStrengthToResponse(out *statistics.RelativeStrengthData, units vos.UnitSystem) relativeStrengthResponse {
+	points := make([]relativeStrengthPointResponse, 0, len(out.Points))
+	for _, p := range out.Points {
+		lifts := make([]liftStrengthResponse, 0, len(p.Lifts))
+		for _, l := range p.Lifts {
+			lifts = append(lifts, liftStrengthResponse{
+				Lift:            l.Lift.String(),
+				EstimatedMax:    units.FromGrams(int64(l.EstimatedMax)),
+				BodyWeightRatio: l.BodyWeightRatio,
+			})
+		}
+		points = append(points, relativeStrengthPointResponse{
+			Date:          p.Date.Format("2006-01-02"),
+			BodyWeight:    weightPtrFromGrams(units, p.BodyWeight),
+			Lifts:         lifts,
+			Total:         weightPtrFromGrams(units, p.Total),
+			TotalMultiple: p.TotalMultiple,
+			DOTS:          p.DOTS,
+			Wilks:         p.Wilks,
+			IPFGL:         p.IPFGL,
+		})
+	}
+	return relativeStrengthResponse{
+		StartDate:  out.StartDate.Format("2006-01-02"),
+		EndDate:    out.EndDate.Format("2006-01-02"),
+		WeightUnit: string(units.WeightUnit()),
+		WindowDays: out.WindowDays,
+		Sex:        string(out.Sex),
+		Points:     points,
+	}
+}
+
+type trainingLoadDayResponse struct {
+	Date     string   `json:"date"`
+	Load     float64  `json:"load"`
+	Acute    float64  `json:"acute"`
+	Chronic  float64  `json:"chronic"`
+	ACWR     *float64 `json:"acwr"`
+	Monotony *float64 `json:"monotony"`
+	Strain   *float64 `json:"strain"`
+	Zone     string   `json:"zone"`
+}
+
+type trainingLoadWarningResponse struct {
+	Code    string  `json:"code"`
+	Message string  `json:"message"`
+	Value   float64 `json:"value"`
+}
+
+type trainingLoadResponse struct {
+	StartDate          string                        `json:"startDate"`
+	EndDate            string                        `json:"endDate"`
+	Method             string                        `json:"method"`
+	LoadUnit           string                        `json:"loadUnit"` // "AU" (srpe), "kg" ou "lb" (tonnage)
+	SessionsWithoutRPE int                           `json:"sessionsWithoutRpe"`
+	Days               []trainingLoadDayResponse     `json:"days"`
+	Warnings           []trainingLoadWarningResponse `json:"warnings"`
+}
+
+func mapTrainingLoadToResponse(out *statistics.TrainingLoadData, units vos.UnitSystem) trainingLoadResponse {
+	// Cargas por tonelagem estão em gramas; as de sRPE são unidades arbitrárias
+	loadUnit := "AU"
+	convert := func(v float64) float64 { return v }
+	if out.Method == vos.LoadMethodTonnage {
+		loadUnit = string(units.WeightUnit())
+		convert = func(v float64) float64 { return units.FromGrams(int64(math.Round(v))) }
+	}
+
+	days := make([]trainingLoadDayResponse, 0, len(out.Days))
+	for _, d := range out.Days {
+		day := trainingLoadDayResponse{
+			Date:     d.Date.Format("2006-01-02"),
+			Load:     convert(d.Load),
+			Acute:    convert(d.Acute),
+			Chronic:  convert(d.Chronic),
+			ACWR:     d.ACWR,
+			Monotony: d.Monotony,
+			Zone:     string(d.Zone),
+		}
+		if d.Strain != nil {
+			strain := convert(*d.Strain)
+			day.Strain = &strain
+		}
+		days = append(days, day)
+	}
+	warnings := make([]trainingLoadWarningResponse, 0, len(out.Warnings))
+	for _, w := range out.Warnings {
+		warnings = append(warnings, trainingLoadWarningResponse{Code: string(w.Code), Message: w.Message, Value: w.Value})
+	}
+	return trainingLoadResponse{
+		StartDate:          out.StartDate.Format("2006-01-02"),
+		EndDate:            out.EndDate.Format("2006-01-02"),
+		Method:             out.Method.String(),
+		LoadUnit:           loadUnit,
+		SessionsWithoutRPE: out.SessionsWithoutRPE,
+		Days:               days,
+		Warnings:           warnings,
+	}
+}
+
+type insightSuggestionResponse struct {
+	Kind    string `json:"kind"`
+	Message string `json:"message"`
+}
+
+type progressInsightResponse struct {
+	ExerciseID       string                    `json:"exerciseId"`
+	ExerciseName     string                    `json:"exerciseName"`
+	Kind             string                    `json:"kind"`
+	SessionsAnalyzed int                       `json:"sessionsAnalyzed"`
+	StalledSessions  int                       `json:"stalledSessions"`
+	BaselineE1RM     float64                   `json:"baselineE1rm"`
+	RecentE1RM       float64                   `json:"recentE1rm"`
+	E1RMChange       float64                   `json:"e1rmChange"` // percentual
+	BaselineVolume   float64                   `json:"baselineVolume"`
+	RecentVolume     float64                   `json:"recentVolume"`
+	VolumeChange     float64                   `json:"volumeChange"` // percentual
+	LastPerformed    string                    `json:"lastPerformed"`
+	Suggestion       insightSuggestionResponse `json:"suggestion"`
+}
+
+type progressInsightsResponse struct {
+	WeightUnit string                    `json:"weightUnit"`
+	Insights   []progressInsightResponse `json:"insights"`
+}
+
+func mapProgressInsightsToResponse(out []statistics.ProgressInsight, units vos.UnitSystem) progressInsightsResponse {
+	insights := make([]progressInsightResponse, 0, len(out))
+	for _, i := range out {
+		insights = append(insights, progressInsightResponse{
+			ExerciseID:       i.ExerciseID.String(),
+			ExerciseName:     i.ExerciseName,
+			Kind:             string(i.Kind),
+			SessionsAnalyzed: i.SessionsAnalyzed,
+			StalledSessions:  i.StalledSessions,
+			BaselineE1RM:     units.FromGrams(int64(i.BaselineE1RM)),
+			RecentE1RM:       units.FromGrams(int64(i.RecentE1RM)),
+			E1RMChange:       i.E1RMChange,
+			BaselineVolume:   units.FromGrams(i.BaselineVolume),
+			RecentVolume:     units.FromGrams(i.RecentVolume),
+			VolumeChange:     i.VolumeChange,
+			LastPerformed:    i.LastPerformed.Format("2006-01-02"),
+			Suggestion:       insightSuggestionResponse{Kind: string(i.Suggestion.Kind), Message: i.Suggestion.Message},
+		})
+	}
+	return progressInsightsResponse{WeightUnit: string(units.WeightUnit()), Insights: insights}
+}
+
+type heatmapDayResponse struct {
+	Date     string  `json:"date"`
+	Sessions int     `json:"sessions"`
+	Value    float64 `json:"value"`
+	Level    int     `json:"level"`
+}
+
+type heatmapResponse struct {
+	Year          int                  `json:"year"`
+	Metric        string               `json:"metric"`
+	Unit          string               `json:"unit"` // "kg" ou "lb" (volume), "min" (duration)
+	Thresholds    []float64            `json:"thresholds"`
+	ActiveDays    int                  `json:"activeDays"`
+	TotalSessions int                  `json:"totalSessions"`
+	Days          []heatmapDayResponse `json:"days"`
+}
+
+func mapHeatmapToResponse(out *statistics.HeatmapData, units vos.UnitSystem) heatmapResponse {
+	unit := "min"
+	convert := func(v float64) float64 { return v }
+	if out.Metric == vos.HeatmapMetricVolume {
+		unit = string(units.WeightUnit())
+		convert = func(v float64) float64 { return units.FromGrams(int64(math.Round(v))) }
+	}
+
+	thresholds := make([]float64, 0, len(out.Thresholds))
+	for _, t := range out.Thresholds {
+		thresholds = append(thresholds, convert(t))
+	}
+	days := make([]heatmapDayResponse, 0, len(out.Days))
+	for _, d := range out.Days {
+		days = append(days, heatmapDayResponse{
+			Date:     d.Date.Format("2006-01-02"),
+			Sessions: d.Sessions,
+			Value:    convert(d.Value),
+			Level:    d.Level,
+		})
+	}
+	return heatmapResponse{
+		Year:          out.Year,
+		Metric:        out.Metric.String(),
+		Unit:          unit,
+		Thresholds:    thresholds,
+		ActiveDays:    out.ActiveDays,
+		TotalSessions: out.TotalSessions,
+		Days:          days,
+	}
+}
+
+type recapWorkoutResponse struct {
+	WorkoutID string `json:"workoutId"`
+	Name      string `json:"name"`
+	Sessions  int    `json:"sessions"`
+}
+
+type recapExerciseResponse struct {
+	ExerciseID   string  `json:"exerciseId"`
+	ExerciseName string  `json:"exerciseName"`
+	Sessions     int     `json:"sessions"`
+	Volume       float64 `json:"volume"`
+}
+
+type recapRecordResponse struct {
+	ExerciseID     string  `json:"exerciseId"`
+	ExerciseName   string  `json:"exerciseName"`
+	Weight         float64 `json:"weight"`
+	Reps           int     `json:"reps"`
+	PreviousWeight float64 `json:"previousWeight"`
+	AchievedAt     string  `json:"achievedAt"`
+}
+
+type timeOfDayResponse struct {
+	TimeOfDay string  `json:"timeOfDay"`
+	Sessions  int     `json:"sessions"`
+	Percent   float64 `json:"percent"`
+}
+
+type recapResponse struct {
+	Period               string                  `json:"period"`
+	StartDate            string                  `json:"startDate"`
+	EndDate              string                  `json:"endDate"`
+	WeightUnit           string                  `json:"weightUnit"`
+	TotalSessions        int                     `json:"totalSessions"`
+	TotalDurationMinutes int                     `json:"totalDurationMinutes"`
+	TotalTonnage         float64                 `json:"totalTonnage"`
+	ActiveDays           int                     `json:"activeDays"`
+	LongestStreak        int                     `json:"longestStreak"`
+	FavoriteWorkout      *recapWorkoutResponse   `json:"favoriteWorkout"`
+	TopExercises         []recapExerciseResponse `json:"topExercises"`
+	PersonalRecords      []recapRecordResponse   `json:"personalRecords"`
+	TimeOfDay            []timeOfDayResponse     `json:"timeOfDay"`
+}
+
+func mapRecapToResponse(out *statistics.RecapData, units vos.UnitSystem) recapResponse {
+	resp := recapResponse{
+		Period:               out.Period.String(),
+		StartDate:            out.StartDate.Format("2006-01-02"),
+		EndDate:              out.EndDate.Format("2006-01-02"),
+		WeightUnit:           string(units.WeightUnit()),
+		TotalSessions:        out.TotalSessions,
+		TotalDurationMinutes: out.TotalDurationMinutes,
+		TotalTonnage:         units.FromGrams(out.TotalTonnage),
+		ActiveDays:           out.ActiveDays,
+		LongestStreak:        out.LongestStreak,
+		TopExercises:         make([]recapExerciseResponse, 0, len(out.TopExercises)),
+		PersonalRecords:      make([]recapRecordResponse, 0, len(out.PersonalRecords)),
+		TimeOfDay:            make([]timeOfDayResponse, 0, len(out.TimeOfDay)),
+	}
+	if w := out.FavoriteWorkout; w != nil {
+		resp.FavoriteWorkout = &recapWorkoutResponse{WorkoutID: w.WorkoutID.String(), Name: w.Name, Sessions: w.Sessions}
+	}
+	for _, e := range out.TopExercises {
+		resp.TopExercises = append(resp.TopExercises, recapExerciseResponse{
+			ExerciseID:   e.ExerciseID.String(),
+			ExerciseName: e.ExerciseName,
+			Sessions:     e.Sessions,
+			Volume:       units.FromGrams(e.Volume),
+		})
+	}
+	for _, pr := range out.PersonalRecords {
+		resp.PersonalRecords = append(resp.PersonalRecords, recapRecordResponse{
+			ExerciseID:     pr.ExerciseID.String(),
+			ExerciseName:   pr.ExerciseName,
+			Weight:         units.FromGrams(int64(pr.Weight)),
+			Reps:           pr.Reps,
+			PreviousWeight: units.FromGrams(int64(pr.PreviousWeight)),
+			AchievedAt:     pr.AchievedAt.Format(time.RFC3339),
+		})
+	}
+	for _, t := range out.TimeOfDay {
+		resp.TimeOfDay = append(resp.TimeOfDay, timeOfDayResponse{TimeOfDay: string(t.TimeOfDay), Sessions: t.Sessions, Percent: t.Percent})
+	}
+	return resp
+}
+
+// HandleGetWellness godoc
+// @Summary Get wellness statistics
+// @Description Relates the post-session feedback to the training: the completed sessions of the period with their
+// @Description rpe, mood, energy and sleepQuality, the average of each rating and, for each mood, energy and
+// @Description sleep quality rating (1-5), the number of sessions and their average tonnage and RPE.
+// @Description Tonnage is weight × reps in the user's weight unit, named by weightUnit.
+// @Tags statistics
+// @Produce json
+// @Security BearerAuth
+// @Param startDate query string false "Start date (RFC3339 or YYYY-MM-DD), defaults to 89 days ago"
+// @Param endDate query string false "End date (RFC3339 or YYYY-MM-DD)"
+// @Success 200 {object} SuccessResponse "Wellness data"
+// @Failure 400 {object} ErrorResponse "Invalid parameters"
+// @Failure 401 {object} ErrorResponse "Unauthorized"
+// @Failure 500 {object} ErrorResponse "Internal server error"
+// @Router /api/v1/stats/wellness [get]
+func (h *StatisticsHandler) HandleGetWellness(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	userID, ok := ctx.Value(userIDKey).(uuid.UUID)
+	if !ok {
+		writeError(w, http.StatusUnauthorized, "UNAUTHORIZED", "Missing user authentication")
+		return
+	}
+
+	input := statistics.GetWellnessInput{UserID: userID}
+	if s := r.URL.Query().Get("startDate"); s != "" {
+		t, err := parseDate(s)
+		if err != nil {
+			writeError(w, http.StatusBadRequest, "VALIDATION_ERROR", "Invalid startDate format. Use YYYY-MM-DD or RFC3339.")
+			return
+		}
+		input.StartDate = &t
+	}
+	if s := r.URL.Query().Get("endDate"); s != "" {
+		t, err := parseDate(s)
+		if err != nil {
+			writeError(w, http.StatusBadRequest, "VALIDATION_ERROR", "Invalid endDate format. Use YYYY-MM-DD or RFC3339.")
+			return
+		}
+		input.EndDate = &t
+	}
+
+	out, err := h.getWellnessUC.Execute(ctx, input)
+	if err != nil {
+		if isStatValidationError(err) {
+			writeError(w, http.StatusBadRequest, "VALIDATION_ERROR", err.Error())
+			return
+		}
+		writeError(w, http.StatusInternalServerError, "INTERNAL_ERROR", "Failed to retrieve wellness data.")
+		return
+	}
+
+	units, err := unitSystemFor(ctx, h.getProfileUC, userID)
+	if err != nil {
+		writeError(w, http.StatusInternalServerError, "INTERNAL_ERROR", "Failed to retrieve wellness data.")
+		return
+	}
+
+	writeSuccess(w, http.StatusOK, mapWellnessToResponse(out, units))
+}
+
+type wellnessSessionResponse struct {
+	SessionID       string  `json:"sessionId"`
+	WorkoutID       string  `json:"workoutId"`
+	WorkoutName     string  `json:"workoutName"`
+	Date            string  `json:"date"`
+	DurationMinutes int     `json:"durationMinutes"`
+	Tonnage         float64 `json:"tonnage"`
+	RPE             *int    `json:"rpe"`
+	Mood            *int    `json:"mood"`
+	Energy          *int    `json:"energy"`
+	SleepQuality    *int    `json:"sleepQuality"`
+}
+
+type wellnessAveragesResponse struct {
+	RPE          *float64 `json:"rpe"`
+	Mood         *float64 `json:"mood"`
+	Energy       *float64 `json:"energy"`
+	SleepQuality *float64 `json:"sleepQuality"`
+}
+
+type wellnessBucketResponse struct {
+	Rating     int      `json:"rating"`
+	Sessions   int      `json:"sessions"`
+	AvgTonnage float64  `json:"avgTonnage"`
+	AvgRPE     *float64 `json:"avgRpe"`
+}
+
+type wellnessResponse struct {
+	StartDate            string                    `json:"startDate"`
+	EndDate              string                    `json:"endDate"`
+	WeightUnit           string                    `json:"weightUnit"`
+	SessionsWithFeedback int                       `json:"sessionsWithFeedback"`
+	Averages             wellnessAveragesResponse  `json:"averages"`
+	ByMood               []wellnessBucketResponse  `json:"byMood"`
+	ByEnergy             []wellnessBucketResponse  `json:"byEnergy"`
+	BySleepQuality       []wellnessBucketResponse  `json:"bySleepQuality"`
+	Sessions             []wellnessSessionResponse `json:"sessions"`
+}
+
+func mapWellnessToResponse(out *statistics.WellnessData, units vos.UnitSystem) wellnessResponse {
+	buckets := func(in []statistics.WellnessBucket) []wellnessBucketResponse {
+		res := make([]wellnessBucketResponse, 0, len(in))
+		for _, b := range in {
+			res = append(res, wellnessBucketResponse{
+				Rating:     b.Rating,
+				Sessions:   b.Sessions,
+				AvgTonnage: units.FromGrams(b.AvgTonnage),
+				AvgRPE:     b.AvgRPE,
+			})
+		}
+		return res
+	}
+
+	sessions := make([]wellnessSessionResponse, 0, len(out.Sessions))
+	for _, s := range out.Sessions {
+		sessions = append(sessions, wellnessSessionResponse{
+			SessionID:       s.SessionID.String(),
+			WorkoutID:       s.WorkoutID.String(),
+			WorkoutName:     s.WorkoutName,
+			Date:            s.Date.Format("2006-01-02"),
+			DurationMinutes: s.DurationMinutes,
+			Tonnage:         units.FromGrams(s.Tonnage),
+			RPE:             s.RPE,
+			Mood:            s.Mood,
+			Energy:          s.Energy,
+			SleepQuality:    s.SleepQuality,
+		})
+	}
+	return wellnessResponse{
+		StartDate:            out.StartDate.Format("2006-01-02"),
+		EndDate:              out.EndDate.Format("2006-01-02"),
+		WeightUnit:           string(units.WeightUnit()),
+		SessionsWithFeedback: out.SessionsWithFeedback,
+		Averages: wellnessAveragesResponse{
+			RPE:          out.Averages.RPE,
+			Mood:         out.Averages.Mood,
+			Energy:       out.Averages.Energy,
+			SleepQuality: out.Averages.SleepQuality,
+		},
+		ByMood:         buckets(out.ByMood),
+		ByEnergy:       buckets(out.ByEnergy),
+		BySleepQuality: buckets(out.BySleepQuality),
+		Sessions:       sessions,
+	}
 }
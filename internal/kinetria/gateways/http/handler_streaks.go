@@ -0,0 +1,217 @@
+package service
+
+import (
+	"errors"
+	"net/http"
+	"time"
+
+	"github.com/go-chi/chi/v5"
+	"github.com/google/uuid"
+	domainerrors "github.com/kinetria/kinetria-back/internal/kinetria/domain/errors"
+	"github.com/kinetria/kinetria-back/internal/kinetria/domain/ports"
+	"github.com/kinetria/kinetria-back/internal/kinetria/domain/streaks"
+)
+
+// StreaksHandler handles HTTP requests for the training streak and planned rest days.
+type StreaksHandler struct {
+	getStreakUC     *streaks.GetStreakUC
+	listRestDaysUC  *streaks.ListRestDaysUC
+	planRestDayUC   *streaks.PlanRestDayUC
+	deleteRestDayUC *streaks.DeleteRestDayUC
+}
+
+// NewStreaksHandler creates a new StreaksHandler.
+func NewStreaksHandler(
+	getStreakUC *streaks.GetStreakUC,
+	listRestDaysUC *streaks.ListRestDaysUC,
+	planRestDayUC *streaks.PlanRestDayUC,
+	deleteRestDayUC *streaks.DeleteRestDayUC,
+) *StreaksHandler {
+	return &StreaksHandler{
+		getStreakUC:     getStreakUC,
+		listRestDaysUC:  listRestDaysUC,
+		planRestDayUC:   planRestDayUC,
+		deleteRestDayUC: deleteRestDayUC,
+	}
+}
+
+// StreakDTO is the user's streak in the mode chosen in their preferences.
+type StreakDTO struct {
+	Mode             string `json:"mode"`
+	WeeklyTarget     int    `json:"weeklyTarget"`
+	Current          int    `json:"current"`
+	Longest          int    `json:"longest"`
+	FreezesAvailable int    `json:"freezesAvailable"`
+	FreezesUsed      int    `json:"freezesUsed"`
+	PeriodSessions   int    `json:"periodSessions"`
+	PeriodTarget     int    `json:"periodTarget"`
+}
+
+// HandleGetStreak godoc
+// @Summary Get training streak
+// @Description Current and longest streak in the user's streak mode: consecutive days with a session ("daily") or consecutive weeks reaching the weekly target ("weekly").
+// @Description Planned rest days never break a daily streak and lower the target of their week. Freezes are earned every 7 streak days or 4 streak weeks (up to 2 banked) and are spent automatically on missed days or weeks.
+// @Description periodSessions/periodTarget describe today (daily) or the current week (weekly), which is still open and never breaks the streak.
+// @Tags streaks
+// @Produce json
+// @Security BearerAuth
+// @Success 200 {object} SuccessResponse{data=StreakDTO}
+// @Failure 401 {object} ErrorResponse "Unauthorized"
+// @Failure 500 {object} ErrorResponse "Internal server error"
+// @Router /api/v1/streak [get]
+func (h *StreaksHandler) HandleGetStreak(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	userID, ok := ctx.Value(userIDKey).(uuid.UUID)
+	if !ok {
+		writeError(w, http.StatusUnauthorized, "UNAUTHORIZED", "Missing user authentication")
+		return
+	}
+
+	out, err := h.getStreakUC.Execute(ctx, userID)
+	if err != nil {
+		writeError(w, http.StatusInternalServerError, "INTERNAL_ERROR", "An unexpected error occurred.")
+		return
+	}
+	writeSuccess(w, http.StatusOK, mapStreakToDTO(out))
+}
+
+// HandleListRestDays godoc
+// @Summary List planned rest days
+// @Tags streaks
+// @Produce json
+// @Security BearerAuth
+// @Param from query string false "First date (YYYY-MM-DD), default today"
+// @Param to query string false "Last date (YYYY-MM-DD), default 90 days after from; at most 366 days after from"
+// @Success 200 {object} SuccessResponse{data=[]string}
+// @Failure 400 {object} ErrorResponse "Invalid parameters"
+// @Failure 401 {object} ErrorResponse "Unauthorized"
+// @Failure 500 {object} ErrorResponse "Internal server error"
+// @Router /api/v1/rest-days [get]
+func (h *StreaksHandler) HandleListRestDays(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	userID, ok := ctx.Value(userIDKey).(uuid.UUID)
+	if !ok {
+		writeError(w, http.StatusUnauthorized, "UNAUTHORIZED", "Missing user authentication")
+		return
+	}
+
+	input := streaks.ListRestDaysInput{UserID: userID}
+	if s := r.URL.Query().Get("from"); s != "" {
+		t, err := time.Parse("2006-01-02", s)
+		if err != nil {
+			writeError(w, http.StatusBadRequest, "VALIDATION_ERROR", "Invalid from format. Use YYYY-MM-DD.")
+			return
+		}
+		input.From = &t
+	}
+	if s := r.URL.Query().Get("to"); s != "" {
+		t, err := time.Parse("2006-01-02", s)
+		if err != nil {
+			writeError(w, http.StatusBadRequest, "VALIDATION_ERROR", "Invalid to format. Use YYYY-MM-DD.")
+			return
+		}
+		input.To = &t
+	}
+
+	days, err := h.listRestDaysUC.Execute(ctx, input)
+	if err != nil {
+		writeRestDayError(w, err)
+		return
+	}
+
+	dates := make([]string, len(days))
+	for i, d := range days {
+		dates[i] = d.Format("2006-01-02")
+	}
+	writeSuccess(w, http.StatusOK, dates)
+}
+
+// HandlePlanRestDay godoc
+// @Summary Plan a rest day
+// @Description Plans a rest day on the given date, from today to 365 days ahead in the user's timezone. Planning the same date twice is not an error.
+// @Tags streaks
+// @Security BearerAuth
+// @Param date path string true "Date (YYYY-MM-DD)"
+// @Success 204 "No Content"
+// @Failure 400 {object} ErrorResponse "Invalid date"
+// @Failure 401 {object} ErrorResponse "Unauthorized"
+// @Failure 500 {object} ErrorResponse "Internal server error"
+// @Router /api/v1/rest-days/{date} [put]
+func (h *StreaksHandler) HandlePlanRestDay(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	userID, ok := ctx.Value(userIDKey).(uuid.UUID)
+	if !ok {
+		writeError(w, http.StatusUnauthorized, "UNAUTHORIZED", "Missing user authentication")
+		return
+	}
+	day, err := time.Parse("2006-01-02", chi.URLParam(r, "date"))
+	if err != nil {
+		writeError(w, http.StatusBadRequest, "VALIDATION_ERROR", "date must be in YYYY-MM-DD format")
+		return
+	}
+
+	if err := h.planRestDayUC.Execute(ctx, userID, day); err != nil {
+		writeRestDayError(w, err)
+		return
+	}
+	w.WriteHeader(http.StatusNoContent)
+}
+
+// HandleDeleteRestDay godoc
+// @Summary Remove a planned rest day
+// @Tags streaks
+// @Security BearerAuth
+// @Param date path string true "Date (YYYY-MM-DD)"
+// @Success 204 "No Content"
+// @Failure 400 {object} ErrorResponse "Invalid date"
+// @Failure 401 {object} ErrorResponse "Unauthorized"
+// @Failure 404 {object} ErrorResponse "No rest day planned on the date"
+// @Failure 500 {object} ErrorResponse "Internal server error"
+// @Router /api/v1/rest-days/{date} [delete]
+func (h *StreaksHandler) HandleDeleteRestDay(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	userID, ok := ctx.Value(userIDKey).(uuid.UUID)
+	if !ok {
+		writeError(w, http.StatusUnauthorized, "UNAUTHORIZED", "Missing user authentication")
+		return
+	}
+	day, err := time.Parse("2006-01-02", chi.URLParam(r, "date"))
+	if err != nil {
+		writeError(w, http.StatusBadRequest, "VALIDATION_ERROR", "date must be in YYYY-MM-DD format")
+		return
+	}
+
+	if err := h.deleteRestDayUC.Execute(ctx, userID, day); err != nil {
+		writeRestDayError(w, err)
+		return
+	}
+	w.WriteHeader(http.StatusNoContent)
+}
+
+func writeRestDayError(w http.ResponseWriter, err error) {
+	switch {
+	case errors.Is(err, domainerrors.ErrNotFound):
+		writeError(w, http.StatusNotFound, "REST_DAY_NOT_FOUND", "No rest day planned on this date.")
+	case errors.Is(err, domainerrors.ErrMalformedParameters), isStatValidationError(err):
+		writeError(w, http.StatusBadRequest, "VALIDATION_ERROR", err.Error())
+	default:
+		writeError(w, http.StatusInternalServerError, "INTERNAL_ERROR", "An unexpected error occurred.")
+	}
+}
+
+func mapStreakToDTO(s *ports.StreakStatus) StreakDTO {
+	return StreakDTO{
+		Mode:             string(s.Mode),
+		WeeklyTarget:     s.WeeklyTarget,
+		Current:          s.Current,
+		Longest:          s.Longest,
+		FreezesAvailable: s.FreezesAvailable,
+		FreezesUsed:      s.FreezesUsed,
+		PeriodSessions:   s.PeriodSessions,
+		PeriodTarget:     s.PeriodTarget,
+	}
+}
@@ -0,0 +1,281 @@
+package service
+
+import (
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/google/uuid"
+	domainerrors "github.com/kinetria/kinetria-back/internal/kinetria/domain/errors"
+	"github.com/kinetria/kinetria-back/internal/kinetria/domain/ports"
+	"github.com/kinetria/kinetria-back/internal/kinetria/domain/profile"
+	domainsessions "github.com/kinetria/kinetria-back/internal/kinetria/domain/sessions"
+	"github.com/kinetria/kinetria-back/internal/kinetria/domain/vos"
+)
+
+// SyncHandler handles the offline sync of the mobile app.
+type SyncHandler struct {
+	syncSessionsUC *domainsessions.SyncSessionsUC
+	getProfileUC   *profile.GetProfileUC
+}
+
+// NewSyncHandler creates a new SyncHandler.
+func NewSyncHandler(syncSessionsUC *domainsessions.SyncSessionsUC, getProfileUC *profile.GetProfileUC) *SyncHandler {
+	return &SyncHandler{syncSessionsUC: syncSessionsUC, getProfileUC: getProfileUC}
+}
+
+// SyncOperationRequest is an operation made by the app while offline. Fields other than
+// id, type, clientTimestamp and sessionId are read according to the type.
+type SyncOperationRequest struct {
+	ID              string    `json:"id" example:"0b6f4c1e-8f55-4a36-9d1b-3f3c2a9c4e11"` // gerado pelo app
+	Type            string    `json:"type" example:"set.record" enums:"session.start,set.record,session.finish,session.abandon"`
+	ClientTimestamp time.Time `json:"clientTimestamp" example:"2026-03-01T10:15:00Z"`
+	SessionID       string    `json:"sessionId"`
+	WorkoutID       string    `json:"workoutId,omitempty"`  // session.start
+	SetID           string    `json:"setId,omitempty"`      // set.record
+	ExerciseID      string    `json:"exerciseId,omitempty"` // set.record
+	SetNumber       int       `json:"setNumber,omitempty"`  // set.record
+	Weight          float64   `json:"weight,omitempty"`     // set.record, kg ou lb conforme a preferência
+	Reps            int       `json:"reps,omitempty"`       // set.record
+	Status          string    `json:"status,omitempty"`     // set.record: completed ou skipped
+	Notes           string    `json:"notes,omitempty"`      // session.finish
+}
+
+// SyncRequest is a batch of offline operations and the cursor returned by the previous sync.
+type SyncRequest struct {
+	Cursor     string                 `json:"cursor,omitempty"` // vazio no primeiro sync
+	Operations []SyncOperationRequest `json:"operations"`
+}
+
+// SyncOperationResultDTO is the outcome of one operation: applied, duplicate (already
+// applied by an earlier sync) or rejected, with the reason.
+type SyncOperationResultDTO struct {
+	ID       string  `json:"id"`
+	Type     string  `json:"type"`
+	EntityID string  `json:"entityId"`
+	Status   string  `json:"status" enums:"applied,duplicate,rejected"`
+	Code     *string `json:"code"`
+	Message  *string `json:"message"`
+}
+
+// SyncedSetDTO is a recorded set of a synced session. Weight is in weightUnit.
+type SyncedSetDTO struct {
+	ID         string    `json:"id"`
+	ExerciseID string    `json:"exerciseId"`
+	SetNumber  int       `json:"setNumber"`
+	Weight     float64   `json:"weight"`
+	Reps       int       `json:"reps"`
+	Status     string    `json:"status"`
+	RecordedAt time.Time `json:"recordedAt"`
+}
+
+// SyncedSessionDTO is the server state of a session changed after the request cursor.
+type SyncedSessionDTO struct {
+	ID         string         `json:"id"`
+	WorkoutID  string         `json:"workoutId"`
+	Status     string         `json:"status"`
+	Notes      string         `json:"notes"`
+	StartedAt  time.Time      `json:"startedAt"`
+	FinishedAt *time.Time     `json:"finishedAt"`
+	Calories   *int           `json:"calories"`
+	RPE        *int           `json:"rpe"`
+	UpdatedAt  time.Time      `json:"updatedAt"`
+	Sets       []SyncedSetDTO `json:"sets"`
+}
+
+// SyncResponse holds the outcome of each operation, in request order, and the sessions
+// changed since the request cursor.
+type SyncResponse struct {
+	Results         []SyncOperationResultDTO `json:"results"`
+	Sessions        []SyncedSessionDTO       `json:"sessions"`
+	Cursor          string                   `json:"cursor"`
+	HasMore         bool                     `json:"hasMore"` // sincronizar de novo com o cursor para o restante
+	WeightUnit      string                   `json:"weightUnit"`
+	NewAchievements []string                 `json:"newAchievements"`
+}
+
+// HandleSync godoc
+// @Summary Sync offline sessions
+// @Description Applies a batch of operations made by the app while offline and returns the sessions changed since the
+// @Description previous sync, on this or other devices. Operations carry IDs generated by the app (operation, session and
+// @Description set IDs) and the time they happened on the device; timestamps in the future are taken as the time of the sync.
+// @Description Operations are applied in order. An operation already synced is not applied again and is reported as
+// @Description duplicate (or with its original rejection), so a batch can be retried safely. On conflict the server state
+// @Description wins: an operation that contradicts it (e.g. starting a session while another is active, recording a set
+// @Description number already recorded, finishing a closed session) is rejected with a code, as are the operations that
+// @Description depend on it. Up to 500 operations per batch and 50 sessions per response; with hasMore, sync again with
+// @Description the returned cursor. Weights are in the user's unit preference.
+// @Tags sync
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param request body SyncRequest true "Offline operations and cursor"
+// @Success 200 {object} SuccessResponse{data=SyncResponse}
+// @Failure 401 {object} ErrorResponse "Unauthorized"
+// @Failure 422 {object} ErrorResponse "Validation error"
+// @Failure 500 {object} ErrorResponse "Internal server error"
+// @Router /api/v1/sync [post]
+func (h *SyncHandler) HandleSync(w http.ResponseWriter, r *http.Request) {
+	r.Body = http.MaxBytesReader(w, r.Body, 4<<20) // 4 MB limit
+	ctx := r.Context()
+
+	userID, ok := ctx.Value(userIDKey).(uuid.UUID)
+	if !ok {
+		writeError(w, http.StatusUnauthorized, "UNAUTHORIZED", "Missing user authentication")
+		return
+	}
+
+	var req SyncRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		writeError(w, http.StatusUnprocessableEntity, "VALIDATION_ERROR", "Request body is invalid.")
+		return
+	}
+	cursor, err := decodeSyncCursor(req.Cursor)
+	if err != nil {
+		writeError(w, http.StatusUnprocessableEntity, "VALIDATION_ERROR", "Invalid cursor.")
+		return
+	}
+
+	units, err := unitSystemFor(ctx, h.getProfileUC, userID)
+	if err != nil {
+		writeError(w, http.StatusInternalServerError, "INTERNAL_ERROR", "An unexpected error occurred.")
+		return
+	}
+
+	input := domainsessions.SyncInput{
+		UserID:     userID,
+		Cursor:     cursor,
+		Operations: make([]domainsessions.SyncOperation, 0, len(req.Operations)),
+	}
+	for _, op := range req.Operations {
+		operation, err := op.toOperation(units)
+		if err != nil {
+			writeError(w, http.StatusUnprocessableEntity, "VALIDATION_ERROR", err.Error())
+			return
+		}
+		input.Operations = append(input.Operations, operation)
+	}
+
+	output, err := h.syncSessionsUC.Execute(ctx, input)
+	if err != nil {
+		if errors.Is(err, domainerrors.ErrMalformedParameters) {
+			writeError(w, http.StatusUnprocessableEntity, "VALIDATION_ERROR", err.Error())
+			return
+		}
+		writeError(w, http.StatusInternalServerError, "INTERNAL_ERROR", "An unexpected error occurred.")
+		return
+	}
+
+	resp := SyncResponse{
+		Results:         make([]SyncOperationResultDTO, 0, len(output.Results)),
+		Sessions:        make([]SyncedSessionDTO, 0, len(output.Changes)),
+		Cursor:          encodeSyncCursor(output.Cursor),
+		HasMore:         output.HasMore,
+		WeightUnit:      string(units.WeightUnit()),
+		NewAchievements: achievementCodes(output.Achievements),
+	}
+	for _, res := range output.Results {
+		dto := SyncOperationResultDTO{
+			ID:       res.OperationID.String(),
+			Type:     res.Type.String(),
+			EntityID: res.EntityID.String(),
+			Status:   res.Status.String(),
+		}
+		if res.Status == vos.SyncOperationRejected {
+			dto.Code, dto.Message = &res.Code, &res.Message
+		}
+		resp.Results = append(resp.Results, dto)
+	}
+	for _, change := range output.Changes {
+		resp.Sessions = append(resp.Sessions, mapSessionChangeToDTO(change, units))
+	}
+	writeSuccess(w, http.StatusOK, resp)
+}
+
+// toOperation parses the IDs of the operation and converts its weight to grams. Only the
+// operation ID must be valid here: other invalid fields reject just this operation.
+func (op SyncOperationRequest) toOperation(units vos.UnitSystem) (domainsessions.SyncOperation, error) {
+	id, err := uuid.Parse(op.ID)
+	if err != nil {
+		return domainsessions.SyncOperation{}, errors.New("invalid operation id format")
+	}
+	return domainsessions.SyncOperation{
+		ID:              id,
+		Type:            vos.SyncOperationType(op.Type),
+		ClientTimestamp: op.ClientTimestamp,
+		SessionID:       parseOptionalUUID(op.SessionID),
+		WorkoutID:       parseOptionalUUID(op.WorkoutID),
+		SetID:           parseOptionalUUID(op.SetID),
+		ExerciseID:      parseOptionalUUID(op.ExerciseID),
+		SetNumber:       op.SetNumber,
+		Weight:          int(units.ToGrams(op.Weight)),
+		Reps:            op.Reps,
+		SetStatus:       vos.SetRecordStatus(op.Status),
+		Notes:           op.Notes,
+	}, nil
+}
+
+// parseOptionalUUID returns uuid.Nil for a missing or invalid ID, which the sync rejects
+// in the operation result.
+func parseOptionalUUID(s string) uuid.UUID {
+	id, err := uuid.Parse(s)
+	if err != nil {
+		return uuid.Nil
+	}
+	return id
+}
+
+func mapSessionChangeToDTO(change ports.SessionChange, units vos.UnitSystem) SyncedSessionDTO {
+	s := change.Session
+	dto := SyncedSessionDTO{
+		ID:         s.ID.String(),
+		WorkoutID:  s.WorkoutID.String(),
+		Status:     s.Status.String(),
+		Notes:      s.Notes,
+		StartedAt:  s.StartedAt,
+		FinishedAt: s.FinishedAt,
+		Calories:   s.Calories,
+		RPE:        s.RPE,
+		UpdatedAt:  s.UpdatedAt,
+		Sets:       make([]SyncedSetDTO, 0, len(change.Sets)),
+	}
+	for _, set := range change.Sets {
+		dto.Sets = append(dto.Sets, SyncedSetDTO{
+			ID:         set.ID.String(),
+			ExerciseID: set.ExerciseID.String(),
+			SetNumber:  set.SetNumber,
+			Weight:     units.FromGrams(int64(set.Weight)),
+			Reps:       set.Reps,
+			Status:     set.Status,
+			RecordedAt: set.RecordedAt,
+		})
+	}
+	return dto
+}
+
+// O cursor é opaco para o app: base64 do change_seq da última mudança entregue
+
+func encodeSyncCursor(c ports.SyncCursor) string {
+	if c.ChangeSeq == 0 {
+		return ""
+	}
+	return base64.RawURLEncoding.EncodeToString([]byte(strconv.FormatInt(c.ChangeSeq, 10)))
+}
+
+func decodeSyncCursor(s string) (ports.SyncCursor, error) {
+	if s == "" {
+		return ports.SyncCursor{}, nil
+	}
+	raw, err := base64.RawURLEncoding.DecodeString(s)
+	if err != nil {
+		return ports.SyncCursor{}, err
+	}
+	seq, err := strconv.ParseInt(string(raw), 10, 64)
+	if err != nil || seq < 0 {
+		return ports.SyncCursor{}, errors.New("invalid cursor")
+	}
+	return ports.SyncCursor{ChangeSeq: seq}, nil
+}
@@ -12,6 +12,8 @@ import (
 	"github.com/google/uuid"
 	"github.com/kinetria/kinetria-back/internal/kinetria/domain/entities"
 	domerrors "github.com/kinetria/kinetria-back/internal/kinetria/domain/errors"
+	"github.com/kinetria/kinetria-back/internal/kinetria/domain/profile"
+	"github.com/kinetria/kinetria-back/internal/kinetria/domain/vos"
 	domainworkouts "github.com/kinetria/kinetria-back/internal/kinetria/domain/workouts"
 	gatewayauth "github.com/kinetria/kinetria-back/internal/kinetria/gateways/auth"
 )
@@ -25,6 +27,7 @@ type WorkoutSummaryDTO struct {
 	Intensity   *string `json:"intensity"`
 	Duration    int     `json:"duration"`
 	ImageURL    *string `json:"imageUrl"`
+	IsFavorite  bool    `json:"isFavorite"`
 }
 
 type ExerciseDTO struct {
@@ -35,7 +38,7 @@ type ExerciseDTO struct {
 	Reps         string   `json:"reps"`
 	Muscles      []string `json:"muscles"`
 	RestTime     int      `json:"restTime"`
-	Weight       *int     `json:"weight"`
+	Weight       *float64 `json:"weight"`
 }
 
 type WorkoutDTO struct {
@@ -46,6 +49,8 @@ type WorkoutDTO struct {
 	Intensity   *string       `json:"intensity"`
 	Duration    int           `json:"duration"`
 	ImageURL    *string       `json:"imageUrl"`
+	IsFavorite  bool          `json:"isFavorite"`
+	WeightUnit  string        `json:"weightUnit"`
 	Exercises   []ExerciseDTO `json:"exercises"`
 }
 
@@ -63,9 +68,10 @@ type ApiResponseDTO struct {
 
 func mapWorkoutToSummaryDTO(w entities.Workout) WorkoutSummaryDTO {
 	dto := WorkoutSummaryDTO{
-		ID:       w.ID.String(),
-		Name:     w.Name,
-		Duration: w.Duration,
+		ID:         w.ID.String(),
+		Name:       w.Name,
+		Duration:   w.Duration,
+		IsFavorite: w.IsFavorite,
 	}
 	if w.Description != "" {
 		dto.Description = &w.Description
@@ -82,7 +88,7 @@ func mapWorkoutToSummaryDTO(w entities.Workout) WorkoutSummaryDTO {
 	return dto
 }
 
-func mapExerciseToDTO(e entities.Exercise) ExerciseDTO {
+func mapExerciseToDTO(e entities.Exercise, units vos.UnitSystem) ExerciseDTO {
 	dto := ExerciseDTO{
 		ID:       e.ID.String(),
 		Name:     e.Name,
@@ -96,18 +102,20 @@ func mapExerciseToDTO(e entities.Exercise) ExerciseDTO {
 		dto.ThumbnailURL = &e.ThumbnailURL
 	}
 	if e.Weight > 0 {
-		dto.Weight = &e.Weight
+		dto.Weight = weightPtrFromGrams(units, &e.Weight)
 	}
 
 	return dto
 }
 
-func mapWorkoutToFullDTO(w entities.Workout, exercises []entities.Exercise) WorkoutDTO {
+func mapWorkoutToFullDTO(w entities.Workout, exercises []entities.Exercise, units vos.UnitSystem) WorkoutDTO {
 	dto := WorkoutDTO{
-		ID:        w.ID.String(),
-		Name:      w.Name,
-		Duration:  w.Duration,
-		Exercises: make([]ExerciseDTO, len(exercises)),
+		ID:         w.ID.String(),
+		Name:       w.Name,
+		Duration:   w.Duration,
+		IsFavorite: w.IsFavorite,
+		WeightUnit: string(units.WeightUnit()),
+		Exercises:  make([]ExerciseDTO, len(exercises)),
 	}
 
 	// Mapear campos opcionais do workout
@@ -126,7 +134,7 @@ func mapWorkoutToFullDTO(w entities.Workout, exercises []entities.Exercise) Work
 
 	// Mapear exercises
 	for i, exercise := range exercises {
-		dto.Exercises[i] = mapExerciseToDTO(exercise)
+		dto.Exercises[i] = mapExerciseToDTO(exercise, units)
 	}
 
 	return dto
@@ -135,12 +143,12 @@ func mapWorkoutToFullDTO(w entities.Workout, exercises []entities.Exercise) Work
 // Request DTOs
 
 type WorkoutExerciseRequest struct {
-	ExerciseID string `json:"exerciseId"`
-	Sets       int    `json:"sets"`
-	Reps       string `json:"reps"`
-	RestTime   int    `json:"restTime"`
-	Weight     *int   `json:"weight"`
-	OrderIndex int    `json:"orderIndex"`
+	ExerciseID string   `json:"exerciseId"`
+	Sets       int      `json:"sets"`
+	Reps       string   `json:"reps"`
+	RestTime   int      `json:"restTime"`
+	Weight     *float64 `json:"weight"` // kg or lb, per the user's unit preference
+	OrderIndex int      `json:"orderIndex"`
 }
 
 type CreateWorkoutRequest struct {
@@ -165,12 +173,14 @@ type UpdateWorkoutRequest struct {
 
 // WorkoutsHandler handles HTTP requests for workouts endpoints.
 type WorkoutsHandler struct {
-	listWorkoutsUC  *domainworkouts.ListWorkoutsUC
-	getWorkoutUC    *domainworkouts.GetWorkoutUC
-	createWorkoutUC *domainworkouts.CreateWorkoutUC
-	updateWorkoutUC *domainworkouts.UpdateWorkoutUC
-	deleteWorkoutUC *domainworkouts.DeleteWorkoutUC
-	jwtManager      *gatewayauth.JWTManager
+	listWorkoutsUC       *domainworkouts.ListWorkoutsUC
+	getWorkoutUC         *domainworkouts.GetWorkoutUC
+	createWorkoutUC      *domainworkouts.CreateWorkoutUC
+	updateWorkoutUC      *domainworkouts.UpdateWorkoutUC
+	deleteWorkoutUC      *domainworkouts.DeleteWorkoutUC
+	setWorkoutFavoriteUC *domainworkouts.SetWorkoutFavoriteUC
+	getProfileUC         *profile.GetProfileUC
+	jwtManager           *gatewayauth.JWTManager
 }
 
 // NewWorkoutsHandler creates a new WorkoutsHandler.
@@ -180,19 +190,23 @@ func NewWorkoutsHandler(
 	createWorkoutUC *domainworkouts.CreateWorkoutUC,
 	updateWorkoutUC *domainworkouts.UpdateWorkoutUC,
 	deleteWorkoutUC *domainworkouts.DeleteWorkoutUC,
+	setWorkoutFavoriteUC *domainworkouts.SetWorkoutFavoriteUC,
+	getProfileUC *profile.GetProfileUC,
 	jwtManager *gatewayauth.JWTManager,
 ) *WorkoutsHandler {
 	return &WorkoutsHandler{
-		listWorkoutsUC:  listWorkoutsUC,
-		getWorkoutUC:    getWorkoutUC,
-		createWorkoutUC: createWorkoutUC,
-		updateWorkoutUC: updateWorkoutUC,
-		deleteWorkoutUC: deleteWorkoutUC,
-		jwtManager:      jwtManager,
+		listWorkoutsUC:       listWorkoutsUC,
+		getWorkoutUC:         getWorkoutUC,
+		createWorkoutUC:      createWorkoutUC,
+		updateWorkoutUC:      updateWorkoutUC,
+		deleteWorkoutUC:      deleteWorkoutUC,
+		setWorkoutFavoriteUC: setWorkoutFavoriteUC,
+		getProfileUC:         getProfileUC,
+		jwtManager:           jwtManager,
 	}
 }
 
-func mapExerciseRequestToInput(req WorkoutExerciseRequest) (domainworkouts.WorkoutExerciseInput, error) {
+func mapExerciseRequestToInput(req WorkoutExerciseRequest, units vos.UnitSystem) (domainworkouts.WorkoutExerciseInput, error) {
 	exerciseID, err := uuid.Parse(req.ExerciseID)
 	if err != nil {
 		return domainworkouts.WorkoutExerciseInput{}, fmt.Errorf("invalid exerciseId '%s': must be a valid UUID", req.ExerciseID)
@@ -202,7 +216,7 @@ func mapExerciseRequestToInput(req WorkoutExerciseRequest) (domainworkouts.Worko
 		Sets:       req.Sets,
 		Reps:       req.Reps,
 		RestTime:   req.RestTime,
-		Weight:     req.Weight,
+		Weight:     weightPtrToGrams(units, req.Weight),
 		OrderIndex: req.OrderIndex,
 	}, nil
 }
@@ -232,6 +246,7 @@ func mapDomainErrorToHTTP(err error) (int, string, string) {
 // @Security BearerAuth
 // @Param page query int false "Page number" default(1)
 // @Param limit query int false "Items per page" default(10)
+// @Param favorites query bool false "Only favorite workouts"
 // @Success 200 {object} SuccessResponse{data=WorkoutListResponse}
 // @Failure 401 {object} ErrorResponse "Unauthorized"
 // @Failure 500 {object} ErrorResponse "Internal server error"
@@ -257,11 +272,20 @@ func (h *WorkoutsHandler) ListWorkouts(w http.ResponseWriter, r *http.Request) {
 		writeError(w, http.StatusUnprocessableEntity, "VALIDATION_ERROR", "pageSize must be a valid integer")
 		return
 	}
+	favoritesOnly := false
+	if v := r.URL.Query().Get("favorites"); v != "" {
+		favoritesOnly, err = strconv.ParseBool(v)
+		if err != nil {
+			writeError(w, http.StatusUnprocessableEntity, "VALIDATION_ERROR", "favorites must be true or false")
+			return
+		}
+	}
 
 	output, err := h.listWorkoutsUC.Execute(ctx, domainworkouts.ListWorkoutsInput{
-		UserID:   userID,
-		Page:     page,
-		PageSize: pageSize,
+		UserID:        userID,
+		FavoritesOnly: favoritesOnly,
+		Page:          page,
+		PageSize:      pageSize,
 	})
 	if err != nil {
 		writeError(w, http.StatusUnprocessableEntity, "VALIDATION_ERROR", err.Error())
@@ -290,7 +314,7 @@ func (h *WorkoutsHandler) ListWorkouts(w http.ResponseWriter, r *http.Request) {
 
 // GetWorkout godoc
 // @Summary Get workout by ID
-// @Description Get detailed workout information with exercises
+// @Description Get detailed workout information with exercises. Exercise weights are in the user's unit preference (weightUnit).
 // @Tags workouts
 // @Produce json
 // @Security BearerAuth
@@ -341,8 +365,14 @@ func (h *WorkoutsHandler) GetWorkout(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
-	// 4. Mapear para DTO
-	dto := mapWorkoutToFullDTO(output.Workout, output.Exercises)
+	units, err := unitSystemFor(ctx, h.getProfileUC, userID)
+	if err != nil {
+		writeError(w, http.StatusInternalServerError, "INTERNAL_ERROR", "An unexpected error occurred.")
+		return
+	}
+
+	// 4. Mapear para DTO (pesos na unidade do usuário)
+	dto := mapWorkoutToFullDTO(output.Workout, output.Exercises, units)
 
 	// 5. Responder com sucesso
 	w.Header().Set("Content-Type", "application/json")
@@ -354,7 +384,7 @@ func (h *WorkoutsHandler) GetWorkout(w http.ResponseWriter, r *http.Request) {
 
 // CreateWorkout godoc
 // @Summary Create a new workout
-// @Description Creates a new workout with exercises for the authenticated user
+// @Description Creates a new workout with exercises for the authenticated user. Exercise weights are in the user's unit preference.
 // @Tags workouts
 // @Accept json
 // @Produce json
@@ -380,9 +410,15 @@ func (h *WorkoutsHandler) CreateWorkout(w http.ResponseWriter, r *http.Request)
 		return
 	}
 
+	units, err := unitSystemFor(ctx, h.getProfileUC, userID)
+	if err != nil {
+		writeError(w, http.StatusInternalServerError, "INTERNAL_ERROR", "An unexpected error occurred.")
+		return
+	}
+
 	exercises := make([]domainworkouts.WorkoutExerciseInput, len(req.Exercises))
 	for i, ex := range req.Exercises {
-		exInput, err := mapExerciseRequestToInput(ex)
+		exInput, err := mapExerciseRequestToInput(ex, units)
 		if err != nil {
 			writeError(w, http.StatusBadRequest, "VALIDATION_ERROR", err.Error())
 			return
@@ -412,7 +448,7 @@ func (h *WorkoutsHandler) CreateWorkout(w http.ResponseWriter, r *http.Request)
 
 // UpdateWorkout godoc
 // @Summary Update a workout
-// @Description Updates a workout owned by the authenticated user
+// @Description Updates a workout owned by the authenticated user. Exercise weights are in the user's unit preference.
 // @Tags workouts
 // @Accept json
 // @Produce json
@@ -448,11 +484,17 @@ func (h *WorkoutsHandler) UpdateWorkout(w http.ResponseWriter, r *http.Request)
 		return
 	}
 
+	units, err := unitSystemFor(ctx, h.getProfileUC, userID)
+	if err != nil {
+		writeError(w, http.StatusInternalServerError, "INTERNAL_ERROR", "An unexpected error occurred.")
+		return
+	}
+
 	var exercises []domainworkouts.WorkoutExerciseInput
 	if len(req.Exercises) > 0 {
 		exercises = make([]domainworkouts.WorkoutExerciseInput, len(req.Exercises))
 		for i, ex := range req.Exercises {
-			exInput, err := mapExerciseRequestToInput(ex)
+			exInput, err := mapExerciseRequestToInput(ex, units)
 			if err != nil {
 				writeError(w, http.StatusBadRequest, "VALIDATION_ERROR", err.Error())
 				return
@@ -520,6 +562,57 @@ func (h *WorkoutsHandler) DeleteWorkout(w http.ResponseWriter, r *http.Request)
 	w.WriteHeader(http.StatusNoContent)
 }
 
+// FavoriteWorkout godoc
+// @Summary Favorite a workout
+// @Description Marks a workout (own or template) as a favorite of the authenticated user. Idempotent.
+// @Tags workouts
+// @Security BearerAuth
+// @Param id path string true "Workout ID (UUID)"
+// @Success 204 "No Content"
+// @Failure 401 {object} ErrorResponse "Unauthorized"
+// @Failure 404 {object} ErrorResponse "Workout not found"
+// @Failure 500 {object} ErrorResponse "Internal server error"
+// @Router /api/v1/workouts/{id}/favorite [put]
+func (h *WorkoutsHandler) FavoriteWorkout(w http.ResponseWriter, r *http.Request) {
+	h.setFavorite(w, r, true)
+}
+
+// UnfavoriteWorkout godoc
+// @Summary Unfavorite a workout
+// @Description Removes a workout from the authenticated user's favorites. Idempotent.
+// @Tags workouts
+// @Security BearerAuth
+// @Param id path string true "Workout ID (UUID)"
+// @Success 204 "No Content"
+// @Failure 401 {object} ErrorResponse "Unauthorized"
+// @Failure 500 {object} ErrorResponse "Internal server error"
+// @Router /api/v1/workouts/{id}/favorite [delete]
+func (h *WorkoutsHandler) UnfavoriteWorkout(w http.ResponseWriter, r *http.Request) {
+	h.setFavorite(w, r, false)
+}
+
+func (h *WorkoutsHandler) setFavorite(w http.ResponseWriter, r *http.Request, favorite bool) {
+	userID, err := h.extractUserIDFromJWT(r)
+	if err != nil {
+		writeError(w, http.StatusUnauthorized, "UNAUTHORIZED", "Invalid or expired access token.")
+		return
+	}
+
+	workoutID, err := uuid.Parse(chi.URLParam(r, "id"))
+	if err != nil {
+		writeError(w, http.StatusUnprocessableEntity, "VALIDATION_ERROR", "workoutId must be a valid UUID")
+		return
+	}
+
+	if err := h.setWorkoutFavoriteUC.Execute(r.Context(), userID, workoutID, favorite); err != nil {
+		statusCode, errCode, msg := mapDomainErrorToHTTP(err)
+		writeError(w, statusCode, errCode, msg)
+		return
+	}
+
+	w.WriteHeader(http.StatusNoContent)
+}
+
 func (h *WorkoutsHandler) extractUserIDFromJWT(r *http.Request) (uuid.UUID, error) {
 	authHeader := r.Header.Get("Authorization")
 	if !strings.HasPrefix(authHeader, "Bearer ") {
@@ -0,0 +1,39 @@
+package service
+
+import (
+	"context"
+	"crypto/subtle"
+	"net/http"
+)
+
+// adminKeyHeader carries the shared secret for administrative endpoints.
+const adminKeyHeader = "X-Admin-Key"
+
+// adminKey marks in the request context that the admin key was checked.
+const adminKey contextKey = "admin"
+
+// AdminKeyMiddleware creates a middleware that only lets requests carrying the configured
+// admin API key through. An empty key disables the protected routes entirely (403).
+// Handlers behind it can tell admin requests apart with isAdminRequest.
+func AdminKeyMiddleware(apiKey string) func(next http.Handler) http.Handler {
+	return func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			if apiKey == "" {
+				writeError(w, http.StatusForbidden, "FORBIDDEN", "Administrative endpoints are disabled.")
+				return
+			}
+			provided := r.Header.Get(adminKeyHeader)
+			if subtle.ConstantTimeCompare([]byte(provided), []byte(apiKey)) != 1 {
+				writeError(w, http.StatusUnauthorized, "UNAUTHORIZED", "Invalid or missing admin key.")
+				return
+			}
+			next.ServeHTTP(w, r.WithContext(context.WithValue(r.Context(), adminKey, true)))
+		})
+	}
+}
+
+// isAdminRequest reports whether the request went through AdminKeyMiddleware.
+func isAdminRequest(ctx context.Context) bool {
+	admin, _ := ctx.Value(adminKey).(bool)
+	return admin
+}
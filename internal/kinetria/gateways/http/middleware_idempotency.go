@@ -0,0 +1,195 @@
+package service
+
+import (
+	"bytes"
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"io"
+	"log"
+	"net/http"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/kinetria/kinetria-back/internal/kinetria/domain/ports"
+)
+
+const (
+	// idempotencyKeyHeader carries the client-chosen key of a POST, PUT or PATCH request.
+	idempotencyKeyHeader = "Idempotency-Key"
+	// idempotentReplayedHeader marks a response replayed from a previous request.
+	idempotentReplayedHeader = "Idempotent-Replayed"
+	maxIdempotencyKeyLength  = 255
+	// maxIdempotentBodyBytes matches the largest JSON body accepted by the API (POST /sync).
+	maxIdempotentBodyBytes = 4 << 20
+	// idempotencyHeartbeat is how often a running request renews the claim of its key.
+	idempotencyHeartbeat = 20 * time.Second
+	// idempotencyStaleAfter is how long a claim may go without being renewed before its key
+	// can be claimed again: several heartbeats, so that only a request whose server stopped
+	// while handling it loses the key.
+	idempotencyStaleAfter = 2 * time.Minute
+)
+
+// IdempotencyKeys makes retried POST, PUT and PATCH requests safe: the first request sent
+// with an Idempotency-Key runs and its response is stored for the TTL; a retry with the same
+// key gets that response back without running again.
+type IdempotencyKeys struct {
+	repo      ports.IdempotencyRepository
+	ttl       time.Duration
+	heartbeat time.Duration
+}
+
+// NewIdempotencyKeys creates a new IdempotencyKeys whose stored responses expire after ttl.
+func NewIdempotencyKeys(repo ports.IdempotencyRepository, ttl time.Duration) *IdempotencyKeys {
+	return &IdempotencyKeys{repo: repo, ttl: ttl, heartbeat: idempotencyHeartbeat}
+}
+
+// Middleware applies the Idempotency-Key header of authenticated POST, PUT and PATCH
+// requests; it must run after AuthMiddleware, as keys are scoped to the user. Requests
+// without the header run as usual. A key is bound to the method, path and body of the
+// first request: reusing it for a different request returns 422, and retrying while the
+// first request still runs returns 409. The first request renews its claim while it runs,
+// so the key is only claimed again once its server stopped. Responses with a 5xx status are
+// not stored, so the request can be retried.
+func (k *IdempotencyKeys) Middleware(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		key := r.Header.Get(idempotencyKeyHeader)
+		userID, ok := r.Context().Value(userIDKey).(uuid.UUID)
+		if key == "" || !ok || !isIdempotentMethod(r.Method) {
+			next.ServeHTTP(w, r)
+			return
+		}
+		if len(key) > maxIdempotencyKeyLength {
+			writeError(w, http.StatusUnprocessableEntity, "VALIDATION_ERROR", "Idempotency-Key must not be longer than 255 characters.")
+			return
+		}
+
+		body, err := io.ReadAll(http.MaxBytesReader(w, r.Body, maxIdempotentBodyBytes))
+		if err != nil {
+			writeError(w, http.StatusUnprocessableEntity, "VALIDATION_ERROR", "Request body is invalid.")
+			return
+		}
+		r.Body = io.NopCloser(bytes.NewReader(body))
+
+		now := time.Now()
+		record := ports.IdempotencyRecord{
+			UserID:      userID,
+			Key:         key,
+			Fingerprint: requestFingerprint(r, body),
+			ClaimID:     uuid.New(),
+			CreatedAt:   now,
+			ExpiresAt:   now.Add(k.ttl),
+		}
+		stored, err := k.repo.Claim(r.Context(), &record, now.Add(-idempotencyStaleAfter))
+		if err != nil {
+			writeError(w, http.StatusInternalServerError, "INTERNAL_ERROR", "An unexpected error occurred.")
+			return
+		}
+		if stored != nil {
+			switch {
+			case stored.Fingerprint != record.Fingerprint:
+				writeError(w, http.StatusUnprocessableEntity, "IDEMPOTENCY_KEY_REUSED", "Idempotency-Key was already used for a different request.")
+			case stored.StatusCode == 0:
+				writeError(w, http.StatusConflict, "IDEMPOTENCY_KEY_IN_PROGRESS", "A request with this Idempotency-Key is still being processed.")
+			default:
+				if stored.ContentType != "" {
+					w.Header().Set("Content-Type", stored.ContentType)
+				}
+				w.Header().Set(idempotentReplayedHeader, "true")
+				w.WriteHeader(stored.StatusCode)
+				_, _ = w.Write(stored.Body)
+			}
+			return
+		}
+
+		rec := &responseRecorder{ResponseWriter: w, status: http.StatusOK}
+		completed := false
+		// A resposta já foi enviada: falhas ao guardá-la só liberam a chave para um novo retry
+		ctx := context.WithoutCancel(r.Context())
+		defer func() {
+			if completed {
+				return
+			}
+			if err := k.repo.Release(ctx, userID, key, record.ClaimID); err != nil {
+				log.Printf("failed to release idempotency key: %v", err)
+			}
+		}()
+
+		stopHeartbeat := k.keepClaim(ctx, userID, key, record.ClaimID)
+		defer stopHeartbeat()
+
+		next.ServeHTTP(rec, r)
+
+		if rec.status >= http.StatusInternalServerError {
+			return
+		}
+		if err := k.repo.Complete(ctx, userID, key, record.ClaimID, rec.status, rec.Header().Get("Content-Type"), rec.body.Bytes()); err != nil {
+			log.Printf("failed to store idempotent response: %v", err)
+			return
+		}
+		completed = true
+	})
+}
+
+// keepClaim renews the claim of key every heartbeat until the returned function is called,
+// which waits for the renewals to stop.
+func (k *IdempotencyKeys) keepClaim(ctx context.Context, userID uuid.UUID, key string, claimID uuid.UUID) func() {
+	stop := make(chan struct{})
+	done := make(chan struct{})
+	go func() {
+		defer close(done)
+		ticker := time.NewTicker(k.heartbeat)
+		defer ticker.Stop()
+		for {
+			select {
+			case <-stop:
+				return
+			case <-ticker.C:
+				// Uma falha isolada não perde a chave: ela só fica velha depois de várias
+				held, err := k.repo.Renew(ctx, userID, key, claimID, time.Now())
+				if err != nil {
+					log.Printf("failed to renew idempotency key: %v", err)
+				} else if !held {
+					return
+				}
+			}
+		}
+	}()
+	return func() {
+		close(stop)
+		<-done
+	}
+}
+
+func isIdempotentMethod(method string) bool {
+	return method == http.MethodPost || method == http.MethodPut || method == http.MethodPatch
+}
+
+// requestFingerprint hashes what identifies a request: method, path, query and body.
+func requestFingerprint(r *http.Request, body []byte) string {
+	h := sha256.New()
+	_, _ = io.WriteString(h, r.Method+" "+r.URL.Path+"?"+r.URL.RawQuery+"\n")
+	_, _ = h.Write(body)
+	return hex.EncodeToString(h.Sum(nil))
+}
+
+// responseRecorder passes a response through while keeping its status and body.
+type responseRecorder struct {
+	http.ResponseWriter
+	status      int
+	body        bytes.Buffer
+	wroteHeader bool
+}
+
+func (rec *responseRecorder) WriteHeader(status int) {
+	if !rec.wroteHeader {
+		rec.status, rec.wroteHeader = status, true
+	}
+	rec.ResponseWriter.WriteHeader(status)
+}
+
+func (rec *responseRecorder) Write(b []byte) (int, error) {
+	rec.wroteHeader = true
+	rec.body.Write(b)
+	return rec.ResponseWriter.Write(b)
+}
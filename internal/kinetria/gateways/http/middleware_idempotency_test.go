@@ -0,0 +1,240 @@
+package service
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/kinetria/kinetria-back/internal/kinetria/domain/ports"
+)
+
+func TestIdempotencyKeysMiddleware(t *testing.T) {
+	userID := uuid.New()
+
+	type fixture struct {
+		repo  *memoryIdempotencyRepo
+		keys  *IdempotencyKeys
+		calls *int
+		// during runs inside the handler, while the request holds its key
+		during *func()
+		serve  func(method, path, key, body string) *httptest.ResponseRecorder
+	}
+	newFixture := func(status int) fixture {
+		repo := &memoryIdempotencyRepo{records: map[string]ports.IdempotencyRecord{}}
+		keys := NewIdempotencyKeys(repo, time.Hour)
+		calls := 0
+		during := func() {}
+		handler := keys.Middleware(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			calls++
+			during()
+			writeSuccess(w, status, map[string]int{"call": calls})
+		}))
+		serve := func(method, path, key, body string) *httptest.ResponseRecorder {
+			req := httptest.NewRequest(method, path, strings.NewReader(body))
+			if key != "" {
+				req.Header.Set(idempotencyKeyHeader, key)
+			}
+			req = req.WithContext(context.WithValue(req.Context(), userIDKey, userID))
+			rec := httptest.NewRecorder()
+			handler.ServeHTTP(rec, req)
+			return rec
+		}
+		return fixture{repo: repo, keys: keys, calls: &calls, during: &during, serve: serve}
+	}
+
+	t.Run("replays_the_original_response", func(t *testing.T) {
+		f := newFixture(http.StatusCreated)
+		first := f.serve(http.MethodPost, "/workouts", "k1", `{"name":"A"}`)
+		second := f.serve(http.MethodPost, "/workouts", "k1", `{"name":"A"}`)
+		if *f.calls != 1 {
+			t.Fatalf("expected the handler to run once, ran %d times", *f.calls)
+		}
+		if second.Code != http.StatusCreated || second.Body.String() != first.Body.String() {
+			t.Errorf("expected the original response, got %d %s", second.Code, second.Body.String())
+		}
+		if second.Header().Get(idempotentReplayedHeader) != "true" || second.Header().Get("Content-Type") != "application/json" {
+			t.Errorf("unexpected replay headers: %v", second.Header())
+		}
+	})
+
+	t.Run("rejects_reused_key_with_different_payload", func(t *testing.T) {
+		f := newFixture(http.StatusCreated)
+		f.serve(http.MethodPost, "/workouts", "k1", `{"name":"A"}`)
+		for _, tc := range []struct{ method, path, body string }{
+			{http.MethodPost, "/workouts", `{"name":"B"}`},
+			{http.MethodPost, "/sessions", `{"name":"A"}`},
+			{http.MethodPut, "/workouts", `{"name":"A"}`},
+		} {
+			rec := f.serve(tc.method, tc.path, "k1", tc.body)
+			if rec.Code != http.StatusUnprocessableEntity || !strings.Contains(rec.Body.String(), "IDEMPOTENCY_KEY_REUSED") {
+				t.Errorf("%s %s: expected IDEMPOTENCY_KEY_REUSED, got %d %s", tc.method, tc.path, rec.Code, rec.Body.String())
+			}
+		}
+		if *f.calls != 1 {
+			t.Errorf("expected the handler to run once, ran %d times", *f.calls)
+		}
+	})
+
+	t.Run("concurrent_retry_while_running", func(t *testing.T) {
+		f := newFixture(http.StatusCreated)
+		f.repo.records[userID.String()+"/k1"] = ports.IdempotencyRecord{
+			UserID:      userID,
+			Key:         "k1",
+			Fingerprint: requestFingerprint(httptest.NewRequest(http.MethodPost, "/workouts", nil), []byte("{}")),
+			ClaimID:     uuid.New(),
+			CreatedAt:   time.Now(),
+			HeartbeatAt: time.Now(),
+			ExpiresAt:   time.Now().Add(time.Hour),
+		}
+		rec := f.serve(http.MethodPost, "/workouts", "k1", "{}")
+		if rec.Code != http.StatusConflict || *f.calls != 0 {
+			t.Errorf("expected 409 without running the handler, got %d", rec.Code)
+		}
+	})
+
+	t.Run("running_request_renews_its_claim", func(t *testing.T) {
+		f := newFixture(http.StatusCreated)
+		f.keys.heartbeat = time.Millisecond
+		*f.during = func() { time.Sleep(20 * time.Millisecond) }
+		f.serve(http.MethodPost, "/workouts", "k1", "{}")
+		if f.repo.renewals == 0 {
+			t.Error("expected the claim to be renewed while the handler ran")
+		}
+	})
+
+	t.Run("claim_is_taken_over_only_when_no_longer_renewed", func(t *testing.T) {
+		fingerprint := requestFingerprint(httptest.NewRequest(http.MethodPost, "/workouts", nil), []byte("{}"))
+		for _, tc := range []struct {
+			name        string
+			heartbeatAt time.Time
+			wantCode    int
+		}{
+			{"renewed", time.Now().Add(-idempotencyHeartbeat), http.StatusConflict},
+			{"stale", time.Now().Add(-idempotencyStaleAfter - time.Second), http.StatusCreated},
+		} {
+			f := newFixture(http.StatusCreated)
+			f.repo.records[userID.String()+"/k1"] = ports.IdempotencyRecord{
+				UserID:      userID,
+				Key:         "k1",
+				Fingerprint: fingerprint,
+				ClaimID:     uuid.New(),
+				CreatedAt:   time.Now().Add(-time.Hour),
+				HeartbeatAt: tc.heartbeatAt,
+				ExpiresAt:   time.Now().Add(time.Hour),
+			}
+			if rec := f.serve(http.MethodPost, "/workouts", "k1", "{}"); rec.Code != tc.wantCode {
+				t.Errorf("%s: expected %d, got %d", tc.name, tc.wantCode, rec.Code)
+			}
+		}
+	})
+
+	t.Run("failed_request_keeps_a_key_claimed_by_another", func(t *testing.T) {
+		f := newFixture(http.StatusInternalServerError)
+		other := uuid.New()
+		*f.during = func() {
+			record := f.repo.records[userID.String()+"/k1"]
+			record.ClaimID = other
+			f.repo.records[userID.String()+"/k1"] = record
+		}
+		f.serve(http.MethodPost, "/workouts", "k1", "{}")
+		if record, ok := f.repo.records[userID.String()+"/k1"]; !ok || record.ClaimID != other {
+			t.Errorf("expected the other request's claim to be kept, got %+v", record)
+		}
+	})
+
+	t.Run("server_errors_are_not_stored", func(t *testing.T) {
+		f := newFixture(http.StatusInternalServerError)
+		f.serve(http.MethodPost, "/workouts", "k1", "{}")
+		f.serve(http.MethodPost, "/workouts", "k1", "{}")
+		if *f.calls != 2 || len(f.repo.records) != 0 {
+			t.Errorf("expected the retry to run again, ran %d times with %d stored keys", *f.calls, len(f.repo.records))
+		}
+	})
+
+	t.Run("without_key_every_request_runs", func(t *testing.T) {
+		f := newFixture(http.StatusCreated)
+		f.serve(http.MethodPost, "/workouts", "", "{}")
+		f.serve(http.MethodPost, "/workouts", "", "{}")
+		if *f.calls != 2 || len(f.repo.records) != 0 {
+			t.Errorf("expected both requests to run, ran %d times", *f.calls)
+		}
+	})
+
+	t.Run("key_too_long", func(t *testing.T) {
+		f := newFixture(http.StatusCreated)
+		rec := f.serve(http.MethodPost, "/workouts", strings.Repeat("k", maxIdempotencyKeyLength+1), "{}")
+		if rec.Code != http.StatusUnprocessableEntity || *f.calls != 0 {
+			t.Errorf("expected 422, got %d", rec.Code)
+		}
+	})
+}
+
+// memoryIdempotencyRepo is an in-memory IdempotencyRepository.
+type memoryIdempotencyRepo struct {
+	mu       sync.Mutex
+	records  map[string]ports.IdempotencyRecord
+	renewals int
+}
+
+func (m *memoryIdempotencyRepo) Claim(_ context.Context, record *ports.IdempotencyRecord, staleBefore time.Time) (*ports.IdempotencyRecord, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	id := record.UserID.String() + "/" + record.Key
+	if stored, ok := m.records[id]; ok {
+		expired := !record.CreatedAt.Before(stored.ExpiresAt)
+		stale := stored.StatusCode == 0 && stored.HeartbeatAt.Before(staleBefore)
+		if !expired && !stale {
+			return &stored, nil
+		}
+	}
+	claimed := *record
+	claimed.HeartbeatAt = record.CreatedAt
+	m.records[id] = claimed
+	return nil, nil
+}
+
+func (m *memoryIdempotencyRepo) Renew(_ context.Context, userID uuid.UUID, key string, claimID uuid.UUID, at time.Time) (bool, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	id := userID.String() + "/" + key
+	record, ok := m.records[id]
+	if !ok || record.ClaimID != claimID || record.StatusCode != 0 {
+		return false, nil
+	}
+	record.HeartbeatAt = at
+	m.records[id] = record
+	m.renewals++
+	return true, nil
+}
+
+func (m *memoryIdempotencyRepo) Complete(_ context.Context, userID uuid.UUID, key string, claimID uuid.UUID, statusCode int, contentType string, body []byte) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	id := userID.String() + "/" + key
+	record, ok := m.records[id]
+	if !ok || record.ClaimID != claimID {
+		return nil
+	}
+	record.StatusCode, record.ContentType, record.Body = statusCode, contentType, body
+	m.records[id] = record
+	return nil
+}
+
+func (m *memoryIdempotencyRepo) Release(_ context.Context, userID uuid.UUID, key string, claimID uuid.UUID) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	id := userID.String() + "/" + key
+	if record, ok := m.records[id]; ok && record.ClaimID == claimID && record.StatusCode == 0 {
+		delete(m.records, id)
+	}
+	return nil
+}
+
+func (m *memoryIdempotencyRepo) PurgeExpired(_ context.Context, _ time.Time) (int64, error) {
+	return 0, nil
+}
@@ -8,14 +8,23 @@ import (
 
 // ServiceRouter mounts all API routes for the kinetria service.
 type ServiceRouter struct {
-	authHandler        *AuthHandler
-	sessionsHandler    *SessionsHandler
-	workoutsHandler    *WorkoutsHandler
-	dashboardHandler   *DashboardHandler
-	profileHandler     *ProfileHandler
-	exercisesHandler   *ExercisesHandler
-	statisticsHandler  *StatisticsHandler
-	jwtManager         *gatewayauth.JWTManager
+	authHandler         *AuthHandler
+	sessionsHandler     *SessionsHandler
+	workoutsHandler     *WorkoutsHandler
+	dashboardHandler    *DashboardHandler
+	profileHandler      *ProfileHandler
+	exercisesHandler    *ExercisesHandler
+	statisticsHandler   *StatisticsHandler
+	mediaHandler        *MediaHandler
+	libraryHandler      *ExerciseLibraryHandler
+	measurementsHandler *MeasurementsHandler
+	goalsHandler        *GoalsHandler
+	achievementsHandler *AchievementsHandler
+	streaksHandler      *StreaksHandler
+	readinessHandler    *ReadinessHandler
+	syncHandler         *SyncHandler
+	idempotencyKeys     *IdempotencyKeys
+	jwtManager          *gatewayauth.JWTManager
 }
 
 // NewServiceRouter creates a new ServiceRouter with the provided handlers.
@@ -27,17 +36,35 @@ func NewServiceRouter(
 	profileHandler *ProfileHandler,
 	exercisesHandler *ExercisesHandler,
 	statisticsHandler *StatisticsHandler,
+	mediaHandler *MediaHandler,
+	libraryHandler *ExerciseLibraryHandler,
+	measurementsHandler *MeasurementsHandler,
+	goalsHandler *GoalsHandler,
+	achievementsHandler *AchievementsHandler,
+	streaksHandler *StreaksHandler,
+	readinessHandler *ReadinessHandler,
+	syncHandler *SyncHandler,
+	idempotencyKeys *IdempotencyKeys,
 	jwtManager *gatewayauth.JWTManager,
 ) ServiceRouter {
 	return ServiceRouter{
-		authHandler:       authHandler,
-		sessionsHandler:   sessionsHandler,
-		workoutsHandler:   workoutsHandler,
-		dashboardHandler:  dashboardHandler,
-		profileHandler:    profileHandler,
-		exercisesHandler:  exercisesHandler,
-		statisticsHandler: statisticsHandler,
-		jwtManager:        jwtManager,
+		authHandler:         authHandler,
+		sessionsHandler:     sessionsHandler,
+		workoutsHandler:     workoutsHandler,
+		dashboardHandler:    dashboardHandler,
+		profileHandler:      profileHandler,
+		exercisesHandler:    exercisesHandler,
+		statisticsHandler:   statisticsHandler,
+		mediaHandler:        mediaHandler,
+		libraryHandler:      libraryHandler,
+		measurementsHandler: measurementsHandler,
+		goalsHandler:        goalsHandler,
+		achievementsHandler: achievementsHandler,
+		streaksHandler:      streaksHandler,
+		readinessHandler:    readinessHandler,
+		syncHandler:         syncHandler,
+		idempotencyKeys:     idempotencyKeys,
+		jwtManager:          jwtManager,
 	}
 }
 
@@ -58,34 +85,97 @@ func (s ServiceRouter) Router(router chi.Router) {
 		r.Post("/logout", s.authHandler.Logout)
 	})
 
-	// Protected routes
-	router.With(AuthMiddleware(s.jwtManager)).Post("/sessions", s.sessionsHandler.StartSession)
-	router.With(AuthMiddleware(s.jwtManager)).Post("/sessions/{sessionId}/sets", s.sessionsHandler.RecordSet)
-	router.With(AuthMiddleware(s.jwtManager)).Patch("/sessions/{sessionId}/finish", s.sessionsHandler.FinishSession)
-	router.With(AuthMiddleware(s.jwtManager)).Patch("/sessions/{sessionId}/abandon", s.sessionsHandler.AbandonSession)
+	// Protected routes. POST, PUT and PATCH honor the Idempotency-Key header (see IdempotencyKeys),
+	// except media uploads, whose multipart bodies are not buffered.
+	router.With(AuthMiddleware(s.jwtManager), s.idempotencyKeys.Middleware).Post("/sessions", s.sessionsHandler.StartSession)
+	router.With(AuthMiddleware(s.jwtManager), s.idempotencyKeys.Middleware).Post("/sessions/log", s.sessionsHandler.LogSession)
+	router.With(AuthMiddleware(s.jwtManager), s.idempotencyKeys.Middleware).Post("/sessions/{sessionId}/sets", s.sessionsHandler.RecordSet)
+	router.With(AuthMiddleware(s.jwtManager), s.idempotencyKeys.Middleware).Patch("/sessions/{sessionId}", s.sessionsHandler.UpdateSession)
+	router.With(AuthMiddleware(s.jwtManager), s.idempotencyKeys.Middleware).Patch("/sessions/{sessionId}/finish", s.sessionsHandler.FinishSession)
+	router.With(AuthMiddleware(s.jwtManager), s.idempotencyKeys.Middleware).Patch("/sessions/{sessionId}/abandon", s.sessionsHandler.AbandonSession)
+	router.With(AuthMiddleware(s.jwtManager)).Get("/sessions/{sessionId}/summary", s.sessionsHandler.GetSessionSummary)
+	router.With(AuthMiddleware(s.jwtManager)).Get("/sessions/{sessionId}/feedback", s.sessionsHandler.GetSessionFeedback)
+	router.With(AuthMiddleware(s.jwtManager), s.idempotencyKeys.Middleware).Put("/sessions/{sessionId}/feedback", s.sessionsHandler.UpdateSessionFeedback)
 
 	// Workouts (authenticated)
 	router.With(AuthMiddleware(s.jwtManager)).Get("/workouts", s.workoutsHandler.ListWorkouts)
 	router.With(AuthMiddleware(s.jwtManager)).Get("/workouts/{id}", s.workoutsHandler.GetWorkout)
-	router.With(AuthMiddleware(s.jwtManager)).Post("/workouts", s.workoutsHandler.CreateWorkout)
-	router.With(AuthMiddleware(s.jwtManager)).Put("/workouts/{id}", s.workoutsHandler.UpdateWorkout)
+	router.With(AuthMiddleware(s.jwtManager), s.idempotencyKeys.Middleware).Post("/workouts", s.workoutsHandler.CreateWorkout)
+	router.With(AuthMiddleware(s.jwtManager), s.idempotencyKeys.Middleware).Put("/workouts/{id}", s.workoutsHandler.UpdateWorkout)
 	router.With(AuthMiddleware(s.jwtManager)).Delete("/workouts/{id}", s.workoutsHandler.DeleteWorkout)
+	router.With(AuthMiddleware(s.jwtManager), s.idempotencyKeys.Middleware).Put("/workouts/{id}/favorite", s.workoutsHandler.FavoriteWorkout)
+	router.With(AuthMiddleware(s.jwtManager)).Delete("/workouts/{id}/favorite", s.workoutsHandler.UnfavoriteWorkout)
 
 	// Dashboard (authenticated)
 	router.With(AuthMiddleware(s.jwtManager)).Get("/dashboard", s.dashboardHandler.GetDashboard)
 
 	// Profile (authenticated)
 	router.With(AuthMiddleware(s.jwtManager)).Get("/profile", s.profileHandler.HandleGetProfile)
-	router.With(AuthMiddleware(s.jwtManager)).Patch("/profile", s.profileHandler.HandleUpdateProfile)
+	router.With(AuthMiddleware(s.jwtManager), s.idempotencyKeys.Middleware).Patch("/profile", s.profileHandler.HandleUpdateProfile)
 
-	// Exercise library (public with optional auth, except /history which requires auth)
+	// Exercise library (public with optional auth, except /recent, /history and /favorite which require auth)
 	router.Get("/exercises", s.exercisesHandler.HandleListExercises)
 	router.Get("/exercises/{id}", s.exercisesHandler.HandleGetExercise)
+	router.With(AuthMiddleware(s.jwtManager)).Get("/exercises/recent", s.exercisesHandler.HandleListRecentExercises)
 	router.With(AuthMiddleware(s.jwtManager)).Get("/exercises/{id}/history", s.exercisesHandler.HandleGetExerciseHistory)
+	router.With(AuthMiddleware(s.jwtManager), s.idempotencyKeys.Middleware).Put("/exercises/{id}/favorite", s.exercisesHandler.HandleFavoriteExercise)
+	router.With(AuthMiddleware(s.jwtManager)).Delete("/exercises/{id}/favorite", s.exercisesHandler.HandleUnfavoriteExercise)
 
 	// Statistics (authenticated)
 	router.With(AuthMiddleware(s.jwtManager)).Get("/stats/overview", s.statisticsHandler.HandleGetOverview)
 	router.With(AuthMiddleware(s.jwtManager)).Get("/stats/progression", s.statisticsHandler.HandleGetProgression)
 	router.With(AuthMiddleware(s.jwtManager)).Get("/stats/personal-records", s.statisticsHandler.HandleGetPersonalRecords)
 	router.With(AuthMiddleware(s.jwtManager)).Get("/stats/frequency", s.statisticsHandler.HandleGetFrequency)
+	router.With(AuthMiddleware(s.jwtManager)).Get("/stats/muscle-volume", s.statisticsHandler.HandleGetMuscleVolume)
+	router.With(AuthMiddleware(s.jwtManager)).Get("/stats/relative-strength", s.statisticsHandler.HandleGetRelativeStrength)
+	router.With(AuthMiddleware(s.jwtManager)).Get("/stats/load", s.statisticsHandler.HandleGetTrainingLoad)
+	router.With(AuthMiddleware(s.jwtManager)).Get("/stats/insights", s.statisticsHandler.HandleGetProgressInsights)
+	router.With(AuthMiddleware(s.jwtManager)).Get("/stats/heatmap", s.statisticsHandler.HandleGetHeatmap)
+	router.With(AuthMiddleware(s.jwtManager)).Get("/stats/recap", s.statisticsHandler.HandleGetRecap)
+	router.With(AuthMiddleware(s.jwtManager)).Get("/stats/wellness", s.statisticsHandler.HandleGetWellness)
+
+	// Body measurements and goal weight (authenticated)
+	router.With(AuthMiddleware(s.jwtManager)).Get("/measurements", s.measurementsHandler.HandleListMeasurements)
+	router.With(AuthMiddleware(s.jwtManager), s.idempotencyKeys.Middleware).Post("/measurements", s.measurementsHandler.HandleCreateMeasurement)
+	router.With(AuthMiddleware(s.jwtManager)).Get("/measurements/trend", s.measurementsHandler.HandleGetMeasurementTrend)
+	router.With(AuthMiddleware(s.jwtManager)).Get("/measurements/goal", s.measurementsHandler.HandleGetGoalWeight)
+	router.With(AuthMiddleware(s.jwtManager), s.idempotencyKeys.Middleware).Put("/measurements/goal", s.measurementsHandler.HandleSetGoalWeight)
+	router.With(AuthMiddleware(s.jwtManager)).Delete("/measurements/goal", s.measurementsHandler.HandleDeleteGoalWeight)
+	router.With(AuthMiddleware(s.jwtManager)).Get("/measurements/{id}", s.measurementsHandler.HandleGetMeasurement)
+	router.With(AuthMiddleware(s.jwtManager), s.idempotencyKeys.Middleware).Patch("/measurements/{id}", s.measurementsHandler.HandleUpdateMeasurement)
+	router.With(AuthMiddleware(s.jwtManager)).Delete("/measurements/{id}", s.measurementsHandler.HandleDeleteMeasurement)
+
+	// Training goals (authenticated)
+	router.With(AuthMiddleware(s.jwtManager)).Get("/goals", s.goalsHandler.HandleListGoals)
+	router.With(AuthMiddleware(s.jwtManager), s.idempotencyKeys.Middleware).Post("/goals", s.goalsHandler.HandleCreateGoal)
+	router.With(AuthMiddleware(s.jwtManager)).Get("/goals/{id}", s.goalsHandler.HandleGetGoal)
+	router.With(AuthMiddleware(s.jwtManager), s.idempotencyKeys.Middleware).Patch("/goals/{id}", s.goalsHandler.HandleUpdateGoal)
+	router.With(AuthMiddleware(s.jwtManager)).Delete("/goals/{id}", s.goalsHandler.HandleDeleteGoal)
+
+	// Achievements (authenticated)
+	router.With(AuthMiddleware(s.jwtManager)).Get("/achievements", s.achievementsHandler.HandleListAchievements)
+
+	// Streak and planned rest days (authenticated)
+	router.With(AuthMiddleware(s.jwtManager)).Get("/streak", s.streaksHandler.HandleGetStreak)
+	router.With(AuthMiddleware(s.jwtManager)).Get("/rest-days", s.streaksHandler.HandleListRestDays)
+	router.With(AuthMiddleware(s.jwtManager), s.idempotencyKeys.Middleware).Put("/rest-days/{date}", s.streaksHandler.HandlePlanRestDay)
+	router.With(AuthMiddleware(s.jwtManager)).Delete("/rest-days/{date}", s.streaksHandler.HandleDeleteRestDay)
+
+	// Readiness check-ins (authenticated)
+	router.With(AuthMiddleware(s.jwtManager)).Get("/readiness/check-ins", s.readinessHandler.HandleListCheckIns)
+	router.With(AuthMiddleware(s.jwtManager), s.idempotencyKeys.Middleware).Post("/readiness/check-ins", s.readinessHandler.HandleCreateCheckIn)
+
+	// Offline sync of the mobile app (authenticated)
+	router.With(AuthMiddleware(s.jwtManager), s.idempotencyKeys.Middleware).Post("/sync", s.syncHandler.HandleSync)
+
+	// Media uploads (authenticated; library exercises also require the admin API key) and stored files (public)
+	router.With(AuthMiddleware(s.jwtManager)).Post("/profile/image", s.mediaHandler.HandleUploadProfileImage)
+	router.With(AuthMiddleware(s.jwtManager)).Post("/workouts/{id}/image", s.mediaHandler.HandleUploadWorkoutImage)
+	router.With(AuthMiddleware(s.jwtManager), AdminKeyMiddleware(s.libraryHandler.adminAPIKey)).Post("/exercises/{id}/thumbnail", s.mediaHandler.HandleUploadExerciseThumbnail)
+	router.With(AuthMiddleware(s.jwtManager), AdminKeyMiddleware(s.libraryHandler.adminAPIKey)).Post("/exercises/{id}/video", s.mediaHandler.HandleUploadExerciseVideo)
+	router.Get("/media/*", s.mediaHandler.HandleServeMedia)
+
+	// Exercise library bulk import/export (admin API key)
+	router.With(AdminKeyMiddleware(s.libraryHandler.adminAPIKey)).Post("/admin/exercises/import", s.libraryHandler.HandleImportExercises)
+	router.With(AdminKeyMiddleware(s.libraryHandler.adminAPIKey)).Get("/admin/exercises/export", s.libraryHandler.HandleExportExercises)
 }
@@ -83,12 +83,30 @@ type WeekStats struct {
 	TotalTimeMinutes int `json:"totalTimeMinutes" example:"60"`
 }
 
+// DashboardInsight represents a plateau or regression shown on the dashboard
+type DashboardInsight struct {
+	ExerciseID   string  `json:"exerciseId" example:"a1b2c3d4-e5f6-7890-abcd-ef1234567890"`
+	ExerciseName string  `json:"exerciseName" example:"Supino Reto"`
+	Kind         string  `json:"kind" example:"plateau" enums:"plateau,regression"`
+	Change       float64 `json:"change" example:"0.4"` // variação percentual da e1RM (ou do volume)
+	Suggestion   InsightSuggestion `json:"suggestion"`
+}
+
+// InsightSuggestion represents the suggested next step for an insight
+type InsightSuggestion struct {
+	Kind    string `json:"kind" example:"rep_range_change" enums:"deload,rep_range_change,exercise_swap"`
+	Message string `json:"message" example:"Supino Reto has stalled around 5 reps. Train it in the 6–10 rep range for a few weeks."`
+}
+
 // DashboardResponse represents the complete dashboard data
 type DashboardResponse struct {
 	User         DashboardUser  `json:"user"`
 	TodayWorkout *TodayWorkout  `json:"todayWorkout"`
 	WeekProgress []DayProgress  `json:"weekProgress"`
 	Stats        WeekStats      `json:"stats"`
+	Insights     []DashboardInsight `json:"insights"`
+	// Streak in the user's streak mode
+	Streak StreakDTO `json:"streak"`
 }
 
 // Workout represents a workout plan
@@ -117,6 +135,10 @@ type PaginationMeta struct {
 type StartSessionRequest struct {
 	WorkoutID string `json:"workoutId" validate:"required" example:"a1b2c3d4-e5f6-7890-abcd-ef1234567890"`
 	Notes     string `json:"notes" example:"Treino de peito e tríceps"`
+	// Readiness is an optional pre-workout check-in recorded with the session
+	Readiness *ReadinessCheckInRequest `json:"readiness,omitempty"`
+	// Adjust optionally scales the day's prescription down when readiness is low: "load" or "volume"
+	Adjust *string `json:"adjust,omitempty" example:"load" enums:"load,volume"`
 }
 
 // StartSessionResponse represents the response after starting a session
@@ -125,6 +147,8 @@ type StartSessionResponse struct {
 	WorkoutID string `json:"workoutId" example:"a1b2c3d4-e5f6-7890-abcd-ef1234567890"`
 	Status    string `json:"status" example:"active"`
 	StartedAt string `json:"startedAt" example:"2026-02-25T15:30:00Z"`
+	// Readiness is the check-in the session started with and the day's targets; null without a check-in
+	Readiness *SessionReadinessDTO `json:"readiness"`
 }
 
 // RecordSetRequest represents the request to record a set
@@ -132,6 +156,7 @@ type RecordSetRequest struct {
 	ExerciseID string  `json:"exerciseId" validate:"required" example:"e1f2g3h4-i5j6-7890-abcd-ef1234567890"`
 	SetNumber  int     `json:"setNumber" validate:"required,min=1" example:"1"`
 	Reps       int     `json:"reps" validate:"required,min=0" example:"12"`
+	// Weight is in the user's unit preference: kg (metric) or lb (imperial)
 	Weight     float64 `json:"weight" validate:"min=0" example:"80.5"`
 	Status     string  `json:"status" validate:"required,oneof=completed skipped" example:"completed"`
 }
@@ -143,12 +168,48 @@ type RecordSetResponse struct {
 	SetNumber   int     `json:"setNumber" example:"1"`
 	Reps        int     `json:"reps" example:"12"`
 	Weight      float64 `json:"weight" example:"80.5"`
+	WeightUnit  string  `json:"weightUnit" example:"kg" enums:"kg,lb"`
 	Status      string  `json:"status" example:"completed"`
+	// NewAchievements are the codes of the achievements awarded by this set
+	NewAchievements []string `json:"newAchievements" example:"sets_1000"`
 }
 
 // FinishSessionRequest represents the request to finish a session
 type FinishSessionRequest struct {
 	Notes string `json:"notes" example:"Treino completo! Ótima performance."`
+	// RPE is the optional session rating of perceived exertion (1-10), used by the training load statistics
+	RPE *int `json:"rpe,omitempty" example:"7" minimum:"1" maximum:"10"`
+	// Mood is the optional mood rating after the session (1-5)
+	Mood *int `json:"mood,omitempty" example:"4" minimum:"1" maximum:"5"`
+	// Energy is the optional energy rating (1-5)
+	Energy *int `json:"energy,omitempty" example:"3" minimum:"1" maximum:"5"`
+	// SleepQuality is the optional rating of the previous night's sleep (1-5)
+	SleepQuality *int `json:"sleepQuality,omitempty" example:"4" minimum:"1" maximum:"5"`
+	// Soreness optionally rates sore muscle groups (1-5), keyed by muscle group
+	Soreness map[string]int `json:"soreness,omitempty"`
+}
+
+// LogSessionRequest represents the request to log a past session with its sets
+type LogSessionRequest struct {
+	WorkoutID  string `json:"workoutId" validate:"required" example:"a1b2c3d4-e5f6-7890-abcd-ef1234567890"`
+	StartedAt  string `json:"startedAt" validate:"required" example:"2026-02-24T18:00:00-03:00"`
+	FinishedAt string `json:"finishedAt" validate:"required" example:"2026-02-24T19:10:00-03:00"`
+	Notes      string `json:"notes" example:"Registrado depois do treino"`
+	// Sets are the sets done in the session, as in RecordSetRequest
+	Sets []RecordSetRequest `json:"sets"`
+	// RPE, Mood, Energy, SleepQuality and Soreness are the optional feedback, as in FinishSessionRequest
+	RPE          *int           `json:"rpe,omitempty" example:"7" minimum:"1" maximum:"10"`
+	Mood         *int           `json:"mood,omitempty" example:"4" minimum:"1" maximum:"5"`
+	Energy       *int           `json:"energy,omitempty" example:"3" minimum:"1" maximum:"5"`
+	SleepQuality *int           `json:"sleepQuality,omitempty" example:"4" minimum:"1" maximum:"5"`
+	Soreness     map[string]int `json:"soreness,omitempty"`
+}
+
+// UpdateSessionRequest represents the corrections to a completed session; omitted fields are kept
+type UpdateSessionRequest struct {
+	StartedAt  *string `json:"startedAt,omitempty" example:"2026-02-24T18:00:00-03:00"`
+	FinishedAt *string `json:"finishedAt,omitempty" example:"2026-02-24T19:10:00-03:00"`
+	Notes      *string `json:"notes,omitempty" example:"Finalizei no app depois"`
 }
 
 // AbandonSessionRequest represents the request to abandon a session
@@ -161,6 +222,10 @@ type SessionStatusResponse struct {
 	SessionID  string `json:"sessionId" example:"f1e2d3c4-b5a6-7890-1234-567890abcdef"`
 	Status     string `json:"status" example:"completed"`
 	FinishedAt string `json:"finishedAt" example:"2026-02-25T16:15:00Z"`
+	// NewAchievements are the codes of the achievements awarded on finish
+	NewAchievements []string `json:"newAchievements" example:"first_workout"`
+	// Summary is the recap of the finished or logged session; null if it could not be built
+	Summary *SessionSummaryDTO `json:"summary,omitempty"`
 }
 
 // UserPreferencesSwagger represents user preferences in profile request/response
@@ -169,6 +234,18 @@ type UserPreferencesSwagger struct {
 	Theme string `json:"theme" example:"dark" enums:"dark,light"`
 	// Language is the display language; valid values: "pt-BR", "en-US"
 	Language string `json:"language" example:"pt-BR" enums:"pt-BR,en-US"`
+	// Timezone is the IANA timezone used to bucket statistics by day and week
+	Timezone string `json:"timezone" example:"America/Sao_Paulo"`
+	// WeekStart is the first day of the week; valid values: "monday", "sunday"
+	WeekStart string `json:"weekStart" example:"monday" enums:"monday,sunday"`
+	// Units selects the unit weights are sent and returned in; valid values: "metric" (kg), "imperial" (lb)
+	Units string `json:"units" example:"metric" enums:"metric,imperial"`
+	// Sex is optional and only used by the relative strength scores; valid values: "male", "female"
+	Sex string `json:"sex,omitempty" example:"male" enums:"male,female"`
+	// StreakMode counts streaks in consecutive days with a session ("daily") or consecutive weeks reaching StreakWeeklyTarget sessions ("weekly")
+	StreakMode string `json:"streakMode,omitempty" example:"weekly" enums:"daily,weekly"`
+	// StreakWeeklyTarget is the number of sessions per week required by the weekly streak mode (1-7)
+	StreakWeeklyTarget int `json:"streakWeeklyTarget,omitempty" example:"3" minimum:"1" maximum:"7"`
 }
 
 // ProfileResponse represents the user profile in API responses
@@ -0,0 +1,46 @@
+package service
+
+import (
+	"context"
+	"errors"
+
+	"github.com/google/uuid"
+	domainerrors "github.com/kinetria/kinetria-back/internal/kinetria/domain/errors"
+	"github.com/kinetria/kinetria-back/internal/kinetria/domain/profile"
+	"github.com/kinetria/kinetria-back/internal/kinetria/domain/vos"
+)
+
+// unitSystemFor returns the unit system the user sends and receives weights in.
+// Weights are stored as grams; handlers convert with the returned system at the boundary.
+// Unknown users get the metric default.
+func unitSystemFor(ctx context.Context, getProfileUC *profile.GetProfileUC, userID uuid.UUID) (vos.UnitSystem, error) {
+	out, err := getProfileUC.Execute(ctx, profile.GetProfileInput{UserID: userID})
+	if err != nil {
+		if errors.Is(err, domainerrors.ErrNotFound) {
+			return vos.UnitSystemMetric, nil
+		}
+		return "", err
+	}
+	if out.User.Preferences.Units == "" {
+		return vos.UnitSystemMetric, nil
+	}
+	return out.User.Preferences.Units, nil
+}
+
+// weightPtrFromGrams converts an optional gram value to the user's unit.
+func weightPtrFromGrams(units vos.UnitSystem, grams *int) *float64 {
+	if grams == nil {
+		return nil
+	}
+	w := units.FromGrams(int64(*grams))
+	return &w
+}
+
+// weightPtrToGrams converts an optional weight in the user's unit to grams.
+func weightPtrToGrams(units vos.UnitSystem, weight *float64) *int {
+	if weight == nil {
+		return nil
+	}
+	g := int(units.ToGrams(*weight))
+	return &g
+}
@@ -0,0 +1,61 @@
+package jobs
+
+import (
+	"context"
+	"log"
+	"time"
+
+	"go.uber.org/fx"
+
+	"github.com/kinetria/kinetria-back/internal/kinetria/domain/ports"
+)
+
+// idempotencyPurgeInterval is how often expired idempotency keys are deleted. Expired keys
+// are already ignored on lookup; purging only keeps the table small.
+const idempotencyPurgeInterval = time.Hour
+
+// StartIdempotencyKeyPurger deletes expired idempotency keys on startup and then every hour.
+func StartIdempotencyKeyPurger(lc fx.Lifecycle, repo ports.IdempotencyRepository) {
+	ctx, cancel := context.WithCancel(context.Background())
+	done := make(chan struct{})
+
+	lc.Append(fx.Hook{
+		OnStart: func(context.Context) error {
+			go func() {
+				defer close(done)
+				ticker := time.NewTicker(idempotencyPurgeInterval)
+				defer ticker.Stop()
+				for {
+					purgeIdempotencyKeys(ctx, repo)
+					select {
+					case <-ctx.Done():
+						return
+					case <-ticker.C:
+					}
+				}
+			}()
+			return nil
+		},
+		OnStop: func(stopCtx context.Context) error {
+			cancel()
+			select {
+			case <-done:
+			case <-stopCtx.Done():
+			}
+			return nil
+		},
+	})
+}
+
+func purgeIdempotencyKeys(ctx context.Context, repo ports.IdempotencyRepository) {
+	deleted, err := repo.PurgeExpired(ctx, time.Now())
+	if err != nil {
+		if ctx.Err() == nil {
+			log.Printf("idempotency key purge failed: %v", err)
+		}
+		return
+	}
+	if deleted > 0 {
+		log.Printf("purged %d expired idempotency keys", deleted)
+	}
+}
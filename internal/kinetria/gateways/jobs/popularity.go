@@ -0,0 +1,62 @@
+package jobs
+
+import (
+	"context"
+	"log"
+	"time"
+
+	"go.uber.org/fx"
+
+	domainexercises "github.com/kinetria/kinetria-back/internal/kinetria/domain/exercises"
+	"github.com/kinetria/kinetria-back/internal/kinetria/gateways/config"
+)
+
+// StartExercisePopularityRefresher recomputes the global exercise popularity score on startup
+// and then every EXERCISE_POPULARITY_REFRESH_INTERVAL. A zero interval disables the job.
+func StartExercisePopularityRefresher(lc fx.Lifecycle, cfg config.Config, uc *domainexercises.RefreshExercisePopularityUC) {
+	interval := cfg.ExercisePopularityRefreshInterval
+	if interval <= 0 {
+		return
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	done := make(chan struct{})
+
+	lc.Append(fx.Hook{
+		OnStart: func(context.Context) error {
+			go func() {
+				defer close(done)
+				ticker := time.NewTicker(interval)
+				defer ticker.Stop()
+				for {
+					refreshExercisePopularity(ctx, uc)
+					select {
+					case <-ctx.Done():
+						return
+					case <-ticker.C:
+					}
+				}
+			}()
+			return nil
+		},
+		OnStop: func(stopCtx context.Context) error {
+			cancel()
+			select {
+			case <-done:
+			case <-stopCtx.Done():
+			}
+			return nil
+		},
+	})
+}
+
+func refreshExercisePopularity(ctx context.Context, uc *domainexercises.RefreshExercisePopularityUC) {
+	updated, err := uc.Execute(ctx)
+	if err != nil {
+		if ctx.Err() == nil {
+			log.Printf("exercise popularity refresh failed: %v", err)
+		}
+		return
+	}
+	log.Printf("exercise popularity refreshed for %d exercises", updated)
+}
@@ -0,0 +1,53 @@
+-- Migration 015: Canonical muscle-group taxonomy with weighted involvement per exercise
+
+CREATE TABLE IF NOT EXISTS exercise_muscles (
+    exercise_id  UUID NOT NULL REFERENCES exercises(id) ON DELETE CASCADE,
+    muscle_group VARCHAR(30) NOT NULL CHECK (muscle_group IN (
+        'chest', 'back', 'lower_back', 'traps', 'shoulders', 'biceps', 'triceps',
+        'forearms', 'quadriceps', 'hamstrings', 'glutes', 'calves', 'core', 'obliques'
+    )),
+    role         VARCHAR(20) NOT NULL CHECK (role IN ('primary', 'secondary')),
+    involvement  NUMERIC(3,2) NOT NULL CHECK (involvement > 0 AND involvement <= 1),
+    PRIMARY KEY (exercise_id, muscle_group)
+);
+
+CREATE INDEX IF NOT EXISTS idx_exercise_muscles_muscle_group ON exercise_muscles(muscle_group);
+
+-- Backfill from the legacy free-text muscles array.
+-- The first listed muscle is the primary mover (full set); the others are secondary (half set).
+WITH legacy AS (
+    SELECT e.id AS exercise_id, m.name, m.ord
+    FROM exercises e
+    CROSS JOIN LATERAL jsonb_array_elements_text(e.muscles) WITH ORDINALITY AS m(name, ord)
+),
+mapped AS (
+    SELECT l.exercise_id, t.muscle_group, l.ord
+    FROM legacy l
+    JOIN (VALUES
+        ('Peito', 'chest'),
+        ('Costas', 'back'),
+        ('Lombar', 'lower_back'),
+        ('Trapézio', 'traps'),
+        ('Ombros', 'shoulders'),
+        ('Bíceps', 'biceps'),
+        ('Tríceps', 'triceps'),
+        ('Antebraço', 'forearms'),
+        ('Quadríceps', 'quadriceps'),
+        ('Pernas', 'quadriceps'),
+        ('Isquiotibiais', 'hamstrings'),
+        ('Glúteos', 'glutes'),
+        ('Panturrilha', 'calves'),
+        ('Core', 'core'),
+        ('Oblíquos', 'obliques')
+    ) AS t(legacy_name, muscle_group)
+        ON t.legacy_name = l.name OR t.muscle_group = LOWER(l.name)
+)
+INSERT INTO exercise_muscles (exercise_id, muscle_group, role, involvement)
+SELECT DISTINCT ON (exercise_id, muscle_group)
+    exercise_id,
+    muscle_group,
+    CASE WHEN ord = 1 THEN 'primary' ELSE 'secondary' END,
+    CASE WHEN ord = 1 THEN 1.00 ELSE 0.50 END
+FROM mapped
+ORDER BY exercise_id, muscle_group, ord
+ON CONFLICT (exercise_id, muscle_group) DO NOTHING;
@@ -0,0 +1,18 @@
+-- Migration 016: Uploaded media files (images and videos) attached to exercises, workouts and users
+
+CREATE TABLE IF NOT EXISTS media_assets (
+    id            UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    uploaded_by   UUID NOT NULL REFERENCES users(id) ON DELETE CASCADE,
+    owner_type    VARCHAR(20) NOT NULL CHECK (owner_type IN ('exercise', 'workout', 'user')),
+    owner_id      UUID NOT NULL,
+    kind          VARCHAR(10) NOT NULL CHECK (kind IN ('image', 'video')),
+    content_type  VARCHAR(100) NOT NULL,
+    size_bytes    BIGINT NOT NULL CHECK (size_bytes > 0),
+    storage_key   TEXT NOT NULL UNIQUE,
+    url           TEXT NOT NULL,
+    thumbnail_key TEXT,
+    thumbnail_url TEXT,
+    created_at    TIMESTAMPTZ NOT NULL DEFAULT NOW()
+);
+
+CREATE INDEX IF NOT EXISTS idx_media_assets_owner ON media_assets(owner_type, owner_id, created_at DESC);
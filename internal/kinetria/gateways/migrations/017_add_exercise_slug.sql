@@ -0,0 +1,45 @@
+-- Migration 017: Stable slug for library exercises (used by bulk import/export)
+
+CREATE OR REPLACE FUNCTION exercise_slugify(name TEXT) RETURNS TEXT AS $$
+    SELECT COALESCE(NULLIF(TRIM(BOTH '-' FROM regexp_replace(
+        translate(lower(name), 'áàâãäéèêëíìîïóòôõöúùûüçñ', 'aaaaaeeeeiiiiooooouuuucn'),
+        '[^a-z0-9]+', '-', 'g'
+    )), ''), 'exercise');
+$$ LANGUAGE SQL IMMUTABLE;
+
+ALTER TABLE exercises ADD COLUMN IF NOT EXISTS slug VARCHAR(255);
+
+-- Backfill: duplicated names get a numeric suffix in creation order
+WITH numbered AS (
+    SELECT
+        id,
+        exercise_slugify(name) AS base_slug,
+        ROW_NUMBER() OVER (PARTITION BY exercise_slugify(name) ORDER BY created_at, id) AS n
+    FROM exercises
+    WHERE slug IS NULL
+)
+UPDATE exercises e
+SET slug = CASE WHEN numbered.n = 1 THEN numbered.base_slug ELSE numbered.base_slug || '-' || numbered.n END
+FROM numbered
+WHERE e.id = numbered.id;
+
+ALTER TABLE exercises ALTER COLUMN slug SET NOT NULL;
+CREATE UNIQUE INDEX IF NOT EXISTS idx_exercises_slug ON exercises(slug);
+
+-- Rows inserted without a slug derive it from the name (suffixed with the id on collision)
+CREATE OR REPLACE FUNCTION exercises_fill_slug() RETURNS TRIGGER AS $$
+BEGIN
+    IF NEW.slug IS NULL OR NEW.slug = '' THEN
+        NEW.slug := exercise_slugify(NEW.name);
+        IF EXISTS (SELECT 1 FROM exercises WHERE slug = NEW.slug AND id <> NEW.id) THEN
+            NEW.slug := NEW.slug || '-' || left(NEW.id::text, 8);
+        END IF;
+    END IF;
+    RETURN NEW;
+END;
+$$ LANGUAGE plpgsql;
+
+DROP TRIGGER IF EXISTS trg_exercises_fill_slug ON exercises;
+CREATE TRIGGER trg_exercises_fill_slug
+    BEFORE INSERT ON exercises
+    FOR EACH ROW EXECUTE FUNCTION exercises_fill_slug();
@@ -0,0 +1,15 @@
+-- Migration 018: Per-user favorite exercises and workouts
+
+CREATE TABLE IF NOT EXISTS favorite_exercises (
+    user_id     UUID NOT NULL REFERENCES users(id) ON DELETE CASCADE,
+    exercise_id UUID NOT NULL REFERENCES exercises(id) ON DELETE CASCADE,
+    created_at  TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    PRIMARY KEY (user_id, exercise_id)
+);
+
+CREATE TABLE IF NOT EXISTS favorite_workouts (
+    user_id    UUID NOT NULL REFERENCES users(id) ON DELETE CASCADE,
+    workout_id UUID NOT NULL REFERENCES workouts(id) ON DELETE CASCADE,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    PRIMARY KEY (user_id, workout_id)
+);
@@ -0,0 +1,10 @@
+-- Migration 019: Global exercise popularity score
+-- popularity_score is recomputed in batch (see RefreshExercisePopularity) and only read
+-- by the library listing, so sorting by popularity costs no more than sorting by name.
+ALTER TABLE exercises ADD COLUMN IF NOT EXISTS popularity_score DOUBLE PRECISION NOT NULL DEFAULT 0;
+ALTER TABLE exercises ADD COLUMN IF NOT EXISTS popularity_updated_at TIMESTAMPTZ;
+
+CREATE INDEX IF NOT EXISTS idx_exercises_popularity ON exercises(popularity_score DESC, name);
+
+-- Supports the per-user "recently performed" lookup (sessions -> set_records -> workout_exercises)
+CREATE INDEX IF NOT EXISTS idx_set_records_workout_exercise_id ON set_records(workout_exercise_id);
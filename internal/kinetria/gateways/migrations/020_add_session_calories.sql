@@ -0,0 +1,24 @@
+-- Migration 020: Per-session calorie estimate
+-- calories_kcal is computed once when the session finishes (MET x body weight x active time)
+-- and read back by the dashboard and statistics. met_value optionally overrides the
+-- workout-type MET for a single exercise.
+ALTER TABLE sessions ADD COLUMN IF NOT EXISTS calories_kcal INT CHECK (calories_kcal >= 0);
+ALTER TABLE exercises ADD COLUMN IF NOT EXISTS met_value DOUBLE PRECISION CHECK (met_value > 0);
+
+-- Backfill sessions finished before the estimate existed: workout-type MET, 70 kg, full duration.
+UPDATE sessions s
+SET calories_kcal = ROUND(
+    (CASE w.type
+        WHEN 'FORÇA' THEN 6.0
+        WHEN 'HIPERTROFIA' THEN 5.0
+        WHEN 'MOBILIDADE' THEN 2.5
+        WHEN 'CONDICIONAMENTO' THEN 8.0
+        ELSE 5.0
+    END) * 70 * EXTRACT(EPOCH FROM (s.finished_at - s.started_at)) / 3600
+)::int
+FROM workouts w
+WHERE w.id = s.workout_id
+  AND s.status = 'completed'
+  AND s.finished_at IS NOT NULL
+  AND s.finished_at >= s.started_at
+  AND s.calories_kcal IS NULL;
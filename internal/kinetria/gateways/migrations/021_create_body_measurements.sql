@@ -0,0 +1,36 @@
+-- Migration 021: Body measurement log and goal weight
+-- Weights are stored in grams and circumferences in millimeters; every value is optional
+-- but an entry records at least one (enforced by the use cases).
+
+CREATE TABLE IF NOT EXISTS body_measurements (
+    id           UUID PRIMARY KEY,
+    user_id      UUID NOT NULL REFERENCES users(id) ON DELETE CASCADE,
+    measured_at  TIMESTAMPTZ NOT NULL,
+    weight_grams INT CHECK (weight_grams > 0),
+    body_fat_pct DOUBLE PRECISION CHECK (body_fat_pct > 0 AND body_fat_pct < 100),
+    neck_mm      INT CHECK (neck_mm > 0),
+    chest_mm     INT CHECK (chest_mm > 0),
+    waist_mm     INT CHECK (waist_mm > 0),
+    hips_mm      INT CHECK (hips_mm > 0),
+    arm_mm       INT CHECK (arm_mm > 0),
+    thigh_mm     INT CHECK (thigh_mm > 0),
+    calf_mm      INT CHECK (calf_mm > 0),
+    notes        TEXT NOT NULL DEFAULT '',
+    created_at   TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    updated_at   TIMESTAMPTZ NOT NULL DEFAULT NOW()
+);
+
+CREATE INDEX IF NOT EXISTS idx_body_measurements_user_measured ON body_measurements(user_id, measured_at DESC);
+
+-- Serves GetLatestBodyWeight without scanning entries that only record circumferences
+CREATE INDEX IF NOT EXISTS idx_body_measurements_user_weight ON body_measurements(user_id, measured_at DESC)
+    WHERE weight_grams IS NOT NULL;
+
+CREATE TABLE IF NOT EXISTS body_weight_goals (
+    user_id      UUID PRIMARY KEY REFERENCES users(id) ON DELETE CASCADE,
+    target_grams INT NOT NULL CHECK (target_grams > 0),
+    start_grams  INT CHECK (start_grams > 0),
+    target_date  DATE,
+    created_at   TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    updated_at   TIMESTAMPTZ NOT NULL DEFAULT NOW()
+);
@@ -0,0 +1,11 @@
+-- Migration 022: Free-form library tags on exercises
+-- The "squat", "bench" and "deadlift" tags map exercises to the powerlifting big lifts
+-- used by the relative strength statistics (DOTS, Wilks, IPF GL).
+
+ALTER TABLE exercises ADD COLUMN IF NOT EXISTS tags JSONB NOT NULL DEFAULT '[]'::jsonb;
+
+CREATE INDEX IF NOT EXISTS idx_exercises_tags ON exercises USING gin(tags);
+
+UPDATE exercises SET tags = '["squat"]'::jsonb    WHERE slug = 'agachamento-com-barra' AND tags = '[]'::jsonb;
+UPDATE exercises SET tags = '["bench"]'::jsonb    WHERE slug = 'supino-reto-com-barra' AND tags = '[]'::jsonb;
+UPDATE exercises SET tags = '["deadlift"]'::jsonb WHERE slug = 'levantamento-terra' AND tags = '[]'::jsonb;
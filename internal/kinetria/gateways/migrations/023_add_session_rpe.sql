@@ -0,0 +1,5 @@
+-- Migration 023: Session RPE
+-- session_rpe is the lifter's rating of perceived exertion for the whole session (1-10),
+-- optionally given when the session finishes. Duration x session RPE is the session load
+-- used by the training load statistics.
+ALTER TABLE sessions ADD COLUMN IF NOT EXISTS session_rpe SMALLINT CHECK (session_rpe BETWEEN 1 AND 10);
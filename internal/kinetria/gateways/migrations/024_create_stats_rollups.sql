@@ -0,0 +1,105 @@
+-- Migration 024: Statistics rollups
+-- Per-user daily and weekly totals of completed sessions and their completed sets, read by
+-- the statistics and dashboard queries instead of aggregating sessions/set_records on every
+-- request. Days and weeks follow the timezone and weekStart in users.preferences.
+-- Rows are refreshed when a session finishes and rebuilt when those preferences change;
+-- existing history is backfilled below (`make rebuild-rollups` rebuilds it again if needed).
+
+-- Fuso do usuário (mesmo default de vos.DefaultTimezone)
+CREATE OR REPLACE FUNCTION user_timezone(p_user_id UUID) RETURNS TEXT AS $$
+    SELECT COALESCE(
+        (SELECT NULLIF(preferences->>'timezone', '') FROM users WHERE id = p_user_id),
+        'America/Sao_Paulo'
+    )
+$$ LANGUAGE SQL STABLE;
+
+-- Primeiro dia da semana de p_day segundo o weekStart do usuário (default: segunda)
+CREATE OR REPLACE FUNCTION user_week_start(p_user_id UUID, p_day DATE) RETURNS DATE AS $$
+    SELECT CASE
+        WHEN (SELECT preferences->>'weekStart' FROM users WHERE id = p_user_id) = 'sunday'
+            THEN p_day - EXTRACT(DOW FROM p_day)::int
+        ELSE p_day - ((EXTRACT(DOW FROM p_day)::int + 6) % 7)
+    END
+$$ LANGUAGE SQL STABLE;
+
+CREATE TABLE IF NOT EXISTS user_daily_stats (
+    user_id          UUID NOT NULL REFERENCES users(id) ON DELETE CASCADE,
+    day              DATE NOT NULL,
+    sessions         INT NOT NULL,
+    duration_seconds BIGINT NOT NULL,
+    calories         BIGINT NOT NULL,
+    sets             INT NOT NULL,
+    reps             BIGINT NOT NULL,
+    volume           BIGINT NOT NULL, -- gramas * reps
+    updated_at       TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    PRIMARY KEY (user_id, day)
+);
+
+CREATE TABLE IF NOT EXISTS user_weekly_stats (
+    user_id          UUID NOT NULL REFERENCES users(id) ON DELETE CASCADE,
+    week_start       DATE NOT NULL,
+    sessions         INT NOT NULL,
+    active_days      INT NOT NULL,
+    duration_seconds BIGINT NOT NULL,
+    calories         BIGINT NOT NULL,
+    sets             INT NOT NULL,
+    reps             BIGINT NOT NULL,
+    volume           BIGINT NOT NULL, -- gramas * reps
+    updated_at       TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    PRIMARY KEY (user_id, week_start)
+);
+
+-- Backfill do histórico: mesmas somas de InsertUserDailyStats/InsertUserWeeklyStats, para
+-- todos os usuários de uma vez
+WITH completed_sessions AS (
+    SELECT
+        s.id,
+        s.user_id,
+        DATE(s.started_at AT TIME ZONE user_timezone(s.user_id)) AS day,
+        COALESCE(EXTRACT(EPOCH FROM (s.finished_at - s.started_at)), 0) AS duration_seconds,
+        COALESCE(s.calories_kcal, 0) AS calories
+    FROM sessions s
+    WHERE s.status = 'completed'
+),
+session_sets AS (
+    SELECT
+        sr.session_id,
+        COUNT(sr.id) AS sets,
+        SUM(sr.reps) AS reps,
+        SUM(sr.weight::bigint * sr.reps) AS volume
+    FROM set_records sr
+    JOIN completed_sessions cs ON sr.session_id = cs.id
+    WHERE sr.status = 'completed'
+    GROUP BY sr.session_id
+)
+INSERT INTO user_daily_stats (user_id, day, sessions, duration_seconds, calories, sets, reps, volume, updated_at)
+SELECT
+    cs.user_id,
+    cs.day,
+    COUNT(*)::int,
+    SUM(cs.duration_seconds)::bigint,
+    SUM(cs.calories)::bigint,
+    COALESCE(SUM(ss.sets), 0)::int,
+    COALESCE(SUM(ss.reps), 0)::bigint,
+    COALESCE(SUM(ss.volume), 0)::bigint,
+    NOW()
+FROM completed_sessions cs
+LEFT JOIN session_sets ss ON ss.session_id = cs.id
+GROUP BY cs.user_id, cs.day
+ON CONFLICT (user_id, day) DO NOTHING;
+
+INSERT INTO user_weekly_stats (user_id, week_start, sessions, active_days, duration_seconds, calories, sets, reps, volume, updated_at)
+SELECT
+    user_id,
+    user_week_start(user_id, day) AS week_start,
+    SUM(sessions)::int,
+    COUNT(*)::int,
+    SUM(duration_seconds)::bigint,
+    SUM(calories)::bigint,
+    SUM(sets)::int,
+    SUM(reps)::bigint,
+    SUM(volume)::bigint,
+    NOW()
+FROM user_daily_stats
+GROUP BY user_id, user_week_start(user_id, day)
+ON CONFLICT (user_id, week_start) DO NOTHING;
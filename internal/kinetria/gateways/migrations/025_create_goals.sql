@@ -0,0 +1,22 @@
+-- Migration 025: Training goals
+-- Typed targets tracked against the session and set history: exercise e1RM or weight,
+-- weekly session count, monthly volume and body weight. Values use the canonical unit of
+-- the goal type (grams, grams × reps or a session count).
+
+CREATE TABLE IF NOT EXISTS goals (
+    id           UUID PRIMARY KEY,
+    user_id      UUID NOT NULL REFERENCES users(id) ON DELETE CASCADE,
+    type         VARCHAR(32) NOT NULL CHECK (type IN ('exercise_e1rm', 'exercise_weight', 'weekly_sessions', 'monthly_volume', 'body_weight')),
+    exercise_id  UUID REFERENCES exercises(id) ON DELETE CASCADE,
+    target_value BIGINT NOT NULL CHECK (target_value > 0),
+    start_value  BIGINT,
+    target_date  DATE,
+    status       VARCHAR(16) NOT NULL DEFAULT 'active' CHECK (status IN ('active', 'achieved')),
+    achieved_at  TIMESTAMPTZ,
+    created_at   TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    updated_at   TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    -- metas de exercício sempre apontam para um exercício; as demais nunca
+    CHECK ((type IN ('exercise_e1rm', 'exercise_weight')) = (exercise_id IS NOT NULL))
+);
+
+CREATE INDEX IF NOT EXISTS idx_goals_user_created ON goals(user_id, created_at DESC);
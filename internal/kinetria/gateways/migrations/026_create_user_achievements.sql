@@ -0,0 +1,36 @@
+-- Migration 026: Awarded achievements
+-- Badges awarded to each user. The rules themselves are data in the achievements catalog
+-- (domain/achievements/rules.json); code references a rule there.
+
+CREATE TABLE IF NOT EXISTS user_achievements (
+    user_id    UUID NOT NULL REFERENCES users(id) ON DELETE CASCADE,
+    code       VARCHAR(64) NOT NULL,
+    awarded_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    PRIMARY KEY (user_id, code)
+);
+
+-- The all-time counters the rules are evaluated on are read from the daily rollups
+-- (migration 024) instead of the whole set history, so each day also keeps its heaviest
+-- set and the exercises performed in it.
+ALTER TABLE user_daily_stats ADD COLUMN IF NOT EXISTS heaviest_set INT NOT NULL DEFAULT 0; -- gramas
+ALTER TABLE user_daily_stats ADD COLUMN IF NOT EXISTS exercise_ids UUID[] NOT NULL DEFAULT '{}';
+
+-- Backfill: mesmos valores de InsertUserDailyStats para os dias já agregados
+UPDATE user_daily_stats uds
+SET heaviest_set = d.heaviest_set,
+    exercise_ids = d.exercise_ids
+FROM (
+    SELECT
+        s.user_id,
+        DATE(s.started_at AT TIME ZONE user_timezone(s.user_id)) AS day,
+        MAX(sr.weight)::int AS heaviest_set,
+        COALESCE(ARRAY_AGG(DISTINCT we.exercise_id) FILTER (WHERE we.exercise_id IS NOT NULL), '{}') AS exercise_ids
+    FROM set_records sr
+    JOIN sessions s ON sr.session_id = s.id
+    LEFT JOIN workout_exercises we ON sr.workout_exercise_id = we.id
+    WHERE s.status = 'completed'
+      AND sr.status = 'completed'
+    GROUP BY s.user_id, DATE(s.started_at AT TIME ZONE user_timezone(s.user_id))
+) d
+WHERE uds.user_id = d.user_id
+  AND uds.day = d.day;
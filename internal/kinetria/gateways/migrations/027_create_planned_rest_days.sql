@@ -0,0 +1,10 @@
+-- Migration 027: Planned rest days
+-- Calendar days (in the user's timezone) the user planned not to train. A planned rest day
+-- without a session does not break the training streak, in either streak mode.
+
+CREATE TABLE IF NOT EXISTS planned_rest_days (
+    user_id    UUID NOT NULL REFERENCES users(id) ON DELETE CASCADE,
+    day        DATE NOT NULL,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    PRIMARY KEY (user_id, day)
+);
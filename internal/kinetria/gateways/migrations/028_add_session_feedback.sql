@@ -0,0 +1,8 @@
+-- Migration 028: Post-session feedback
+-- Alongside session_rpe (migration 023) a session stores the lifter's mood, energy and
+-- the quality of the previous night's sleep (1-5 each) and soreness per muscle group,
+-- as a JSON object of muscle group slug -> 1-5. All of it can be edited after the session.
+ALTER TABLE sessions ADD COLUMN IF NOT EXISTS mood SMALLINT CHECK (mood BETWEEN 1 AND 5);
+ALTER TABLE sessions ADD COLUMN IF NOT EXISTS energy SMALLINT CHECK (energy BETWEEN 1 AND 5);
+ALTER TABLE sessions ADD COLUMN IF NOT EXISTS sleep_quality SMALLINT CHECK (sleep_quality BETWEEN 1 AND 5);
+ALTER TABLE sessions ADD COLUMN IF NOT EXISTS soreness JSONB NOT NULL DEFAULT '{}';
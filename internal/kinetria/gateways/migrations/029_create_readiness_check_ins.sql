@@ -0,0 +1,21 @@
+-- Migration 029: Readiness check-ins
+-- Pre-workout answers (sleep hours, stress, soreness and optional HRV) and the readiness
+-- score they produced. A check-in used to start a session records the session and the
+-- adjustment applied to the day's prescription, if any.
+
+CREATE TABLE IF NOT EXISTS readiness_check_ins (
+    id          UUID PRIMARY KEY,
+    user_id     UUID NOT NULL REFERENCES users(id) ON DELETE CASCADE,
+    session_id  UUID REFERENCES sessions(id) ON DELETE SET NULL,
+    sleep_hours DOUBLE PRECISION NOT NULL CHECK (sleep_hours BETWEEN 0 AND 24),
+    stress      SMALLINT NOT NULL CHECK (stress BETWEEN 1 AND 5),
+    soreness    SMALLINT NOT NULL CHECK (soreness BETWEEN 1 AND 5),
+    hrv         SMALLINT CHECK (hrv BETWEEN 1 AND 300),
+    score       SMALLINT NOT NULL CHECK (score BETWEEN 0 AND 100),
+    level       VARCHAR(16) NOT NULL CHECK (level IN ('low', 'moderate', 'high')),
+    adjustment  VARCHAR(16) CHECK (adjustment IN ('load', 'volume')),
+    checked_at  TIMESTAMPTZ NOT NULL,
+    created_at  TIMESTAMPTZ NOT NULL DEFAULT NOW()
+);
+
+CREATE INDEX IF NOT EXISTS idx_readiness_check_ins_user_checked ON readiness_check_ins(user_id, checked_at DESC);
@@ -0,0 +1,67 @@
+-- Migration 030: Offline sync operations
+-- Outcome of each operation sent by the app through POST /sync, keyed by the client
+-- operation ID, so that a replayed batch is not applied twice.
+
+CREATE TABLE IF NOT EXISTS sync_operations (
+    user_id     UUID NOT NULL REFERENCES users(id) ON DELETE CASCADE,
+    id          UUID NOT NULL,
+    type        VARCHAR(32) NOT NULL,
+    entity_id   UUID NOT NULL,
+    status      VARCHAR(16) NOT NULL CHECK (status IN ('applied', 'rejected')),
+    code        VARCHAR(64) NOT NULL DEFAULT '',
+    message     TEXT NOT NULL DEFAULT '',
+    applied_at  TIMESTAMPTZ NOT NULL,
+    PRIMARY KEY (user_id, id)
+);
+
+-- Change feed of POST /sync. Every write to a session, and through the trigger below to
+-- its sets, gives the session the next number of a per-user sequence. The number comes from
+-- users.session_change_seq, whose row stays locked until the writing transaction commits,
+-- so a user's changes are numbered in commit order and a cursor never skips a change that
+-- commits after a later-started one.
+ALTER TABLE users ADD COLUMN IF NOT EXISTS session_change_seq BIGINT NOT NULL DEFAULT 0;
+ALTER TABLE sessions ADD COLUMN IF NOT EXISTS change_seq BIGINT NOT NULL DEFAULT 0;
+
+-- Backfill: o histórico entra no feed na ordem de updated_at
+UPDATE sessions s
+SET change_seq = n.seq
+FROM (
+    SELECT id, ROW_NUMBER() OVER (PARTITION BY user_id ORDER BY updated_at, id) AS seq
+    FROM sessions
+) n
+WHERE s.id = n.id;
+
+UPDATE users u
+SET session_change_seq = m.seq
+FROM (SELECT user_id, MAX(change_seq) AS seq FROM sessions GROUP BY user_id) m
+WHERE u.id = m.user_id;
+
+CREATE OR REPLACE FUNCTION set_session_change_seq() RETURNS TRIGGER AS $$
+BEGIN
+    UPDATE users SET session_change_seq = session_change_seq + 1
+    WHERE id = NEW.user_id
+    RETURNING session_change_seq INTO NEW.change_seq;
+    RETURN NEW;
+END;
+$$ LANGUAGE plpgsql;
+
+DROP TRIGGER IF EXISTS trg_sessions_change_seq ON sessions;
+CREATE TRIGGER trg_sessions_change_seq
+BEFORE INSERT OR UPDATE ON sessions
+FOR EACH ROW EXECUTE FUNCTION set_session_change_seq();
+
+-- Gravar, alterar ou remover uma série conta como mudança da sessão dela
+CREATE OR REPLACE FUNCTION touch_session_on_set_change() RETURNS TRIGGER AS $$
+BEGIN
+    UPDATE sessions SET updated_at = NOW()
+    WHERE id = CASE WHEN TG_OP = 'DELETE' THEN OLD.session_id ELSE NEW.session_id END;
+    RETURN NULL;
+END;
+$$ LANGUAGE plpgsql;
+
+DROP TRIGGER IF EXISTS trg_set_records_touch_session ON set_records;
+CREATE TRIGGER trg_set_records_touch_session
+AFTER INSERT OR UPDATE OR DELETE ON set_records
+FOR EACH ROW EXECUTE FUNCTION touch_session_on_set_change();
+
+CREATE INDEX IF NOT EXISTS idx_sessions_user_change_seq ON sessions(user_id, change_seq);
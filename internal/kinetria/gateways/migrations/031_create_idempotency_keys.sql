@@ -0,0 +1,22 @@
+-- Migration 031: Idempotency keys
+-- Responses of POST, PUT and PATCH requests sent with an Idempotency-Key header, so that a
+-- retried request gets the original response instead of running again. A row without
+-- status_code belongs to a request still running, identified by claim_id, which renews
+-- heartbeat_at while it runs; the key is only claimed again once the heartbeats stop.
+-- Rows expire after IDEMPOTENCY_KEY_TTL.
+
+CREATE TABLE IF NOT EXISTS idempotency_keys (
+    user_id      UUID NOT NULL REFERENCES users(id) ON DELETE CASCADE,
+    key          VARCHAR(255) NOT NULL,
+    fingerprint  CHAR(64) NOT NULL,
+    claim_id     UUID NOT NULL,
+    status_code  SMALLINT,
+    content_type VARCHAR(255) NOT NULL DEFAULT '',
+    body         BYTEA,
+    created_at   TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    heartbeat_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    expires_at   TIMESTAMPTZ NOT NULL,
+    PRIMARY KEY (user_id, key)
+);
+
+CREATE INDEX IF NOT EXISTS idx_idempotency_keys_expires_at ON idempotency_keys(expires_at);
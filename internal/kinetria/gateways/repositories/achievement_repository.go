@@ -0,0 +1,65 @@
+package repositories
+
+import (
+	"context"
+	"database/sql"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/kinetria/kinetria-back/internal/kinetria/domain/entities"
+	"github.com/kinetria/kinetria-back/internal/kinetria/domain/ports"
+	"github.com/kinetria/kinetria-back/internal/kinetria/gateways/repositories/queries"
+)
+
+// AchievementRepository implements ports.AchievementRepository using PostgreSQL via SQLC.
+type AchievementRepository struct {
+	q *queries.Queries
+}
+
+// NewAchievementRepository creates a new AchievementRepository.
+func NewAchievementRepository(db *sql.DB) *AchievementRepository {
+	return &AchievementRepository{q: queries.New(txDB{db})}
+}
+
+// GetStats returns the user's all-time counters, read from the daily stats rollups plus the
+// sets of the active session.
+func (r *AchievementRepository) GetStats(ctx context.Context, userID uuid.UUID) (*ports.AchievementStats, error) {
+	row, err := r.q.GetAchievementStats(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+	return &ports.AchievementStats{
+		Sessions:    int(row.Sessions),
+		Sets:        int(row.Sets),
+		Reps:        row.Reps,
+		Volume:      row.Volume,
+		HeaviestSet: int(row.HeaviestSet),
+		Exercises:   int(row.Exercises),
+	}, nil
+}
+
+// ListByUser returns the user's awarded achievements, oldest first.
+func (r *AchievementRepository) ListByUser(ctx context.Context, userID uuid.UUID) ([]entities.UserAchievement, error) {
+	rows, err := r.q.ListUserAchievements(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+	result := make([]entities.UserAchievement, 0, len(rows))
+	for _, row := range rows {
+		result = append(result, entities.UserAchievement{UserID: row.UserID, Code: row.Code, AwardedAt: row.AwardedAt})
+	}
+	return result, nil
+}
+
+// Award records the achievement; returns false if the user already had it.
+func (r *AchievementRepository) Award(ctx context.Context, userID uuid.UUID, code string, awardedAt time.Time) (bool, error) {
+	n, err := r.q.AwardUserAchievement(ctx, queries.AwardUserAchievementParams{
+		UserID:    userID,
+		Code:      code,
+		AwardedAt: awardedAt,
+	})
+	if err != nil {
+		return false, err
+	}
+	return n > 0, nil
+}
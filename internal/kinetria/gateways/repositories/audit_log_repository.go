@@ -16,7 +16,7 @@ type AuditLogRepository struct {
 
 // NewAuditLogRepository creates a new AuditLogRepository backed by the provided *sql.DB.
 func NewAuditLogRepository(db *sql.DB) *AuditLogRepository {
-	return &AuditLogRepository{q: queries.New(db)}
+	return &AuditLogRepository{q: queries.New(txDB{db})}
 }
 
 // Append inserts a new audit log entry into the database.
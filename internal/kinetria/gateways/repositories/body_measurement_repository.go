@@ -0,0 +1,278 @@
+package repositories
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/kinetria/kinetria-back/internal/kinetria/domain/entities"
+	"github.com/kinetria/kinetria-back/internal/kinetria/domain/ports"
+	"github.com/kinetria/kinetria-back/internal/kinetria/domain/vos"
+	"github.com/kinetria/kinetria-back/internal/kinetria/gateways/repositories/queries"
+)
+
+// BodyMeasurementRepository implements ports.BodyMeasurementRepository and
+// ports.BodyWeightRepository using PostgreSQL via SQLC.
+type BodyMeasurementRepository struct {
+	q *queries.Queries
+}
+
+// NewBodyMeasurementRepository creates a new BodyMeasurementRepository.
+func NewBodyMeasurementRepository(db *sql.DB) *BodyMeasurementRepository {
+	return &BodyMeasurementRepository{q: queries.New(txDB{db})}
+}
+
+// circumferenceColumns holds one nullable column per body site.
+type circumferenceColumns struct {
+	neck, chest, waist, hips, arm, thigh, calf sql.NullInt32
+}
+
+func toCircumferenceColumns(m map[vos.BodySite]int) circumferenceColumns {
+	col := func(site vos.BodySite) sql.NullInt32 {
+		mm, ok := m[site]
+		if !ok || mm <= 0 {
+			return sql.NullInt32{}
+		}
+		return sql.NullInt32{Int32: int32(mm), Valid: true}
+	}
+	return circumferenceColumns{
+		neck:  col(vos.BodySiteNeck),
+		chest: col(vos.BodySiteChest),
+		waist: col(vos.BodySiteWaist),
+		hips:  col(vos.BodySiteHips),
+		arm:   col(vos.BodySiteArm),
+		thigh: col(vos.BodySiteThigh),
+		calf:  col(vos.BodySiteCalf),
+	}
+}
+
+func mapSQLCBodyMeasurementToEntity(row queries.BodyMeasurement) entities.BodyMeasurement {
+	m := entities.BodyMeasurement{
+		ID:             row.ID,
+		UserID:         row.UserID,
+		MeasuredAt:     row.MeasuredAt,
+		WeightGrams:    nullInt32ToIntPtr(row.WeightGrams),
+		Circumferences: make(map[vos.BodySite]int),
+		Notes:          row.Notes,
+		CreatedAt:      row.CreatedAt,
+		UpdatedAt:      row.UpdatedAt,
+	}
+	if row.BodyFatPct.Valid {
+		pct := row.BodyFatPct.Float64
+		m.BodyFatPercent = &pct
+	}
+	for site, v := range map[vos.BodySite]sql.NullInt32{
+		vos.BodySiteNeck:  row.NeckMm,
+		vos.BodySiteChest: row.ChestMm,
+		vos.BodySiteWaist: row.WaistMm,
+		vos.BodySiteHips:  row.HipsMm,
+		vos.BodySiteArm:   row.ArmMm,
+		vos.BodySiteThigh: row.ThighMm,
+		vos.BodySiteCalf:  row.CalfMm,
+	} {
+		if v.Valid {
+			m.Circumferences[site] = int(v.Int32)
+		}
+	}
+	return m
+}
+
+func toNullInt32(v *int) sql.NullInt32 {
+	if v == nil {
+		return sql.NullInt32{}
+	}
+	return sql.NullInt32{Int32: int32(*v), Valid: true}
+}
+
+func toNullFloat64(v *float64) sql.NullFloat64 {
+	if v == nil {
+		return sql.NullFloat64{}
+	}
+	return sql.NullFloat64{Float64: *v, Valid: true}
+}
+
+// Create inserts a new measurement.
+func (r *BodyMeasurementRepository) Create(ctx context.Context, m *entities.BodyMeasurement) error {
+	c := toCircumferenceColumns(m.Circumferences)
+	return r.q.CreateBodyMeasurement(ctx, queries.CreateBodyMeasurementParams{
+		ID:          m.ID,
+		UserID:      m.UserID,
+		MeasuredAt:  m.MeasuredAt,
+		WeightGrams: toNullInt32(m.WeightGrams),
+		BodyFatPct:  toNullFloat64(m.BodyFatPercent),
+		NeckMm:      c.neck,
+		ChestMm:     c.chest,
+		WaistMm:     c.waist,
+		HipsMm:      c.hips,
+		ArmMm:       c.arm,
+		ThighMm:     c.thigh,
+		CalfMm:      c.calf,
+		Notes:       m.Notes,
+		CreatedAt:   m.CreatedAt,
+		UpdatedAt:   m.UpdatedAt,
+	})
+}
+
+// GetByID returns the user's measurement, or (nil, nil) if it does not exist.
+func (r *BodyMeasurementRepository) GetByID(ctx context.Context, userID, id uuid.UUID) (*entities.BodyMeasurement, error) {
+	row, err := r.q.GetBodyMeasurementByID(ctx, queries.GetBodyMeasurementByIDParams{ID: id, UserID: userID})
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, nil
+		}
+		return nil, err
+	}
+	m := mapSQLCBodyMeasurementToEntity(row)
+	return &m, nil
+}
+
+// Update overwrites every field of the measurement.
+func (r *BodyMeasurementRepository) Update(ctx context.Context, m *entities.BodyMeasurement) error {
+	c := toCircumferenceColumns(m.Circumferences)
+	return r.q.UpdateBodyMeasurement(ctx, queries.UpdateBodyMeasurementParams{
+		ID:          m.ID,
+		UserID:      m.UserID,
+		MeasuredAt:  m.MeasuredAt,
+		WeightGrams: toNullInt32(m.WeightGrams),
+		BodyFatPct:  toNullFloat64(m.BodyFatPercent),
+		NeckMm:      c.neck,
+		ChestMm:     c.chest,
+		WaistMm:     c.waist,
+		HipsMm:      c.hips,
+		ArmMm:       c.arm,
+		ThighMm:     c.thigh,
+		CalfMm:      c.calf,
+		Notes:       m.Notes,
+		UpdatedAt:   m.UpdatedAt,
+	})
+}
+
+// Delete removes the user's measurement; returns false if nothing was deleted.
+func (r *BodyMeasurementRepository) Delete(ctx context.Context, userID, id uuid.UUID) (bool, error) {
+	n, err := r.q.DeleteBodyMeasurement(ctx, queries.DeleteBodyMeasurementParams{ID: id, UserID: userID})
+	if err != nil {
+		return false, err
+	}
+	return n > 0, nil
+}
+
+// ListByUser returns a page of measurements taken in [start, end], most recent first, and the total count.
+func (r *BodyMeasurementRepository) ListByUser(ctx context.Context, userID uuid.UUID, start, end time.Time, limit, offset int) ([]entities.BodyMeasurement, int, error) {
+	rows, err := r.q.ListBodyMeasurementsByUser(ctx, queries.ListBodyMeasurementsByUserParams{
+		UserID:       userID,
+		MeasuredAt:   start,
+		MeasuredAt_2: end,
+		Limit:        int32(limit),
+		Offset:       int32(offset),
+	})
+	if err != nil {
+		return nil, 0, err
+	}
+	total, err := r.q.CountBodyMeasurementsByUser(ctx, queries.CountBodyMeasurementsByUserParams{
+		UserID:       userID,
+		MeasuredAt:   start,
+		MeasuredAt_2: end,
+	})
+	if err != nil {
+		return nil, 0, err
+	}
+
+	result := make([]entities.BodyMeasurement, 0, len(rows))
+	for _, row := range rows {
+		result = append(result, mapSQLCBodyMeasurementToEntity(row))
+	}
+	return result, int(total), nil
+}
+
+// ListByUserInRange returns every measurement taken in [start, end], oldest first.
+func (r *BodyMeasurementRepository) ListByUserInRange(ctx context.Context, userID uuid.UUID, start, end time.Time) ([]entities.BodyMeasurement, error) {
+	rows, err := r.q.ListBodyMeasurementsInRange(ctx, queries.ListBodyMeasurementsInRangeParams{
+		UserID:       userID,
+		MeasuredAt:   start,
+		MeasuredAt_2: end,
+	})
+	if err != nil {
+		return nil, err
+	}
+	result := make([]entities.BodyMeasurement, 0, len(rows))
+	for _, row := range rows {
+		result = append(result, mapSQLCBodyMeasurementToEntity(row))
+	}
+	return result, nil
+}
+
+// GetLatestBodyWeight returns the most recent logged body weight in grams, or nil if none.
+func (r *BodyMeasurementRepository) GetLatestBodyWeight(ctx context.Context, userID uuid.UUID) (*int, error) {
+	grams, err := r.q.GetLatestBodyWeight(ctx, userID)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, nil
+		}
+		return nil, err
+	}
+	g := int(grams)
+	return &g, nil
+}
+
+// ListBodyWeights returns the weigh-ins in [start, end], oldest first, preceded by the
+// last weigh-in before start.
+func (r *BodyMeasurementRepository) ListBodyWeights(ctx context.Context, userID uuid.UUID, start, end time.Time) ([]ports.BodyWeightEntry, error) {
+	rows, err := r.q.ListBodyWeights(ctx, queries.ListBodyWeightsParams{
+		UserID:       userID,
+		MeasuredAt:   start,
+		MeasuredAt_2: end,
+	})
+	if err != nil {
+		return nil, err
+	}
+	result := make([]ports.BodyWeightEntry, 0, len(rows))
+	for _, row := range rows {
+		result = append(result, ports.BodyWeightEntry{MeasuredAt: row.MeasuredAt, WeightGrams: int(row.WeightGrams)})
+	}
+	return result, nil
+}
+
+// GetGoalWeight returns the user's goal weight, or (nil, nil) if none is set.
+func (r *BodyMeasurementRepository) GetGoalWeight(ctx context.Context, userID uuid.UUID) (*entities.BodyWeightGoal, error) {
+	row, err := r.q.GetBodyWeightGoal(ctx, userID)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, nil
+		}
+		return nil, err
+	}
+	goal := &entities.BodyWeightGoal{
+		UserID:      row.UserID,
+		TargetGrams: int(row.TargetGrams),
+		StartGrams:  nullInt32ToIntPtr(row.StartGrams),
+		CreatedAt:   row.CreatedAt,
+		UpdatedAt:   row.UpdatedAt,
+	}
+	if row.TargetDate.Valid {
+		goal.TargetDate = &row.TargetDate.Time
+	}
+	return goal, nil
+}
+
+// UpsertGoalWeight creates or replaces the user's goal weight.
+func (r *BodyMeasurementRepository) UpsertGoalWeight(ctx context.Context, goal *entities.BodyWeightGoal) error {
+	var targetDate sql.NullTime
+	if goal.TargetDate != nil {
+		targetDate = sql.NullTime{Time: *goal.TargetDate, Valid: true}
+	}
+	return r.q.UpsertBodyWeightGoal(ctx, queries.UpsertBodyWeightGoalParams{
+		UserID:      goal.UserID,
+		TargetGrams: int32(goal.TargetGrams),
+		StartGrams:  toNullInt32(goal.StartGrams),
+		TargetDate:  targetDate,
+		CreatedAt:   goal.CreatedAt,
+		UpdatedAt:   goal.UpdatedAt,
+	})
+}
+
+// DeleteGoalWeight removes the user's goal weight, if any.
+func (r *BodyMeasurementRepository) DeleteGoalWeight(ctx context.Context, userID uuid.UUID) error {
+	return r.q.DeleteBodyWeightGoal(ctx, userID)
+}
@@ -6,14 +6,18 @@ import (
 	"encoding/json"
 	"errors"
 	"fmt"
+	"strconv"
+	"time"
 
 	"github.com/google/uuid"
 	"github.com/kinetria/kinetria-back/internal/kinetria/domain/entities"
 	"github.com/kinetria/kinetria-back/internal/kinetria/domain/ports"
+	"github.com/kinetria/kinetria-back/internal/kinetria/domain/vos"
 	"github.com/kinetria/kinetria-back/internal/kinetria/gateways/repositories/queries"
 )
 
-// ExerciseRepository implements ports.ExerciseRepository using SQLC.
+// ExerciseRepository implements ports.ExerciseRepository, ports.ExerciseLibraryRepository and
+// ports.ExerciseUsageRepository using SQLC.
 type ExerciseRepository struct {
 	db *sql.DB
 	q  *queries.Queries
@@ -21,7 +25,7 @@ type ExerciseRepository struct {
 
 // NewExerciseRepository creates a new ExerciseRepository.
 func NewExerciseRepository(db *sql.DB) *ExerciseRepository {
-	return &ExerciseRepository{db: db, q: queries.New(db)}
+	return &ExerciseRepository{db: db, q: queries.New(txDB{db})}
 }
 
 // ExistsByIDAndWorkoutID checks if an exercise exists and belongs to a workout.
@@ -55,6 +59,8 @@ func (r *ExerciseRepository) List(ctx context.Context, filters ports.ExerciseFil
 		Difficulty:  toNullString(filters.Difficulty),
 		Limit:       int32(pageSize),
 		Offset:      int32(offset),
+		FavoritesOf: toNullUUID(filters.FavoritesOf),
+		Sort:        filters.Sort.String(),
 	}
 
 	countParams := queries.CountExercisesParams{
@@ -62,6 +68,7 @@ func (r *ExerciseRepository) List(ctx context.Context, filters ports.ExerciseFil
 		MuscleGroup: params.MuscleGroup,
 		Equipment:   params.Equipment,
 		Difficulty:  params.Difficulty,
+		FavoritesOf: params.FavoritesOf,
 	}
 
 	total, err := r.q.CountExercises(ctx, countParams)
@@ -76,7 +83,7 @@ func (r *ExerciseRepository) List(ctx context.Context, filters ports.ExerciseFil
 
 	exercises := make([]*entities.Exercise, 0, len(rows))
 	for _, row := range rows {
-		e, err := mapSQLCLibraryExerciseToEntity(row)
+		e, err := mapSQLCLibraryExerciseToEntity(queries.GetExerciseByIDRow(row))
 		if err != nil {
 			return nil, 0, err
 		}
@@ -185,6 +192,145 @@ func (r *ExerciseRepository) GetHistory(ctx context.Context, userID, exerciseID
 	return entries, int(total), nil
 }
 
+// ListAll returns every library exercise, ordered by slug.
+func (r *ExerciseRepository) ListAll(ctx context.Context) ([]*entities.Exercise, error) {
+	rows, err := r.q.ListAllExercises(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	exercises := make([]*entities.Exercise, 0, len(rows))
+	for _, row := range rows {
+		e, err := mapSQLCLibraryExerciseToEntity(queries.GetExerciseByIDRow(row))
+		if err != nil {
+			return nil, err
+		}
+		exercises = append(exercises, &e)
+	}
+	return exercises, nil
+}
+
+// UpsertBySlug inserts or updates the exercises and replaces their muscle groups (transactional).
+func (r *ExerciseRepository) UpsertBySlug(ctx context.Context, exercises []*entities.Exercise) error {
+	tx, err := r.db.BeginTx(ctx, nil)
+	if err != nil {
+		return fmt.Errorf("failed to begin transaction: %w", err)
+	}
+	defer tx.Rollback()
+
+	qtx := r.q.WithTx(tx)
+
+	for _, e := range exercises {
+		muscles := e.Muscles
+		if muscles == nil {
+			muscles = []string{}
+		}
+		musclesJSON, err := json.Marshal(muscles)
+		if err != nil {
+			return fmt.Errorf("failed to marshal muscles for exercise %s: %w", e.Slug, err)
+		}
+		tags := e.Tags
+		if tags == nil {
+			tags = []string{}
+		}
+		tagsJSON, err := json.Marshal(tags)
+		if err != nil {
+			return fmt.Errorf("failed to marshal tags for exercise %s: %w", e.Slug, err)
+		}
+
+		var description string
+		if e.Description != nil {
+			description = *e.Description
+		}
+
+		id, err := qtx.UpsertExerciseBySlug(ctx, queries.UpsertExerciseBySlugParams{
+			ID:           e.ID,
+			Slug:         e.Slug,
+			Name:         e.Name,
+			Description:  description,
+			ThumbnailUrl: e.ThumbnailURL,
+			Muscles:      musclesJSON,
+			Tags:         tagsJSON,
+			Instructions: toNullString(e.Instructions),
+			Tips:         toNullString(e.Tips),
+			Difficulty:   toNullString(e.Difficulty),
+			Equipment:    toNullString(e.Equipment),
+			VideoUrl:     toNullString(e.VideoURL),
+		})
+		if err != nil {
+			return fmt.Errorf("failed to upsert exercise %s: %w", e.Slug, err)
+		}
+
+		if err := qtx.DeleteExerciseMuscles(ctx, id); err != nil {
+			return fmt.Errorf("failed to clear muscle groups for exercise %s: %w", e.Slug, err)
+		}
+		for _, m := range e.MuscleGroups {
+			err := qtx.CreateExerciseMuscle(ctx, queries.CreateExerciseMuscleParams{
+				ExerciseID:  id,
+				MuscleGroup: m.MuscleGroup.String(),
+				Role:        m.Role.String(),
+				Involvement: strconv.FormatFloat(m.Involvement, 'f', 2, 64),
+			})
+			if err != nil {
+				return fmt.Errorf("failed to create muscle group for exercise %s: %w", e.Slug, err)
+			}
+		}
+	}
+
+	return tx.Commit()
+}
+
+// ListRecentByUser returns the exercises the user performed most recently or most frequently.
+func (r *ExerciseRepository) ListRecentByUser(ctx context.Context, userID uuid.UUID, sort vos.RecentExerciseSort, limit int) ([]ports.RecentExercise, error) {
+	rows, err := r.q.ListRecentExercisesByUser(ctx, queries.ListRecentExercisesByUserParams{
+		UserID: userID,
+		Sort:   sort.String(),
+		Limit:  int32(limit),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	recent := make([]ports.RecentExercise, 0, len(rows))
+	for _, row := range rows {
+		e, err := mapSQLCLibraryExerciseToEntity(queries.GetExerciseByIDRow{
+			ID:           row.ID,
+			Slug:         row.Slug,
+			Name:         row.Name,
+			Description:  row.Description,
+			ThumbnailUrl: row.ThumbnailUrl,
+			Muscles:      row.Muscles,
+			Tags:         row.Tags,
+			Instructions: row.Instructions,
+			Tips:         row.Tips,
+			Difficulty:   row.Difficulty,
+			Equipment:    row.Equipment,
+			VideoUrl:     row.VideoUrl,
+			CreatedAt:    row.CreatedAt,
+			UpdatedAt:    row.UpdatedAt,
+			MuscleGroups: row.MuscleGroups,
+		})
+		if err != nil {
+			return nil, err
+		}
+		recent = append(recent, ports.RecentExercise{
+			Exercise:        &e,
+			LastPerformedAt: row.LastPerformed,
+			TimesPerformed:  int(row.TimesPerformed),
+		})
+	}
+	return recent, nil
+}
+
+// RefreshPopularity recomputes popularity_score for all exercises in a single statement.
+func (r *ExerciseRepository) RefreshPopularity(ctx context.Context, now, since time.Time, halfLife time.Duration) (int64, error) {
+	return r.q.RefreshExercisePopularity(ctx, queries.RefreshExercisePopularityParams{
+		Now:             now,
+		Since:           since,
+		HalfLifeSeconds: halfLife.Seconds(),
+	})
+}
+
 // toNullString converts a *string to sql.NullString.
 func toNullString(s *string) sql.NullString {
 	if s == nil {
@@ -193,8 +339,23 @@ func toNullString(s *string) sql.NullString {
 	return sql.NullString{String: *s, Valid: true}
 }
 
-// mapSQLCLibraryExerciseToEntity converts a queries.Exercise to entities.Exercise for library use.
-func mapSQLCLibraryExerciseToEntity(row queries.Exercise) (entities.Exercise, error) {
+// toNullUUID converts a *uuid.UUID to uuid.NullUUID.
+func toNullUUID(id *uuid.UUID) uuid.NullUUID {
+	if id == nil {
+		return uuid.NullUUID{}
+	}
+	return uuid.NullUUID{UUID: *id, Valid: true}
+}
+
+// exerciseMuscleJSON mirrors the objects aggregated into the muscle_groups column.
+type exerciseMuscleJSON struct {
+	MuscleGroup string  `json:"muscle_group"`
+	Role        string  `json:"role"`
+	Involvement float64 `json:"involvement"`
+}
+
+// mapSQLCLibraryExerciseToEntity converts an exercise row to entities.Exercise for library use.
+func mapSQLCLibraryExerciseToEntity(row queries.GetExerciseByIDRow) (entities.Exercise, error) {
 	var muscles []string
 	if len(row.Muscles) > 0 {
 		if err := json.Unmarshal(row.Muscles, &muscles); err != nil {
@@ -202,11 +363,36 @@ func mapSQLCLibraryExerciseToEntity(row queries.Exercise) (entities.Exercise, er
 		}
 	}
 
+	var tags []string
+	if len(row.Tags) > 0 {
+		if err := json.Unmarshal(row.Tags, &tags); err != nil {
+			return entities.Exercise{}, fmt.Errorf("failed to parse tags JSON for exercise %s: %w", row.ID, err)
+		}
+	}
+
+	var muscleRows []exerciseMuscleJSON
+	if len(row.MuscleGroups) > 0 {
+		if err := json.Unmarshal(row.MuscleGroups, &muscleRows); err != nil {
+			return entities.Exercise{}, fmt.Errorf("failed to parse muscle groups JSON for exercise %s: %w", row.ID, err)
+		}
+	}
+	muscleGroups := make([]entities.ExerciseMuscle, 0, len(muscleRows))
+	for _, m := range muscleRows {
+		muscleGroups = append(muscleGroups, entities.ExerciseMuscle{
+			MuscleGroup: vos.MuscleGroup(m.MuscleGroup),
+			Role:        vos.MuscleRole(m.Role),
+			Involvement: m.Involvement,
+		})
+	}
+
 	e := entities.Exercise{
 		ID:           row.ID,
+		Slug:         row.Slug,
 		Name:         row.Name,
 		ThumbnailURL: row.ThumbnailUrl,
 		Muscles:      muscles,
+		MuscleGroups: muscleGroups,
+		Tags:         tags,
 	}
 
 	if row.Description != "" {
@@ -0,0 +1,73 @@
+package repositories
+
+import (
+	"context"
+	"database/sql"
+
+	"github.com/google/uuid"
+	"github.com/kinetria/kinetria-back/internal/kinetria/gateways/repositories/queries"
+)
+
+// FavoriteRepository implements ports.FavoriteRepository using SQLC.
+type FavoriteRepository struct {
+	q *queries.Queries
+}
+
+// NewFavoriteRepository creates a new FavoriteRepository.
+func NewFavoriteRepository(db *sql.DB) *FavoriteRepository {
+	return &FavoriteRepository{q: queries.New(txDB{db})}
+}
+
+// AddExercise marks an exercise as favorite (no-op if already favorited).
+func (r *FavoriteRepository) AddExercise(ctx context.Context, userID, exerciseID uuid.UUID) error {
+	return r.q.AddFavoriteExercise(ctx, queries.AddFavoriteExerciseParams{UserID: userID, ExerciseID: exerciseID})
+}
+
+// RemoveExercise unmarks a favorite exercise.
+func (r *FavoriteRepository) RemoveExercise(ctx context.Context, userID, exerciseID uuid.UUID) error {
+	return r.q.RemoveFavoriteExercise(ctx, queries.RemoveFavoriteExerciseParams{UserID: userID, ExerciseID: exerciseID})
+}
+
+// AddWorkout marks a workout as favorite (no-op if already favorited).
+func (r *FavoriteRepository) AddWorkout(ctx context.Context, userID, workoutID uuid.UUID) error {
+	return r.q.AddFavoriteWorkout(ctx, queries.AddFavoriteWorkoutParams{UserID: userID, WorkoutID: workoutID})
+}
+
+// RemoveWorkout unmarks a favorite workout.
+func (r *FavoriteRepository) RemoveWorkout(ctx context.Context, userID, workoutID uuid.UUID) error {
+	return r.q.RemoveFavoriteWorkout(ctx, queries.RemoveFavoriteWorkoutParams{UserID: userID, WorkoutID: workoutID})
+}
+
+// FavoriteExerciseIDs returns which of the given exercises the user has favorited.
+func (r *FavoriteRepository) FavoriteExerciseIDs(ctx context.Context, userID uuid.UUID, exerciseIDs []uuid.UUID) (map[uuid.UUID]bool, error) {
+	favorites, err := r.q.ListFavoriteExerciseIDs(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+	return intersectIDs(favorites, exerciseIDs), nil
+}
+
+// FavoriteWorkoutIDs returns which of the given workouts the user has favorited.
+func (r *FavoriteRepository) FavoriteWorkoutIDs(ctx context.Context, userID uuid.UUID, workoutIDs []uuid.UUID) (map[uuid.UUID]bool, error) {
+	favorites, err := r.q.ListFavoriteWorkoutIDs(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+	return intersectIDs(favorites, workoutIDs), nil
+}
+
+// intersectIDs returns the set of ids that are also in favorites.
+// A user's favorites are few, so loading them all is cheaper than an array parameter.
+func intersectIDs(favorites, ids []uuid.UUID) map[uuid.UUID]bool {
+	set := make(map[uuid.UUID]bool, len(favorites))
+	for _, id := range favorites {
+		set[id] = true
+	}
+	result := make(map[uuid.UUID]bool, len(ids))
+	for _, id := range ids {
+		if set[id] {
+			result[id] = true
+		}
+	}
+	return result
+}
@@ -0,0 +1,142 @@
+package repositories
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/kinetria/kinetria-back/internal/kinetria/domain/entities"
+	"github.com/kinetria/kinetria-back/internal/kinetria/domain/vos"
+	"github.com/kinetria/kinetria-back/internal/kinetria/gateways/repositories/queries"
+)
+
+// GoalRepository implements ports.GoalRepository using PostgreSQL via SQLC.
+type GoalRepository struct {
+	q *queries.Queries
+}
+
+// NewGoalRepository creates a new GoalRepository.
+func NewGoalRepository(db *sql.DB) *GoalRepository {
+	return &GoalRepository{q: queries.New(txDB{db})}
+}
+
+func mapSQLCGoalToEntity(row queries.Goal) entities.Goal {
+	goal := entities.Goal{
+		ID:          row.ID,
+		UserID:      row.UserID,
+		Type:        vos.GoalType(row.Type),
+		TargetValue: row.TargetValue,
+		Status:      vos.GoalStatus(row.Status),
+		CreatedAt:   row.CreatedAt,
+		UpdatedAt:   row.UpdatedAt,
+	}
+	if row.ExerciseID.Valid {
+		id := row.ExerciseID.UUID
+		goal.ExerciseID = &id
+	}
+	if row.StartValue.Valid {
+		v := row.StartValue.Int64
+		goal.StartValue = &v
+	}
+	if row.TargetDate.Valid {
+		d := row.TargetDate.Time
+		goal.TargetDate = &d
+	}
+	if row.AchievedAt.Valid {
+		at := row.AchievedAt.Time
+		goal.AchievedAt = &at
+	}
+	return goal
+}
+
+func toNullInt64(v *int64) sql.NullInt64 {
+	if v == nil {
+		return sql.NullInt64{}
+	}
+	return sql.NullInt64{Int64: *v, Valid: true}
+}
+
+func toNullTime(t *time.Time) sql.NullTime {
+	if t == nil {
+		return sql.NullTime{}
+	}
+	return sql.NullTime{Time: *t, Valid: true}
+}
+
+// Create inserts a new goal.
+func (r *GoalRepository) Create(ctx context.Context, goal *entities.Goal) error {
+	return r.q.CreateGoal(ctx, queries.CreateGoalParams{
+		ID:          goal.ID,
+		UserID:      goal.UserID,
+		Type:        goal.Type.String(),
+		ExerciseID:  toNullUUID(goal.ExerciseID),
+		TargetValue: goal.TargetValue,
+		StartValue:  toNullInt64(goal.StartValue),
+		TargetDate:  toNullTime(goal.TargetDate),
+		Status:      goal.Status.String(),
+		AchievedAt:  toNullTime(goal.AchievedAt),
+		CreatedAt:   goal.CreatedAt,
+		UpdatedAt:   goal.UpdatedAt,
+	})
+}
+
+// GetByID returns the user's goal, or (nil, nil) if it does not exist.
+func (r *GoalRepository) GetByID(ctx context.Context, userID, id uuid.UUID) (*entities.Goal, error) {
+	row, err := r.q.GetGoalByID(ctx, queries.GetGoalByIDParams{ID: id, UserID: userID})
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, nil
+		}
+		return nil, err
+	}
+	goal := mapSQLCGoalToEntity(row)
+	return &goal, nil
+}
+
+// ListByUser returns the user's goals, active first and then most recent first.
+func (r *GoalRepository) ListByUser(ctx context.Context, userID uuid.UUID) ([]entities.Goal, error) {
+	rows, err := r.q.ListGoalsByUser(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+	result := make([]entities.Goal, 0, len(rows))
+	for _, row := range rows {
+		result = append(result, mapSQLCGoalToEntity(row))
+	}
+	return result, nil
+}
+
+// Update stores the target value and target date of the goal.
+func (r *GoalRepository) Update(ctx context.Context, goal *entities.Goal) error {
+	return r.q.UpdateGoal(ctx, queries.UpdateGoalParams{
+		ID:          goal.ID,
+		UserID:      goal.UserID,
+		TargetValue: goal.TargetValue,
+		TargetDate:  toNullTime(goal.TargetDate),
+		UpdatedAt:   goal.UpdatedAt,
+	})
+}
+
+// Delete removes the user's goal; returns false if nothing was deleted.
+func (r *GoalRepository) Delete(ctx context.Context, userID, id uuid.UUID) (bool, error) {
+	n, err := r.q.DeleteGoal(ctx, queries.DeleteGoalParams{ID: id, UserID: userID})
+	if err != nil {
+		return false, err
+	}
+	return n > 0, nil
+}
+
+// MarkAchieved sets an active goal as achieved; returns false if it was not active.
+func (r *GoalRepository) MarkAchieved(ctx context.Context, userID, id uuid.UUID, achievedAt time.Time) (bool, error) {
+	n, err := r.q.MarkGoalAchieved(ctx, queries.MarkGoalAchievedParams{
+		ID:         id,
+		UserID:     userID,
+		AchievedAt: sql.NullTime{Time: achievedAt, Valid: true},
+	})
+	if err != nil {
+		return false, err
+	}
+	return n > 0, nil
+}
@@ -0,0 +1,101 @@
+package repositories
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/kinetria/kinetria-back/internal/kinetria/domain/ports"
+	"github.com/kinetria/kinetria-back/internal/kinetria/gateways/repositories/queries"
+)
+
+// IdempotencyRepository implements ports.IdempotencyRepository using PostgreSQL via SQLC.
+type IdempotencyRepository struct {
+	q *queries.Queries
+}
+
+// NewIdempotencyRepository creates a new IdempotencyRepository.
+func NewIdempotencyRepository(db *sql.DB) *IdempotencyRepository {
+	return &IdempotencyRepository{q: queries.New(txDB{db})}
+}
+
+// Claim stores record as a running request unless the key is already stored for the user,
+// in which case the stored record is returned.
+func (r *IdempotencyRepository) Claim(ctx context.Context, record *ports.IdempotencyRecord, staleBefore time.Time) (*ports.IdempotencyRecord, error) {
+	// O registro existente pode expirar entre o INSERT e a leitura: tenta de novo uma vez
+	for attempt := 0; attempt < 2; attempt++ {
+		claimed, err := r.q.ClaimIdempotencyKey(ctx, queries.ClaimIdempotencyKeyParams{
+			UserID:      record.UserID,
+			Key:         record.Key,
+			ClaimID:     record.ClaimID,
+			Fingerprint: record.Fingerprint,
+			CreatedAt:   record.CreatedAt,
+			ExpiresAt:   record.ExpiresAt,
+			HeartbeatAt: staleBefore,
+		})
+		if err != nil {
+			return nil, err
+		}
+		if claimed > 0 {
+			return nil, nil
+		}
+
+		row, err := r.q.FindIdempotencyKey(ctx, queries.FindIdempotencyKeyParams{UserID: record.UserID, Key: record.Key})
+		if err != nil {
+			if errors.Is(err, sql.ErrNoRows) {
+				continue
+			}
+			return nil, err
+		}
+		return &ports.IdempotencyRecord{
+			UserID:      row.UserID,
+			Key:         row.Key,
+			Fingerprint: row.Fingerprint,
+			ClaimID:     row.ClaimID,
+			StatusCode:  int(row.StatusCode.Int16),
+			ContentType: row.ContentType,
+			Body:        row.Body,
+			CreatedAt:   row.CreatedAt,
+			HeartbeatAt: row.HeartbeatAt,
+			ExpiresAt:   row.ExpiresAt,
+		}, nil
+	}
+	return nil, errors.New("idempotency key could not be claimed")
+}
+
+// Renew records at as the heartbeat of the running request holding claimID, reporting
+// whether it still holds the key.
+func (r *IdempotencyRepository) Renew(ctx context.Context, userID uuid.UUID, key string, claimID uuid.UUID, at time.Time) (bool, error) {
+	renewed, err := r.q.RenewIdempotencyKey(ctx, queries.RenewIdempotencyKeyParams{
+		UserID:      userID,
+		Key:         key,
+		ClaimID:     claimID,
+		HeartbeatAt: at,
+	})
+	return renewed > 0, err
+}
+
+// Complete stores the response of the request holding claimID.
+func (r *IdempotencyRepository) Complete(ctx context.Context, userID uuid.UUID, key string, claimID uuid.UUID, statusCode int, contentType string, body []byte) error {
+	return r.q.CompleteIdempotencyKey(ctx, queries.CompleteIdempotencyKeyParams{
+		UserID:      userID,
+		Key:         key,
+		ClaimID:     claimID,
+		StatusCode:  sql.NullInt16{Int16: int16(statusCode), Valid: true},
+		ContentType: contentType,
+		Body:        body,
+	})
+}
+
+// Release deletes the key claimed by a request that failed, unless another request
+// claimed it since.
+func (r *IdempotencyRepository) Release(ctx context.Context, userID uuid.UUID, key string, claimID uuid.UUID) error {
+	return r.q.DeleteIdempotencyKey(ctx, queries.DeleteIdempotencyKeyParams{UserID: userID, Key: key, ClaimID: claimID})
+}
+
+// PurgeExpired deletes the records expired at now.
+func (r *IdempotencyRepository) PurgeExpired(ctx context.Context, now time.Time) (int64, error) {
+	return r.q.DeleteExpiredIdempotencyKeys(ctx, now)
+}
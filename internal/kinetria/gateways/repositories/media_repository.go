@@ -0,0 +1,79 @@
+package repositories
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+
+	"github.com/kinetria/kinetria-back/internal/kinetria/domain/entities"
+	"github.com/kinetria/kinetria-back/internal/kinetria/domain/vos"
+	"github.com/kinetria/kinetria-back/internal/kinetria/gateways/repositories/queries"
+)
+
+// MediaRepository implements ports.MediaRepository using SQLC.
+type MediaRepository struct {
+	db *sql.DB
+	q  *queries.Queries
+}
+
+// NewMediaRepository creates a new MediaRepository.
+func NewMediaRepository(db *sql.DB) *MediaRepository {
+	return &MediaRepository{db: db, q: queries.New(txDB{db})}
+}
+
+// Create inserts the media asset and writes its URL to the owning entity (transactional).
+func (r *MediaRepository) Create(ctx context.Context, asset *entities.MediaAsset) error {
+	tx, err := r.db.BeginTx(ctx, nil)
+	if err != nil {
+		return fmt.Errorf("failed to begin transaction: %w", err)
+	}
+	defer tx.Rollback()
+
+	qtx := r.q.WithTx(tx)
+
+	err = qtx.CreateMediaAsset(ctx, queries.CreateMediaAssetParams{
+		ID:           asset.ID,
+		UploadedBy:   asset.UploadedBy,
+		OwnerType:    asset.OwnerType.String(),
+		OwnerID:      asset.OwnerID,
+		Kind:         asset.Kind.String(),
+		ContentType:  asset.ContentType,
+		SizeBytes:    asset.SizeBytes,
+		StorageKey:   asset.StorageKey,
+		Url:          asset.URL,
+		ThumbnailKey: sql.NullString{String: asset.ThumbnailKey, Valid: asset.ThumbnailKey != ""},
+		ThumbnailUrl: sql.NullString{String: asset.ThumbnailURL, Valid: asset.ThumbnailURL != ""},
+		CreatedAt:    asset.CreatedAt,
+	})
+	if err != nil {
+		return fmt.Errorf("failed to create media asset: %w", err)
+	}
+
+	switch {
+	case asset.OwnerType == vos.MediaOwnerExercise && asset.Kind == vos.MediaKindVideo:
+		err = qtx.UpdateExerciseVideoURL(ctx, queries.UpdateExerciseVideoURLParams{
+			ID:       asset.OwnerID,
+			VideoUrl: sql.NullString{String: asset.URL, Valid: true},
+		})
+	case asset.OwnerType == vos.MediaOwnerExercise:
+		err = qtx.UpdateExerciseThumbnailURL(ctx, queries.UpdateExerciseThumbnailURLParams{
+			ID:           asset.OwnerID,
+			ThumbnailUrl: asset.ThumbnailURL,
+		})
+	case asset.OwnerType == vos.MediaOwnerWorkout:
+		err = qtx.UpdateWorkoutImageURL(ctx, queries.UpdateWorkoutImageURLParams{
+			ID:       asset.OwnerID,
+			ImageUrl: asset.URL,
+		})
+	case asset.OwnerType == vos.MediaOwnerUser:
+		err = qtx.UpdateUserProfileImageURL(ctx, queries.UpdateUserProfileImageURLParams{
+			ID:              asset.OwnerID,
+			ProfileImageUrl: sql.NullString{String: asset.URL, Valid: true},
+		})
+	}
+	if err != nil {
+		return fmt.Errorf("failed to attach media to %s: %w", asset.OwnerType, err)
+	}
+
+	return tx.Commit()
+}
@@ -0,0 +1,46 @@
+-- Os totais vêm dos rollups diários (sessões concluídas); as séries da sessão em andamento
+-- ainda não estão neles e são somadas à parte (usa idx_sessions_active_user).
+-- name: GetAchievementStats :one
+WITH daily AS (
+    SELECT
+        COALESCE(SUM(sessions), 0)::int AS sessions,
+        COALESCE(SUM(sets), 0)::int AS sets,
+        COALESCE(SUM(reps), 0)::bigint AS reps,
+        COALESCE(SUM(volume), 0)::bigint AS volume,
+        COALESCE(MAX(heaviest_set), 0)::int AS heaviest_set
+    FROM user_daily_stats
+    WHERE user_id = $1
+),
+active_sets AS (
+    SELECT sr.id, sr.reps, sr.weight, we.exercise_id
+    FROM sessions s
+    JOIN set_records sr ON sr.session_id = s.id
+    LEFT JOIN workout_exercises we ON sr.workout_exercise_id = we.id
+    WHERE s.user_id = $1
+      AND s.status = 'active'
+      AND sr.status = 'completed'
+),
+exercises AS (
+    SELECT UNNEST(exercise_ids) AS exercise_id FROM user_daily_stats WHERE user_id = $1
+    UNION
+    SELECT exercise_id FROM active_sets WHERE exercise_id IS NOT NULL
+)
+SELECT
+    d.sessions,
+    (d.sets + (SELECT COUNT(*) FROM active_sets))::int AS sets,
+    (d.reps + (SELECT COALESCE(SUM(reps), 0) FROM active_sets))::bigint AS reps,
+    (d.volume + (SELECT COALESCE(SUM(weight::bigint * reps), 0) FROM active_sets))::bigint AS volume,
+    GREATEST(d.heaviest_set, (SELECT COALESCE(MAX(weight), 0) FROM active_sets))::int AS heaviest_set,
+    (SELECT COUNT(*) FROM exercises)::int AS exercises
+FROM daily d;
+
+-- name: ListUserAchievements :many
+SELECT user_id, code, awarded_at
+FROM user_achievements
+WHERE user_id = $1
+ORDER BY awarded_at, code;
+
+-- name: AwardUserAchievement :execrows
+INSERT INTO user_achievements (user_id, code, awarded_at)
+VALUES ($1, $2, $3)
+ON CONFLICT (user_id, code) DO NOTHING;
@@ -0,0 +1,81 @@
+-- name: CreateBodyMeasurement :exec
+INSERT INTO body_measurements (
+    id, user_id, measured_at, weight_grams, body_fat_pct,
+    neck_mm, chest_mm, waist_mm, hips_mm, arm_mm, thigh_mm, calf_mm,
+    notes, created_at, updated_at
+) VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, $13, $14, $15);
+
+-- name: GetBodyMeasurementByID :one
+SELECT id, user_id, measured_at, weight_grams, body_fat_pct,
+       neck_mm, chest_mm, waist_mm, hips_mm, arm_mm, thigh_mm, calf_mm,
+       notes, created_at, updated_at
+FROM body_measurements
+WHERE id = $1 AND user_id = $2;
+
+-- name: UpdateBodyMeasurement :exec
+UPDATE body_measurements
+SET measured_at = $3, weight_grams = $4, body_fat_pct = $5,
+    neck_mm = $6, chest_mm = $7, waist_mm = $8, hips_mm = $9, arm_mm = $10, thigh_mm = $11, calf_mm = $12,
+    notes = $13, updated_at = $14
+WHERE id = $1 AND user_id = $2;
+
+-- name: DeleteBodyMeasurement :execrows
+DELETE FROM body_measurements WHERE id = $1 AND user_id = $2;
+
+-- name: ListBodyMeasurementsByUser :many
+SELECT id, user_id, measured_at, weight_grams, body_fat_pct,
+       neck_mm, chest_mm, waist_mm, hips_mm, arm_mm, thigh_mm, calf_mm,
+       notes, created_at, updated_at
+FROM body_measurements
+WHERE user_id = $1 AND measured_at >= $2 AND measured_at <= $3
+ORDER BY measured_at DESC
+LIMIT $4 OFFSET $5;
+
+-- name: CountBodyMeasurementsByUser :one
+SELECT COUNT(*)::bigint
+FROM body_measurements
+WHERE user_id = $1 AND measured_at >= $2 AND measured_at <= $3;
+
+-- name: ListBodyMeasurementsInRange :many
+SELECT id, user_id, measured_at, weight_grams, body_fat_pct,
+       neck_mm, chest_mm, waist_mm, hips_mm, arm_mm, thigh_mm, calf_mm,
+       notes, created_at, updated_at
+FROM body_measurements
+WHERE user_id = $1 AND measured_at >= $2 AND measured_at <= $3
+ORDER BY measured_at ASC;
+
+-- name: GetLatestBodyWeight :one
+SELECT weight_grams::int
+FROM body_measurements
+WHERE user_id = $1 AND weight_grams IS NOT NULL
+ORDER BY measured_at DESC
+LIMIT 1;
+
+-- name: ListBodyWeights :many
+(SELECT measured_at, weight_grams::int AS weight_grams
+ FROM body_measurements
+ WHERE user_id = $1 AND weight_grams IS NOT NULL AND measured_at < $2
+ ORDER BY measured_at DESC
+ LIMIT 1)
+UNION ALL
+(SELECT measured_at, weight_grams::int AS weight_grams
+ FROM body_measurements
+ WHERE user_id = $1 AND weight_grams IS NOT NULL AND measured_at >= $2 AND measured_at <= $3)
+ORDER BY measured_at;
+
+-- name: GetBodyWeightGoal :one
+SELECT user_id, target_grams, start_grams, target_date, created_at, updated_at
+FROM body_weight_goals
+WHERE user_id = $1;
+
+-- name: UpsertBodyWeightGoal :exec
+INSERT INTO body_weight_goals (user_id, target_grams, start_grams, target_date, created_at, updated_at)
+VALUES ($1, $2, $3, $4, $5, $6)
+ON CONFLICT (user_id) DO UPDATE
+SET target_grams = EXCLUDED.target_grams,
+    start_grams = EXCLUDED.start_grams,
+    target_date = EXCLUDED.target_date,
+    updated_at = EXCLUDED.updated_at;
+
+-- name: DeleteBodyWeightGoal :exec
+DELETE FROM body_weight_goals WHERE user_id = $1;
@@ -24,16 +24,31 @@ ORDER BY we.order_index ASC;
 
 -- name: ListExercises :many
 SELECT
-    id, name, description, thumbnail_url, muscles,
+    id, slug, name, description, thumbnail_url, muscles, tags,
     instructions, tips, difficulty, equipment, video_url,
-    created_at, updated_at
+    created_at, updated_at,
+    COALESCE((
+        SELECT jsonb_agg(jsonb_build_object(
+            'muscle_group', em.muscle_group,
+            'role', em.role,
+            'involvement', em.involvement
+        ) ORDER BY em.involvement DESC, em.muscle_group)
+        FROM exercise_muscles em
+        WHERE em.exercise_id = exercises.id
+    ), '[]'::jsonb)::jsonb AS muscle_groups
 FROM exercises
 WHERE
     ($1::text IS NULL OR name ILIKE '%' || $1::text || '%')
-    AND ($2::text IS NULL OR muscles @> jsonb_build_array($2::text))
+    AND ($2::text IS NULL
+         OR muscles @> jsonb_build_array($2::text)
+         OR EXISTS (SELECT 1 FROM exercise_muscles em WHERE em.exercise_id = exercises.id AND em.muscle_group = $2::text))
     AND ($3::text IS NULL OR equipment = $3::text)
     AND ($4::text IS NULL OR difficulty = $4::text)
-ORDER BY name ASC
+    AND ($7::uuid IS NULL
+         OR EXISTS (SELECT 1 FROM favorite_exercises fe WHERE fe.exercise_id = exercises.id AND fe.user_id = $7::uuid))
+ORDER BY
+    CASE WHEN $8::text = 'popularity' THEN popularity_score END DESC NULLS LAST,
+    name ASC
 LIMIT $5 OFFSET $6;
 
 -- name: CountExercises :one
@@ -41,15 +56,28 @@ SELECT COUNT(*)
 FROM exercises
 WHERE
     ($1::text IS NULL OR name ILIKE '%' || $1::text || '%')
-    AND ($2::text IS NULL OR muscles @> jsonb_build_array($2::text))
+    AND ($2::text IS NULL
+         OR muscles @> jsonb_build_array($2::text)
+         OR EXISTS (SELECT 1 FROM exercise_muscles em WHERE em.exercise_id = exercises.id AND em.muscle_group = $2::text))
     AND ($3::text IS NULL OR equipment = $3::text)
-    AND ($4::text IS NULL OR difficulty = $4::text);
+    AND ($4::text IS NULL OR difficulty = $4::text)
+    AND ($5::uuid IS NULL
+         OR EXISTS (SELECT 1 FROM favorite_exercises fe WHERE fe.exercise_id = exercises.id AND fe.user_id = $5::uuid));
 
 -- name: GetExerciseByID :one
 SELECT
-    id, name, description, thumbnail_url, muscles,
+    id, slug, name, description, thumbnail_url, muscles, tags,
     instructions, tips, difficulty, equipment, video_url,
-    created_at, updated_at
+    created_at, updated_at,
+    COALESCE((
+        SELECT jsonb_agg(jsonb_build_object(
+            'muscle_group', em.muscle_group,
+            'role', em.role,
+            'involvement', em.involvement
+        ) ORDER BY em.involvement DESC, em.muscle_group)
+        FROM exercise_muscles em
+        WHERE em.exercise_id = exercises.id
+    ), '[]'::jsonb)::jsonb AS muscle_groups
 FROM exercises
 WHERE id = $1;
 
@@ -94,3 +122,98 @@ FROM sessions s
 JOIN workout_exercises we ON we.workout_id = s.workout_id AND we.exercise_id = $1
 JOIN set_records sr ON sr.session_id = s.id AND sr.workout_exercise_id = we.id
 WHERE s.user_id = $2 AND s.status = 'completed';
+
+-- name: ListAllExercises :many
+SELECT
+    id, slug, name, description, thumbnail_url, muscles, tags,
+    instructions, tips, difficulty, equipment, video_url,
+    created_at, updated_at,
+    COALESCE((
+        SELECT jsonb_agg(jsonb_build_object(
+            'muscle_group', em.muscle_group,
+            'role', em.role,
+            'involvement', em.involvement
+        ) ORDER BY em.involvement DESC, em.muscle_group)
+        FROM exercise_muscles em
+        WHERE em.exercise_id = exercises.id
+    ), '[]'::jsonb)::jsonb AS muscle_groups
+FROM exercises
+ORDER BY slug ASC;
+
+-- name: UpsertExerciseBySlug :one
+INSERT INTO exercises (
+    id, slug, name, description, thumbnail_url, muscles, tags,
+    instructions, tips, difficulty, equipment, video_url
+) VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12)
+ON CONFLICT (slug) DO UPDATE SET
+    name          = EXCLUDED.name,
+    description   = EXCLUDED.description,
+    thumbnail_url = EXCLUDED.thumbnail_url,
+    muscles       = EXCLUDED.muscles,
+    tags          = EXCLUDED.tags,
+    instructions  = EXCLUDED.instructions,
+    tips          = EXCLUDED.tips,
+    difficulty    = EXCLUDED.difficulty,
+    equipment     = EXCLUDED.equipment,
+    video_url     = EXCLUDED.video_url,
+    updated_at    = NOW()
+RETURNING id;
+
+-- name: DeleteExerciseMuscles :exec
+DELETE FROM exercise_muscles WHERE exercise_id = $1;
+
+-- name: CreateExerciseMuscle :exec
+INSERT INTO exercise_muscles (exercise_id, muscle_group, role, involvement)
+VALUES ($1, $2, $3, $4);
+
+-- name: ListRecentExercisesByUser :many
+WITH usage AS (
+    SELECT we.exercise_id,
+           MAX(s.started_at)    AS last_performed,
+           COUNT(DISTINCT s.id) AS times_performed
+    FROM sessions s
+    JOIN set_records sr ON sr.session_id = s.id AND sr.status = 'completed'
+    JOIN workout_exercises we ON we.id = sr.workout_exercise_id
+    WHERE s.user_id = $1 AND s.status = 'completed'
+    GROUP BY we.exercise_id
+)
+SELECT
+    e.id, e.slug, e.name, e.description, e.thumbnail_url, e.muscles, e.tags,
+    e.instructions, e.tips, e.difficulty, e.equipment, e.video_url,
+    e.created_at, e.updated_at,
+    COALESCE((
+        SELECT jsonb_agg(jsonb_build_object(
+            'muscle_group', em.muscle_group,
+            'role', em.role,
+            'involvement', em.involvement
+        ) ORDER BY em.involvement DESC, em.muscle_group)
+        FROM exercise_muscles em
+        WHERE em.exercise_id = e.id
+    ), '[]'::jsonb)::jsonb AS muscle_groups,
+    u.last_performed,
+    u.times_performed
+FROM usage u
+JOIN exercises e ON e.id = u.exercise_id
+ORDER BY
+    CASE WHEN $2::text = 'frequent' THEN u.times_performed END DESC NULLS LAST,
+    u.last_performed DESC,
+    e.name ASC
+LIMIT $3;
+
+-- name: RefreshExercisePopularity :execrows
+WITH performed AS (
+    SELECT DISTINCT s.id AS session_id, s.started_at, we.exercise_id
+    FROM sessions s
+    JOIN set_records sr ON sr.session_id = s.id AND sr.status = 'completed'
+    JOIN workout_exercises we ON we.id = sr.workout_exercise_id
+    WHERE s.status = 'completed' AND s.started_at >= $2
+),
+scores AS (
+    SELECT exercise_id,
+           SUM(POWER(0.5, EXTRACT(EPOCH FROM ($1::timestamptz - started_at)) / $3::float8)) AS score
+    FROM performed
+    GROUP BY exercise_id
+)
+UPDATE exercises
+SET popularity_score = COALESCE((SELECT sc.score FROM scores sc WHERE sc.exercise_id = exercises.id), 0),
+    popularity_updated_at = $1;
@@ -112,16 +112,31 @@ func (q *Queries) ListExercisesByWorkoutID(ctx context.Context, workoutID uuid.U
 
 const listExercises = `-- name: ListExercises :many
 SELECT
-    id, name, description, thumbnail_url, muscles,
+    id, slug, name, description, thumbnail_url, muscles, tags,
     instructions, tips, difficulty, equipment, video_url,
-    created_at, updated_at
+    created_at, updated_at,
+    COALESCE((
+        SELECT jsonb_agg(jsonb_build_object(
+            'muscle_group', em.muscle_group,
+            'role', em.role,
+            'involvement', em.involvement
+        ) ORDER BY em.involvement DESC, em.muscle_group)
+        FROM exercise_muscles em
+        WHERE em.exercise_id = exercises.id
+    ), '[]'::jsonb)::jsonb AS muscle_groups
 FROM exercises
 WHERE
     ($1::text IS NULL OR name ILIKE '%' || $1::text || '%')
-    AND ($2::text IS NULL OR muscles @> jsonb_build_array($2::text))
+    AND ($2::text IS NULL
+         OR muscles @> jsonb_build_array($2::text)
+         OR EXISTS (SELECT 1 FROM exercise_muscles em WHERE em.exercise_id = exercises.id AND em.muscle_group = $2::text))
     AND ($3::text IS NULL OR equipment = $3::text)
     AND ($4::text IS NULL OR difficulty = $4::text)
-ORDER BY name ASC
+    AND ($7::uuid IS NULL
+         OR EXISTS (SELECT 1 FROM favorite_exercises fe WHERE fe.exercise_id = exercises.id AND fe.user_id = $7::uuid))
+ORDER BY
+    CASE WHEN $8::text = 'popularity' THEN popularity_score END DESC NULLS LAST,
+    name ASC
 LIMIT $5 OFFSET $6
 `
 
@@ -132,9 +147,29 @@ type ListExercisesParams struct {
 	Difficulty  sql.NullString `json:"difficulty"`
 	Limit       int32          `json:"limit"`
 	Offset      int32          `json:"offset"`
+	FavoritesOf uuid.NullUUID  `json:"favorites_of"`
+	Sort        string         `json:"sort"`
 }
 
-func (q *Queries) ListExercises(ctx context.Context, arg ListExercisesParams) ([]Exercise, error) {
+type ListExercisesRow struct {
+	ID           uuid.UUID       `json:"id"`
+	Slug         string          `json:"slug"`
+	Name         string          `json:"name"`
+	Description  string          `json:"description"`
+	ThumbnailUrl string          `json:"thumbnail_url"`
+	Muscles      json.RawMessage `json:"muscles"`
+	Tags         json.RawMessage `json:"tags"`
+	Instructions sql.NullString  `json:"instructions"`
+	Tips         sql.NullString  `json:"tips"`
+	Difficulty   sql.NullString  `json:"difficulty"`
+	Equipment    sql.NullString  `json:"equipment"`
+	VideoUrl     sql.NullString  `json:"video_url"`
+	CreatedAt    time.Time       `json:"created_at"`
+	UpdatedAt    time.Time       `json:"updated_at"`
+	MuscleGroups json.RawMessage `json:"muscle_groups"`
+}
+
+func (q *Queries) ListExercises(ctx context.Context, arg ListExercisesParams) ([]ListExercisesRow, error) {
 	rows, err := q.db.QueryContext(ctx, listExercises,
 		arg.Search,
 		arg.MuscleGroup,
@@ -142,20 +177,24 @@ func (q *Queries) ListExercises(ctx context.Context, arg ListExercisesParams) ([
 		arg.Difficulty,
 		arg.Limit,
 		arg.Offset,
+		arg.FavoritesOf,
+		arg.Sort,
 	)
 	if err != nil {
 		return nil, err
 	}
 	defer rows.Close()
-	var items []Exercise
+	var items []ListExercisesRow
 	for rows.Next() {
-		var i Exercise
+		var i ListExercisesRow
 		if err := rows.Scan(
 			&i.ID,
+			&i.Slug,
 			&i.Name,
 			&i.Description,
 			&i.ThumbnailUrl,
 			&i.Muscles,
+			&i.Tags,
 			&i.Instructions,
 			&i.Tips,
 			&i.Difficulty,
@@ -163,6 +202,7 @@ func (q *Queries) ListExercises(ctx context.Context, arg ListExercisesParams) ([
 			&i.VideoUrl,
 			&i.CreatedAt,
 			&i.UpdatedAt,
+			&i.MuscleGroups,
 		); err != nil {
 			return nil, err
 		}
@@ -182,9 +222,13 @@ SELECT COUNT(*)
 FROM exercises
 WHERE
     ($1::text IS NULL OR name ILIKE '%' || $1::text || '%')
-    AND ($2::text IS NULL OR muscles @> jsonb_build_array($2::text))
+    AND ($2::text IS NULL
+         OR muscles @> jsonb_build_array($2::text)
+         OR EXISTS (SELECT 1 FROM exercise_muscles em WHERE em.exercise_id = exercises.id AND em.muscle_group = $2::text))
     AND ($3::text IS NULL OR equipment = $3::text)
     AND ($4::text IS NULL OR difficulty = $4::text)
+    AND ($5::uuid IS NULL
+         OR EXISTS (SELECT 1 FROM favorite_exercises fe WHERE fe.exercise_id = exercises.id AND fe.user_id = $5::uuid))
 `
 
 type CountExercisesParams struct {
@@ -192,6 +236,7 @@ type CountExercisesParams struct {
 	MuscleGroup sql.NullString `json:"muscle_group"`
 	Equipment   sql.NullString `json:"equipment"`
 	Difficulty  sql.NullString `json:"difficulty"`
+	FavoritesOf uuid.NullUUID  `json:"favorites_of"`
 }
 
 func (q *Queries) CountExercises(ctx context.Context, arg CountExercisesParams) (int64, error) {
@@ -200,6 +245,7 @@ func (q *Queries) CountExercises(ctx context.Context, arg CountExercisesParams)
 		arg.MuscleGroup,
 		arg.Equipment,
 		arg.Difficulty,
+		arg.FavoritesOf,
 	)
 	var count int64
 	err := row.Scan(&count)
@@ -208,22 +254,51 @@ func (q *Queries) CountExercises(ctx context.Context, arg CountExercisesParams)
 
 const getExerciseByID = `-- name: GetExerciseByID :one
 SELECT
-    id, name, description, thumbnail_url, muscles,
+    id, slug, name, description, thumbnail_url, muscles, tags,
     instructions, tips, difficulty, equipment, video_url,
-    created_at, updated_at
+    created_at, updated_at,
+    COALESCE((
+        SELECT jsonb_agg(jsonb_build_object(
+            'muscle_group', em.muscle_group,
+            'role', em.role,
+            'involvement', em.involvement
+        ) ORDER BY em.involvement DESC, em.muscle_group)
+        FROM exercise_muscles em
+        WHERE em.exercise_id = exercises.id
+    ), '[]'::jsonb)::jsonb AS muscle_groups
 FROM exercises
 WHERE id = $1
 `
 
-func (q *Queries) GetExerciseByID(ctx context.Context, id uuid.UUID) (Exercise, error) {
+type GetExerciseByIDRow struct {
+	ID           uuid.UUID       `json:"id"`
+	Slug         string          `json:"slug"`
+	Name         string          `json:"name"`
+	Description  string          `json:"description"`
+	ThumbnailUrl string          `json:"thumbnail_url"`
+	Muscles      json.RawMessage `json:"muscles"`
+	Tags         json.RawMessage `json:"tags"`
+	Instructions sql.NullString  `json:"instructions"`
+	Tips         sql.NullString  `json:"tips"`
+	Difficulty   sql.NullString  `json:"difficulty"`
+	Equipment    sql.NullString  `json:"equipment"`
+	VideoUrl     sql.NullString  `json:"video_url"`
+	CreatedAt    time.Time       `json:"created_at"`
+	UpdatedAt    time.Time       `json:"updated_at"`
+	MuscleGroups json.RawMessage `json:"muscle_groups"`
+}
+
+func (q *Queries) GetExerciseByID(ctx context.Context, id uuid.UUID) (GetExerciseByIDRow, error) {
 	row := q.db.QueryRowContext(ctx, getExerciseByID, id)
-	var i Exercise
+	var i GetExerciseByIDRow
 	err := row.Scan(
 		&i.ID,
+		&i.Slug,
 		&i.Name,
 		&i.Description,
 		&i.ThumbnailUrl,
 		&i.Muscles,
+		&i.Tags,
 		&i.Instructions,
 		&i.Tips,
 		&i.Difficulty,
@@ -231,6 +306,7 @@ func (q *Queries) GetExerciseByID(ctx context.Context, id uuid.UUID) (Exercise,
 		&i.VideoUrl,
 		&i.CreatedAt,
 		&i.UpdatedAt,
+		&i.MuscleGroups,
 	)
 	return i, err
 }
@@ -369,3 +445,299 @@ func (q *Queries) CountExerciseHistory(ctx context.Context, arg CountExerciseHis
 	return count, err
 }
 
+
+const listAllExercises = `-- name: ListAllExercises :many
+SELECT
+    id, slug, name, description, thumbnail_url, muscles, tags,
+    instructions, tips, difficulty, equipment, video_url,
+    created_at, updated_at,
+    COALESCE((
+        SELECT jsonb_agg(jsonb_build_object(
+            'muscle_group', em.muscle_group,
+            'role', em.role,
+            'involvement', em.involvement
+        ) ORDER BY em.involvement DESC, em.muscle_group)
+        FROM exercise_muscles em
+        WHERE em.exercise_id = exercises.id
+    ), '[]'::jsonb)::jsonb AS muscle_groups
+FROM exercises
+ORDER BY slug ASC
+`
+
+type ListAllExercisesRow struct {
+	ID           uuid.UUID       `json:"id"`
+	Slug         string          `json:"slug"`
+	Name         string          `json:"name"`
+	Description  string          `json:"description"`
+	ThumbnailUrl string          `json:"thumbnail_url"`
+	Muscles      json.RawMessage `json:"muscles"`
+	Tags         json.RawMessage `json:"tags"`
+	Instructions sql.NullString  `json:"instructions"`
+	Tips         sql.NullString  `json:"tips"`
+	Difficulty   sql.NullString  `json:"difficulty"`
+	Equipment    sql.NullString  `json:"equipment"`
+	VideoUrl     sql.NullString  `json:"video_url"`
+	CreatedAt    time.Time       `json:"created_at"`
+	UpdatedAt    time.Time       `json:"updated_at"`
+	MuscleGroups json.RawMessage `json:"muscle_groups"`
+}
+
+func (q *Queries) ListAllExercises(ctx context.Context) ([]ListAllExercisesRow, error) {
+	rows, err := q.db.QueryContext(ctx, listAllExercises)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []ListAllExercisesRow
+	for rows.Next() {
+		var i ListAllExercisesRow
+		if err := rows.Scan(
+			&i.ID,
+			&i.Slug,
+			&i.Name,
+			&i.Description,
+			&i.ThumbnailUrl,
+			&i.Muscles,
+			&i.Tags,
+			&i.Instructions,
+			&i.Tips,
+			&i.Difficulty,
+			&i.Equipment,
+			&i.VideoUrl,
+			&i.CreatedAt,
+			&i.UpdatedAt,
+			&i.MuscleGroups,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const upsertExerciseBySlug = `-- name: UpsertExerciseBySlug :one
+INSERT INTO exercises (
+    id, slug, name, description, thumbnail_url, muscles, tags,
+    instructions, tips, difficulty, equipment, video_url
+) VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12)
+ON CONFLICT (slug) DO UPDATE SET
+    name          = EXCLUDED.name,
+    description   = EXCLUDED.description,
+    thumbnail_url = EXCLUDED.thumbnail_url,
+    muscles       = EXCLUDED.muscles,
+    tags          = EXCLUDED.tags,
+    instructions  = EXCLUDED.instructions,
+    tips          = EXCLUDED.tips,
+    difficulty    = EXCLUDED.difficulty,
+    equipment     = EXCLUDED.equipment,
+    video_url     = EXCLUDED.video_url,
+    updated_at    = NOW()
+RETURNING id
+`
+
+type UpsertExerciseBySlugParams struct {
+	ID           uuid.UUID       `json:"id"`
+	Slug         string          `json:"slug"`
+	Name         string          `json:"name"`
+	Description  string          `json:"description"`
+	ThumbnailUrl string          `json:"thumbnail_url"`
+	Muscles      json.RawMessage `json:"muscles"`
+	Tags         json.RawMessage `json:"tags"`
+	Instructions sql.NullString  `json:"instructions"`
+	Tips         sql.NullString  `json:"tips"`
+	Difficulty   sql.NullString  `json:"difficulty"`
+	Equipment    sql.NullString  `json:"equipment"`
+	VideoUrl     sql.NullString  `json:"video_url"`
+}
+
+func (q *Queries) UpsertExerciseBySlug(ctx context.Context, arg UpsertExerciseBySlugParams) (uuid.UUID, error) {
+	row := q.db.QueryRowContext(ctx, upsertExerciseBySlug,
+		arg.ID,
+		arg.Slug,
+		arg.Name,
+		arg.Description,
+		arg.ThumbnailUrl,
+		arg.Muscles,
+		arg.Tags,
+		arg.Instructions,
+		arg.Tips,
+		arg.Difficulty,
+		arg.Equipment,
+		arg.VideoUrl,
+	)
+	var id uuid.UUID
+	err := row.Scan(&id)
+	return id, err
+}
+
+const deleteExerciseMuscles = `-- name: DeleteExerciseMuscles :exec
+DELETE FROM exercise_muscles WHERE exercise_id = $1
+`
+
+func (q *Queries) DeleteExerciseMuscles(ctx context.Context, exerciseID uuid.UUID) error {
+	_, err := q.db.ExecContext(ctx, deleteExerciseMuscles, exerciseID)
+	return err
+}
+
+const createExerciseMuscle = `-- name: CreateExerciseMuscle :exec
+INSERT INTO exercise_muscles (exercise_id, muscle_group, role, involvement)
+VALUES ($1, $2, $3, $4)
+`
+
+type CreateExerciseMuscleParams struct {
+	ExerciseID  uuid.UUID `json:"exercise_id"`
+	MuscleGroup string    `json:"muscle_group"`
+	Role        string    `json:"role"`
+	Involvement string    `json:"involvement"`
+}
+
+func (q *Queries) CreateExerciseMuscle(ctx context.Context, arg CreateExerciseMuscleParams) error {
+	_, err := q.db.ExecContext(ctx, createExerciseMuscle,
+		arg.ExerciseID,
+		arg.MuscleGroup,
+		arg.Role,
+		arg.Involvement,
+	)
+	return err
+}
+
+const listRecentExercisesByUser = `-- name: ListRecentExercisesByUser :many
+WITH usage AS (
+    SELECT we.exercise_id,
+           MAX(s.started_at)    AS last_performed,
+           COUNT(DISTINCT s.id) AS times_performed
+    FROM sessions s
+    JOIN set_records sr ON sr.session_id = s.id AND sr.status = 'completed'
+    JOIN workout_exercises we ON we.id = sr.workout_exercise_id
+    WHERE s.user_id = $1 AND s.status = 'completed'
+    GROUP BY we.exercise_id
+)
+SELECT
+    e.id, e.slug, e.name, e.description, e.thumbnail_url, e.muscles, e.tags,
+    e.instructions, e.tips, e.difficulty, e.equipment, e.video_url,
+    e.created_at, e.updated_at,
+    COALESCE((
+        SELECT jsonb_agg(jsonb_build_object(
+            'muscle_group', em.muscle_group,
+            'role', em.role,
+            'involvement', em.involvement
+        ) ORDER BY em.involvement DESC, em.muscle_group)
+        FROM exercise_muscles em
+        WHERE em.exercise_id = e.id
+    ), '[]'::jsonb)::jsonb AS muscle_groups,
+    u.last_performed,
+    u.times_performed
+FROM usage u
+JOIN exercises e ON e.id = u.exercise_id
+ORDER BY
+    CASE WHEN $2::text = 'frequent' THEN u.times_performed END DESC NULLS LAST,
+    u.last_performed DESC,
+    e.name ASC
+LIMIT $3
+`
+
+type ListRecentExercisesByUserParams struct {
+	UserID uuid.UUID `json:"user_id"`
+	Sort   string    `json:"sort"`
+	Limit  int32     `json:"limit"`
+}
+
+type ListRecentExercisesByUserRow struct {
+	ID             uuid.UUID       `json:"id"`
+	Slug           string          `json:"slug"`
+	Name           string          `json:"name"`
+	Description    string          `json:"description"`
+	ThumbnailUrl   string          `json:"thumbnail_url"`
+	Muscles        json.RawMessage `json:"muscles"`
+	Tags           json.RawMessage `json:"tags"`
+	Instructions   sql.NullString  `json:"instructions"`
+	Tips           sql.NullString  `json:"tips"`
+	Difficulty     sql.NullString  `json:"difficulty"`
+	Equipment      sql.NullString  `json:"equipment"`
+	VideoUrl       sql.NullString  `json:"video_url"`
+	CreatedAt      time.Time       `json:"created_at"`
+	UpdatedAt      time.Time       `json:"updated_at"`
+	MuscleGroups   json.RawMessage `json:"muscle_groups"`
+	LastPerformed  time.Time       `json:"last_performed"`
+	TimesPerformed int64           `json:"times_performed"`
+}
+
+func (q *Queries) ListRecentExercisesByUser(ctx context.Context, arg ListRecentExercisesByUserParams) ([]ListRecentExercisesByUserRow, error) {
+	rows, err := q.db.QueryContext(ctx, listRecentExercisesByUser, arg.UserID, arg.Sort, arg.Limit)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []ListRecentExercisesByUserRow
+	for rows.Next() {
+		var i ListRecentExercisesByUserRow
+		if err := rows.Scan(
+			&i.ID,
+			&i.Slug,
+			&i.Name,
+			&i.Description,
+			&i.ThumbnailUrl,
+			&i.Muscles,
+			&i.Tags,
+			&i.Instructions,
+			&i.Tips,
+			&i.Difficulty,
+			&i.Equipment,
+			&i.VideoUrl,
+			&i.CreatedAt,
+			&i.UpdatedAt,
+			&i.MuscleGroups,
+			&i.LastPerformed,
+			&i.TimesPerformed,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const refreshExercisePopularity = `-- name: RefreshExercisePopularity :execrows
+WITH performed AS (
+    SELECT DISTINCT s.id AS session_id, s.started_at, we.exercise_id
+    FROM sessions s
+    JOIN set_records sr ON sr.session_id = s.id AND sr.status = 'completed'
+    JOIN workout_exercises we ON we.id = sr.workout_exercise_id
+    WHERE s.status = 'completed' AND s.started_at >= $2
+),
+scores AS (
+    SELECT exercise_id,
+           SUM(POWER(0.5, EXTRACT(EPOCH FROM ($1::timestamptz - started_at)) / $3::float8)) AS score
+    FROM performed
+    GROUP BY exercise_id
+)
+UPDATE exercises
+SET popularity_score = COALESCE((SELECT sc.score FROM scores sc WHERE sc.exercise_id = exercises.id), 0),
+    popularity_updated_at = $1
+`
+
+type RefreshExercisePopularityParams struct {
+	Now             time.Time `json:"now"`
+	Since           time.Time `json:"since"`
+	HalfLifeSeconds float64   `json:"half_life_seconds"`
+}
+
+func (q *Queries) RefreshExercisePopularity(ctx context.Context, arg RefreshExercisePopularityParams) (int64, error) {
+	result, err := q.db.ExecContext(ctx, refreshExercisePopularity, arg.Now, arg.Since, arg.HalfLifeSeconds)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected()
+}
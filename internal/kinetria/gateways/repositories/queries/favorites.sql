@@ -0,0 +1,21 @@
+-- name: AddFavoriteExercise :exec
+INSERT INTO favorite_exercises (user_id, exercise_id)
+VALUES ($1, $2)
+ON CONFLICT (user_id, exercise_id) DO NOTHING;
+
+-- name: RemoveFavoriteExercise :exec
+DELETE FROM favorite_exercises WHERE user_id = $1 AND exercise_id = $2;
+
+-- name: ListFavoriteExerciseIDs :many
+SELECT exercise_id FROM favorite_exercises WHERE user_id = $1;
+
+-- name: AddFavoriteWorkout :exec
+INSERT INTO favorite_workouts (user_id, workout_id)
+VALUES ($1, $2)
+ON CONFLICT (user_id, workout_id) DO NOTHING;
+
+-- name: RemoveFavoriteWorkout :exec
+DELETE FROM favorite_workouts WHERE user_id = $1 AND workout_id = $2;
+
+-- name: ListFavoriteWorkoutIDs :many
+SELECT workout_id FROM favorite_workouts WHERE user_id = $1;
@@ -0,0 +1,31 @@
+-- name: CreateGoal :exec
+INSERT INTO goals (
+    id, user_id, type, exercise_id, target_value, start_value, target_date,
+    status, achieved_at, created_at, updated_at
+) VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11);
+
+-- name: GetGoalByID :one
+SELECT id, user_id, type, exercise_id, target_value, start_value, target_date,
+       status, achieved_at, created_at, updated_at
+FROM goals
+WHERE id = $1 AND user_id = $2;
+
+-- name: ListGoalsByUser :many
+SELECT id, user_id, type, exercise_id, target_value, start_value, target_date,
+       status, achieved_at, created_at, updated_at
+FROM goals
+WHERE user_id = $1
+ORDER BY (status = 'active') DESC, created_at DESC;
+
+-- name: UpdateGoal :exec
+UPDATE goals
+SET target_value = $3, target_date = $4, updated_at = $5
+WHERE id = $1 AND user_id = $2;
+
+-- name: DeleteGoal :execrows
+DELETE FROM goals WHERE id = $1 AND user_id = $2;
+
+-- name: MarkGoalAchieved :execrows
+UPDATE goals
+SET status = 'achieved', achieved_at = $3, updated_at = NOW()
+WHERE id = $1 AND user_id = $2 AND status = 'active';
@@ -0,0 +1,39 @@
+-- Insere a chave ou substitui uma expirada ou cuja requisição parou de renová-la antes de $7.
+-- name: ClaimIdempotencyKey :execrows
+INSERT INTO idempotency_keys (user_id, key, claim_id, fingerprint, created_at, heartbeat_at, expires_at)
+VALUES ($1, $2, $3, $4, $5, $5, $6)
+ON CONFLICT (user_id, key) DO UPDATE
+SET claim_id = EXCLUDED.claim_id,
+    fingerprint = EXCLUDED.fingerprint,
+    status_code = NULL,
+    content_type = '',
+    body = NULL,
+    created_at = EXCLUDED.created_at,
+    heartbeat_at = EXCLUDED.heartbeat_at,
+    expires_at = EXCLUDED.expires_at
+WHERE idempotency_keys.expires_at <= EXCLUDED.created_at
+   OR (idempotency_keys.status_code IS NULL AND idempotency_keys.heartbeat_at < $7);
+
+-- Renova a chave enquanto a requisição que a reservou ainda executa.
+-- name: RenewIdempotencyKey :execrows
+UPDATE idempotency_keys
+SET heartbeat_at = $4
+WHERE user_id = $1 AND key = $2 AND claim_id = $3 AND status_code IS NULL;
+
+-- name: FindIdempotencyKey :one
+SELECT user_id, key, fingerprint, claim_id, status_code, content_type, body, created_at, heartbeat_at, expires_at
+FROM idempotency_keys
+WHERE user_id = $1 AND key = $2;
+
+-- name: CompleteIdempotencyKey :exec
+UPDATE idempotency_keys
+SET status_code = $4, content_type = $5, body = $6
+WHERE user_id = $1 AND key = $2 AND claim_id = $3;
+
+-- name: DeleteIdempotencyKey :exec
+DELETE FROM idempotency_keys
+WHERE user_id = $1 AND key = $2 AND claim_id = $3 AND status_code IS NULL;
+
+-- name: DeleteExpiredIdempotencyKeys :execrows
+DELETE FROM idempotency_keys
+WHERE expires_at <= $1;
@@ -0,0 +1,17 @@
+-- name: CreateMediaAsset :exec
+INSERT INTO media_assets (
+    id, uploaded_by, owner_type, owner_id, kind, content_type, size_bytes,
+    storage_key, url, thumbnail_key, thumbnail_url, created_at
+) VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12);
+
+-- name: UpdateExerciseThumbnailURL :exec
+UPDATE exercises SET thumbnail_url = $2, updated_at = NOW() WHERE id = $1;
+
+-- name: UpdateExerciseVideoURL :exec
+UPDATE exercises SET video_url = $2, updated_at = NOW() WHERE id = $1;
+
+-- name: UpdateWorkoutImageURL :exec
+UPDATE workouts SET image_url = $2, updated_at = NOW() WHERE id = $1;
+
+-- name: UpdateUserProfileImageURL :exec
+UPDATE users SET profile_image_url = $2, updated_at = NOW() WHERE id = $1;
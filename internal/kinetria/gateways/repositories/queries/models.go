@@ -25,19 +25,133 @@ type AuditLog struct {
 	UserAgent  sql.NullString        `json:"user_agent"`
 }
 
+type BodyMeasurement struct {
+	ID          uuid.UUID       `json:"id"`
+	UserID      uuid.UUID       `json:"user_id"`
+	MeasuredAt  time.Time       `json:"measured_at"`
+	WeightGrams sql.NullInt32   `json:"weight_grams"`
+	BodyFatPct  sql.NullFloat64 `json:"body_fat_pct"`
+	NeckMm      sql.NullInt32   `json:"neck_mm"`
+	ChestMm     sql.NullInt32   `json:"chest_mm"`
+	WaistMm     sql.NullInt32   `json:"waist_mm"`
+	HipsMm      sql.NullInt32   `json:"hips_mm"`
+	ArmMm       sql.NullInt32   `json:"arm_mm"`
+	ThighMm     sql.NullInt32   `json:"thigh_mm"`
+	CalfMm      sql.NullInt32   `json:"calf_mm"`
+	Notes       string          `json:"notes"`
+	CreatedAt   time.Time       `json:"created_at"`
+	UpdatedAt   time.Time       `json:"updated_at"`
+}
+
+type BodyWeightGoal struct {
+	UserID      uuid.UUID     `json:"user_id"`
+	TargetGrams int32         `json:"target_grams"`
+	StartGrams  sql.NullInt32 `json:"start_grams"`
+	TargetDate  sql.NullTime  `json:"target_date"`
+	CreatedAt   time.Time     `json:"created_at"`
+	UpdatedAt   time.Time     `json:"updated_at"`
+}
+
 type Exercise struct {
-	ID           uuid.UUID       `json:"id"`
-	Name         string          `json:"name"`
-	Description  string          `json:"description"`
-	ThumbnailUrl string          `json:"thumbnail_url"`
-	Muscles      json.RawMessage `json:"muscles"`
-	Instructions sql.NullString  `json:"instructions"`
-	Tips         sql.NullString  `json:"tips"`
-	Difficulty   sql.NullString  `json:"difficulty"`
-	Equipment    sql.NullString  `json:"equipment"`
-	VideoUrl     sql.NullString  `json:"video_url"`
-	CreatedAt    time.Time       `json:"created_at"`
-	UpdatedAt    time.Time       `json:"updated_at"`
+	ID                  uuid.UUID       `json:"id"`
+	Name                string          `json:"name"`
+	Description         string          `json:"description"`
+	ThumbnailUrl        string          `json:"thumbnail_url"`
+	Muscles             json.RawMessage `json:"muscles"`
+	Instructions        sql.NullString  `json:"instructions"`
+	Tips                sql.NullString  `json:"tips"`
+	Difficulty          sql.NullString  `json:"difficulty"`
+	Equipment           sql.NullString  `json:"equipment"`
+	VideoUrl            sql.NullString  `json:"video_url"`
+	CreatedAt           time.Time       `json:"created_at"`
+	UpdatedAt           time.Time       `json:"updated_at"`
+	Slug                string          `json:"slug"`
+	PopularityScore     float64         `json:"popularity_score"`
+	PopularityUpdatedAt sql.NullTime    `json:"popularity_updated_at"`
+	MetValue            sql.NullFloat64 `json:"met_value"`
+	Tags                json.RawMessage `json:"tags"`
+}
+
+type ExerciseMuscle struct {
+	ExerciseID  uuid.UUID `json:"exercise_id"`
+	MuscleGroup string    `json:"muscle_group"`
+	Role        string    `json:"role"`
+	Involvement string    `json:"involvement"`
+}
+
+type FavoriteExercise struct {
+	UserID     uuid.UUID `json:"user_id"`
+	ExerciseID uuid.UUID `json:"exercise_id"`
+	CreatedAt  time.Time `json:"created_at"`
+}
+
+type FavoriteWorkout struct {
+	UserID    uuid.UUID `json:"user_id"`
+	WorkoutID uuid.UUID `json:"workout_id"`
+	CreatedAt time.Time `json:"created_at"`
+}
+
+type Goal struct {
+	ID          uuid.UUID     `json:"id"`
+	UserID      uuid.UUID     `json:"user_id"`
+	Type        string        `json:"type"`
+	ExerciseID  uuid.NullUUID `json:"exercise_id"`
+	TargetValue int64         `json:"target_value"`
+	StartValue  sql.NullInt64 `json:"start_value"`
+	TargetDate  sql.NullTime  `json:"target_date"`
+	Status      string        `json:"status"`
+	AchievedAt  sql.NullTime  `json:"achieved_at"`
+	CreatedAt   time.Time     `json:"created_at"`
+	UpdatedAt   time.Time     `json:"updated_at"`
+}
+
+type IdempotencyKey struct {
+	UserID      uuid.UUID     `json:"user_id"`
+	Key         string        `json:"key"`
+	Fingerprint string        `json:"fingerprint"`
+	ClaimID     uuid.UUID     `json:"claim_id"`
+	StatusCode  sql.NullInt16 `json:"status_code"`
+	ContentType string        `json:"content_type"`
+	Body        []byte        `json:"body"`
+	CreatedAt   time.Time     `json:"created_at"`
+	HeartbeatAt time.Time     `json:"heartbeat_at"`
+	ExpiresAt   time.Time     `json:"expires_at"`
+}
+
+type MediaAsset struct {
+	ID           uuid.UUID      `json:"id"`
+	UploadedBy   uuid.UUID      `json:"uploaded_by"`
+	OwnerType    string         `json:"owner_type"`
+	OwnerID      uuid.UUID      `json:"owner_id"`
+	Kind         string         `json:"kind"`
+	ContentType  string         `json:"content_type"`
+	SizeBytes    int64          `json:"size_bytes"`
+	StorageKey   string         `json:"storage_key"`
+	Url          string         `json:"url"`
+	ThumbnailKey sql.NullString `json:"thumbnail_key"`
+	ThumbnailUrl sql.NullString `json:"thumbnail_url"`
+	CreatedAt    time.Time      `json:"created_at"`
+}
+
+type PlannedRestDay struct {
+	UserID    uuid.UUID `json:"user_id"`
+	Day       time.Time `json:"day"`
+	CreatedAt time.Time `json:"created_at"`
+}
+
+type ReadinessCheckIn struct {
+	ID         uuid.UUID      `json:"id"`
+	UserID     uuid.UUID      `json:"user_id"`
+	SessionID  uuid.NullUUID  `json:"session_id"`
+	SleepHours float64        `json:"sleep_hours"`
+	Stress     int16          `json:"stress"`
+	Soreness   int16          `json:"soreness"`
+	Hrv        sql.NullInt16  `json:"hrv"`
+	Score      int16          `json:"score"`
+	Level      string         `json:"level"`
+	Adjustment sql.NullString `json:"adjustment"`
+	CheckedAt  time.Time      `json:"checked_at"`
+	CreatedAt  time.Time      `json:"created_at"`
 }
 
 type RefreshToken struct {
@@ -50,15 +164,22 @@ type RefreshToken struct {
 }
 
 type Session struct {
-	ID         uuid.UUID    `json:"id"`
-	UserID     uuid.UUID    `json:"user_id"`
-	WorkoutID  uuid.UUID    `json:"workout_id"`
-	Status     string       `json:"status"`
-	Notes      string       `json:"notes"`
-	StartedAt  time.Time    `json:"started_at"`
-	FinishedAt sql.NullTime `json:"finished_at"`
-	CreatedAt  time.Time    `json:"created_at"`
-	UpdatedAt  time.Time    `json:"updated_at"`
+	ID           uuid.UUID       `json:"id"`
+	UserID       uuid.UUID       `json:"user_id"`
+	WorkoutID    uuid.UUID       `json:"workout_id"`
+	Status       string          `json:"status"`
+	Notes        string          `json:"notes"`
+	StartedAt    time.Time       `json:"started_at"`
+	FinishedAt   sql.NullTime    `json:"finished_at"`
+	CreatedAt    time.Time       `json:"created_at"`
+	UpdatedAt    time.Time       `json:"updated_at"`
+	CaloriesKcal sql.NullInt32   `json:"calories_kcal"`
+	SessionRpe   sql.NullInt16   `json:"session_rpe"`
+	Mood         sql.NullInt16   `json:"mood"`
+	Energy       sql.NullInt16   `json:"energy"`
+	SleepQuality sql.NullInt16   `json:"sleep_quality"`
+	Soreness     json.RawMessage `json:"soreness"`
+	ChangeSeq    int64           `json:"change_seq"`
 }
 
 type SetRecord struct {
@@ -72,15 +193,60 @@ type SetRecord struct {
 	WorkoutExerciseID uuid.NullUUID `json:"workout_exercise_id"`
 }
 
+type SyncOperation struct {
+	UserID    uuid.UUID `json:"user_id"`
+	ID        uuid.UUID `json:"id"`
+	Type      string    `json:"type"`
+	EntityID  uuid.UUID `json:"entity_id"`
+	Status    string    `json:"status"`
+	Code      string    `json:"code"`
+	Message   string    `json:"message"`
+	AppliedAt time.Time `json:"applied_at"`
+}
+
 type User struct {
-	ID              uuid.UUID      `json:"id"`
-	Email           string         `json:"email"`
-	Name            string         `json:"name"`
-	PasswordHash    string         `json:"password_hash"`
-	ProfileImageUrl sql.NullString `json:"profile_image_url"`
-	Preferences     []byte         `json:"preferences"`
-	CreatedAt       time.Time      `json:"created_at"`
-	UpdatedAt       time.Time      `json:"updated_at"`
+	ID               uuid.UUID      `json:"id"`
+	Email            string         `json:"email"`
+	Name             string         `json:"name"`
+	PasswordHash     string         `json:"password_hash"`
+	ProfileImageUrl  sql.NullString `json:"profile_image_url"`
+	Preferences      []byte         `json:"preferences"`
+	CreatedAt        time.Time      `json:"created_at"`
+	UpdatedAt        time.Time      `json:"updated_at"`
+	SessionChangeSeq int64          `json:"session_change_seq"`
+}
+
+type UserAchievement struct {
+	UserID    uuid.UUID `json:"user_id"`
+	Code      string    `json:"code"`
+	AwardedAt time.Time `json:"awarded_at"`
+}
+
+type UserDailyStat struct {
+	UserID          uuid.UUID   `json:"user_id"`
+	Day             time.Time   `json:"day"`
+	Sessions        int32       `json:"sessions"`
+	DurationSeconds int64       `json:"duration_seconds"`
+	Calories        int64       `json:"calories"`
+	Sets            int32       `json:"sets"`
+	Reps            int64       `json:"reps"`
+	Volume          int64       `json:"volume"`
+	UpdatedAt       time.Time   `json:"updated_at"`
+	HeaviestSet     int32       `json:"heaviest_set"`
+	ExerciseIds     []uuid.UUID `json:"exercise_ids"`
+}
+
+type UserWeeklyStat struct {
+	UserID          uuid.UUID `json:"user_id"`
+	WeekStart       time.Time `json:"week_start"`
+	Sessions        int32     `json:"sessions"`
+	ActiveDays      int32     `json:"active_days"`
+	DurationSeconds int64     `json:"duration_seconds"`
+	Calories        int64     `json:"calories"`
+	Sets            int32     `json:"sets"`
+	Reps            int64     `json:"reps"`
+	Volume          int64     `json:"volume"`
+	UpdatedAt       time.Time `json:"updated_at"`
 }
 
 type Workout struct {
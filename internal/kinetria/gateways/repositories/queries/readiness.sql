@@ -0,0 +1,35 @@
+-- name: CreateReadinessCheckIn :exec
+INSERT INTO readiness_check_ins (
+    id, user_id, sleep_hours, stress, soreness, hrv, score, level, checked_at
+) VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9);
+
+-- name: ListReadinessCheckInsByUser :many
+SELECT id, user_id, session_id, sleep_hours, stress, soreness, hrv, score, level,
+       adjustment, checked_at, created_at
+FROM readiness_check_ins
+WHERE user_id = $1
+  AND checked_at BETWEEN $2 AND $3
+ORDER BY checked_at;
+
+-- name: FindLatestUnlinkedReadinessCheckIn :one
+SELECT id, user_id, session_id, sleep_hours, stress, soreness, hrv, score, level,
+       adjustment, checked_at, created_at
+FROM readiness_check_ins
+WHERE user_id = $1
+  AND session_id IS NULL
+  AND checked_at >= $2
+ORDER BY checked_at DESC
+LIMIT 1;
+
+-- name: LinkReadinessCheckInSession :exec
+UPDATE readiness_check_ins
+SET session_id = $2, adjustment = $3
+WHERE id = $1;
+
+-- name: AverageReadinessHRV :one
+SELECT AVG(hrv)::float8 AS avg_hrv
+FROM readiness_check_ins
+WHERE user_id = $1
+  AND hrv IS NOT NULL
+  AND checked_at >= $2
+  AND checked_at < $3;
@@ -2,14 +2,32 @@
 INSERT INTO sessions (id, user_id, workout_id, started_at, status, notes, created_at, updated_at)
 VALUES ($1, $2, $3, $4, $5, $6, $7, $8);
 
+-- name: CreateCompletedSession :exec
+INSERT INTO sessions (id, user_id, workout_id, started_at, finished_at, status, notes, created_at, updated_at)
+VALUES ($1, $2, $3, $4, $5, 'completed', $6, $7, $8);
+
+-- Trava a linha do usuário para serializar, na transação, a checagem de sobreposição e a inserção.
+-- name: LockUserForSessionWrite :exec
+SELECT id FROM users WHERE id = $1 FOR UPDATE;
+
+-- Sessões não abandonadas, exceto $4, que se sobrepõem a [$3, $2); uma sessão ativa vai até agora.
+-- name: CountOverlappingSessions :one
+SELECT COUNT(*)
+FROM sessions
+WHERE user_id = $1
+  AND status <> 'abandoned'
+  AND started_at < $2
+  AND COALESCE(finished_at, NOW()) > $3
+  AND id <> $4;
+
 -- name: FindActiveSessionByUserID :one
-SELECT id, user_id, workout_id, started_at, finished_at, status, notes, created_at, updated_at
+SELECT id, user_id, workout_id, started_at, finished_at, status, notes, created_at, updated_at, calories_kcal, session_rpe
 FROM sessions
 WHERE user_id = $1 AND status = 'active'
 LIMIT 1;
 
 -- name: FindSessionByID :one
-SELECT id, user_id, workout_id, started_at, finished_at, status, notes, created_at, updated_at
+SELECT id, user_id, workout_id, started_at, finished_at, status, notes, created_at, updated_at, calories_kcal, session_rpe
 FROM sessions
 WHERE id = $1;
 
@@ -18,6 +36,100 @@ UPDATE sessions
 SET status = $2, finished_at = $3, notes = $4, updated_at = $5
 WHERE id = $1 AND status = 'active';
 
+-- name: UpdateCompletedSessionTimes :execrows
+UPDATE sessions
+SET started_at = $2, finished_at = $3, notes = $4, updated_at = $5
+WHERE id = $1 AND status = 'completed';
+
+-- name: SetSessionCalories :exec
+UPDATE sessions
+SET calories_kcal = $2, updated_at = $3
+WHERE id = $1;
+
+-- name: SetSessionFeedback :exec
+UPDATE sessions
+SET session_rpe = $2, mood = $3, energy = $4, sleep_quality = $5, soreness = $6, updated_at = $7
+WHERE id = $1;
+
+-- name: GetSessionFeedback :one
+SELECT session_rpe, mood, energy, sleep_quality, soreness
+FROM sessions
+WHERE id = $1;
+
+-- name: ListSessionLoads :many
+SELECT
+    s.id,
+    s.started_at,
+    s.workout_id,
+    COALESCE(w.name, '')::text                                                         AS workout_name,
+    DATE(s.started_at AT TIME ZONE $4::text)                                           AS date,
+    (EXTRACT(EPOCH FROM (s.finished_at - s.started_at)) / 60)::int                     AS duration_minutes,
+    s.session_rpe,
+    s.mood,
+    s.energy,
+    s.sleep_quality,
+    COALESCE(SUM(sr.weight::bigint * sr.reps) FILTER (WHERE sr.status = 'completed'), 0)::bigint AS tonnage
+FROM sessions s
+LEFT JOIN workouts w ON w.id = s.workout_id
+LEFT JOIN set_records sr ON sr.session_id = s.id
+WHERE s.user_id = $1
+  AND s.status = 'completed'
+  AND s.finished_at IS NOT NULL
+  AND s.started_at >= $2
+  AND s.started_at < $3
+GROUP BY s.id, w.id
+ORDER BY s.started_at;
+
+-- name: GetSessionEffort :one
+SELECT
+    s.started_at,
+    w.type AS workout_type,
+    MAX(sr.recorded_at) FILTER (WHERE sr.status = 'completed')::timestamptz AS last_set_at
+FROM sessions s
+JOIN workouts w ON w.id = s.workout_id
+LEFT JOIN set_records sr ON sr.session_id = s.id
+WHERE s.id = $1
+GROUP BY s.id, s.started_at, w.type;
+
+-- name: GetSessionExerciseEffort :many
+SELECT
+    e.met_value,
+    COUNT(*)::bigint AS completed_sets
+FROM set_records sr
+JOIN workout_exercises we ON we.id = sr.workout_exercise_id
+JOIN exercises e ON e.id = we.exercise_id
+WHERE sr.session_id = $1
+  AND sr.status = 'completed'
+GROUP BY e.id, e.met_value;
+
+-- name: ListSessionSummarySets :many
+SELECT
+    we.id          AS workout_exercise_id,
+    we.exercise_id,
+    e.name         AS exercise_name,
+    we.sets        AS prescribed_sets,
+    we.reps        AS prescribed_reps,
+    sr.set_number,
+    sr.weight,
+    sr.reps,
+    sr.status
+FROM sessions s
+JOIN workout_exercises we ON we.workout_id = s.workout_id
+JOIN exercises e ON e.id = we.exercise_id
+LEFT JOIN set_records sr ON sr.session_id = s.id AND sr.workout_exercise_id = we.id
+WHERE s.id = $1
+ORDER BY we.order_index, we.id, sr.set_number;
+
+-- name: FindPreviousCompletedSession :one
+SELECT id, user_id, workout_id, started_at, finished_at, status, notes, created_at, updated_at, calories_kcal, session_rpe
+FROM sessions
+WHERE user_id = $1
+  AND workout_id = $2
+  AND status = 'completed'
+  AND started_at < $3
+ORDER BY started_at DESC
+LIMIT 1;
+
 -- name: GetCompletedSessionsByDateRange :many
 SELECT 
     id, 
@@ -28,42 +140,43 @@ SELECT
     started_at, 
     finished_at, 
     created_at, 
-    updated_at
+    updated_at,
+    calories_kcal,
+    session_rpe
 FROM sessions
 WHERE user_id = $1
   AND status = 'completed'
-  AND DATE(started_at) BETWEEN $2 AND $3
+  AND DATE(started_at AT TIME ZONE $4::text) BETWEEN $2 AND $3
 ORDER BY started_at DESC;
 
 
+-- As agregações de estatísticas leem do rollup diário (user_daily_stats, migration 024).
 -- name: GetStatsByUserAndPeriod :one
 SELECT
-    COUNT(*)::bigint AS total_workouts,
-    COALESCE(SUM(EXTRACT(EPOCH FROM (finished_at - started_at)) / 60), 0)::bigint AS total_time_minutes
-FROM sessions
+    COALESCE(SUM(sessions), 0)::bigint AS total_workouts,
+    COALESCE(SUM(duration_seconds) / 60, 0)::bigint AS total_time_minutes,
+    COALESCE(SUM(calories), 0)::bigint AS total_calories
+FROM user_daily_stats
 WHERE user_id = $1
-  AND status = 'completed'
-  AND started_at >= $2
-  AND started_at <= $3;
+  AND day >= DATE($2::timestamptz AT TIME ZONE user_timezone($1))
+  AND day <= DATE($3::timestamptz AT TIME ZONE user_timezone($1));
 
 -- name: GetFrequencyByUserAndPeriod :many
 SELECT
-    DATE(started_at) AS date,
-    COUNT(*)::bigint AS count
-FROM sessions
+    day AS date,
+    sessions::bigint AS count
+FROM user_daily_stats
 WHERE user_id = $1
-  AND status = 'completed'
-  AND started_at >= $2
-  AND started_at <= $3
-GROUP BY DATE(started_at)
-ORDER BY date;
+  AND sessions > 0
+  AND day >= DATE($2::timestamptz AT TIME ZONE $4::text)
+  AND day <= DATE($3::timestamptz AT TIME ZONE $4::text)
+ORDER BY day;
 
 -- name: GetSessionsForStreak :many
 SELECT
-    DATE(started_at) AS date
-FROM sessions
+    day AS date
+FROM user_daily_stats
 WHERE user_id = $1
-  AND status = 'completed'
-  AND started_at >= NOW() - INTERVAL '365 days'
-GROUP BY DATE(started_at)
-ORDER BY date DESC;
+  AND sessions > 0
+  AND day >= DATE((NOW() - INTERVAL '365 days') AT TIME ZONE $2::text)
+ORDER BY day DESC;
@@ -8,6 +8,7 @@ package queries
 import (
 	"context"
 	"database/sql"
+	"encoding/json"
 	"time"
 
 	"github.com/google/uuid"
@@ -43,25 +44,125 @@ func (q *Queries) CreateSession(ctx context.Context, arg CreateSessionParams) er
 	return err
 }
 
-const findActiveSessionByUserID = `-- name: FindActiveSessionByUserID :one
-SELECT id, user_id, workout_id, started_at, finished_at, status, notes, created_at, updated_at
-FROM sessions
-WHERE user_id = $1 AND status = 'active'
-LIMIT 1
+const createCompletedSession = `-- name: CreateCompletedSession :exec
+INSERT INTO sessions (id, user_id, workout_id, started_at, finished_at, status, notes, created_at, updated_at)
+VALUES ($1, $2, $3, $4, $5, 'completed', $6, $7, $8)
 `
 
-type FindActiveSessionByUserIDRow struct {
+type CreateCompletedSessionParams struct {
 	ID         uuid.UUID    `json:"id"`
 	UserID     uuid.UUID    `json:"user_id"`
 	WorkoutID  uuid.UUID    `json:"workout_id"`
 	StartedAt  time.Time    `json:"started_at"`
 	FinishedAt sql.NullTime `json:"finished_at"`
-	Status     string       `json:"status"`
 	Notes      string       `json:"notes"`
 	CreatedAt  time.Time    `json:"created_at"`
 	UpdatedAt  time.Time    `json:"updated_at"`
 }
 
+func (q *Queries) CreateCompletedSession(ctx context.Context, arg CreateCompletedSessionParams) error {
+	_, err := q.db.ExecContext(ctx, createCompletedSession,
+		arg.ID,
+		arg.UserID,
+		arg.WorkoutID,
+		arg.StartedAt,
+		arg.FinishedAt,
+		arg.Notes,
+		arg.CreatedAt,
+		arg.UpdatedAt,
+	)
+	return err
+}
+
+const updateCompletedSessionTimes = `-- name: UpdateCompletedSessionTimes :execrows
+UPDATE sessions
+SET started_at = $2, finished_at = $3, notes = $4, updated_at = $5
+WHERE id = $1 AND status = 'completed'
+`
+
+type UpdateCompletedSessionTimesParams struct {
+	ID         uuid.UUID    `json:"id"`
+	StartedAt  time.Time    `json:"started_at"`
+	FinishedAt sql.NullTime `json:"finished_at"`
+	Notes      string       `json:"notes"`
+	UpdatedAt  time.Time    `json:"updated_at"`
+}
+
+func (q *Queries) UpdateCompletedSessionTimes(ctx context.Context, arg UpdateCompletedSessionTimesParams) (int64, error) {
+	result, err := q.db.ExecContext(ctx, updateCompletedSessionTimes,
+		arg.ID,
+		arg.StartedAt,
+		arg.FinishedAt,
+		arg.Notes,
+		arg.UpdatedAt,
+	)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected()
+}
+
+const lockUserForSessionWrite = `-- name: LockUserForSessionWrite :exec
+SELECT id FROM users WHERE id = $1 FOR UPDATE
+`
+
+// Trava a linha do usuário para serializar, na transação, a checagem de sobreposição e a inserção.
+func (q *Queries) LockUserForSessionWrite(ctx context.Context, id uuid.UUID) error {
+	_, err := q.db.ExecContext(ctx, lockUserForSessionWrite, id)
+	return err
+}
+
+const countOverlappingSessions = `-- name: CountOverlappingSessions :one
+SELECT COUNT(*)
+FROM sessions
+WHERE user_id = $1
+  AND status <> 'abandoned'
+  AND started_at < $2
+  AND COALESCE(finished_at, NOW()) > $3
+  AND id <> $4
+`
+
+type CountOverlappingSessionsParams struct {
+	UserID     uuid.UUID `json:"user_id"`
+	StartedAt  time.Time `json:"started_at"`
+	FinishedAt time.Time `json:"finished_at"`
+	ID         uuid.UUID `json:"id"`
+}
+
+// Sessões não abandonadas, exceto $4, que se sobrepõem a [$3, $2); uma sessão ativa vai até agora.
+func (q *Queries) CountOverlappingSessions(ctx context.Context, arg CountOverlappingSessionsParams) (int64, error) {
+	row := q.db.QueryRowContext(ctx, countOverlappingSessions,
+		arg.UserID,
+		arg.StartedAt,
+		arg.FinishedAt,
+		arg.ID,
+	)
+	var count int64
+	err := row.Scan(&count)
+	return count, err
+}
+
+const findActiveSessionByUserID = `-- name: FindActiveSessionByUserID :one
+SELECT id, user_id, workout_id, started_at, finished_at, status, notes, created_at, updated_at, calories_kcal, session_rpe
+FROM sessions
+WHERE user_id = $1 AND status = 'active'
+LIMIT 1
+`
+
+type FindActiveSessionByUserIDRow struct {
+	ID           uuid.UUID     `json:"id"`
+	UserID       uuid.UUID     `json:"user_id"`
+	WorkoutID    uuid.UUID     `json:"workout_id"`
+	StartedAt    time.Time     `json:"started_at"`
+	FinishedAt   sql.NullTime  `json:"finished_at"`
+	Status       string        `json:"status"`
+	Notes        string        `json:"notes"`
+	CreatedAt    time.Time     `json:"created_at"`
+	UpdatedAt    time.Time     `json:"updated_at"`
+	CaloriesKcal sql.NullInt32 `json:"calories_kcal"`
+	SessionRpe   sql.NullInt16 `json:"session_rpe"`
+}
+
 func (q *Queries) FindActiveSessionByUserID(ctx context.Context, userID uuid.UUID) (FindActiveSessionByUserIDRow, error) {
 	row := q.db.QueryRowContext(ctx, findActiveSessionByUserID, userID)
 	var i FindActiveSessionByUserIDRow
@@ -75,26 +176,30 @@ func (q *Queries) FindActiveSessionByUserID(ctx context.Context, userID uuid.UUI
 		&i.Notes,
 		&i.CreatedAt,
 		&i.UpdatedAt,
+		&i.CaloriesKcal,
+		&i.SessionRpe,
 	)
 	return i, err
 }
 
 const findSessionByID = `-- name: FindSessionByID :one
-SELECT id, user_id, workout_id, started_at, finished_at, status, notes, created_at, updated_at
+SELECT id, user_id, workout_id, started_at, finished_at, status, notes, created_at, updated_at, calories_kcal, session_rpe
 FROM sessions
 WHERE id = $1
 `
 
 type FindSessionByIDRow struct {
-	ID         uuid.UUID    `json:"id"`
-	UserID     uuid.UUID    `json:"user_id"`
-	WorkoutID  uuid.UUID    `json:"workout_id"`
-	StartedAt  time.Time    `json:"started_at"`
-	FinishedAt sql.NullTime `json:"finished_at"`
-	Status     string       `json:"status"`
-	Notes      string       `json:"notes"`
-	CreatedAt  time.Time    `json:"created_at"`
-	UpdatedAt  time.Time    `json:"updated_at"`
+	ID           uuid.UUID     `json:"id"`
+	UserID       uuid.UUID     `json:"user_id"`
+	WorkoutID    uuid.UUID     `json:"workout_id"`
+	StartedAt    time.Time     `json:"started_at"`
+	FinishedAt   sql.NullTime  `json:"finished_at"`
+	Status       string        `json:"status"`
+	Notes        string        `json:"notes"`
+	CreatedAt    time.Time     `json:"created_at"`
+	UpdatedAt    time.Time     `json:"updated_at"`
+	CaloriesKcal sql.NullInt32 `json:"calories_kcal"`
+	SessionRpe   sql.NullInt16 `json:"session_rpe"`
 }
 
 func (q *Queries) FindSessionByID(ctx context.Context, id uuid.UUID) (FindSessionByIDRow, error) {
@@ -110,6 +215,8 @@ func (q *Queries) FindSessionByID(ctx context.Context, id uuid.UUID) (FindSessio
 		&i.Notes,
 		&i.CreatedAt,
 		&i.UpdatedAt,
+		&i.CaloriesKcal,
+		&i.SessionRpe,
 	)
 	return i, err
 }
@@ -124,11 +231,13 @@ SELECT
     started_at, 
     finished_at, 
     created_at, 
-    updated_at
+    updated_at,
+    calories_kcal,
+    session_rpe
 FROM sessions
 WHERE user_id = $1
   AND status = 'completed'
-  AND DATE(started_at) BETWEEN $2 AND $3
+  AND DATE(started_at AT TIME ZONE $4::text) BETWEEN $2 AND $3
 ORDER BY started_at DESC
 `
 
@@ -136,10 +245,11 @@ type GetCompletedSessionsByDateRangeParams struct {
 	UserID      uuid.UUID `json:"user_id"`
 	StartedAt   time.Time `json:"started_at"`
 	StartedAt_2 time.Time `json:"started_at_2"`
+	Timezone    string    `json:"timezone"`
 }
 
 func (q *Queries) GetCompletedSessionsByDateRange(ctx context.Context, arg GetCompletedSessionsByDateRangeParams) ([]Session, error) {
-	rows, err := q.db.QueryContext(ctx, getCompletedSessionsByDateRange, arg.UserID, arg.StartedAt, arg.StartedAt_2)
+	rows, err := q.db.QueryContext(ctx, getCompletedSessionsByDateRange, arg.UserID, arg.StartedAt, arg.StartedAt_2, arg.Timezone)
 	if err != nil {
 		return nil, err
 	}
@@ -157,6 +267,8 @@ func (q *Queries) GetCompletedSessionsByDateRange(ctx context.Context, arg GetCo
 			&i.FinishedAt,
 			&i.CreatedAt,
 			&i.UpdatedAt,
+			&i.CaloriesKcal,
+			&i.SessionRpe,
 		); err != nil {
 			return nil, err
 		}
@@ -199,16 +311,349 @@ func (q *Queries) UpdateSessionStatus(ctx context.Context, arg UpdateSessionStat
 	return result.RowsAffected()
 }
 
-// GetStatsByUserAndPeriod
-const getStatsByUserAndPeriod = `-- name: GetStatsByUserAndPeriod :one
+const setSessionCalories = `-- name: SetSessionCalories :exec
+UPDATE sessions
+SET calories_kcal = $2, updated_at = $3
+WHERE id = $1
+`
+
+type SetSessionCaloriesParams struct {
+	ID           uuid.UUID     `json:"id"`
+	CaloriesKcal sql.NullInt32 `json:"calories_kcal"`
+	UpdatedAt    time.Time     `json:"updated_at"`
+}
+
+func (q *Queries) SetSessionCalories(ctx context.Context, arg SetSessionCaloriesParams) error {
+	_, err := q.db.ExecContext(ctx, setSessionCalories, arg.ID, arg.CaloriesKcal, arg.UpdatedAt)
+	return err
+}
+
+const setSessionFeedback = `-- name: SetSessionFeedback :exec
+UPDATE sessions
+SET session_rpe = $2, mood = $3, energy = $4, sleep_quality = $5, soreness = $6, updated_at = $7
+WHERE id = $1
+`
+
+type SetSessionFeedbackParams struct {
+	ID           uuid.UUID       `json:"id"`
+	SessionRpe   sql.NullInt16   `json:"session_rpe"`
+	Mood         sql.NullInt16   `json:"mood"`
+	Energy       sql.NullInt16   `json:"energy"`
+	SleepQuality sql.NullInt16   `json:"sleep_quality"`
+	Soreness     json.RawMessage `json:"soreness"`
+	UpdatedAt    time.Time       `json:"updated_at"`
+}
+
+func (q *Queries) SetSessionFeedback(ctx context.Context, arg SetSessionFeedbackParams) error {
+	_, err := q.db.ExecContext(ctx, setSessionFeedback,
+		arg.ID,
+		arg.SessionRpe,
+		arg.Mood,
+		arg.Energy,
+		arg.SleepQuality,
+		arg.Soreness,
+		arg.UpdatedAt,
+	)
+	return err
+}
+
+const getSessionFeedback = `-- name: GetSessionFeedback :one
+SELECT session_rpe, mood, energy, sleep_quality, soreness
+FROM sessions
+WHERE id = $1
+`
+
+type GetSessionFeedbackRow struct {
+	SessionRpe   sql.NullInt16   `json:"session_rpe"`
+	Mood         sql.NullInt16   `json:"mood"`
+	Energy       sql.NullInt16   `json:"energy"`
+	SleepQuality sql.NullInt16   `json:"sleep_quality"`
+	Soreness     json.RawMessage `json:"soreness"`
+}
+
+func (q *Queries) GetSessionFeedback(ctx context.Context, id uuid.UUID) (GetSessionFeedbackRow, error) {
+	row := q.db.QueryRowContext(ctx, getSessionFeedback, id)
+	var i GetSessionFeedbackRow
+	err := row.Scan(
+		&i.SessionRpe,
+		&i.Mood,
+		&i.Energy,
+		&i.SleepQuality,
+		&i.Soreness,
+	)
+	return i, err
+}
+
+const listSessionLoads = `-- name: ListSessionLoads :many
+SELECT
+    s.id,
+    s.started_at,
+    s.workout_id,
+    COALESCE(w.name, '')::text                                                         AS workout_name,
+    DATE(s.started_at AT TIME ZONE $4::text)                                           AS date,
+    (EXTRACT(EPOCH FROM (s.finished_at - s.started_at)) / 60)::int                     AS duration_minutes,
+    s.session_rpe,
+    s.mood,
+    s.energy,
+    s.sleep_quality,
+    COALESCE(SUM(sr.weight::bigint * sr.reps) FILTER (WHERE sr.status = 'completed'), 0)::bigint AS tonnage
+FROM sessions s
+LEFT JOIN workouts w ON w.id = s.workout_id
+LEFT JOIN set_records sr ON sr.session_id = s.id
+WHERE s.user_id = $1
+  AND s.status = 'completed'
+  AND s.finished_at IS NOT NULL
+  AND s.started_at >= $2
+  AND s.started_at < $3
+GROUP BY s.id, w.id
+ORDER BY s.started_at
+`
+
+type ListSessionLoadsParams struct {
+	UserID      uuid.UUID `json:"user_id"`
+	StartedAt   time.Time `json:"started_at"`
+	StartedAt_2 time.Time `json:"started_at_2"`
+	Timezone    string    `json:"timezone"`
+}
+
+type ListSessionLoadsRow struct {
+	ID              uuid.UUID     `json:"id"`
+	StartedAt       time.Time     `json:"started_at"`
+	WorkoutID       uuid.UUID     `json:"workout_id"`
+	WorkoutName     string        `json:"workout_name"`
+	Date            time.Time     `json:"date"`
+	DurationMinutes int32         `json:"duration_minutes"`
+	SessionRpe      sql.NullInt16 `json:"session_rpe"`
+	Mood            sql.NullInt16 `json:"mood"`
+	Energy          sql.NullInt16 `json:"energy"`
+	SleepQuality    sql.NullInt16 `json:"sleep_quality"`
+	Tonnage         int64         `json:"tonnage"`
+}
+
+func (q *Queries) ListSessionLoads(ctx context.Context, arg ListSessionLoadsParams) ([]ListSessionLoadsRow, error) {
+	rows, err := q.db.QueryContext(ctx, listSessionLoads, arg.UserID, arg.StartedAt, arg.StartedAt_2, arg.Timezone)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []ListSessionLoadsRow
+	for rows.Next() {
+		var i ListSessionLoadsRow
+		if err := rows.Scan(
+			&i.ID,
+			&i.StartedAt,
+			&i.WorkoutID,
+			&i.WorkoutName,
+			&i.Date,
+			&i.DurationMinutes,
+			&i.SessionRpe,
+			&i.Mood,
+			&i.Energy,
+			&i.SleepQuality,
+			&i.Tonnage,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const getSessionEffort = `-- name: GetSessionEffort :one
 SELECT
-    COUNT(*)::bigint AS total_workouts,
-    COALESCE(SUM(EXTRACT(EPOCH FROM (finished_at - started_at)) / 60), 0)::bigint AS total_time_minutes
+    s.started_at,
+    w.type AS workout_type,
+    MAX(sr.recorded_at) FILTER (WHERE sr.status = 'completed')::timestamptz AS last_set_at
+FROM sessions s
+JOIN workouts w ON w.id = s.workout_id
+LEFT JOIN set_records sr ON sr.session_id = s.id
+WHERE s.id = $1
+GROUP BY s.id, s.started_at, w.type
+`
+
+type GetSessionEffortRow struct {
+	StartedAt   time.Time    `json:"started_at"`
+	WorkoutType string       `json:"workout_type"`
+	LastSetAt   sql.NullTime `json:"last_set_at"`
+}
+
+func (q *Queries) GetSessionEffort(ctx context.Context, id uuid.UUID) (GetSessionEffortRow, error) {
+	row := q.db.QueryRowContext(ctx, getSessionEffort, id)
+	var i GetSessionEffortRow
+	err := row.Scan(&i.StartedAt, &i.WorkoutType, &i.LastSetAt)
+	return i, err
+}
+
+const getSessionExerciseEffort = `-- name: GetSessionExerciseEffort :many
+SELECT
+    e.met_value,
+    COUNT(*)::bigint AS completed_sets
+FROM set_records sr
+JOIN workout_exercises we ON we.id = sr.workout_exercise_id
+JOIN exercises e ON e.id = we.exercise_id
+WHERE sr.session_id = $1
+  AND sr.status = 'completed'
+GROUP BY e.id, e.met_value
+`
+
+type GetSessionExerciseEffortRow struct {
+	MetValue      sql.NullFloat64 `json:"met_value"`
+	CompletedSets int64           `json:"completed_sets"`
+}
+
+func (q *Queries) GetSessionExerciseEffort(ctx context.Context, sessionID uuid.UUID) ([]GetSessionExerciseEffortRow, error) {
+	rows, err := q.db.QueryContext(ctx, getSessionExerciseEffort, sessionID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []GetSessionExerciseEffortRow
+	for rows.Next() {
+		var i GetSessionExerciseEffortRow
+		if err := rows.Scan(&i.MetValue, &i.CompletedSets); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listSessionSummarySets = `-- name: ListSessionSummarySets :many
+SELECT
+    we.id          AS workout_exercise_id,
+    we.exercise_id,
+    e.name         AS exercise_name,
+    we.sets        AS prescribed_sets,
+    we.reps        AS prescribed_reps,
+    sr.set_number,
+    sr.weight,
+    sr.reps,
+    sr.status
+FROM sessions s
+JOIN workout_exercises we ON we.workout_id = s.workout_id
+JOIN exercises e ON e.id = we.exercise_id
+LEFT JOIN set_records sr ON sr.session_id = s.id AND sr.workout_exercise_id = we.id
+WHERE s.id = $1
+ORDER BY we.order_index, we.id, sr.set_number
+`
+
+type ListSessionSummarySetsRow struct {
+	WorkoutExerciseID uuid.UUID      `json:"workout_exercise_id"`
+	ExerciseID        uuid.UUID      `json:"exercise_id"`
+	ExerciseName      string         `json:"exercise_name"`
+	PrescribedSets    int32          `json:"prescribed_sets"`
+	PrescribedReps    string         `json:"prescribed_reps"`
+	SetNumber         sql.NullInt32  `json:"set_number"`
+	Weight            sql.NullInt32  `json:"weight"`
+	Reps              sql.NullInt32  `json:"reps"`
+	Status            sql.NullString `json:"status"`
+}
+
+func (q *Queries) ListSessionSummarySets(ctx context.Context, sessionID uuid.UUID) ([]ListSessionSummarySetsRow, error) {
+	rows, err := q.db.QueryContext(ctx, listSessionSummarySets, sessionID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []ListSessionSummarySetsRow
+	for rows.Next() {
+		var i ListSessionSummarySetsRow
+		if err := rows.Scan(
+			&i.WorkoutExerciseID,
+			&i.ExerciseID,
+			&i.ExerciseName,
+			&i.PrescribedSets,
+			&i.PrescribedReps,
+			&i.SetNumber,
+			&i.Weight,
+			&i.Reps,
+			&i.Status,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const findPreviousCompletedSession = `-- name: FindPreviousCompletedSession :one
+SELECT id, user_id, workout_id, started_at, finished_at, status, notes, created_at, updated_at, calories_kcal, session_rpe
 FROM sessions
 WHERE user_id = $1
+  AND workout_id = $2
   AND status = 'completed'
-  AND started_at >= $2
-  AND started_at <= $3
+  AND started_at < $3
+ORDER BY started_at DESC
+LIMIT 1
+`
+
+type FindPreviousCompletedSessionParams struct {
+	UserID    uuid.UUID `json:"user_id"`
+	WorkoutID uuid.UUID `json:"workout_id"`
+	StartedAt time.Time `json:"started_at"`
+}
+
+type FindPreviousCompletedSessionRow struct {
+	ID           uuid.UUID     `json:"id"`
+	UserID       uuid.UUID     `json:"user_id"`
+	WorkoutID    uuid.UUID     `json:"workout_id"`
+	StartedAt    time.Time     `json:"started_at"`
+	FinishedAt   sql.NullTime  `json:"finished_at"`
+	Status       string        `json:"status"`
+	Notes        string        `json:"notes"`
+	CreatedAt    time.Time     `json:"created_at"`
+	UpdatedAt    time.Time     `json:"updated_at"`
+	CaloriesKcal sql.NullInt32 `json:"calories_kcal"`
+	SessionRpe   sql.NullInt16 `json:"session_rpe"`
+}
+
+func (q *Queries) FindPreviousCompletedSession(ctx context.Context, arg FindPreviousCompletedSessionParams) (FindPreviousCompletedSessionRow, error) {
+	row := q.db.QueryRowContext(ctx, findPreviousCompletedSession, arg.UserID, arg.WorkoutID, arg.StartedAt)
+	var i FindPreviousCompletedSessionRow
+	err := row.Scan(
+		&i.ID,
+		&i.UserID,
+		&i.WorkoutID,
+		&i.StartedAt,
+		&i.FinishedAt,
+		&i.Status,
+		&i.Notes,
+		&i.CreatedAt,
+		&i.UpdatedAt,
+		&i.CaloriesKcal,
+		&i.SessionRpe,
+	)
+	return i, err
+}
+
+// GetStatsByUserAndPeriod
+const getStatsByUserAndPeriod = `-- name: GetStatsByUserAndPeriod :one
+SELECT
+    COALESCE(SUM(sessions), 0)::bigint AS total_workouts,
+    COALESCE(SUM(duration_seconds) / 60, 0)::bigint AS total_time_minutes,
+    COALESCE(SUM(calories), 0)::bigint AS total_calories
+FROM user_daily_stats
+WHERE user_id = $1
+  AND day >= DATE($2::timestamptz AT TIME ZONE user_timezone($1))
+  AND day <= DATE($3::timestamptz AT TIME ZONE user_timezone($1))
 `
 
 type GetStatsByUserAndPeriodParams struct {
@@ -220,33 +665,34 @@ StartedAt_2 time.Time `json:"started_at_2"`
 type GetStatsByUserAndPeriodRow struct {
 TotalWorkouts    int64 `json:"total_workouts"`
 TotalTimeMinutes int64 `json:"total_time_minutes"`
+TotalCalories    int64 `json:"total_calories"`
 }
 
 func (q *Queries) GetStatsByUserAndPeriod(ctx context.Context, arg GetStatsByUserAndPeriodParams) (GetStatsByUserAndPeriodRow, error) {
 row := q.db.QueryRowContext(ctx, getStatsByUserAndPeriod, arg.UserID, arg.StartedAt, arg.StartedAt_2)
 var i GetStatsByUserAndPeriodRow
-err := row.Scan(&i.TotalWorkouts, &i.TotalTimeMinutes)
+err := row.Scan(&i.TotalWorkouts, &i.TotalTimeMinutes, &i.TotalCalories)
 return i, err
 }
 
 // GetFrequencyByUserAndPeriod
 const getFrequencyByUserAndPeriod = `-- name: GetFrequencyByUserAndPeriod :many
 SELECT
-    DATE(started_at) AS date,
-    COUNT(*)::bigint AS count
-FROM sessions
+    day AS date,
+    sessions::bigint AS count
+FROM user_daily_stats
 WHERE user_id = $1
-  AND status = 'completed'
-  AND started_at >= $2
-  AND started_at <= $3
-GROUP BY DATE(started_at)
-ORDER BY date
+  AND sessions > 0
+  AND day >= DATE($2::timestamptz AT TIME ZONE $4::text)
+  AND day <= DATE($3::timestamptz AT TIME ZONE $4::text)
+ORDER BY day
 `
 
 type GetFrequencyByUserAndPeriodParams struct {
 UserID      uuid.UUID `json:"user_id"`
 StartedAt   time.Time `json:"started_at"`
 StartedAt_2 time.Time `json:"started_at_2"`
+Timezone    string    `json:"timezone"`
 }
 
 type GetFrequencyByUserAndPeriodRow struct {
@@ -255,7 +701,7 @@ Count int64     `json:"count"`
 }
 
 func (q *Queries) GetFrequencyByUserAndPeriod(ctx context.Context, arg GetFrequencyByUserAndPeriodParams) ([]GetFrequencyByUserAndPeriodRow, error) {
-rows, err := q.db.QueryContext(ctx, getFrequencyByUserAndPeriod, arg.UserID, arg.StartedAt, arg.StartedAt_2)
+rows, err := q.db.QueryContext(ctx, getFrequencyByUserAndPeriod, arg.UserID, arg.StartedAt, arg.StartedAt_2, arg.Timezone)
 if err != nil {
 return nil, err
 }
@@ -280,17 +726,21 @@ return items, nil
 // GetSessionsForStreak
 const getSessionsForStreak = `-- name: GetSessionsForStreak :many
 SELECT
-    DATE(started_at) AS date
-FROM sessions
+    day AS date
+FROM user_daily_stats
 WHERE user_id = $1
-  AND status = 'completed'
-  AND started_at >= NOW() - INTERVAL '365 days'
-GROUP BY DATE(started_at)
-ORDER BY date DESC
+  AND sessions > 0
+  AND day >= DATE((NOW() - INTERVAL '365 days') AT TIME ZONE $2::text)
+ORDER BY day DESC
 `
 
-func (q *Queries) GetSessionsForStreak(ctx context.Context, userID uuid.UUID) ([]time.Time, error) {
-rows, err := q.db.QueryContext(ctx, getSessionsForStreak, userID)
+type GetSessionsForStreakParams struct {
+UserID   uuid.UUID `json:"user_id"`
+Timezone string    `json:"timezone"`
+}
+
+func (q *Queries) GetSessionsForStreak(ctx context.Context, arg GetSessionsForStreakParams) ([]time.Time, error) {
+rows, err := q.db.QueryContext(ctx, getSessionsForStreak, arg.UserID, arg.Timezone)
 if err != nil {
 return nil, err
 }
@@ -7,18 +7,16 @@ SELECT id, session_id, workout_exercise_id, set_number, weight, reps, status, re
 FROM set_records
 WHERE session_id = $1 AND workout_exercise_id = $2 AND set_number = $3;
 
+-- As agregações de estatísticas leem do rollup diário (user_daily_stats, migration 024).
 -- name: GetTotalSetsRepsVolume :one
 SELECT
-    COUNT(sr.id)::bigint AS total_sets,
-    COALESCE(SUM(sr.reps), 0)::bigint AS total_reps,
-    COALESCE(SUM(sr.weight::bigint * sr.reps), 0)::bigint AS total_volume
-FROM set_records sr
-JOIN sessions s ON sr.session_id = s.id
-WHERE s.user_id = $1
-  AND s.status = 'completed'
-  AND sr.status = 'completed'
-  AND s.started_at >= $2
-  AND s.started_at <= $3;
+    COALESCE(SUM(sets), 0)::bigint AS total_sets,
+    COALESCE(SUM(reps), 0)::bigint AS total_reps,
+    COALESCE(SUM(volume), 0)::bigint AS total_volume
+FROM user_daily_stats
+WHERE user_id = $1
+  AND day >= DATE($2::timestamptz AT TIME ZONE user_timezone($1))
+  AND day <= DATE($3::timestamptz AT TIME ZONE user_timezone($1));
 
 -- name: GetPersonalRecordsByUser :many
 WITH best_sets AS (
@@ -89,7 +87,7 @@ LIMIT 15;
 
 -- name: GetProgressionByUserAndExercise :many
 SELECT
-    DATE(s.started_at)                  AS date,
+    DATE(s.started_at AT TIME ZONE $5::text) AS date,
     MAX(sr.weight)::bigint              AS max_weight,
     SUM(sr.weight::bigint * sr.reps)    AS total_volume
 FROM set_records sr
@@ -102,5 +100,70 @@ WHERE s.user_id = $1
   AND s.started_at >= $2
   AND s.started_at <= $3
   AND ($4::uuid IS NULL OR we.exercise_id = $4::uuid)
-GROUP BY DATE(s.started_at)
+GROUP BY DATE(s.started_at AT TIME ZONE $5::text)
 ORDER BY date;
+
+-- name: GetMuscleVolumeByUserAndPeriod :many
+SELECT
+    (DATE_TRUNC('week', (s.started_at AT TIME ZONE $4::text) + make_interval(days => $5::int))
+        - make_interval(days => $5::int))::date                          AS week_start,
+    em.muscle_group,
+    SUM(em.involvement)::float8                                          AS hard_sets,
+    ROUND(SUM(sr.weight::bigint * sr.reps * em.involvement))::bigint     AS volume
+FROM set_records sr
+JOIN sessions s ON sr.session_id = s.id
+JOIN workout_exercises we ON sr.workout_exercise_id = we.id
+JOIN exercise_muscles em ON em.exercise_id = we.exercise_id
+WHERE s.user_id = $1
+  AND s.status = 'completed'
+  AND sr.status = 'completed'
+  AND s.started_at >= $2
+  AND s.started_at < $3
+GROUP BY week_start, em.muscle_group
+ORDER BY week_start, em.muscle_group;
+
+-- name: GetBigLiftSetsByUser :many
+SELECT
+    DATE(s.started_at AT TIME ZONE $4::text) AS date,
+    lift.tag::text                           AS lift,
+    sr.reps,
+    MAX(sr.weight)::int                      AS weight
+FROM set_records sr
+JOIN sessions s ON sr.session_id = s.id
+JOIN workout_exercises we ON sr.workout_exercise_id = we.id
+JOIN exercises e ON e.id = we.exercise_id
+CROSS JOIN LATERAL jsonb_array_elements_text(e.tags) AS lift(tag)
+WHERE s.user_id = $1
+  AND s.status = 'completed'
+  AND sr.status = 'completed'
+  AND sr.weight > 0
+  AND sr.reps BETWEEN 1 AND 10
+  AND lift.tag IN ('squat', 'bench', 'deadlift')
+  AND s.started_at >= $2
+  AND s.started_at < $3
+GROUP BY date, lift.tag, sr.reps
+ORDER BY date, lift.tag, sr.reps;
+
+-- name: GetExerciseSetSummariesByUser :many
+SELECT
+    s.id                                     AS session_id,
+    DATE(s.started_at AT TIME ZONE $4::text) AS date,
+    s.started_at,
+    e.id                                     AS exercise_id,
+    e.name                                   AS exercise_name,
+    sr.reps,
+    MAX(sr.weight)::int                      AS max_weight,
+    SUM(sr.weight::bigint * sr.reps)::bigint AS volume
+FROM set_records sr
+JOIN sessions s ON sr.session_id = s.id
+JOIN workout_exercises we ON sr.workout_exercise_id = we.id
+JOIN exercises e ON e.id = we.exercise_id
+WHERE s.user_id = $1
+  AND s.status = 'completed'
+  AND sr.status = 'completed'
+  AND sr.weight > 0
+  AND sr.reps > 0
+  AND s.started_at >= $2
+  AND s.started_at <= $3
+GROUP BY s.id, s.started_at, e.id, e.name, sr.reps
+ORDER BY s.started_at, e.id, sr.reps;
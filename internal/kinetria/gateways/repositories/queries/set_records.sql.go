@@ -84,16 +84,13 @@ func (q *Queries) FindSetRecordBySessionExerciseSet(ctx context.Context, arg Fin
 // GetTotalSetsRepsVolume
 const getTotalSetsRepsVolume = `-- name: GetTotalSetsRepsVolume :one
 SELECT
-    COUNT(sr.id)::bigint AS total_sets,
-    COALESCE(SUM(sr.reps), 0)::bigint AS total_reps,
-    COALESCE(SUM(sr.weight::bigint * sr.reps), 0)::bigint AS total_volume
-FROM set_records sr
-JOIN sessions s ON sr.session_id = s.id
-WHERE s.user_id = $1
-  AND s.status = 'completed'
-  AND sr.status = 'completed'
-  AND s.started_at >= $2
-  AND s.started_at <= $3
+    COALESCE(SUM(sets), 0)::bigint AS total_sets,
+    COALESCE(SUM(reps), 0)::bigint AS total_reps,
+    COALESCE(SUM(volume), 0)::bigint AS total_volume
+FROM user_daily_stats
+WHERE user_id = $1
+  AND day >= DATE($2::timestamptz AT TIME ZONE user_timezone($1))
+  AND day <= DATE($3::timestamptz AT TIME ZONE user_timezone($1))
 `
 
 type GetTotalSetsRepsVolumeParams struct {
@@ -226,7 +223,7 @@ return items, nil
 // GetProgressionByUserAndExercise
 const getProgressionByUserAndExercise = `-- name: GetProgressionByUserAndExercise :many
 SELECT
-    DATE(s.started_at)                  AS date,
+    DATE(s.started_at AT TIME ZONE $5::text) AS date,
     MAX(sr.weight)::bigint              AS max_weight,
     SUM(sr.weight::bigint * sr.reps)    AS total_volume
 FROM set_records sr
@@ -239,7 +236,7 @@ WHERE s.user_id = $1
   AND s.started_at >= $2
   AND s.started_at <= $3
   AND ($4::uuid IS NULL OR we.exercise_id = $4::uuid)
-GROUP BY DATE(s.started_at)
+GROUP BY DATE(s.started_at AT TIME ZONE $5::text)
 ORDER BY date
 `
 
@@ -248,6 +245,7 @@ UserID      uuid.UUID     `json:"user_id"`
 StartedAt   time.Time     `json:"started_at"`
 StartedAt_2 time.Time     `json:"started_at_2"`
 ExerciseID  uuid.NullUUID `json:"exercise_id"`
+Timezone    string        `json:"timezone"`
 }
 
 type GetProgressionByUserAndExerciseRow struct {
@@ -262,6 +260,7 @@ arg.UserID,
 arg.StartedAt,
 arg.StartedAt_2,
 arg.ExerciseID,
+arg.Timezone,
 )
 if err != nil {
 return nil, err
@@ -283,3 +282,206 @@ return nil, err
 }
 return items, nil
 }
+
+const getMuscleVolumeByUserAndPeriod = `-- name: GetMuscleVolumeByUserAndPeriod :many
+SELECT
+    (DATE_TRUNC('week', (s.started_at AT TIME ZONE $4::text) + make_interval(days => $5::int))
+        - make_interval(days => $5::int))::date                          AS week_start,
+    em.muscle_group,
+    SUM(em.involvement)::float8                                          AS hard_sets,
+    ROUND(SUM(sr.weight::bigint * sr.reps * em.involvement))::bigint     AS volume
+FROM set_records sr
+JOIN sessions s ON sr.session_id = s.id
+JOIN workout_exercises we ON sr.workout_exercise_id = we.id
+JOIN exercise_muscles em ON em.exercise_id = we.exercise_id
+WHERE s.user_id = $1
+  AND s.status = 'completed'
+  AND sr.status = 'completed'
+  AND s.started_at >= $2
+  AND s.started_at < $3
+GROUP BY week_start, em.muscle_group
+ORDER BY week_start, em.muscle_group
+`
+
+type GetMuscleVolumeByUserAndPeriodParams struct {
+	UserID         uuid.UUID `json:"user_id"`
+	StartedAt      time.Time `json:"started_at"`
+	StartedAt_2    time.Time `json:"started_at_2"`
+	Timezone       string    `json:"timezone"`
+	WeekOffsetDays int32     `json:"week_offset_days"`
+}
+
+type GetMuscleVolumeByUserAndPeriodRow struct {
+	WeekStart   time.Time `json:"week_start"`
+	MuscleGroup string    `json:"muscle_group"`
+	HardSets    float64   `json:"hard_sets"`
+	Volume      int64     `json:"volume"`
+}
+
+func (q *Queries) GetMuscleVolumeByUserAndPeriod(ctx context.Context, arg GetMuscleVolumeByUserAndPeriodParams) ([]GetMuscleVolumeByUserAndPeriodRow, error) {
+	rows, err := q.db.QueryContext(ctx, getMuscleVolumeByUserAndPeriod, arg.UserID, arg.StartedAt, arg.StartedAt_2, arg.Timezone, arg.WeekOffsetDays)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []GetMuscleVolumeByUserAndPeriodRow
+	for rows.Next() {
+		var i GetMuscleVolumeByUserAndPeriodRow
+		if err := rows.Scan(
+			&i.WeekStart,
+			&i.MuscleGroup,
+			&i.HardSets,
+			&i.Volume,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const getBigLiftSetsByUser = `-- name: GetBigLiftSetsByUser :many
+SELECT
+    DATE(s.started_at AT TIME ZONE $4::text) AS date,
+    lift.tag::text                           AS lift,
+    sr.reps,
+    MAX(sr.weight)::int                      AS weight
+FROM set_records sr
+JOIN sessions s ON sr.session_id = s.id
+JOIN workout_exercises we ON sr.workout_exercise_id = we.id
+JOIN exercises e ON e.id = we.exercise_id
+CROSS JOIN LATERAL jsonb_array_elements_text(e.tags) AS lift(tag)
+WHERE s.user_id = $1
+  AND s.status = 'completed'
+  AND sr.status = 'completed'
+  AND sr.weight > 0
+  AND sr.reps BETWEEN 1 AND 10
+  AND lift.tag IN ('squat', 'bench', 'deadlift')
+  AND s.started_at >= $2
+  AND s.started_at < $3
+GROUP BY date, lift.tag, sr.reps
+ORDER BY date, lift.tag, sr.reps
+`
+
+type GetBigLiftSetsByUserParams struct {
+	UserID      uuid.UUID `json:"user_id"`
+	StartedAt   time.Time `json:"started_at"`
+	StartedAt_2 time.Time `json:"started_at_2"`
+	Timezone    string    `json:"timezone"`
+}
+
+type GetBigLiftSetsByUserRow struct {
+	Date   time.Time `json:"date"`
+	Lift   string    `json:"lift"`
+	Reps   int32     `json:"reps"`
+	Weight int32     `json:"weight"`
+}
+
+func (q *Queries) GetBigLiftSetsByUser(ctx context.Context, arg GetBigLiftSetsByUserParams) ([]GetBigLiftSetsByUserRow, error) {
+	rows, err := q.db.QueryContext(ctx, getBigLiftSetsByUser, arg.UserID, arg.StartedAt, arg.StartedAt_2, arg.Timezone)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []GetBigLiftSetsByUserRow
+	for rows.Next() {
+		var i GetBigLiftSetsByUserRow
+		if err := rows.Scan(
+			&i.Date,
+			&i.Lift,
+			&i.Reps,
+			&i.Weight,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const getExerciseSetSummariesByUser = `-- name: GetExerciseSetSummariesByUser :many
+SELECT
+    s.id                                     AS session_id,
+    DATE(s.started_at AT TIME ZONE $4::text) AS date,
+    s.started_at,
+    e.id                                     AS exercise_id,
+    e.name                                   AS exercise_name,
+    sr.reps,
+    MAX(sr.weight)::int                      AS max_weight,
+    SUM(sr.weight::bigint * sr.reps)::bigint AS volume
+FROM set_records sr
+JOIN sessions s ON sr.session_id = s.id
+JOIN workout_exercises we ON sr.workout_exercise_id = we.id
+JOIN exercises e ON e.id = we.exercise_id
+WHERE s.user_id = $1
+  AND s.status = 'completed'
+  AND sr.status = 'completed'
+  AND sr.weight > 0
+  AND sr.reps > 0
+  AND s.started_at >= $2
+  AND s.started_at <= $3
+GROUP BY s.id, s.started_at, e.id, e.name, sr.reps
+ORDER BY s.started_at, e.id, sr.reps
+`
+
+type GetExerciseSetSummariesByUserParams struct {
+	UserID      uuid.UUID `json:"user_id"`
+	StartedAt   time.Time `json:"started_at"`
+	StartedAt_2 time.Time `json:"started_at_2"`
+	Timezone    string    `json:"timezone"`
+}
+
+type GetExerciseSetSummariesByUserRow struct {
+	SessionID    uuid.UUID `json:"session_id"`
+	Date         time.Time `json:"date"`
+	StartedAt    time.Time `json:"started_at"`
+	ExerciseID   uuid.UUID `json:"exercise_id"`
+	ExerciseName string    `json:"exercise_name"`
+	Reps         int32     `json:"reps"`
+	MaxWeight    int32     `json:"max_weight"`
+	Volume       int64     `json:"volume"`
+}
+
+func (q *Queries) GetExerciseSetSummariesByUser(ctx context.Context, arg GetExerciseSetSummariesByUserParams) ([]GetExerciseSetSummariesByUserRow, error) {
+	rows, err := q.db.QueryContext(ctx, getExerciseSetSummariesByUser, arg.UserID, arg.StartedAt, arg.StartedAt_2, arg.Timezone)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []GetExerciseSetSummariesByUserRow
+	for rows.Next() {
+		var i GetExerciseSetSummariesByUserRow
+		if err := rows.Scan(
+			&i.SessionID,
+			&i.Date,
+			&i.StartedAt,
+			&i.ExerciseID,
+			&i.ExerciseName,
+			&i.Reps,
+			&i.MaxWeight,
+			&i.Volume,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
@@ -0,0 +1,90 @@
+-- Rollups diários e semanais (migration 024). $2 NULL: todos os dias/semanas do usuário;
+-- senão só o dia (no fuso do usuário) que contém o instante $2 e a semana desse dia.
+
+-- name: DeleteUserDailyStats :exec
+DELETE FROM user_daily_stats
+WHERE user_id = $1
+  AND ($2::timestamptz IS NULL OR day = DATE($2::timestamptz AT TIME ZONE user_timezone($1)));
+
+-- name: InsertUserDailyStats :exec
+WITH user_sessions AS (
+    SELECT
+        s.id,
+        DATE(s.started_at AT TIME ZONE user_timezone($1)) AS day,
+        COALESCE(EXTRACT(EPOCH FROM (s.finished_at - s.started_at)), 0) AS duration_seconds,
+        COALESCE(s.calories_kcal, 0) AS calories
+    FROM sessions s
+    WHERE s.user_id = $1
+      AND s.status = 'completed'
+      -- o dia local que contém $2 está dentro de ±2 dias dele (usa idx_sessions_user_stats)
+      AND ($2::timestamptz IS NULL OR s.started_at BETWEEN $2::timestamptz - INTERVAL '2 days' AND $2::timestamptz + INTERVAL '2 days')
+),
+session_sets AS (
+    SELECT
+        sr.session_id,
+        COUNT(sr.id) AS sets,
+        SUM(sr.reps) AS reps,
+        SUM(sr.weight::bigint * sr.reps) AS volume,
+        MAX(sr.weight) AS heaviest_set
+    FROM set_records sr
+    JOIN user_sessions us ON sr.session_id = us.id
+    WHERE sr.status = 'completed'
+    GROUP BY sr.session_id
+),
+day_exercises AS (
+    SELECT
+        us.day,
+        ARRAY_AGG(DISTINCT we.exercise_id) AS exercise_ids
+    FROM set_records sr
+    JOIN user_sessions us ON sr.session_id = us.id
+    JOIN workout_exercises we ON sr.workout_exercise_id = we.id
+    WHERE sr.status = 'completed'
+    GROUP BY us.day
+)
+INSERT INTO user_daily_stats (user_id, day, sessions, duration_seconds, calories, sets, reps, volume, heaviest_set, exercise_ids, updated_at)
+SELECT
+    $1,
+    us.day,
+    COUNT(*)::int,
+    SUM(us.duration_seconds)::bigint,
+    SUM(us.calories)::bigint,
+    COALESCE(SUM(ss.sets), 0)::int,
+    COALESCE(SUM(ss.reps), 0)::bigint,
+    COALESCE(SUM(ss.volume), 0)::bigint,
+    COALESCE(MAX(ss.heaviest_set), 0)::int,
+    COALESCE(de.exercise_ids, '{}'),
+    NOW()
+FROM user_sessions us
+LEFT JOIN session_sets ss ON ss.session_id = us.id
+LEFT JOIN day_exercises de ON de.day = us.day
+WHERE $2::timestamptz IS NULL OR us.day = DATE($2::timestamptz AT TIME ZONE user_timezone($1))
+GROUP BY us.day, de.exercise_ids;
+
+-- name: DeleteUserWeeklyStats :exec
+DELETE FROM user_weekly_stats
+WHERE user_id = $1
+  AND ($2::timestamptz IS NULL OR week_start = user_week_start($1, DATE($2::timestamptz AT TIME ZONE user_timezone($1))));
+
+-- name: InsertUserWeeklyStats :exec
+INSERT INTO user_weekly_stats (user_id, week_start, sessions, active_days, duration_seconds, calories, sets, reps, volume, updated_at)
+SELECT
+    $1,
+    user_week_start($1, day) AS week_start,
+    SUM(sessions)::int,
+    COUNT(*)::int,
+    SUM(duration_seconds)::bigint,
+    SUM(calories)::bigint,
+    SUM(sets)::int,
+    SUM(reps)::bigint,
+    SUM(volume)::bigint,
+    NOW()
+FROM user_daily_stats
+WHERE user_id = $1
+  AND ($2::timestamptz IS NULL OR (
+      day BETWEEN DATE($2::timestamptz AT TIME ZONE user_timezone($1)) - 6 AND DATE($2::timestamptz AT TIME ZONE user_timezone($1)) + 6
+      AND user_week_start($1, day) = user_week_start($1, DATE($2::timestamptz AT TIME ZONE user_timezone($1)))
+  ))
+GROUP BY user_week_start($1, day);
+
+-- name: ListUserIDs :many
+SELECT id FROM users ORDER BY id;
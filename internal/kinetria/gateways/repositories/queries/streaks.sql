@@ -0,0 +1,23 @@
+-- name: ListStreakActivityDays :many
+SELECT day, sessions
+FROM user_daily_stats
+WHERE user_id = $1
+  AND sessions > 0
+ORDER BY day;
+
+-- name: ListPlannedRestDays :many
+SELECT day
+FROM planned_rest_days
+WHERE user_id = $1
+  AND day BETWEEN $2::date AND $3::date
+ORDER BY day;
+
+-- name: CreatePlannedRestDay :execrows
+INSERT INTO planned_rest_days (user_id, day)
+VALUES ($1, $2::date)
+ON CONFLICT (user_id, day) DO NOTHING;
+
+-- name: DeletePlannedRestDay :execrows
+DELETE FROM planned_rest_days
+WHERE user_id = $1
+  AND day = $2::date;
@@ -0,0 +1,25 @@
+-- name: FindSyncOperation :one
+SELECT user_id, id, type, entity_id, status, code, message, applied_at
+FROM sync_operations
+WHERE user_id = $1 AND id = $2;
+
+-- Com outra transação gravando a mesma operação, espera por ela e não insere nada.
+-- name: CreateSyncOperation :execrows
+INSERT INTO sync_operations (user_id, id, type, entity_id, status, code, message, applied_at)
+VALUES ($1, $2, $3, $4, $5, $6, $7, $8)
+ON CONFLICT (user_id, id) DO NOTHING;
+
+-- name: ListSessionChanges :many
+SELECT id, user_id, workout_id, started_at, finished_at, status, notes, created_at, updated_at, calories_kcal, session_rpe, change_seq
+FROM sessions
+WHERE user_id = $1
+  AND change_seq > $2
+ORDER BY change_seq
+LIMIT $3;
+
+-- name: ListSyncedSetsBySession :many
+SELECT sr.id, we.exercise_id, sr.set_number, sr.weight, sr.reps, sr.status, sr.recorded_at
+FROM set_records sr
+JOIN workout_exercises we ON sr.workout_exercise_id = we.id
+WHERE sr.session_id = $1
+ORDER BY sr.recorded_at, sr.set_number;
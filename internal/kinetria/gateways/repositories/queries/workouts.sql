@@ -7,13 +7,15 @@ SELECT EXISTS(
 SELECT id, user_id, name, description, type, intensity, duration, image_url, created_at, updated_at, created_by, deleted_at
 FROM workouts
 WHERE (created_by = $1 OR created_by IS NULL) AND deleted_at IS NULL
+  AND (NOT $4::boolean OR EXISTS (SELECT 1 FROM favorite_workouts fw WHERE fw.workout_id = workouts.id AND fw.user_id = $1))
 ORDER BY created_at DESC
 LIMIT $2 OFFSET $3;
 
 -- name: CountWorkoutsByUserID :one
 SELECT COUNT(*)
 FROM workouts
-WHERE (created_by = $1 OR created_by IS NULL) AND deleted_at IS NULL;
+WHERE (created_by = $1 OR created_by IS NULL) AND deleted_at IS NULL
+  AND (NOT $2::boolean OR EXISTS (SELECT 1 FROM favorite_workouts fw WHERE fw.workout_id = workouts.id AND fw.user_id = $1));
 
 -- name: GetFirstWorkoutByUserID :one
 SELECT 
@@ -30,8 +32,12 @@ SELECT
     created_by,
     deleted_at
 FROM workouts
-WHERE user_id = $1 AND deleted_at IS NULL
-ORDER BY created_at ASC
+WHERE deleted_at IS NULL
+  AND (user_id = $1
+       OR EXISTS (SELECT 1 FROM favorite_workouts fw WHERE fw.workout_id = workouts.id AND fw.user_id = $1))
+ORDER BY
+    EXISTS (SELECT 1 FROM favorite_workouts fw WHERE fw.workout_id = workouts.id AND fw.user_id = $1) DESC,
+    created_at ASC
 LIMIT 1;
 
 -- name: GetWorkoutByID :one
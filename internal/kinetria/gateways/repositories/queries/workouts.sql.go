@@ -17,10 +17,16 @@ const countWorkoutsByUserID = `-- name: CountWorkoutsByUserID :one
 SELECT COUNT(*)
 FROM workouts
 WHERE (created_by = $1 OR created_by IS NULL) AND deleted_at IS NULL
+  AND (NOT $2::boolean OR EXISTS (SELECT 1 FROM favorite_workouts fw WHERE fw.workout_id = workouts.id AND fw.user_id = $1))
 `
 
-func (q *Queries) CountWorkoutsByUserID(ctx context.Context, userID uuid.UUID) (int64, error) {
-row := q.db.QueryRowContext(ctx, countWorkoutsByUserID, userID)
+type CountWorkoutsByUserIDParams struct {
+UserID        uuid.UUID `json:"user_id"`
+FavoritesOnly bool      `json:"favorites_only"`
+}
+
+func (q *Queries) CountWorkoutsByUserID(ctx context.Context, arg CountWorkoutsByUserIDParams) (int64, error) {
+row := q.db.QueryRowContext(ctx, countWorkoutsByUserID, arg.UserID, arg.FavoritesOnly)
 var count int64
 err := row.Scan(&count)
 return count, err
@@ -59,8 +65,12 @@ SELECT
     created_by,
     deleted_at
 FROM workouts
-WHERE user_id = $1 AND deleted_at IS NULL
-ORDER BY created_at ASC
+WHERE deleted_at IS NULL
+  AND (user_id = $1
+       OR EXISTS (SELECT 1 FROM favorite_workouts fw WHERE fw.workout_id = workouts.id AND fw.user_id = $1))
+ORDER BY
+    EXISTS (SELECT 1 FROM favorite_workouts fw WHERE fw.workout_id = workouts.id AND fw.user_id = $1) DESC,
+    created_at ASC
 LIMIT 1
 `
 
@@ -157,18 +167,25 @@ const listWorkoutsByUserID = `-- name: ListWorkoutsByUserID :many
 SELECT id, user_id, name, description, type, intensity, duration, image_url, created_at, updated_at, created_by, deleted_at
 FROM workouts
 WHERE (created_by = $1 OR created_by IS NULL) AND deleted_at IS NULL
+  AND (NOT $4::boolean OR EXISTS (SELECT 1 FROM favorite_workouts fw WHERE fw.workout_id = workouts.id AND fw.user_id = $1))
 ORDER BY created_at DESC
 LIMIT $2 OFFSET $3
 `
 
 type ListWorkoutsByUserIDParams struct {
-UserID uuid.UUID `json:"user_id"`
-Limit  int32     `json:"limit"`
-Offset int32     `json:"offset"`
+UserID        uuid.UUID `json:"user_id"`
+Limit         int32     `json:"limit"`
+Offset        int32     `json:"offset"`
+FavoritesOnly bool      `json:"favorites_only"`
 }
 
 func (q *Queries) ListWorkoutsByUserID(ctx context.Context, arg ListWorkoutsByUserIDParams) ([]Workout, error) {
-rows, err := q.db.QueryContext(ctx, listWorkoutsByUserID, arg.UserID, arg.Limit, arg.Offset)
+rows, err := q.db.QueryContext(ctx, listWorkoutsByUserID,
+arg.UserID,
+arg.Limit,
+arg.Offset,
+arg.FavoritesOnly,
+)
 if err != nil {
 return nil, err
 }
@@ -0,0 +1,126 @@
+package repositories
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/kinetria/kinetria-back/internal/kinetria/domain/entities"
+	"github.com/kinetria/kinetria-back/internal/kinetria/domain/vos"
+	"github.com/kinetria/kinetria-back/internal/kinetria/gateways/repositories/queries"
+)
+
+// ReadinessRepository implements ports.ReadinessRepository using PostgreSQL via SQLC.
+type ReadinessRepository struct {
+	q *queries.Queries
+}
+
+// NewReadinessRepository creates a new ReadinessRepository.
+func NewReadinessRepository(db *sql.DB) *ReadinessRepository {
+	return &ReadinessRepository{q: queries.New(txDB{db})}
+}
+
+func mapSQLCReadinessCheckInToEntity(row queries.ReadinessCheckIn) entities.ReadinessCheckIn {
+	checkIn := entities.ReadinessCheckIn{
+		ID:     row.ID,
+		UserID: row.UserID,
+		ReadinessMarkers: vos.ReadinessMarkers{
+			SleepHours: row.SleepHours,
+			Stress:     int(row.Stress),
+			Soreness:   int(row.Soreness),
+			HRV:        nullInt16ToIntPtr(row.Hrv),
+		},
+		Score:     int(row.Score),
+		Level:     vos.ReadinessLevel(row.Level),
+		CheckedAt: row.CheckedAt,
+	}
+	if row.SessionID.Valid {
+		id := row.SessionID.UUID
+		checkIn.SessionID = &id
+	}
+	if row.Adjustment.Valid {
+		a := vos.ReadinessAdjustment(row.Adjustment.String)
+		checkIn.Adjustment = &a
+	}
+	return checkIn
+}
+
+// Create inserts a new check-in, not yet linked to a session.
+func (r *ReadinessRepository) Create(ctx context.Context, checkIn *entities.ReadinessCheckIn) error {
+	return r.q.CreateReadinessCheckIn(ctx, queries.CreateReadinessCheckInParams{
+		ID:         checkIn.ID,
+		UserID:     checkIn.UserID,
+		SleepHours: checkIn.SleepHours,
+		Stress:     int16(checkIn.Stress),
+		Soreness:   int16(checkIn.Soreness),
+		Hrv:        toNullInt16(checkIn.HRV),
+		Score:      int16(checkIn.Score),
+		Level:      checkIn.Level.String(),
+		CheckedAt:  checkIn.CheckedAt,
+	})
+}
+
+// ListByUser returns the check-ins made in [from, to], oldest first.
+func (r *ReadinessRepository) ListByUser(ctx context.Context, userID uuid.UUID, from, to time.Time) ([]entities.ReadinessCheckIn, error) {
+	rows, err := r.q.ListReadinessCheckInsByUser(ctx, queries.ListReadinessCheckInsByUserParams{
+		UserID:      userID,
+		CheckedAt:   from,
+		CheckedAt_2: to,
+	})
+	if err != nil {
+		return nil, err
+	}
+	result := make([]entities.ReadinessCheckIn, 0, len(rows))
+	for _, row := range rows {
+		result = append(result, mapSQLCReadinessCheckInToEntity(row))
+	}
+	return result, nil
+}
+
+// FindLatestUnlinked returns the latest check-in made since since and not linked to a
+// session, or nil if there is none.
+func (r *ReadinessRepository) FindLatestUnlinked(ctx context.Context, userID uuid.UUID, since time.Time) (*entities.ReadinessCheckIn, error) {
+	row, err := r.q.FindLatestUnlinkedReadinessCheckIn(ctx, queries.FindLatestUnlinkedReadinessCheckInParams{
+		UserID:    userID,
+		CheckedAt: since,
+	})
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, nil
+		}
+		return nil, err
+	}
+	checkIn := mapSQLCReadinessCheckInToEntity(row)
+	return &checkIn, nil
+}
+
+// LinkSession records the session started with the check-in and the adjustment applied.
+func (r *ReadinessRepository) LinkSession(ctx context.Context, checkInID, sessionID uuid.UUID, adjustment *vos.ReadinessAdjustment) error {
+	var adj sql.NullString
+	if adjustment != nil {
+		adj = sql.NullString{String: adjustment.String(), Valid: true}
+	}
+	return r.q.LinkReadinessCheckInSession(ctx, queries.LinkReadinessCheckInSessionParams{
+		ID:         checkInID,
+		SessionID:  uuid.NullUUID{UUID: sessionID, Valid: true},
+		Adjustment: adj,
+	})
+}
+
+// AverageHRV returns the mean HRV of the check-ins made in [from, to), or nil without any.
+func (r *ReadinessRepository) AverageHRV(ctx context.Context, userID uuid.UUID, from, to time.Time) (*float64, error) {
+	avg, err := r.q.AverageReadinessHRV(ctx, queries.AverageReadinessHRVParams{
+		UserID:      userID,
+		CheckedAt:   from,
+		CheckedAt_2: to,
+	})
+	if err != nil {
+		return nil, err
+	}
+	if !avg.Valid {
+		return nil, nil
+	}
+	return &avg.Float64, nil
+}
@@ -19,7 +19,7 @@ type RefreshTokenRepository struct {
 
 // NewRefreshTokenRepository creates a new RefreshTokenRepository backed by the provided *sql.DB.
 func NewRefreshTokenRepository(db *sql.DB) *RefreshTokenRepository {
-	return &RefreshTokenRepository{q: queries.New(db)}
+	return &RefreshTokenRepository{q: queries.New(txDB{db})}
 }
 
 // Create inserts a new refresh token into the database.
@@ -3,11 +3,14 @@ package repositories
 import (
 	"context"
 	"database/sql"
+	"encoding/json"
 	"errors"
+	"fmt"
 	"time"
 
 	"github.com/google/uuid"
 	"github.com/kinetria/kinetria-back/internal/kinetria/domain/entities"
+	domainerrors "github.com/kinetria/kinetria-back/internal/kinetria/domain/errors"
 	"github.com/kinetria/kinetria-back/internal/kinetria/domain/ports"
 	"github.com/kinetria/kinetria-back/internal/kinetria/domain/vos"
 	"github.com/kinetria/kinetria-back/internal/kinetria/gateways/repositories/queries"
@@ -15,12 +18,13 @@ import (
 
 // SessionRepository implements ports.SessionRepository using PostgreSQL via SQLC.
 type SessionRepository struct {
-	q *queries.Queries
+	q  *queries.Queries
+	db *sql.DB
 }
 
 // NewSessionRepository creates a new SessionRepository backed by the provided *sql.DB.
 func NewSessionRepository(db *sql.DB) *SessionRepository {
-	return &SessionRepository{q: queries.New(db)}
+	return &SessionRepository{q: queries.New(txDB{db}), db: db}
 }
 
 // Create inserts a new session into the database.
@@ -37,6 +41,109 @@ func (r *SessionRepository) Create(ctx context.Context, session *entities.Sessio
 	})
 }
 
+// CreateCompleted inserts a completed session and its sets in a single transaction (or in the
+// one already in ctx).
+// Returns ErrSessionOverlap if the session overlaps another non-abandoned session of the user.
+func (r *SessionRepository) CreateCompleted(ctx context.Context, session *entities.Session, sets []entities.SetRecord) error {
+	if session.FinishedAt == nil {
+		return errors.New("completed session without finishedAt")
+	}
+
+	return inTx(ctx, r.db, func(ctx context.Context) error {
+		if err := r.q.LockUserForSessionWrite(ctx, session.UserID); err != nil {
+			return fmt.Errorf("failed to lock user sessions: %w", err)
+		}
+		// Sobreposição: começa antes do fim da nova sessão e termina depois do início dela
+		overlapping, err := r.q.CountOverlappingSessions(ctx, queries.CountOverlappingSessionsParams{
+			UserID:     session.UserID,
+			StartedAt:  *session.FinishedAt,
+			FinishedAt: session.StartedAt,
+			ID:         session.ID,
+		})
+		if err != nil {
+			return fmt.Errorf("failed to check overlapping sessions: %w", err)
+		}
+		if overlapping > 0 {
+			return domainerrors.ErrSessionOverlap
+		}
+
+		err = r.q.CreateCompletedSession(ctx, queries.CreateCompletedSessionParams{
+			ID:         session.ID,
+			UserID:     session.UserID,
+			WorkoutID:  session.WorkoutID,
+			StartedAt:  session.StartedAt,
+			FinishedAt: sql.NullTime{Time: *session.FinishedAt, Valid: true},
+			Notes:      session.Notes,
+			CreatedAt:  session.CreatedAt,
+			UpdatedAt:  session.UpdatedAt,
+		})
+		if err != nil {
+			return fmt.Errorf("failed to create session: %w", err)
+		}
+
+		for _, set := range sets {
+			err = r.q.CreateSetRecord(ctx, queries.CreateSetRecordParams{
+				ID:                set.ID,
+				SessionID:         set.SessionID,
+				WorkoutExerciseID: uuid.NullUUID{UUID: set.WorkoutExerciseID, Valid: true},
+				SetNumber:         int32(set.SetNumber),
+				Weight:            int32(set.Weight),
+				Reps:              int32(set.Reps),
+				Status:            set.Status,
+				RecordedAt:        set.RecordedAt,
+			})
+			if err != nil {
+				return fmt.Errorf("failed to create set record: %w", err)
+			}
+		}
+
+		return nil
+	})
+}
+
+// UpdateCompleted stores new times and notes of a completed session in a single transaction (or
+// in the one already in ctx).
+// Returns (false, nil) if the session is not completed and ErrSessionOverlap if the new times
+// overlap another non-abandoned session of the user.
+func (r *SessionRepository) UpdateCompleted(ctx context.Context, session *entities.Session) (bool, error) {
+	if session.FinishedAt == nil {
+		return false, errors.New("completed session without finishedAt")
+	}
+
+	var updated bool
+	err := inTx(ctx, r.db, func(ctx context.Context) error {
+		if err := r.q.LockUserForSessionWrite(ctx, session.UserID); err != nil {
+			return fmt.Errorf("failed to lock user sessions: %w", err)
+		}
+		overlapping, err := r.q.CountOverlappingSessions(ctx, queries.CountOverlappingSessionsParams{
+			UserID:     session.UserID,
+			StartedAt:  *session.FinishedAt,
+			FinishedAt: session.StartedAt,
+			ID:         session.ID,
+		})
+		if err != nil {
+			return fmt.Errorf("failed to check overlapping sessions: %w", err)
+		}
+		if overlapping > 0 {
+			return domainerrors.ErrSessionOverlap
+		}
+
+		rowsAffected, err := r.q.UpdateCompletedSessionTimes(ctx, queries.UpdateCompletedSessionTimesParams{
+			ID:         session.ID,
+			StartedAt:  session.StartedAt,
+			FinishedAt: sql.NullTime{Time: *session.FinishedAt, Valid: true},
+			Notes:      session.Notes,
+			UpdatedAt:  session.UpdatedAt,
+		})
+		if err != nil {
+			return fmt.Errorf("failed to update session: %w", err)
+		}
+		updated = rowsAffected > 0
+		return nil
+	})
+	return updated, err
+}
+
 // FindActiveByUserID retrieves the active session for a user, if one exists.
 // Returns (nil, nil) if no active session is found.
 func (r *SessionRepository) FindActiveByUserID(ctx context.Context, userID uuid.UUID) (*entities.Session, error) {
@@ -63,6 +170,8 @@ func (r *SessionRepository) FindActiveByUserID(ctx context.Context, userID uuid.
 		FinishedAt: finishedAt,
 		CreatedAt:  row.CreatedAt,
 		UpdatedAt:  row.UpdatedAt,
+		Calories:   nullInt32ToIntPtr(row.CaloriesKcal),
+		RPE:        nullInt16ToIntPtr(row.SessionRpe),
 	}, nil
 }
 
@@ -88,6 +197,8 @@ func (r *SessionRepository) FindByID(ctx context.Context, sessionID uuid.UUID) (
 		FinishedAt: finishedAt,
 		CreatedAt:  row.CreatedAt,
 		UpdatedAt:  row.UpdatedAt,
+		Calories:   nullInt32ToIntPtr(row.CaloriesKcal),
+		RPE:        nullInt16ToIntPtr(row.SessionRpe),
 	}, nil
 }
 
@@ -112,6 +223,176 @@ func (r *SessionRepository) UpdateStatus(ctx context.Context, sessionID uuid.UUI
 	return rowsAffected > 0, nil
 }
 
+// SetSessionCalories stores the calorie estimate of a session.
+func (r *SessionRepository) SetSessionCalories(ctx context.Context, sessionID uuid.UUID, kcal int) error {
+	return r.q.SetSessionCalories(ctx, queries.SetSessionCaloriesParams{
+		ID:           sessionID,
+		CaloriesKcal: sql.NullInt32{Int32: int32(kcal), Valid: true},
+		UpdatedAt:    time.Now(),
+	})
+}
+
+// SetSessionFeedback replaces the post-session feedback of a session.
+func (r *SessionRepository) SetSessionFeedback(ctx context.Context, sessionID uuid.UUID, feedback vos.SessionFeedback) error {
+	soreness := feedback.Soreness
+	if soreness == nil {
+		soreness = map[vos.MuscleGroup]int{}
+	}
+	sorenessJSON, err := json.Marshal(soreness)
+	if err != nil {
+		return err
+	}
+	return r.q.SetSessionFeedback(ctx, queries.SetSessionFeedbackParams{
+		ID:           sessionID,
+		SessionRpe:   toNullInt16(feedback.RPE),
+		Mood:         toNullInt16(feedback.Mood),
+		Energy:       toNullInt16(feedback.Energy),
+		SleepQuality: toNullInt16(feedback.SleepQuality),
+		Soreness:     sorenessJSON,
+		UpdatedAt:    time.Now(),
+	})
+}
+
+// GetSessionFeedback returns the post-session feedback of a session.
+func (r *SessionRepository) GetSessionFeedback(ctx context.Context, sessionID uuid.UUID) (*vos.SessionFeedback, error) {
+	row, err := r.q.GetSessionFeedback(ctx, sessionID)
+	if err != nil {
+		return nil, err
+	}
+	feedback := &vos.SessionFeedback{
+		RPE:          nullInt16ToIntPtr(row.SessionRpe),
+		Mood:         nullInt16ToIntPtr(row.Mood),
+		Energy:       nullInt16ToIntPtr(row.Energy),
+		SleepQuality: nullInt16ToIntPtr(row.SleepQuality),
+		Soreness:     map[vos.MuscleGroup]int{},
+	}
+	if len(row.Soreness) > 0 {
+		if err := json.Unmarshal(row.Soreness, &feedback.Soreness); err != nil {
+			return nil, err
+		}
+	}
+	return feedback, nil
+}
+
+// ListSessionLoads retorna duração, RPE, avaliações e tonelagem das sessões completed do usuário no
+// período, com o dia de cada sessão no fuso loc.
+func (r *SessionRepository) ListSessionLoads(ctx context.Context, userID uuid.UUID, start, end time.Time, loc *time.Location) ([]ports.SessionLoad, error) {
+	rows, err := r.q.ListSessionLoads(ctx, queries.ListSessionLoadsParams{
+		UserID:      userID,
+		StartedAt:   start,
+		StartedAt_2: end,
+		Timezone:    loc.String(),
+	})
+	if err != nil {
+		return nil, err
+	}
+	result := make([]ports.SessionLoad, 0, len(rows))
+	for _, row := range rows {
+		result = append(result, ports.SessionLoad{
+			SessionID:       row.ID,
+			StartedAt:       row.StartedAt,
+			WorkoutID:       row.WorkoutID,
+			WorkoutName:     row.WorkoutName,
+			Date:            row.Date,
+			DurationMinutes: int(row.DurationMinutes),
+			RPE:             nullInt16ToIntPtr(row.SessionRpe),
+			Tonnage:         row.Tonnage,
+			Mood:            nullInt16ToIntPtr(row.Mood),
+			Energy:          nullInt16ToIntPtr(row.Energy),
+			SleepQuality:    nullInt16ToIntPtr(row.SleepQuality),
+		})
+	}
+	return result, nil
+}
+
+// GetSessionEffort returns the workout type, timing and per-exercise completed sets of a session.
+func (r *SessionRepository) GetSessionEffort(ctx context.Context, sessionID uuid.UUID) (*ports.SessionEffort, error) {
+	row, err := r.q.GetSessionEffort(ctx, sessionID)
+	if err != nil {
+		return nil, err
+	}
+	effort := &ports.SessionEffort{
+		WorkoutType: row.WorkoutType,
+		StartedAt:   row.StartedAt,
+	}
+	if row.LastSetAt.Valid {
+		effort.LastSetAt = &row.LastSetAt.Time
+	}
+
+	rows, err := r.q.GetSessionExerciseEffort(ctx, sessionID)
+	if err != nil {
+		return nil, err
+	}
+	for _, ex := range rows {
+		item := ports.ExerciseEffort{CompletedSets: int(ex.CompletedSets)}
+		if ex.MetValue.Valid {
+			met := ex.MetValue.Float64
+			item.MET = &met
+		}
+		effort.Exercises = append(effort.Exercises, item)
+	}
+	return effort, nil
+}
+
+// ListSessionSets returns the prescribed exercises of the session's workout with the sets
+// recorded for each, in workout order.
+func (r *SessionRepository) ListSessionSets(ctx context.Context, sessionID uuid.UUID) ([]ports.SessionSetRow, error) {
+	rows, err := r.q.ListSessionSummarySets(ctx, sessionID)
+	if err != nil {
+		return nil, err
+	}
+	result := make([]ports.SessionSetRow, 0, len(rows))
+	for _, row := range rows {
+		result = append(result, ports.SessionSetRow{
+			WorkoutExerciseID: row.WorkoutExerciseID,
+			ExerciseID:        row.ExerciseID,
+			ExerciseName:      row.ExerciseName,
+			PrescribedSets:    int(row.PrescribedSets),
+			PrescribedReps:    row.PrescribedReps,
+			SetNumber:         nullInt32ToIntPtr(row.SetNumber),
+			Weight:            int(row.Weight.Int32),
+			Reps:              int(row.Reps.Int32),
+			Status:            row.Status.String,
+		})
+	}
+	return result, nil
+}
+
+// FindPreviousCompleted returns the latest completed session of the workout started before
+// the given time. Returns (nil, nil) if there is none.
+func (r *SessionRepository) FindPreviousCompleted(ctx context.Context, userID, workoutID uuid.UUID, before time.Time) (*entities.Session, error) {
+	row, err := r.q.FindPreviousCompletedSession(ctx, queries.FindPreviousCompletedSessionParams{
+		UserID:    userID,
+		WorkoutID: workoutID,
+		StartedAt: before,
+	})
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, nil
+		}
+		return nil, err
+	}
+
+	var finishedAt *time.Time
+	if row.FinishedAt.Valid {
+		finishedAt = &row.FinishedAt.Time
+	}
+
+	return &entities.Session{
+		ID:         row.ID,
+		UserID:     row.UserID,
+		WorkoutID:  row.WorkoutID,
+		Status:     vos.SessionStatus(row.Status),
+		Notes:      row.Notes,
+		StartedAt:  row.StartedAt,
+		FinishedAt: finishedAt,
+		CreatedAt:  row.CreatedAt,
+		UpdatedAt:  row.UpdatedAt,
+		Calories:   nullInt32ToIntPtr(row.CaloriesKcal),
+		RPE:        nullInt16ToIntPtr(row.SessionRpe),
+	}, nil
+}
+
 // GetCompletedSessionsByUserAndDateRange retorna todas as sessões completed do usuário
 // no intervalo de datas (inclusive).
 func (r *SessionRepository) GetCompletedSessionsByUserAndDateRange(
@@ -119,11 +400,13 @@ func (r *SessionRepository) GetCompletedSessionsByUserAndDateRange(
 	userID uuid.UUID,
 	startDate time.Time,
 	endDate time.Time,
+	loc *time.Location,
 ) ([]entities.Session, error) {
 	rows, err := r.q.GetCompletedSessionsByDateRange(ctx, queries.GetCompletedSessionsByDateRangeParams{
 		UserID:      userID,
 		StartedAt:   startDate,
 		StartedAt_2: endDate,
+		Timezone:    loc.String(),
 	})
 	if err != nil {
 		return nil, err
@@ -146,6 +429,8 @@ func (r *SessionRepository) GetCompletedSessionsByUserAndDateRange(
 			FinishedAt: finishedAt,
 			CreatedAt:  row.CreatedAt,
 			UpdatedAt:  row.UpdatedAt,
+			Calories:   nullInt32ToIntPtr(row.CaloriesKcal),
+			RPE:        nullInt16ToIntPtr(row.SessionRpe),
 		})
 	}
 
@@ -165,15 +450,17 @@ func (r *SessionRepository) GetStatsByUserAndPeriod(ctx context.Context, userID
 	return &ports.SessionStats{
 		TotalWorkouts: int(row.TotalWorkouts),
 		TotalTime:     int(row.TotalTimeMinutes),
+		TotalCalories: int(row.TotalCalories),
 	}, nil
 }
 
-// GetFrequencyByUserAndPeriod retorna a frequência de treinos por dia no período.
-func (r *SessionRepository) GetFrequencyByUserAndPeriod(ctx context.Context, userID uuid.UUID, start, end time.Time) ([]ports.FrequencyData, error) {
+// GetFrequencyByUserAndPeriod retorna a frequência de treinos por dia (no fuso loc) no período.
+func (r *SessionRepository) GetFrequencyByUserAndPeriod(ctx context.Context, userID uuid.UUID, start, end time.Time, loc *time.Location) ([]ports.FrequencyData, error) {
 	rows, err := r.q.GetFrequencyByUserAndPeriod(ctx, queries.GetFrequencyByUserAndPeriodParams{
 		UserID:      userID,
 		StartedAt:   start,
 		StartedAt_2: end,
+		Timezone:    loc.String(),
 	})
 	if err != nil {
 		return nil, err
@@ -188,7 +475,33 @@ func (r *SessionRepository) GetFrequencyByUserAndPeriod(ctx context.Context, use
 	return result, nil
 }
 
-// GetSessionsForStreak retorna datas únicas de sessões completadas nos últimos 365 dias.
-func (r *SessionRepository) GetSessionsForStreak(ctx context.Context, userID uuid.UUID) ([]time.Time, error) {
-	return r.q.GetSessionsForStreak(ctx, userID)
+// GetSessionsForStreak retorna datas únicas (no fuso loc) de sessões completadas nos últimos 365 dias.
+func (r *SessionRepository) GetSessionsForStreak(ctx context.Context, userID uuid.UUID, loc *time.Location) ([]time.Time, error) {
+	return r.q.GetSessionsForStreak(ctx, queries.GetSessionsForStreakParams{
+		UserID:   userID,
+		Timezone: loc.String(),
+	})
+}
+
+func nullInt32ToIntPtr(v sql.NullInt32) *int {
+	if !v.Valid {
+		return nil
+	}
+	i := int(v.Int32)
+	return &i
+}
+
+func nullInt16ToIntPtr(v sql.NullInt16) *int {
+	if !v.Valid {
+		return nil
+	}
+	i := int(v.Int16)
+	return &i
+}
+
+func toNullInt16(v *int) sql.NullInt16 {
+	if v == nil {
+		return sql.NullInt16{}
+	}
+	return sql.NullInt16{Int16: int16(*v), Valid: true}
 }
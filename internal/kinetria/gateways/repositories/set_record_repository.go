@@ -11,6 +11,7 @@ import (
 	"github.com/kinetria/kinetria-back/internal/kinetria/domain/entities"
 	domainerrors "github.com/kinetria/kinetria-back/internal/kinetria/domain/errors"
 	"github.com/kinetria/kinetria-back/internal/kinetria/domain/ports"
+	"github.com/kinetria/kinetria-back/internal/kinetria/domain/vos"
 	"github.com/kinetria/kinetria-back/internal/kinetria/gateways/repositories/queries"
 )
 
@@ -22,7 +23,7 @@ type SetRecordRepository struct {
 
 // NewSetRecordRepository creates a new SetRecordRepository.
 func NewSetRecordRepository(db *sql.DB) *SetRecordRepository {
-	return &SetRecordRepository{db: db, q: queries.New(db)}
+	return &SetRecordRepository{db: db, q: queries.New(txDB{db})}
 }
 
 // Create inserts a new set record.
@@ -109,7 +110,7 @@ func (r *SetRecordRepository) GetPersonalRecordsByUser(ctx context.Context, user
 }
 
 // GetProgressionByUserAndExercise retorna a progressão de treinos do usuário no período.
-func (r *SetRecordRepository) GetProgressionByUserAndExercise(ctx context.Context, userID uuid.UUID, exerciseID *uuid.UUID, start, end time.Time) ([]ports.ProgressionPoint, error) {
+func (r *SetRecordRepository) GetProgressionByUserAndExercise(ctx context.Context, userID uuid.UUID, exerciseID *uuid.UUID, start, end time.Time, loc *time.Location) ([]ports.ProgressionPoint, error) {
 	var nullExID uuid.NullUUID
 	if exerciseID != nil {
 		nullExID = uuid.NullUUID{UUID: *exerciseID, Valid: true}
@@ -119,6 +120,7 @@ func (r *SetRecordRepository) GetProgressionByUserAndExercise(ctx context.Contex
 		StartedAt:   start,
 		StartedAt_2: end,
 		ExerciseID:  nullExID,
+		Timezone:    loc.String(),
 	})
 	if err != nil {
 		return nil, err
@@ -133,3 +135,85 @@ func (r *SetRecordRepository) GetProgressionByUserAndExercise(ctx context.Contex
 	}
 	return result, nil
 }
+
+// GetMuscleVolumeByUserAndPeriod retorna séries e volume por grupo muscular e semana
+// (iniciando em weekStart, no fuso loc).
+func (r *SetRecordRepository) GetMuscleVolumeByUserAndPeriod(ctx context.Context, userID uuid.UUID, start, end time.Time, loc *time.Location, weekStart vos.WeekStart) ([]ports.MuscleVolumeRow, error) {
+	// DATE_TRUNC('week') começa na segunda; deslocar um dia faz a semana começar no domingo.
+	var weekOffsetDays int32
+	if weekStart.Weekday() == time.Sunday {
+		weekOffsetDays = 1
+	}
+	rows, err := r.q.GetMuscleVolumeByUserAndPeriod(ctx, queries.GetMuscleVolumeByUserAndPeriodParams{
+		UserID:         userID,
+		StartedAt:      start,
+		StartedAt_2:    end,
+		Timezone:       loc.String(),
+		WeekOffsetDays: weekOffsetDays,
+	})
+	if err != nil {
+		return nil, err
+	}
+	result := make([]ports.MuscleVolumeRow, 0, len(rows))
+	for _, row := range rows {
+		result = append(result, ports.MuscleVolumeRow{
+			WeekStart:   row.WeekStart,
+			MuscleGroup: row.MuscleGroup,
+			HardSets:    row.HardSets,
+			Volume:      row.Volume,
+		})
+	}
+	return result, nil
+}
+
+// GetBigLiftSetsByUser retorna a série mais pesada por dia (no fuso loc), levantamento básico e
+// número de repetições, a partir das tags dos exercícios.
+func (r *SetRecordRepository) GetBigLiftSetsByUser(ctx context.Context, userID uuid.UUID, start, end time.Time, loc *time.Location) ([]ports.BigLiftSetRow, error) {
+	rows, err := r.q.GetBigLiftSetsByUser(ctx, queries.GetBigLiftSetsByUserParams{
+		UserID:      userID,
+		StartedAt:   start,
+		StartedAt_2: end,
+		Timezone:    loc.String(),
+	})
+	if err != nil {
+		return nil, err
+	}
+	result := make([]ports.BigLiftSetRow, 0, len(rows))
+	for _, row := range rows {
+		result = append(result, ports.BigLiftSetRow{
+			Date:   row.Date,
+			Lift:   vos.BigLift(row.Lift),
+			Reps:   int(row.Reps),
+			Weight: int(row.Weight),
+		})
+	}
+	return result, nil
+}
+
+// GetExerciseSetSummariesByUser retorna carga máxima e volume por sessão, exercício e número de
+// repetições, com o dia de cada sessão no fuso loc.
+func (r *SetRecordRepository) GetExerciseSetSummariesByUser(ctx context.Context, userID uuid.UUID, start, end time.Time, loc *time.Location) ([]ports.ExerciseSetSummaryRow, error) {
+	rows, err := r.q.GetExerciseSetSummariesByUser(ctx, queries.GetExerciseSetSummariesByUserParams{
+		UserID:      userID,
+		StartedAt:   start,
+		StartedAt_2: end,
+		Timezone:    loc.String(),
+	})
+	if err != nil {
+		return nil, err
+	}
+	result := make([]ports.ExerciseSetSummaryRow, 0, len(rows))
+	for _, row := range rows {
+		result = append(result, ports.ExerciseSetSummaryRow{
+			SessionID:    row.SessionID,
+			Date:         row.Date,
+			StartedAt:    row.StartedAt,
+			ExerciseID:   row.ExerciseID,
+			ExerciseName: row.ExerciseName,
+			Reps:         int(row.Reps),
+			MaxWeight:    int(row.MaxWeight),
+			Volume:       row.Volume,
+		})
+	}
+	return result, nil
+}
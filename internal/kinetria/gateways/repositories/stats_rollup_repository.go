@@ -0,0 +1,58 @@
+package repositories
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/kinetria/kinetria-back/internal/kinetria/gateways/repositories/queries"
+)
+
+// StatsRollupRepository implements ports.StatsRollupRepository over the user_daily_stats and
+// user_weekly_stats tables.
+type StatsRollupRepository struct {
+	db *sql.DB
+	q  *queries.Queries
+}
+
+// NewStatsRollupRepository creates a new StatsRollupRepository.
+func NewStatsRollupRepository(db *sql.DB) *StatsRollupRepository {
+	return &StatsRollupRepository{db: db, q: queries.New(txDB{db})}
+}
+
+// RefreshDay recomputes the daily rollup of the day containing at and the weekly rollup of its week.
+func (r *StatsRollupRepository) RefreshDay(ctx context.Context, userID uuid.UUID, at time.Time) error {
+	return r.rebuild(ctx, userID, sql.NullTime{Time: at, Valid: true})
+}
+
+// RebuildUser recomputes all daily and weekly rollups of the user.
+func (r *StatsRollupRepository) RebuildUser(ctx context.Context, userID uuid.UUID) error {
+	return r.rebuild(ctx, userID, sql.NullTime{})
+}
+
+// ListUserIDs returns the IDs of all users.
+func (r *StatsRollupRepository) ListUserIDs(ctx context.Context) ([]uuid.UUID, error) {
+	return r.q.ListUserIDs(ctx)
+}
+
+// rebuild replaces the rollups of the day/week containing at (all of them when at is null)
+// in a single transaction (or the one already in ctx); the weekly rows are summed from the fresh daily rows.
+func (r *StatsRollupRepository) rebuild(ctx context.Context, userID uuid.UUID, at sql.NullTime) error {
+	return inTx(ctx, r.db, func(ctx context.Context) error {
+		if err := r.q.DeleteUserDailyStats(ctx, queries.DeleteUserDailyStatsParams{UserID: userID, At: at}); err != nil {
+			return fmt.Errorf("failed to delete daily stats: %w", err)
+		}
+		if err := r.q.InsertUserDailyStats(ctx, queries.InsertUserDailyStatsParams{UserID: userID, At: at}); err != nil {
+			return fmt.Errorf("failed to insert daily stats: %w", err)
+		}
+		if err := r.q.DeleteUserWeeklyStats(ctx, queries.DeleteUserWeeklyStatsParams{UserID: userID, At: at}); err != nil {
+			return fmt.Errorf("failed to delete weekly stats: %w", err)
+		}
+		if err := r.q.InsertUserWeeklyStats(ctx, queries.InsertUserWeeklyStatsParams{UserID: userID, At: at}); err != nil {
+			return fmt.Errorf("failed to insert weekly stats: %w", err)
+		}
+		return nil
+	})
+}
@@ -0,0 +1,62 @@
+package repositories
+
+import (
+	"context"
+	"database/sql"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/kinetria/kinetria-back/internal/kinetria/domain/ports"
+	"github.com/kinetria/kinetria-back/internal/kinetria/gateways/repositories/queries"
+)
+
+// StreakRepository implements ports.StreakRepository using PostgreSQL via SQLC.
+// Activity days come from the daily stats rollups, already bucketed in the user's timezone.
+type StreakRepository struct {
+	q *queries.Queries
+}
+
+// NewStreakRepository creates a new StreakRepository.
+func NewStreakRepository(db *sql.DB) *StreakRepository {
+	return &StreakRepository{q: queries.New(txDB{db})}
+}
+
+// ListActivityDays returns every day with completed sessions, oldest first.
+func (r *StreakRepository) ListActivityDays(ctx context.Context, userID uuid.UUID) ([]ports.StreakDay, error) {
+	rows, err := r.q.ListStreakActivityDays(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+	result := make([]ports.StreakDay, 0, len(rows))
+	for _, row := range rows {
+		result = append(result, ports.StreakDay{Day: row.Day, Sessions: int(row.Sessions)})
+	}
+	return result, nil
+}
+
+// ListRestDays returns the planned rest days between from and to (inclusive), oldest first.
+func (r *StreakRepository) ListRestDays(ctx context.Context, userID uuid.UUID, from, to time.Time) ([]time.Time, error) {
+	return r.q.ListPlannedRestDays(ctx, queries.ListPlannedRestDaysParams{
+		UserID:  userID,
+		FromDay: from,
+		ToDay:   to,
+	})
+}
+
+// AddRestDay plans a rest day; returns false if the day was already planned.
+func (r *StreakRepository) AddRestDay(ctx context.Context, userID uuid.UUID, day time.Time) (bool, error) {
+	n, err := r.q.CreatePlannedRestDay(ctx, queries.CreatePlannedRestDayParams{UserID: userID, Day: day})
+	if err != nil {
+		return false, err
+	}
+	return n > 0, nil
+}
+
+// DeleteRestDay removes a planned rest day; returns false if it was not planned.
+func (r *StreakRepository) DeleteRestDay(ctx context.Context, userID uuid.UUID, day time.Time) (bool, error) {
+	n, err := r.q.DeletePlannedRestDay(ctx, queries.DeletePlannedRestDayParams{UserID: userID, Day: day})
+	if err != nil {
+		return false, err
+	}
+	return n > 0, nil
+}
@@ -0,0 +1,121 @@
+package repositories
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/kinetria/kinetria-back/internal/kinetria/domain/entities"
+	"github.com/kinetria/kinetria-back/internal/kinetria/domain/ports"
+	"github.com/kinetria/kinetria-back/internal/kinetria/domain/vos"
+	"github.com/kinetria/kinetria-back/internal/kinetria/gateways/repositories/queries"
+)
+
+// SyncRepository implements ports.SyncRepository using PostgreSQL via SQLC.
+type SyncRepository struct {
+	q *queries.Queries
+}
+
+// NewSyncRepository creates a new SyncRepository.
+func NewSyncRepository(db *sql.DB) *SyncRepository {
+	return &SyncRepository{q: queries.New(txDB{db})}
+}
+
+// FindOperation returns the stored outcome of an operation, or (nil, nil) if it was never applied.
+func (r *SyncRepository) FindOperation(ctx context.Context, userID, operationID uuid.UUID) (*ports.SyncOperationRecord, error) {
+	row, err := r.q.FindSyncOperation(ctx, queries.FindSyncOperationParams{UserID: userID, ID: operationID})
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, nil
+		}
+		return nil, err
+	}
+	return &ports.SyncOperationRecord{
+		ID:        row.ID,
+		UserID:    row.UserID,
+		Type:      vos.SyncOperationType(row.Type),
+		EntityID:  row.EntityID,
+		Status:    vos.SyncOperationStatus(row.Status),
+		Code:      row.Code,
+		Message:   row.Message,
+		AppliedAt: row.AppliedAt,
+	}, nil
+}
+
+// SaveOperation stores the outcome of an operation. It returns false, keeping the stored
+// outcome, if the operation was already stored.
+func (r *SyncRepository) SaveOperation(ctx context.Context, record *ports.SyncOperationRecord) (bool, error) {
+	rows, err := r.q.CreateSyncOperation(ctx, queries.CreateSyncOperationParams{
+		UserID:    record.UserID,
+		ID:        record.ID,
+		Type:      record.Type.String(),
+		EntityID:  record.EntityID,
+		Status:    record.Status.String(),
+		Code:      record.Code,
+		Message:   record.Message,
+		AppliedAt: record.AppliedAt,
+	})
+	if err != nil {
+		return false, err
+	}
+	return rows > 0, nil
+}
+
+// ListSessionChanges returns up to limit sessions of the user changed after the cursor, with
+// their sets, in feed order.
+func (r *SyncRepository) ListSessionChanges(ctx context.Context, userID uuid.UUID, after ports.SyncCursor, limit int) ([]ports.SessionChange, error) {
+	rows, err := r.q.ListSessionChanges(ctx, queries.ListSessionChangesParams{
+		UserID:    userID,
+		ChangeSeq: after.ChangeSeq,
+		Limit:     int32(limit),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	changes := make([]ports.SessionChange, 0, len(rows))
+	for _, row := range rows {
+		var finishedAt *time.Time
+		if row.FinishedAt.Valid {
+			finishedAt = &row.FinishedAt.Time
+		}
+
+		setRows, err := r.q.ListSyncedSetsBySession(ctx, row.ID)
+		if err != nil {
+			return nil, err
+		}
+		sets := make([]ports.SyncedSet, 0, len(setRows))
+		for _, s := range setRows {
+			sets = append(sets, ports.SyncedSet{
+				ID:         s.ID,
+				ExerciseID: s.ExerciseID,
+				SetNumber:  int(s.SetNumber),
+				Weight:     int(s.Weight),
+				Reps:       int(s.Reps),
+				Status:     s.Status,
+				RecordedAt: s.RecordedAt,
+			})
+		}
+
+		changes = append(changes, ports.SessionChange{
+			Session: entities.Session{
+				ID:         row.ID,
+				UserID:     row.UserID,
+				WorkoutID:  row.WorkoutID,
+				Status:     vos.SessionStatus(row.Status),
+				Notes:      row.Notes,
+				StartedAt:  row.StartedAt,
+				FinishedAt: finishedAt,
+				CreatedAt:  row.CreatedAt,
+				UpdatedAt:  row.UpdatedAt,
+				Calories:   nullInt32ToIntPtr(row.CaloriesKcal),
+				RPE:        nullInt16ToIntPtr(row.SessionRpe),
+			},
+			Sets:   sets,
+			Cursor: ports.SyncCursor{ChangeSeq: row.ChangeSeq},
+		})
+	}
+	return changes, nil
+}
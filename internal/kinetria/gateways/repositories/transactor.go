@@ -0,0 +1,97 @@
+package repositories
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+
+	"github.com/kinetria/kinetria-back/internal/kinetria/gateways/repositories/queries"
+)
+
+// txKey is the context key of the transaction of a unit of work.
+type txKey struct{}
+
+// Transactor implements ports.Transactor: the transaction travels in the context and the
+// repositories, built over txDB, run their queries in it.
+type Transactor struct {
+	db *sql.DB
+}
+
+// NewTransactor creates a new Transactor.
+func NewTransactor(db *sql.DB) *Transactor {
+	return &Transactor{db: db}
+}
+
+// WithinTx runs fn in a transaction, committed if fn succeeds. Inside another unit of work
+// it runs in a savepoint of the enclosing transaction instead.
+func (t *Transactor) WithinTx(ctx context.Context, fn func(ctx context.Context) error) error {
+	if tx, ok := ctx.Value(txKey{}).(*sql.Tx); ok {
+		return withinSavepoint(ctx, tx, fn)
+	}
+
+	tx, err := t.db.BeginTx(ctx, nil)
+	if err != nil {
+		return fmt.Errorf("failed to begin transaction: %w", err)
+	}
+	defer tx.Rollback()
+
+	if err := fn(context.WithValue(ctx, txKey{}, tx)); err != nil {
+		return err
+	}
+	return tx.Commit()
+}
+
+// withinSavepoint runs fn in a savepoint of tx, rolled back to if fn fails. Nested
+// savepoints can share the name: Postgres rolls back to and releases the latest one.
+func withinSavepoint(ctx context.Context, tx *sql.Tx, fn func(ctx context.Context) error) error {
+	if _, err := tx.ExecContext(ctx, "SAVEPOINT unit_of_work"); err != nil {
+		return fmt.Errorf("failed to create savepoint: %w", err)
+	}
+	if err := fn(ctx); err != nil {
+		if _, rbErr := tx.ExecContext(ctx, "ROLLBACK TO SAVEPOINT unit_of_work"); rbErr != nil {
+			return errors.Join(err, fmt.Errorf("failed to roll back to savepoint: %w", rbErr))
+		}
+		return err
+	}
+	if _, err := tx.ExecContext(ctx, "RELEASE SAVEPOINT unit_of_work"); err != nil {
+		return fmt.Errorf("failed to release savepoint: %w", err)
+	}
+	return nil
+}
+
+// txDB runs each query in the transaction of the context, if any, and on the pool otherwise.
+type txDB struct {
+	db *sql.DB
+}
+
+var _ queries.DBTX = txDB{}
+
+func (d txDB) conn(ctx context.Context) queries.DBTX {
+	if tx, ok := ctx.Value(txKey{}).(*sql.Tx); ok {
+		return tx
+	}
+	return d.db
+}
+
+func (d txDB) ExecContext(ctx context.Context, query string, args ...interface{}) (sql.Result, error) {
+	return d.conn(ctx).ExecContext(ctx, query, args...)
+}
+
+func (d txDB) PrepareContext(ctx context.Context, query string) (*sql.Stmt, error) {
+	return d.conn(ctx).PrepareContext(ctx, query)
+}
+
+func (d txDB) QueryContext(ctx context.Context, query string, args ...interface{}) (*sql.Rows, error) {
+	return d.conn(ctx).QueryContext(ctx, query, args...)
+}
+
+func (d txDB) QueryRowContext(ctx context.Context, query string, args ...interface{}) *sql.Row {
+	return d.conn(ctx).QueryRowContext(ctx, query, args...)
+}
+
+// inTx runs fn, the writes of a repository method, as a unit of work over db: in a
+// transaction of its own, or in a savepoint when the caller already runs one.
+func inTx(ctx context.Context, db *sql.DB, fn func(ctx context.Context) error) error {
+	return NewTransactor(db).WithinTx(ctx, fn)
+}
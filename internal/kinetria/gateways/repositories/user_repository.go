@@ -24,7 +24,7 @@ type UserRepository struct {
 
 // NewUserRepository creates a new UserRepository backed by the provided *sql.DB.
 func NewUserRepository(db *sql.DB) *UserRepository {
-	return &UserRepository{q: queries.New(db)}
+	return &UserRepository{q: queries.New(txDB{db})}
 }
 
 // Create inserts a new user into the database.
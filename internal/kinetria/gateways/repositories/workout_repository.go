@@ -21,7 +21,7 @@ type WorkoutRepository struct {
 
 // NewWorkoutRepository creates a new WorkoutRepository backed by the provided *sql.DB.
 func NewWorkoutRepository(db *sql.DB) *WorkoutRepository {
-	return &WorkoutRepository{q: queries.New(db), db: db}
+	return &WorkoutRepository{q: queries.New(txDB{db}), db: db}
 }
 
 // ExistsByIDAndUserID checks if a workout exists for the given ID and user ID.
@@ -33,18 +33,22 @@ func (r *WorkoutRepository) ExistsByIDAndUserID(ctx context.Context, workoutID,
 }
 
 // ListByUserID returns paginated workouts for a user.
-func (r *WorkoutRepository) ListByUserID(ctx context.Context, userID uuid.UUID, offset, limit int) ([]entities.Workout, int, error) {
+func (r *WorkoutRepository) ListByUserID(ctx context.Context, userID uuid.UUID, favoritesOnly bool, offset, limit int) ([]entities.Workout, int, error) {
 	// Count total workouts for the user
-	total, err := r.q.CountWorkoutsByUserID(ctx, userID)
+	total, err := r.q.CountWorkoutsByUserID(ctx, queries.CountWorkoutsByUserIDParams{
+		UserID:        userID,
+		FavoritesOnly: favoritesOnly,
+	})
 	if err != nil {
 		return nil, 0, err
 	}
 
 	// List workouts with pagination
 	sqlcWorkouts, err := r.q.ListWorkoutsByUserID(ctx, queries.ListWorkoutsByUserIDParams{
-		UserID: userID,
-		Limit:  int32(limit),
-		Offset: int32(offset),
+		UserID:        userID,
+		Limit:         int32(limit),
+		Offset:        int32(offset),
+		FavoritesOnly: favoritesOnly,
 	})
 	if err != nil {
 		return nil, 0, err
@@ -59,7 +63,7 @@ func (r *WorkoutRepository) ListByUserID(ctx context.Context, userID uuid.UUID,
 	return workouts, int(total), nil
 }
 
-// GetFirstByUserID retorna o primeiro workout do usuário (ordenado por created_at ASC).
+// GetFirstByUserID retorna o primeiro workout do usuário: favoritos primeiro, depois por created_at ASC.
 // Retorna nil se o usuário não tiver workouts.
 func (r *WorkoutRepository) GetFirstByUserID(ctx context.Context, userID uuid.UUID) (*entities.Workout, error) {
 	row, err := r.q.GetFirstWorkoutByUserID(ctx, userID)
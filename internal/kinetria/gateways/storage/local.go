@@ -0,0 +1,95 @@
+package storage
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"os"
+	"path"
+	"path/filepath"
+	"strings"
+)
+
+// LocalStorage stores media files under a base directory.
+// It also implements http.Handler so the API can serve the stored files.
+type LocalStorage struct {
+	baseDir       string
+	publicBaseURL string
+	fileServer    http.Handler
+}
+
+// NewLocalStorage creates the base directory if needed and returns a LocalStorage.
+// publicBaseURL is the URL the files are served from (e.g. http://localhost:8080/api/v1/media).
+func NewLocalStorage(baseDir, publicBaseURL string) (*LocalStorage, error) {
+	if err := os.MkdirAll(baseDir, 0o755); err != nil {
+		return nil, fmt.Errorf("failed to create media directory: %w", err)
+	}
+	return &LocalStorage{
+		baseDir:       baseDir,
+		publicBaseURL: strings.TrimRight(publicBaseURL, "/"),
+		fileServer:    http.FileServer(filesOnly{http.Dir(baseDir)}),
+	}, nil
+}
+
+// Put writes data to baseDir/key and returns the public URL of the file.
+func (s *LocalStorage) Put(_ context.Context, key, _ string, data []byte) (string, error) {
+	p, err := s.pathFor(key)
+	if err != nil {
+		return "", err
+	}
+	if err := os.MkdirAll(filepath.Dir(p), 0o755); err != nil {
+		return "", fmt.Errorf("failed to create media directory: %w", err)
+	}
+	if err := os.WriteFile(p, data, 0o644); err != nil {
+		return "", fmt.Errorf("failed to write media file: %w", err)
+	}
+	return s.publicBaseURL + "/" + key, nil
+}
+
+// Delete removes baseDir/key. Missing files are ignored.
+func (s *LocalStorage) Delete(_ context.Context, key string) error {
+	p, err := s.pathFor(key)
+	if err != nil {
+		return err
+	}
+	if err := os.Remove(p); err != nil && !errors.Is(err, os.ErrNotExist) {
+		return fmt.Errorf("failed to delete media file: %w", err)
+	}
+	return nil
+}
+
+// ServeHTTP serves stored files; r.URL.Path must be the storage key. Directories are not
+// listed, so keys cannot be enumerated.
+func (s *LocalStorage) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	w.Header().Set("X-Content-Type-Options", "nosniff")
+	s.fileServer.ServeHTTP(w, r)
+}
+
+// pathFor maps a storage key to a file path, rejecting keys that escape baseDir.
+func (s *LocalStorage) pathFor(key string) (string, error) {
+	clean := path.Clean("/" + key)
+	if key == "" || clean != "/"+key {
+		return "", fmt.Errorf("invalid media key %q", key)
+	}
+	return filepath.Join(s.baseDir, filepath.FromSlash(clean)), nil
+}
+
+// filesOnly is an http.FileSystem that opens regular files only: directories are reported
+// as missing, which makes http.FileServer answer 404 instead of listing them.
+type filesOnly struct {
+	fs http.FileSystem
+}
+
+func (f filesOnly) Open(name string) (http.File, error) {
+	file, err := f.fs.Open(name)
+	if err != nil {
+		return nil, err
+	}
+	info, err := file.Stat()
+	if err != nil || info.IsDir() {
+		file.Close()
+		return nil, os.ErrNotExist
+	}
+	return file, nil
+}
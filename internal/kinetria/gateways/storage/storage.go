@@ -0,0 +1,37 @@
+// Package storage provides ports.MediaStorage adapters for uploaded media files.
+package storage
+
+import (
+	"fmt"
+
+	"github.com/kinetria/kinetria-back/internal/kinetria/domain/ports"
+	"github.com/kinetria/kinetria-back/internal/kinetria/gateways/config"
+)
+
+const (
+	// DriverLocal stores files on the local filesystem and serves them through the API.
+	DriverLocal = "local"
+	// DriverS3 stores files in a private S3-compatible bucket (AWS S3, MinIO, R2...) and
+	// serves them through the API.
+	DriverS3 = "s3"
+)
+
+// NewMediaStorage builds the media storage adapter selected by MEDIA_STORAGE_DRIVER.
+func NewMediaStorage(cfg config.Config) (ports.MediaStorage, error) {
+	switch cfg.MediaStorageDriver {
+	case DriverLocal:
+		return NewLocalStorage(cfg.MediaLocalDir, cfg.MediaPublicBaseURL)
+	case DriverS3:
+		return NewS3Storage(S3Config{
+			Endpoint:        cfg.S3Endpoint,
+			Region:          cfg.S3Region,
+			Bucket:          cfg.S3Bucket,
+			AccessKeyID:     cfg.S3AccessKeyID,
+			SecretAccessKey: cfg.S3SecretAccessKey,
+			ForcePathStyle:  cfg.S3ForcePathStyle,
+			PublicBaseURL:   cfg.MediaPublicBaseURL,
+		})
+	default:
+		return nil, fmt.Errorf("unknown media storage driver %q", cfg.MediaStorageDriver)
+	}
+}
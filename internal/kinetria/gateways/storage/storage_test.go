@@ -0,0 +1,177 @@
+package storage
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestLocalStorage_PutServeDelete(t *testing.T) {
+	dir := t.TempDir()
+	s, err := NewLocalStorage(dir, "http://localhost:8080/api/v1/media/")
+	if err != nil {
+		t.Fatalf("NewLocalStorage: %v", err)
+	}
+
+	url, err := s.Put(context.Background(), "users/u1/a.jpg", "image/jpeg", []byte("jpeg"))
+	if err != nil {
+		t.Fatalf("Put: %v", err)
+	}
+	if url != "http://localhost:8080/api/v1/media/users/u1/a.jpg" {
+		t.Errorf("unexpected url %q", url)
+	}
+
+	rec := httptest.NewRecorder()
+	s.ServeHTTP(rec, httptest.NewRequest(http.MethodGet, "/users/u1/a.jpg", nil))
+	if rec.Code != http.StatusOK || rec.Body.String() != "jpeg" {
+		t.Errorf("expected stored file to be served, got %d %q", rec.Code, rec.Body.String())
+	}
+
+	if err := s.Delete(context.Background(), "users/u1/a.jpg"); err != nil {
+		t.Fatalf("Delete: %v", err)
+	}
+	if _, err := os.Stat(filepath.Join(dir, "users", "u1", "a.jpg")); !os.IsNotExist(err) {
+		t.Errorf("expected file to be removed, stat err = %v", err)
+	}
+	if err := s.Delete(context.Background(), "users/u1/a.jpg"); err != nil {
+		t.Errorf("deleting a missing key should not fail: %v", err)
+	}
+}
+
+func TestLocalStorage_DoesNotListDirectories(t *testing.T) {
+	s, err := NewLocalStorage(t.TempDir(), "http://localhost/media")
+	if err != nil {
+		t.Fatalf("NewLocalStorage: %v", err)
+	}
+	if _, err := s.Put(context.Background(), "users/u1/a.jpg", "image/jpeg", []byte("jpeg")); err != nil {
+		t.Fatalf("Put: %v", err)
+	}
+	for _, p := range []string{"/", "/users", "/users/", "/users/u1/"} {
+		rec := httptest.NewRecorder()
+		s.ServeHTTP(rec, httptest.NewRequest(http.MethodGet, p, nil))
+		if rec.Code != http.StatusNotFound || strings.Contains(rec.Body.String(), "a.jpg") {
+			t.Errorf("GET %s: expected 404 without a listing, got %d %q", p, rec.Code, rec.Body.String())
+		}
+	}
+}
+
+func TestLocalStorage_RejectsEscapingKeys(t *testing.T) {
+	s, err := NewLocalStorage(t.TempDir(), "http://localhost/media")
+	if err != nil {
+		t.Fatalf("NewLocalStorage: %v", err)
+	}
+	for _, key := range []string{"../etc/passwd", "users/../../x", "", "/abs"} {
+		if _, err := s.Put(context.Background(), key, "image/jpeg", []byte("x")); err == nil {
+			t.Errorf("expected key %q to be rejected", key)
+		}
+	}
+}
+
+func TestS3Storage_PutSignsPathStyleRequest(t *testing.T) {
+	var gotMethod, gotPath, gotAuth, gotSHA, gotBody string
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		gotMethod, gotPath = r.Method, r.URL.Path
+		gotAuth = r.Header.Get("Authorization")
+		gotSHA = r.Header.Get("X-Amz-Content-Sha256")
+		b, _ := io.ReadAll(r.Body)
+		gotBody = string(b)
+		w.WriteHeader(http.StatusOK)
+	}))
+	defer srv.Close()
+
+	s, err := NewS3Storage(S3Config{
+		Endpoint:        srv.URL,
+		Region:          "us-east-1",
+		Bucket:          "media",
+		AccessKeyID:     "AKIDEXAMPLE",
+		SecretAccessKey: "secret",
+		ForcePathStyle:  true,
+		PublicBaseURL:   "http://localhost:8080/api/v1/media/",
+	})
+	if err != nil {
+		t.Fatalf("NewS3Storage: %v", err)
+	}
+	s.now = func() time.Time { return time.Date(2024, 3, 1, 12, 0, 0, 0, time.UTC) }
+
+	url, err := s.Put(context.Background(), "workouts/w1/a.png", "image/png", []byte("png"))
+	if err != nil {
+		t.Fatalf("Put: %v", err)
+	}
+
+	if url != "http://localhost:8080/api/v1/media/workouts/w1/a.png" {
+		t.Errorf("unexpected url %q", url)
+	}
+	if gotMethod != http.MethodPut || gotPath != "/media/workouts/w1/a.png" || gotBody != "png" {
+		t.Errorf("unexpected request %s %s %q", gotMethod, gotPath, gotBody)
+	}
+	if gotSHA != sha256Hex([]byte("png")) {
+		t.Errorf("unexpected payload hash %q", gotSHA)
+	}
+	wantPrefix := "AWS4-HMAC-SHA256 Credential=AKIDEXAMPLE/20240301/us-east-1/s3/aws4_request, SignedHeaders=content-type;host;x-amz-content-sha256;x-amz-date, Signature="
+	if !strings.HasPrefix(gotAuth, wantPrefix) {
+		t.Errorf("unexpected Authorization header %q", gotAuth)
+	}
+}
+
+func TestS3Storage_PutReturnsErrorOnFailureStatus(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		http.Error(w, "AccessDenied", http.StatusForbidden)
+	}))
+	defer srv.Close()
+
+	s, err := NewS3Storage(S3Config{Endpoint: srv.URL, Bucket: "media", AccessKeyID: "a", SecretAccessKey: "b", ForcePathStyle: true, PublicBaseURL: "http://api/media"})
+	if err != nil {
+		t.Fatalf("NewS3Storage: %v", err)
+	}
+	if _, err := s.Put(context.Background(), "k", "image/png", []byte("x")); err == nil || !strings.Contains(err.Error(), "403") {
+		t.Errorf("expected 403 error, got %v", err)
+	}
+}
+
+func TestS3Storage_ServeHTTPProxiesSignedReads(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if !strings.HasPrefix(r.Header.Get("Authorization"), "AWS4-HMAC-SHA256 ") {
+			http.Error(w, "AccessDenied", http.StatusForbidden)
+			return
+		}
+		if r.URL.Path != "/media/exercises/e1/v.mp4" {
+			http.Error(w, "AccessDenied", http.StatusForbidden)
+			return
+		}
+		if r.Header.Get("Range") != "bytes=0-1" {
+			t.Errorf("expected the Range header to be forwarded, got %q", r.Header.Get("Range"))
+		}
+		w.Header().Set("Content-Type", "video/mp4")
+		w.Header().Set("Content-Range", "bytes 0-1/4")
+		w.WriteHeader(http.StatusPartialContent)
+		_, _ = w.Write([]byte("mp"))
+	}))
+	defer srv.Close()
+
+	s, err := NewS3Storage(S3Config{Endpoint: srv.URL, Bucket: "media", AccessKeyID: "a", SecretAccessKey: "b", ForcePathStyle: true, PublicBaseURL: "http://api/media"})
+	if err != nil {
+		t.Fatalf("NewS3Storage: %v", err)
+	}
+
+	req := httptest.NewRequest(http.MethodGet, "/exercises/e1/v.mp4", nil)
+	req.Header.Set("Range", "bytes=0-1")
+	rec := httptest.NewRecorder()
+	s.ServeHTTP(rec, req)
+	if rec.Code != http.StatusPartialContent || rec.Body.String() != "mp" || rec.Header().Get("Content-Type") != "video/mp4" {
+		t.Errorf("expected the object to be proxied, got %d %q %v", rec.Code, rec.Body.String(), rec.Header())
+	}
+
+	for _, p := range []string{"/exercises/e1/missing.mp4", "/", "/exercises/", "/exercises/../x"} {
+		rec := httptest.NewRecorder()
+		s.ServeHTTP(rec, httptest.NewRequest(http.MethodGet, p, nil))
+		if rec.Code != http.StatusNotFound {
+			t.Errorf("GET %s: expected 404, got %d", p, rec.Code)
+		}
+	}
+}
@@ -146,7 +146,7 @@ func TestSessionsEndpoints(t *testing.T) {
 		payload := map[string]interface{}{
 			"exerciseId": exerciseID.String(),
 			"setNumber":  1,
-			"weight":     80000,
+			"weight":     80,
 			"reps":       10,
 			"status":     "completed",
 		}
@@ -168,14 +168,21 @@ func TestSessionsEndpoints(t *testing.T) {
 		assert.NotEmpty(t, data["id"])
 		assert.Equal(t, sessionID, data["sessionId"])
 		assert.Equal(t, float64(1), data["setNumber"])
+		assert.Equal(t, float64(80), data["weight"])
+		assert.Equal(t, "kg", data["weightUnit"])
 		assert.Equal(t, "completed", data["status"])
+
+		var storedGrams int
+		err = ts.DB.QueryRow(`SELECT weight FROM set_records WHERE id = $1`, data["id"]).Scan(&storedGrams)
+		require.NoError(t, err)
+		assert.Equal(t, 80000, storedGrams)
 	})
 
 	t.Run("Record set with invalid session ID", func(t *testing.T) {
 		payload := map[string]interface{}{
 			"exerciseId": exerciseID.String(),
 			"setNumber":  1,
-			"weight":     80000,
+			"weight":     80,
 			"reps":       10,
 			"status":     "completed",
 		}
@@ -213,6 +220,7 @@ func TestSessionsEndpoints(t *testing.T) {
 		assert.Equal(t, sessionID, data["id"])
 		assert.Equal(t, "completed", data["status"])
 		assert.NotEmpty(t, data["finishedAt"])
+		assert.Contains(t, data, "calories")
 	})
 
 	t.Run("Finish session already closed", func(t *testing.T) {
@@ -12,17 +12,24 @@ import (
 	"github.com/go-chi/chi/v5"
 	"github.com/go-playground/validator/v10"
 	_ "github.com/jackc/pgx/v5/stdlib"
+	domainachievements "github.com/kinetria/kinetria-back/internal/kinetria/domain/achievements"
 	domainauth "github.com/kinetria/kinetria-back/internal/kinetria/domain/auth"
 	domaindashboard "github.com/kinetria/kinetria-back/internal/kinetria/domain/dashboard"
 	domainexercises "github.com/kinetria/kinetria-back/internal/kinetria/domain/exercises"
+	domaingoals "github.com/kinetria/kinetria-back/internal/kinetria/domain/goals"
+	domainmeasurements "github.com/kinetria/kinetria-back/internal/kinetria/domain/measurements"
+	domainmedia "github.com/kinetria/kinetria-back/internal/kinetria/domain/media"
 	domainprofile "github.com/kinetria/kinetria-back/internal/kinetria/domain/profile"
+	domainreadiness "github.com/kinetria/kinetria-back/internal/kinetria/domain/readiness"
 	domainsessions "github.com/kinetria/kinetria-back/internal/kinetria/domain/sessions"
 	domainstatistics "github.com/kinetria/kinetria-back/internal/kinetria/domain/statistics"
+	domainstreaks "github.com/kinetria/kinetria-back/internal/kinetria/domain/streaks"
 	domainworkouts "github.com/kinetria/kinetria-back/internal/kinetria/domain/workouts"
 	gatewayauth "github.com/kinetria/kinetria-back/internal/kinetria/gateways/auth"
 	"github.com/kinetria/kinetria-back/internal/kinetria/gateways/config"
 	service "github.com/kinetria/kinetria-back/internal/kinetria/gateways/http"
 	"github.com/kinetria/kinetria-back/internal/kinetria/gateways/repositories"
+	"github.com/kinetria/kinetria-back/internal/kinetria/gateways/storage"
 	"github.com/stretchr/testify/require"
 	"github.com/testcontainers/testcontainers-go"
 	"github.com/testcontainers/testcontainers-go/modules/postgres"
@@ -83,50 +90,119 @@ func SetupTestServer(t *testing.T) *TestServer {
 	setRecordRepo := repositories.NewSetRecordRepository(db)
 	refreshTokenRepo := repositories.NewRefreshTokenRepository(db)
 	auditLogRepo := repositories.NewAuditLogRepository(db)
+	mediaRepo := repositories.NewMediaRepository(db)
+	favoriteRepo := repositories.NewFavoriteRepository(db)
+	measurementRepo := repositories.NewBodyMeasurementRepository(db)
+	statsRollupRepo := repositories.NewStatsRollupRepository(db)
+	goalRepo := repositories.NewGoalRepository(db)
+	achievementRepo := repositories.NewAchievementRepository(db)
+	streakRepo := repositories.NewStreakRepository(db)
+	readinessRepo := repositories.NewReadinessRepository(db)
+	syncRepo := repositories.NewSyncRepository(db)
+	idempotencyRepo := repositories.NewIdempotencyRepository(db)
+	transactor := repositories.NewTransactor(db)
+
+	mediaStorage, err := storage.NewLocalStorage(t.TempDir(), "http://localhost/api/v1/media")
+	require.NoError(t, err)
 
 	registerUC := domainauth.NewRegisterUC(userRepo, refreshTokenRepo, jwtManager, cfg.JWTExpiry, 7*24*time.Hour)
 	loginUC := domainauth.NewLoginUC(userRepo, refreshTokenRepo, jwtManager, cfg.JWTExpiry, 7*24*time.Hour)
 	refreshTokenUC := domainauth.NewRefreshTokenUC(refreshTokenRepo, jwtManager, cfg.JWTExpiry, 7*24*time.Hour)
 	logoutUC := domainauth.NewLogoutUC(refreshTokenRepo)
 
-	startSessionUC := domainsessions.NewStartSessionUC(sessionRepo, workoutRepo, auditLogRepo)
-	recordSetUC := domainsessions.NewRecordSetUseCase(sessionRepo, setRecordRepo, exerciseRepo, auditLogRepo)
-	finishSessionUC := domainsessions.NewFinishSessionUseCase(sessionRepo, auditLogRepo)
-	abandonSessionUC := domainsessions.NewAbandonSessionUseCase(sessionRepo, auditLogRepo)
-
-	listWorkoutsUC := domainworkouts.NewListWorkoutsUC(workoutRepo)
-	getWorkoutUC := domainworkouts.NewGetWorkoutUC(workoutRepo)
+	getStreakUC := domainstreaks.NewGetStreakUC(streakRepo, userRepo)
+	evaluateAchievementsUC := domainachievements.NewEvaluateAchievementsUC(achievementRepo, getStreakUC, domainachievements.DefaultRules())
+	listAchievementsUC := domainachievements.NewListAchievementsUC(achievementRepo, getStreakUC, domainachievements.DefaultRules())
+	evaluateGoalsUC := domaingoals.NewEvaluateGoalsUC(goalRepo, userRepo, sessionRepo, setRecordRepo, measurementRepo, auditLogRepo)
+
+	checkInUC := domainreadiness.NewCheckInUC(readinessRepo, auditLogRepo)
+	listCheckInsUC := domainreadiness.NewListCheckInsUC(readinessRepo, userRepo)
+	startSessionUC := domainsessions.NewStartSessionUC(transactor, sessionRepo, workoutRepo, auditLogRepo, readinessRepo, checkInUC)
+	recordSetUC := domainsessions.NewRecordSetUseCase(transactor, sessionRepo, setRecordRepo, exerciseRepo, auditLogRepo, evaluateAchievementsUC)
+	finishSessionUC := domainsessions.NewFinishSessionUseCase(transactor, sessionRepo, sessionRepo, measurementRepo, statsRollupRepo, auditLogRepo, sessionRepo, setRecordRepo, evaluateAchievementsUC, evaluateGoalsUC)
+	getSessionSummaryUC := domainsessions.NewGetSessionSummaryUC(sessionRepo, sessionRepo, setRecordRepo)
+	getSessionFeedbackUC := domainsessions.NewGetSessionFeedbackUC(sessionRepo, sessionRepo)
+	updateSessionFeedbackUC := domainsessions.NewUpdateSessionFeedbackUC(sessionRepo, sessionRepo, auditLogRepo)
+	abandonSessionUC := domainsessions.NewAbandonSessionUseCase(transactor, sessionRepo, auditLogRepo)
+	logSessionUC := domainsessions.NewLogSessionUC(transactor, sessionRepo, workoutRepo, exerciseRepo, sessionRepo, measurementRepo, statsRollupRepo, auditLogRepo, sessionRepo, setRecordRepo, evaluateAchievementsUC, evaluateGoalsUC)
+	updateSessionUC := domainsessions.NewUpdateSessionUC(transactor, sessionRepo, sessionRepo, sessionRepo, measurementRepo, statsRollupRepo, auditLogRepo, evaluateAchievementsUC, evaluateGoalsUC)
+	syncSessionsUC := domainsessions.NewSyncSessionsUC(transactor, syncRepo, sessionRepo, startSessionUC, recordSetUC, finishSessionUC, abandonSessionUC)
+
+	listWorkoutsUC := domainworkouts.NewListWorkoutsUC(workoutRepo, favoriteRepo)
+	getWorkoutUC := domainworkouts.NewGetWorkoutUC(workoutRepo, favoriteRepo)
 	createWorkoutUC := domainworkouts.NewCreateWorkoutUC(workoutRepo, exerciseRepo)
 	updateWorkoutUC := domainworkouts.NewUpdateWorkoutUC(workoutRepo, exerciseRepo)
 	deleteWorkoutUC := domainworkouts.NewDeleteWorkoutUC(workoutRepo)
+	setWorkoutFavoriteUC := domainworkouts.NewSetWorkoutFavoriteUC(workoutRepo, favoriteRepo)
 
 	getUserProfileUC := domaindashboard.NewGetUserProfileUC(tracer, userRepo)
 	getTodayWorkoutUC := domaindashboard.NewGetTodayWorkoutUC(tracer, workoutRepo)
-	getWeekProgressUC := domaindashboard.NewGetWeekProgressUC(tracer, sessionRepo)
-	getWeekStatsUC := domaindashboard.NewGetWeekStatsUC(tracer, sessionRepo)
+	getWeekProgressUC := domaindashboard.NewGetWeekProgressUC(tracer, sessionRepo, userRepo)
+	getWeekStatsUC := domaindashboard.NewGetWeekStatsUC(tracer, sessionRepo, userRepo)
 
 	getProfileUC := domainprofile.NewGetProfileUC(tracer, userRepo)
-	updateProfileUC := domainprofile.NewUpdateProfileUC(tracer, userRepo)
+	updateProfileUC := domainprofile.NewUpdateProfileUC(tracer, transactor, userRepo, statsRollupRepo)
 
-	listExercisesUC := domainexercises.NewListExercisesUC(exerciseRepo)
-	getExerciseUC := domainexercises.NewGetExerciseUC(exerciseRepo)
+	listExercisesUC := domainexercises.NewListExercisesUC(exerciseRepo, favoriteRepo)
+	getExerciseUC := domainexercises.NewGetExerciseUC(exerciseRepo, favoriteRepo)
 	getExerciseHistoryUC := domainexercises.NewGetExerciseHistoryUC(exerciseRepo)
-
-	getOverviewUC := domainstatistics.NewGetOverviewUC(sessionRepo, setRecordRepo)
-	getProgressionUC := domainstatistics.NewGetProgressionUC(setRecordRepo)
+	getRecentExercisesUC := domainexercises.NewGetRecentExercisesUC(exerciseRepo, favoriteRepo)
+	setExerciseFavoriteUC := domainexercises.NewSetExerciseFavoriteUC(exerciseRepo, favoriteRepo)
+	importExercisesUC := domainexercises.NewImportExercisesUC(exerciseRepo)
+	exportExercisesUC := domainexercises.NewExportExercisesUC(exerciseRepo)
+
+	listRestDaysUC := domainstreaks.NewListRestDaysUC(streakRepo, userRepo)
+	planRestDayUC := domainstreaks.NewPlanRestDayUC(streakRepo, userRepo)
+	deleteRestDayUC := domainstreaks.NewDeleteRestDayUC(streakRepo)
+	getOverviewUC := domainstatistics.NewGetOverviewUC(sessionRepo, setRecordRepo, userRepo, getStreakUC)
+	getProgressionUC := domainstatistics.NewGetProgressionUC(setRecordRepo, userRepo)
 	getPersonalRecordsUC := domainstatistics.NewGetPersonalRecordsUC(setRecordRepo)
-	getFrequencyUC := domainstatistics.NewGetFrequencyUC(sessionRepo)
+	getFrequencyUC := domainstatistics.NewGetFrequencyUC(sessionRepo, userRepo)
+	getMuscleVolumeUC := domainstatistics.NewGetMuscleVolumeUC(setRecordRepo, userRepo, domainstatistics.VolumeLandmarks{MinSets: 10, MaxSets: 20})
+	getRelativeStrengthUC := domainstatistics.NewGetRelativeStrengthUC(setRecordRepo, measurementRepo, userRepo)
+	getTrainingLoadUC := domainstatistics.NewGetTrainingLoadUC(sessionRepo, userRepo)
+	getProgressInsightsUC := domainstatistics.NewGetProgressInsightsUC(setRecordRepo, userRepo)
+	getHeatmapUC := domainstatistics.NewGetHeatmapUC(sessionRepo, userRepo)
+	getRecapUC := domainstatistics.NewGetRecapUC(sessionRepo, setRecordRepo, userRepo)
+	getWellnessUC := domainstatistics.NewGetWellnessUC(sessionRepo, userRepo)
+
+	createMeasurementUC := domainmeasurements.NewCreateMeasurementUC(measurementRepo, evaluateGoalsUC)
+	getMeasurementUC := domainmeasurements.NewGetMeasurementUC(measurementRepo)
+	listMeasurementsUC := domainmeasurements.NewListMeasurementsUC(measurementRepo)
+	updateMeasurementUC := domainmeasurements.NewUpdateMeasurementUC(measurementRepo, evaluateGoalsUC)
+	deleteMeasurementUC := domainmeasurements.NewDeleteMeasurementUC(measurementRepo)
+	getMeasurementTrendUC := domainmeasurements.NewGetMeasurementTrendUC(measurementRepo, userRepo)
+	getGoalWeightUC := domainmeasurements.NewGetGoalWeightUC(measurementRepo, measurementRepo)
+	setGoalWeightUC := domainmeasurements.NewSetGoalWeightUC(measurementRepo, measurementRepo, userRepo)
+	deleteGoalWeightUC := domainmeasurements.NewDeleteGoalWeightUC(measurementRepo)
+
+	createGoalUC := domaingoals.NewCreateGoalUC(goalRepo, userRepo, exerciseRepo, sessionRepo, setRecordRepo, measurementRepo, auditLogRepo)
+	getGoalUC := domaingoals.NewGetGoalUC(goalRepo, userRepo, sessionRepo, setRecordRepo, measurementRepo, auditLogRepo)
+	listGoalsUC := domaingoals.NewListGoalsUC(goalRepo, userRepo, sessionRepo, setRecordRepo, measurementRepo, auditLogRepo)
+	updateGoalUC := domaingoals.NewUpdateGoalUC(goalRepo, userRepo, sessionRepo, setRecordRepo, measurementRepo, auditLogRepo)
+	deleteGoalUC := domaingoals.NewDeleteGoalUC(goalRepo)
+
+	uploadMediaUC := domainmedia.NewUploadMediaUC(mediaStorage, mediaRepo, exerciseRepo, workoutRepo, domainmedia.UploadLimits{MaxImageBytes: 5 << 20, MaxVideoBytes: 50 << 20})
 
 	authHandler := service.NewAuthHandler(registerUC, loginUC, refreshTokenUC, logoutUC, jwtManager, validate)
-	sessionsHandler := service.NewSessionsHandler(startSessionUC, recordSetUC, finishSessionUC, abandonSessionUC)
-	workoutsHandler := service.NewWorkoutsHandler(listWorkoutsUC, getWorkoutUC, createWorkoutUC, updateWorkoutUC, deleteWorkoutUC, jwtManager)
-	dashboardHandler := service.NewDashboardHandler(getUserProfileUC, getTodayWorkoutUC, getWeekProgressUC, getWeekStatsUC)
+	sessionsHandler := service.NewSessionsHandler(startSessionUC, recordSetUC, finishSessionUC, abandonSessionUC, getSessionSummaryUC, getSessionFeedbackUC, updateSessionFeedbackUC, logSessionUC, updateSessionUC, getProfileUC)
+	workoutsHandler := service.NewWorkoutsHandler(listWorkoutsUC, getWorkoutUC, createWorkoutUC, updateWorkoutUC, deleteWorkoutUC, setWorkoutFavoriteUC, getProfileUC, jwtManager)
+	dashboardHandler := service.NewDashboardHandler(getUserProfileUC, getTodayWorkoutUC, getWeekProgressUC, getWeekStatsUC, getProgressInsightsUC, getStreakUC)
 	profileHandler := service.NewProfileHandler(getProfileUC, updateProfileUC)
-	exercisesHandler := service.NewExercisesHandler(listExercisesUC, getExerciseUC, getExerciseHistoryUC, jwtManager)
-	statisticsHandler := service.NewStatisticsHandler(getOverviewUC, getProgressionUC, getPersonalRecordsUC, getFrequencyUC)
+	exercisesHandler := service.NewExercisesHandler(listExercisesUC, getExerciseUC, getExerciseHistoryUC, getRecentExercisesUC, setExerciseFavoriteUC, getProfileUC, jwtManager)
+	statisticsHandler := service.NewStatisticsHandler(getOverviewUC, getProgressionUC, getPersonalRecordsUC, getFrequencyUC, getMuscleVolumeUC, getRelativeStrengthUC, getTrainingLoadUC, getProgressInsightsUC, getHeatmapUC, getRecapUC, getWellnessUC, getProfileUC)
+	mediaHandler := service.NewMediaHandler(uploadMediaUC, mediaStorage)
+	libraryHandler := service.NewExerciseLibraryHandler(importExercisesUC, exportExercisesUC, "test-admin-key")
+	measurementsHandler := service.NewMeasurementsHandler(createMeasurementUC, getMeasurementUC, listMeasurementsUC, updateMeasurementUC, deleteMeasurementUC, getMeasurementTrendUC, getGoalWeightUC, setGoalWeightUC, deleteGoalWeightUC, getProfileUC)
+	goalsHandler := service.NewGoalsHandler(createGoalUC, getGoalUC, listGoalsUC, updateGoalUC, deleteGoalUC, getProfileUC)
+	achievementsHandler := service.NewAchievementsHandler(listAchievementsUC, getProfileUC)
+	streaksHandler := service.NewStreaksHandler(getStreakUC, listRestDaysUC, planRestDayUC, deleteRestDayUC)
+	readinessHandler := service.NewReadinessHandler(checkInUC, listCheckInsUC)
+	syncHandler := service.NewSyncHandler(syncSessionsUC, getProfileUC)
+	idempotencyKeys := service.NewIdempotencyKeys(idempotencyRepo, 24*time.Hour)
 
 	router := chi.NewRouter()
-	serviceRouter := service.NewServiceRouter(authHandler, sessionsHandler, workoutsHandler, dashboardHandler, profileHandler, exercisesHandler, statisticsHandler, jwtManager)
+	serviceRouter := service.NewServiceRouter(authHandler, sessionsHandler, workoutsHandler, dashboardHandler, profileHandler, exercisesHandler, statisticsHandler, mediaHandler, libraryHandler, measurementsHandler, goalsHandler, achievementsHandler, streaksHandler, readinessHandler, syncHandler, idempotencyKeys, jwtManager)
 	router.Route(serviceRouter.Pattern(), serviceRouter.Router)
 
 	httpServer := httptest.NewServer(router)
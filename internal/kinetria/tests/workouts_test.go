@@ -180,7 +180,8 @@ func TestWorkoutsEndpoints(t *testing.T) {
 		assert.Equal(t, float64(4), ex1["sets"])
 		assert.Equal(t, "8-12", ex1["reps"])
 		assert.Equal(t, float64(90), ex1["restTime"])
-		assert.Equal(t, float64(80000), ex1["weight"])
+		assert.Equal(t, float64(80), ex1["weight"])
+		assert.Equal(t, "kg", data["weightUnit"])
 		assert.Contains(t, ex1["muscles"], "Peito")
 	})
 